@@ -0,0 +1,531 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// defaultDynamicFieldName is the name of the dynamic field when the schema does not declare one.
+const defaultDynamicFieldName = "$meta"
+
+// buffer accumulates validated rows in column layout until they are persisted into one file.
+type buffer struct {
+	// fields which values shall be provided in data files,
+	// auto-id primary key, function output and dynamic fields are excluded
+	fields       []*entity.Field
+	dynamicField string
+	// fields which values are generated by milvus and must not be provided
+	generated map[string]struct{}
+
+	columns map[string][]any
+	dynamic []map[string]any
+	rowNum  int
+	size    int64
+}
+
+func newBuffer(schema *entity.Schema) (*buffer, error) {
+	if schema == nil {
+		return nil, errors.New("collection schema is nil")
+	}
+	if !lo.ContainsBy(schema.Fields, func(f *entity.Field) bool { return f.PrimaryKey }) {
+		return nil, errors.New("primary key field not found in collection schema")
+	}
+
+	outputFields := make(map[string]struct{})
+	for _, fn := range schema.Functions {
+		for _, name := range fn.OutputFieldNames {
+			outputFields[name] = struct{}{}
+		}
+	}
+
+	b := &buffer{
+		generated: make(map[string]struct{}),
+		columns:   make(map[string][]any),
+	}
+	for _, field := range schema.Fields {
+		if field.IsDynamic {
+			b.dynamicField = field.Name
+			continue
+		}
+		if field.PrimaryKey && (field.AutoID || schema.AutoID) {
+			b.generated[field.Name] = struct{}{}
+			continue
+		}
+		if _, ok := outputFields[field.Name]; ok {
+			b.generated[field.Name] = struct{}{}
+			continue
+		}
+		if err := checkFieldSchema(field); err != nil {
+			return nil, err
+		}
+		b.fields = append(b.fields, field)
+		b.columns[field.Name] = make([]any, 0)
+	}
+	if b.dynamicField == "" && schema.EnableDynamicField {
+		b.dynamicField = defaultDynamicFieldName
+	}
+	return b, nil
+}
+
+func checkFieldSchema(field *entity.Field) error {
+	switch field.DataType {
+	case entity.FieldTypeFloatVector, entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector,
+		entity.FieldTypeBFloat16Vector, entity.FieldTypeInt8Vector:
+		if _, err := field.GetDim(); err != nil {
+			return errors.Wrapf(err, "invalid dim of field %s", field.Name)
+		}
+	case entity.FieldTypeArray:
+		switch field.ElementType {
+		case entity.FieldTypeBool, entity.FieldTypeInt8, entity.FieldTypeInt16, entity.FieldTypeInt32,
+			entity.FieldTypeInt64, entity.FieldTypeFloat, entity.FieldTypeDouble, entity.FieldTypeVarChar:
+		default:
+			return errors.Newf("unsupported element type %s of array field %s", field.ElementType.Name(), field.Name)
+		}
+	case entity.FieldTypeBool, entity.FieldTypeInt8, entity.FieldTypeInt16, entity.FieldTypeInt32, entity.FieldTypeInt64,
+		entity.FieldTypeFloat, entity.FieldTypeDouble, entity.FieldTypeString, entity.FieldTypeVarChar,
+		entity.FieldTypeJSON, entity.FieldTypeSparseVector:
+	default:
+		return errors.Newf("unsupported data type %s of field %s", field.DataType.Name(), field.Name)
+	}
+	return nil
+}
+
+func (b *buffer) hasDynamic() bool {
+	return b.dynamicField != ""
+}
+
+// normalizedRow is a row validated against the schema, ready to be appended.
+type normalizedRow struct {
+	values  map[string]any
+	dynamic map[string]any
+	size    int64
+}
+
+// appendRow validates the row against the schema and appends the normalized values.
+func (b *buffer) appendRow(row map[string]any) error {
+	r, err := b.normalizeRow(row)
+	if err != nil {
+		return err
+	}
+	b.appendNormalized(r)
+	return nil
+}
+
+// normalizeRow validates the row against the schema without changing the buffer.
+func (b *buffer) normalizeRow(row map[string]any) (*normalizedRow, error) {
+	values := make(map[string]any, len(b.fields))
+	var size int64
+	for _, field := range b.fields {
+		raw, ok := row[field.Name]
+		if !ok || raw == nil {
+			if field.Nullable || field.DefaultValue != nil {
+				values[field.Name] = nil
+				continue
+			}
+			return nil, errors.Newf("value of field %s is not provided", field.Name)
+		}
+		v, err := normalizeValue(field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[field.Name] = v
+		size += valueSize(v)
+	}
+
+	var dynamicValues map[string]any
+	if b.hasDynamic() {
+		dynamicValues = make(map[string]any)
+	}
+	for key, raw := range row {
+		if _, ok := values[key]; ok {
+			continue
+		}
+		if _, ok := b.generated[key]; ok {
+			return nil, errors.Newf("field %s is generated by milvus, no need to provide", key)
+		}
+		if !b.hasDynamic() {
+			return nil, errors.Newf("field %s is not defined in the collection schema", key)
+		}
+		if key == b.dynamicField {
+			m, err := toDynamicMap(raw)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range m {
+				dynamicValues[k] = v
+			}
+			continue
+		}
+		dynamicValues[key] = raw
+	}
+	if b.hasDynamic() {
+		bs, err := json.Marshal(dynamicValues)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal dynamic values")
+		}
+		size += int64(len(bs))
+	}
+	return &normalizedRow{values: values, dynamic: dynamicValues, size: size}, nil
+}
+
+func (b *buffer) appendNormalized(r *normalizedRow) {
+	if b.hasDynamic() {
+		b.dynamic = append(b.dynamic, r.dynamic)
+	}
+	for name, v := range r.values {
+		b.columns[name] = append(b.columns[name], v)
+	}
+	b.rowNum++
+	b.size += r.size
+}
+
+func toDynamicMap(raw any) (map[string]any, error) {
+	var bs []byte
+	switch v := raw.(type) {
+	case map[string]any:
+		return v, nil
+	case string:
+		bs = []byte(v)
+	case []byte:
+		bs = v
+	default:
+		return nil, errors.Newf("illegal value of dynamic field, expect a JSON object but got %T", raw)
+	}
+	m := make(map[string]any)
+	if err := json.Unmarshal(bs, &m); err != nil {
+		return nil, errors.Wrap(err, "illegal value of dynamic field, not a JSON object")
+	}
+	return m, nil
+}
+
+func (b *buffer) reset() {
+	for _, field := range b.fields {
+		b.columns[field.Name] = make([]any, 0)
+	}
+	b.dynamic = nil
+	b.rowNum = 0
+	b.size = 0
+}
+
+// normalizeValue checks the input value against the field schema and converts it
+// into the canonical Go type used by persisters:
+// bool, int8, int16, int32, int64, float32, float64, string, []any(array), []float32,
+// []byte(json, binary/float16/bfloat16 vector), []int8 and entity.SparseEmbedding.
+func normalizeValue(field *entity.Field, raw any) (any, error) {
+	switch field.DataType {
+	case entity.FieldTypeFloatVector:
+		var vec []float32
+		switch v := raw.(type) {
+		case []float32:
+			vec = v
+		case entity.FloatVector:
+			vec = v
+		case []float64:
+			vec = lo.Map(v, func(f float64, _ int) float32 { return float32(f) })
+		default:
+			return nil, wrapTypeErr(field, raw)
+		}
+		return vec, checkDim(field, len(vec))
+	case entity.FieldTypeBinaryVector:
+		var vec []byte
+		switch v := raw.(type) {
+		case []byte:
+			vec = v
+		case entity.BinaryVector:
+			vec = v
+		default:
+			return nil, wrapTypeErr(field, raw)
+		}
+		return vec, checkDim(field, len(vec)*8)
+	case entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		var vec []byte
+		switch v := raw.(type) {
+		case []byte:
+			vec = v
+		case entity.Float16Vector:
+			vec = v
+		case entity.BFloat16Vector:
+			vec = v
+		case []float32:
+			if field.DataType == entity.FieldTypeFloat16Vector {
+				vec = entity.FloatVector(v).ToFloat16Vector()
+			} else {
+				vec = entity.FloatVector(v).ToBFloat16Vector()
+			}
+		default:
+			return nil, wrapTypeErr(field, raw)
+		}
+		if len(vec)%2 != 0 {
+			return nil, errors.Newf("illegal byte length %d of field %s", len(vec), field.Name)
+		}
+		return vec, checkDim(field, len(vec)/2)
+	case entity.FieldTypeInt8Vector:
+		var vec []int8
+		switch v := raw.(type) {
+		case []int8:
+			vec = v
+		case entity.Int8Vector:
+			vec = v
+		default:
+			return nil, wrapTypeErr(field, raw)
+		}
+		return vec, checkDim(field, len(vec))
+	case entity.FieldTypeSparseVector:
+		switch v := raw.(type) {
+		case entity.SparseEmbedding:
+			return v, nil
+		case map[uint32]float32:
+			positions := lo.Keys(v)
+			values := lo.Map(positions, func(pos uint32, _ int) float32 { return v[pos] })
+			return entity.NewSliceSparseEmbedding(positions, values)
+		default:
+			return nil, wrapTypeErr(field, raw)
+		}
+	case entity.FieldTypeJSON:
+		var bs []byte
+		switch v := raw.(type) {
+		case []byte:
+			bs = v
+		case string:
+			bs = []byte(v)
+		default:
+			var err error
+			if bs, err = json.Marshal(v); err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal value of JSON field %s", field.Name)
+			}
+		}
+		if !json.Valid(bs) {
+			return nil, errors.Newf("value of field %s is not a valid JSON", field.Name)
+		}
+		return bs, nil
+	case entity.FieldTypeArray:
+		arr, err := toAnySlice(raw)
+		if err != nil {
+			return nil, wrapTypeErr(field, raw)
+		}
+		if maxCap, ok := getTypeParamInt(field, entity.TypeParamMaxCapacity); ok && int64(len(arr)) > maxCap {
+			return nil, errors.Newf("array length %d of field %s exceeds max capacity %d", len(arr), field.Name, maxCap)
+		}
+		elemField := &entity.Field{Name: field.Name, DataType: field.ElementType, TypeParams: field.TypeParams}
+		result := make([]any, 0, len(arr))
+		for _, elem := range arr {
+			v, err := normalizeScalar(elemField, elem)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	default:
+		return normalizeScalar(field, raw)
+	}
+}
+
+func normalizeScalar(field *entity.Field, raw any) (any, error) {
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		v, ok := raw.(bool)
+		if !ok {
+			return nil, wrapTypeErr(field, raw)
+		}
+		return v, nil
+	case entity.FieldTypeInt8:
+		v, err := toInt64(field, raw, 8)
+		return int8(v), err
+	case entity.FieldTypeInt16:
+		v, err := toInt64(field, raw, 16)
+		return int16(v), err
+	case entity.FieldTypeInt32:
+		v, err := toInt64(field, raw, 32)
+		return int32(v), err
+	case entity.FieldTypeInt64:
+		return toInt64(field, raw, 64)
+	case entity.FieldTypeFloat:
+		v, err := toFloat64(field, raw)
+		return float32(v), err
+	case entity.FieldTypeDouble:
+		return toFloat64(field, raw)
+	case entity.FieldTypeVarChar, entity.FieldTypeString:
+		v, ok := raw.(string)
+		if !ok {
+			return nil, wrapTypeErr(field, raw)
+		}
+		if maxLen, ok := getTypeParamInt(field, entity.TypeParamMaxLength); ok && int64(len(v)) > maxLen {
+			return nil, errors.Newf("length %d of field %s exceeds max length %d", len(v), field.Name, maxLen)
+		}
+		return v, nil
+	default:
+		return nil, errors.Newf("unsupported data type %s of field %s", field.DataType.Name(), field.Name)
+	}
+}
+
+func toInt64(field *entity.Field, raw any, bitSize int) (int64, error) {
+	var v int64
+	switch n := raw.(type) {
+	case int:
+		v = int64(n)
+	case int8:
+		v = int64(n)
+	case int16:
+		v = int64(n)
+	case int32:
+		v = int64(n)
+	case int64:
+		v = n
+	case uint8:
+		v = int64(n)
+	case uint16:
+		v = int64(n)
+	case uint32:
+		v = int64(n)
+	case json.Number:
+		parsed, err := strconv.ParseInt(n.String(), 10, bitSize)
+		if err != nil {
+			return 0, errors.Wrapf(err, "illegal value of field %s", field.Name)
+		}
+		return parsed, nil
+	default:
+		return 0, wrapTypeErr(field, raw)
+	}
+	if bitSize < 64 {
+		limit := int64(1) << (bitSize - 1)
+		if v < -limit || v >= limit {
+			return 0, errors.Newf("value %d of field %s overflows %s", v, field.Name, field.DataType.Name())
+		}
+	}
+	return v, nil
+}
+
+func toFloat64(field *entity.Field, raw any) (float64, error) {
+	switch n := raw.(type) {
+	case float32:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	case int:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil {
+			return 0, errors.Wrapf(err, "illegal value of field %s", field.Name)
+		}
+		return f, nil
+	default:
+		return 0, wrapTypeErr(field, raw)
+	}
+}
+
+func toAnySlice(raw any) ([]any, error) {
+	switch v := raw.(type) {
+	case []any:
+		return v, nil
+	case []bool:
+		return toAny(v), nil
+	case []int8:
+		return toAny(v), nil
+	case []int16:
+		return toAny(v), nil
+	case []int32:
+		return toAny(v), nil
+	case []int64:
+		return toAny(v), nil
+	case []int:
+		return toAny(v), nil
+	case []float32:
+		return toAny(v), nil
+	case []float64:
+		return toAny(v), nil
+	case []string:
+		return toAny(v), nil
+	default:
+		return nil, errors.Newf("unexpected array value type %T", raw)
+	}
+}
+
+func toAny[T any](values []T) []any {
+	return lo.Map(values, func(v T, _ int) any { return v })
+}
+
+func getTypeParamInt(field *entity.Field, key string) (int64, bool) {
+	str, ok := field.TypeParams[key]
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+func checkDim(field *entity.Field, dim int) error {
+	expect, err := field.GetDim()
+	if err != nil {
+		return err
+	}
+	if int64(dim) != expect {
+		return errors.Newf("dimension %d of field %s does not match schema dim %d", dim, field.Name, expect)
+	}
+	return nil
+}
+
+func wrapTypeErr(field *entity.Field, raw any) error {
+	return errors.Newf("unexpected value type %T for field %s of type %s", raw, field.Name, field.DataType.Name())
+}
+
+// valueSize returns the estimated in-file size of a normalized value.
+func valueSize(v any) int64 {
+	switch val := v.(type) {
+	case bool, int8:
+		return 1
+	case int16:
+		return 2
+	case int32, float32:
+		return 4
+	case int64, float64:
+		return 8
+	case string:
+		return int64(len(val))
+	case []byte:
+		return int64(len(val))
+	case []float32:
+		return int64(len(val) * 4)
+	case []int8:
+		return int64(len(val))
+	case entity.SparseEmbedding:
+		return int64(val.Len() * 8)
+	case []any:
+		var size int64
+		for _, elem := range val {
+			size += valueSize(elem)
+		}
+		return size
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,310 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+type BulkWriterSuite struct {
+	suite.Suite
+
+	schema *entity.Schema
+}
+
+func (s *BulkWriterSuite) SetupTest() {
+	s.schema = entity.NewSchema().WithName("bulk_writer").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(16)).
+		WithField(entity.NewField().WithName("score").WithDataType(entity.FieldTypeFloat).WithNullable(true)).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt32).WithMaxCapacity(4)).
+		WithField(entity.NewField().WithName("meta").WithDataType(entity.FieldTypeJSON)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(4)).
+		WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(2)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseVector))
+}
+
+func (s *BulkWriterSuite) genRow(i int) map[string]any {
+	return map[string]any{
+		"id":     int64(i),
+		"name":   fmt.Sprintf("name_%d", i),
+		"score":  float32(i) / 2,
+		"tags":   []int32{int32(i), int32(i + 1)},
+		"meta":   map[string]any{"i": i},
+		"vector": []float32{0.1, 0.2, 0.3, float32(i)},
+		"fp16":   []float32{1, 2},
+		"sparse": map[uint32]float32{uint32(i): 0.5},
+		"extra":  i,
+	}
+}
+
+func (s *BulkWriterSuite) TestAppendRowValidation() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	cases := []struct {
+		tag    string
+		modify func(row map[string]any)
+	}{
+		{"missing_field", func(row map[string]any) { delete(row, "name") }},
+		{"type_mismatch", func(row map[string]any) { row["id"] = "1" }},
+		{"exceed_max_length", func(row map[string]any) { row["name"] = "a_very_long_name_string" }},
+		{"exceed_max_capacity", func(row map[string]any) { row["tags"] = []int32{1, 2, 3, 4, 5} }},
+		{"array_elem_overflow", func(row map[string]any) { row["tags"] = []int64{1 << 40} }},
+		{"dim_mismatch", func(row map[string]any) { row["vector"] = []float32{0.1} }},
+		{"invalid_json", func(row map[string]any) { row["meta"] = "{" }},
+	}
+	for _, c := range cases {
+		s.Run(c.tag, func() {
+			row := s.genRow(1)
+			c.modify(row)
+			s.Error(w.AppendRow(ctx, row))
+		})
+	}
+	s.Equal(0, w.BufferRowCount())
+
+	s.Run("nullable", func() {
+		row := s.genRow(1)
+		row["score"] = nil
+		s.NoError(w.AppendRow(ctx, row))
+	})
+
+	s.Run("no_dynamic", func() {
+		schema := entity.NewSchema().WithName("no_dynamic").
+			WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+		w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(schema, s.T().TempDir()))
+		s.Require().NoError(err)
+		s.Error(w.AppendRow(ctx, map[string]any{"vector": []float32{1, 2}, "extra": 1}))
+		s.Error(w.AppendRow(ctx, map[string]any{"id": int64(1), "vector": []float32{1, 2}}))
+		s.NoError(w.AppendRow(ctx, map[string]any{"vector": []float32{1, 2}}))
+	})
+}
+
+func (s *BulkWriterSuite) TestLocalParquet() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithChunkSize(1024))
+	s.Require().NoError(err)
+
+	rowNum := 100
+	for i := 0; i < rowNum; i++ {
+		s.Require().NoError(w.AppendRow(ctx, s.genRow(i)))
+	}
+	s.Require().NoError(w.Commit(ctx))
+	s.EqualValues(rowNum, w.TotalRowCount())
+
+	batches := w.BatchFiles()
+	s.Greater(len(batches), 1)
+
+	readRows := int64(0)
+	for _, files := range batches {
+		s.Require().Len(files, 1)
+		f, err := os.Open(files[0])
+		s.Require().NoError(err)
+		reader, err := file.NewParquetReader(f)
+		s.Require().NoError(err)
+		fr, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		s.Require().NoError(err)
+		table, err := fr.ReadTable(ctx)
+		s.Require().NoError(err)
+
+		schema := table.Schema()
+		s.Equal([]string{"id", "name", "score", "tags", "meta", "vector", "fp16", "sparse", "$meta"},
+			func() []string {
+				names := make([]string, 0)
+				for _, field := range schema.Fields() {
+					names = append(names, field.Name)
+				}
+				return names
+			}())
+		readRows += table.NumRows()
+
+		meta := table.Column(8).Data().Chunk(0).(*array.String)
+		m := make(map[string]any)
+		s.NoError(json.Unmarshal([]byte(meta.Value(0)), &m))
+		s.Contains(m, "extra")
+		table.Release()
+		reader.Close()
+	}
+	s.EqualValues(rowNum, readRows)
+}
+
+func (s *BulkWriterSuite) TestLocalJSON() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithFileType(BulkFileTypeJSON))
+	s.Require().NoError(err)
+
+	ids := []int64{1, 2, 3}
+	vectors := [][]float32{{1, 2, 3, 4}, {1, 2, 3, 4}, {1, 2, 3, 4}}
+	sparse := make([]entity.SparseEmbedding, 0, len(ids))
+	for range ids {
+		se, err := entity.NewSliceSparseEmbedding([]uint32{1, 10}, []float32{0.1, 0.2})
+		s.Require().NoError(err)
+		sparse = append(sparse, se)
+	}
+	dynamic := column.NewColumnJSONBytes("$meta", [][]byte{[]byte(`{"a":1}`), []byte(`{"a":2}`), []byte(`{"a":3}`)}).WithIsDynamic(true)
+	err = w.AppendColumns(ctx,
+		column.NewColumnInt64("id", ids),
+		column.NewColumnVarChar("name", []string{"a", "b", "c"}),
+		column.NewColumnInt32Array("tags", [][]int32{{1}, {2}, {3}}),
+		column.NewColumnJSONBytes("meta", [][]byte{[]byte(`{}`), []byte(`[]`), []byte(`1`)}),
+		column.NewColumnFloatVector("vector", 4, vectors),
+		column.NewColumnFloat16VectorFromFp32Vector("fp16", 2, [][]float32{{1, 2}, {3, 4}, {5, 6}}),
+		column.NewColumnSparseVectors("sparse", sparse),
+		dynamic,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close(ctx))
+
+	batches := w.BatchFiles()
+	s.Require().Len(batches, 1)
+	bs, err := os.ReadFile(batches[0][0])
+	s.Require().NoError(err)
+
+	rows := make([]map[string]any, 0)
+	s.Require().NoError(json.Unmarshal(bs, &rows))
+	s.Require().Len(rows, 3)
+	s.NotContains(rows[0], "score")
+	s.Equal([]any{float64(1), float64(2)}, rows[0]["fp16"])
+	s.Equal(map[string]any{"indices": []any{float64(1), float64(10)}, "values": []any{0.1, 0.2}}, rows[0]["sparse"])
+	s.Equal(map[string]any{"a": float64(1)}, rows[0]["$meta"])
+
+	s.Error(w.AppendColumns(ctx, column.NewColumnInt64("id", ids), column.NewColumnVarChar("name", []string{"a"})))
+}
+
+func (s *BulkWriterSuite) TestAppendColumnsIllegalRow() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithFileType(BulkFileTypeJSON))
+	s.Require().NoError(err)
+
+	ids := []int64{1, 2, 3}
+	vectors := [][]float32{{1, 2, 3, 4}, {1, 2, 3, 4}, {1, 2, 3, 4}}
+	sparse := make([]entity.SparseEmbedding, 0, len(ids))
+	for range ids {
+		se, err := entity.NewSliceSparseEmbedding([]uint32{1}, []float32{0.1})
+		s.Require().NoError(err)
+		sparse = append(sparse, se)
+	}
+	columns := func(names []string) []column.Column {
+		return []column.Column{
+			column.NewColumnInt64("id", ids),
+			column.NewColumnVarChar("name", names),
+			column.NewColumnInt32Array("tags", [][]int32{{1}, {2}, {3}}),
+			column.NewColumnJSONBytes("meta", [][]byte{[]byte(`{}`), []byte(`{}`), []byte(`{}`)}),
+			column.NewColumnFloatVector("vector", 4, vectors),
+			column.NewColumnFloat16VectorFromFp32Vector("fp16", 2, [][]float32{{1, 2}, {3, 4}, {5, 6}}),
+			column.NewColumnSparseVectors("sparse", sparse),
+		}
+	}
+
+	// the name of row 1 exceeds the max length, none of the rows is appended
+	err = w.AppendColumns(ctx, columns([]string{"a", "name longer than max length", "c"})...)
+	s.Error(err)
+	s.Contains(err.Error(), "row 1")
+	s.Equal(0, w.BufferRowCount())
+	s.EqualValues(0, w.TotalRowCount())
+
+	s.Require().NoError(w.AppendColumns(ctx, columns([]string{"a", "b", "c"})...))
+	s.Equal(3, w.BufferRowCount())
+	s.EqualValues(3, w.TotalRowCount())
+}
+
+type mockUploader struct {
+	exist   bool
+	err     error
+	objects map[string]string
+}
+
+func (m *mockUploader) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	return m.exist, m.err
+}
+
+func (m *mockUploader) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	if m.err != nil {
+		return minio.UploadInfo{}, m.err
+	}
+	bs, err := os.ReadFile(filePath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	m.objects[objectName] = string(bs)
+	return minio.UploadInfo{Key: objectName}, nil
+}
+
+func (s *BulkWriterSuite) TestRemote() {
+	ctx := context.Background()
+	opt := NewRemoteBulkWriterOption(s.schema, "bulk_data", "localhost:9000", "a-bucket").
+		WithLocalPath(s.T().TempDir()).
+		WithFileType(BulkFileTypeJSON).
+		WithChunkSize(512)
+
+	s.Run("normal", func() {
+		uploader := &mockUploader{exist: true, objects: make(map[string]string)}
+		w, err := newRemoteBulkWriter(ctx, opt, uploader)
+		s.Require().NoError(err)
+		for i := 0; i < 10; i++ {
+			s.Require().NoError(w.AppendRow(ctx, s.genRow(i)))
+		}
+		s.Require().NoError(w.Close(ctx))
+
+		batches := w.BatchFiles()
+		s.Greater(len(batches), 1)
+		for _, files := range batches {
+			s.Contains(uploader.objects, files[0])
+		}
+		importOpt := w.NewBulkImportOption("http://localhost:19530")
+		s.Equal("bulk_writer", importOpt.CollectionName)
+		s.Equal(batches, importOpt.Files)
+
+		_, err = os.Stat(w.LocalBulkWriter.DataPath())
+		s.True(os.IsNotExist(err))
+	})
+
+	s.Run("bucket_not_exist", func() {
+		_, err := newRemoteBulkWriter(ctx, opt, &mockUploader{exist: false})
+		s.Error(err)
+	})
+
+	s.Run("upload_failed", func() {
+		uploader := &mockUploader{exist: true, objects: make(map[string]string)}
+		w, err := newRemoteBulkWriter(ctx, opt, uploader)
+		s.Require().NoError(err)
+		s.Require().NoError(w.AppendRow(ctx, s.genRow(1)))
+		uploader.err = errors.New("mocked")
+		s.Error(w.Commit(ctx))
+	})
+}
+
+func TestBulkWriter(t *testing.T) {
+	suite.Run(t, new(BulkWriterSuite))
+}
@@ -0,0 +1,240 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// LocalBulkWriter validates rows against a collection schema and writes them into
+// size-bounded files under a local directory, the files could be used by bulk import directly.
+//
+// Files of one writer are placed under `<localPath>/<uuid>/`, one file per batch.
+type LocalBulkWriter struct {
+	mut sync.Mutex
+
+	schema    *entity.Schema
+	fileType  BulkFileType
+	chunkSize int64
+	uuid      string
+	localPath string
+
+	buffer     *buffer
+	flushCount int
+	totalRows  int64
+	batchFiles [][]string
+
+	// callback invoked after each file persisted, used by RemoteBulkWriter to upload files
+	onFlush func(ctx context.Context, files []string) ([]string, error)
+}
+
+// NewLocalBulkWriter creates a LocalBulkWriter with provided option.
+func NewLocalBulkWriter(opt *LocalBulkWriterOption) (*LocalBulkWriter, error) {
+	if opt.fileType.Extension() == "" {
+		return nil, errors.Newf("unsupported bulk file type %d", opt.fileType)
+	}
+	if opt.chunkSize <= 0 {
+		return nil, errors.Newf("invalid chunk size %d", opt.chunkSize)
+	}
+	buf, err := newBuffer(opt.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	localPath := filepath.Join(opt.localPath, id)
+	if err := os.MkdirAll(localPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "failed to create local path %s", localPath)
+	}
+
+	return &LocalBulkWriter{
+		schema:    opt.schema,
+		fileType:  opt.fileType,
+		chunkSize: opt.chunkSize,
+		uuid:      id,
+		localPath: localPath,
+		buffer:    buf,
+	}, nil
+}
+
+// UUID returns the unique id of this writer, which is also the name of the output sub directory.
+func (w *LocalBulkWriter) UUID() string {
+	return w.uuid
+}
+
+// DataPath returns the local directory where files are written.
+func (w *LocalBulkWriter) DataPath() string {
+	return w.localPath
+}
+
+// BufferRowCount returns the number of rows not persisted yet.
+func (w *LocalBulkWriter) BufferRowCount() int {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.buffer.rowNum
+}
+
+// TotalRowCount returns the number of rows appended since the writer created.
+func (w *LocalBulkWriter) TotalRowCount() int64 {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.totalRows
+}
+
+// AppendRow validates the row and appends it into the buffer, the buffer is persisted
+// into a new file once its size exceeds the chunk size.
+// Keys not defined in schema are stored into the dynamic field if the schema enables it.
+func (w *LocalBulkWriter) AppendRow(ctx context.Context, row map[string]any) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if err := w.buffer.appendRow(row); err != nil {
+		return err
+	}
+	w.totalRows++
+	if w.buffer.size >= w.chunkSize {
+		return w.flush(ctx)
+	}
+	return nil
+}
+
+// AppendColumns appends column-based data, all columns must have the same length.
+// A JSON column flagged as dynamic is merged into the dynamic field of each row.
+// All the rows are validated before appended, none of them is appended if any row is illegal.
+func (w *LocalBulkWriter) AppendColumns(ctx context.Context, columns ...column.Column) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	rowNum := columns[0].Len()
+	for _, col := range columns {
+		if col.Len() != rowNum {
+			return errors.Newf("column %s length %d does not match other columns %d", col.Name(), col.Len(), rowNum)
+		}
+	}
+	dynamicColumns := make(map[int]bool)
+	for idx, col := range columns {
+		if col.Type() == entity.FieldTypeJSON && col.FieldData().GetIsDynamic() {
+			dynamicColumns[idx] = true
+		}
+	}
+
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	rows := make([]*normalizedRow, 0, rowNum)
+	for i := 0; i < rowNum; i++ {
+		row := make(map[string]any, len(columns))
+		for idx, col := range columns {
+			isNull, err := col.IsNull(i)
+			if err != nil {
+				return err
+			}
+			if isNull {
+				row[col.Name()] = nil
+				continue
+			}
+			v, err := col.Get(i)
+			if err != nil {
+				return err
+			}
+			if dynamicColumns[idx] {
+				m := make(map[string]any)
+				if err := json.Unmarshal(v.([]byte), &m); err != nil {
+					return errors.Wrapf(err, "illegal dynamic value at row %d", i)
+				}
+				for key, value := range m {
+					row[key] = value
+				}
+				continue
+			}
+			row[col.Name()] = v
+		}
+		r, err := w.buffer.normalizeRow(row)
+		if err != nil {
+			return errors.Wrapf(err, "row %d", i)
+		}
+		rows = append(rows, r)
+	}
+
+	for _, r := range rows {
+		w.buffer.appendNormalized(r)
+		w.totalRows++
+		if w.buffer.size >= w.chunkSize {
+			if err := w.flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Commit persists all the buffered rows.
+func (w *LocalBulkWriter) Commit(ctx context.Context) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.flush(ctx)
+}
+
+// BatchFiles returns the files persisted so far, each element is the file list of one batch.
+func (w *LocalBulkWriter) BatchFiles() [][]string {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	result := make([][]string, 0, len(w.batchFiles))
+	for _, files := range w.batchFiles {
+		result = append(result, append([]string{}, files...))
+	}
+	return result
+}
+
+// Close commits the remaining rows. Files persisted are kept.
+func (w *LocalBulkWriter) Close(ctx context.Context) error {
+	return w.Commit(ctx)
+}
+
+func (w *LocalBulkWriter) flush(ctx context.Context) error {
+	if w.buffer.rowNum == 0 {
+		return nil
+	}
+	w.flushCount++
+	path := filepath.Join(w.localPath, fmt.Sprintf("%d%s", w.flushCount, w.fileType.Extension()))
+	if err := w.buffer.persist(path, w.fileType); err != nil {
+		return errors.Wrapf(err, "failed to persist file %s", path)
+	}
+	w.buffer.reset()
+
+	files := []string{path}
+	if w.onFlush != nil {
+		var err error
+		files, err = w.onFlush(ctx, files)
+		if err != nil {
+			return err
+		}
+	}
+	w.batchFiles = append(w.batchFiles, files)
+	return nil
+}
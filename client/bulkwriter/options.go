@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// BulkFileType is the output file format of bulk writers.
+type BulkFileType int
+
+const (
+	// BulkFileTypeParquet writes parquet files, the recommended format for import.
+	BulkFileTypeParquet BulkFileType = iota + 1
+	// BulkFileTypeJSON writes JSON files containing a list of row objects.
+	BulkFileTypeJSON
+)
+
+// Extension returns the file extension of the file type, including the leading dot.
+func (t BulkFileType) Extension() string {
+	switch t {
+	case BulkFileTypeParquet:
+		return ".parquet"
+	case BulkFileTypeJSON:
+		return ".json"
+	default:
+		return ""
+	}
+}
+
+// String implements fmt.Stringer.
+func (t BulkFileType) String() string {
+	switch t {
+	case BulkFileTypeParquet:
+		return "PARQUET"
+	case BulkFileTypeJSON:
+		return "JSON"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+const (
+	mb = 1024 * 1024
+
+	// DefaultLocalChunkSize is the default size bound of files written by LocalBulkWriter.
+	DefaultLocalChunkSize int64 = 128 * mb
+	// DefaultRemoteChunkSize is the default size bound of files written by RemoteBulkWriter.
+	DefaultRemoteChunkSize int64 = 1024 * mb
+)
+
+// LocalBulkWriterOption is the option for LocalBulkWriter.
+type LocalBulkWriterOption struct {
+	schema    *entity.Schema
+	localPath string
+	fileType  BulkFileType
+	chunkSize int64
+}
+
+// NewLocalBulkWriterOption returns the option for LocalBulkWriter with parquet output
+// and DefaultLocalChunkSize.
+func NewLocalBulkWriterOption(schema *entity.Schema, localPath string) *LocalBulkWriterOption {
+	return &LocalBulkWriterOption{
+		schema:    schema,
+		localPath: localPath,
+		fileType:  BulkFileTypeParquet,
+		chunkSize: DefaultLocalChunkSize,
+	}
+}
+
+func (opt *LocalBulkWriterOption) WithFileType(fileType BulkFileType) *LocalBulkWriterOption {
+	opt.fileType = fileType
+	return opt
+}
+
+// WithChunkSize sets the approximate upper bound in bytes of each output file.
+func (opt *LocalBulkWriterOption) WithChunkSize(chunkSize int64) *LocalBulkWriterOption {
+	opt.chunkSize = chunkSize
+	return opt
+}
+
+// RemoteBulkWriterOption is the option for RemoteBulkWriter.
+type RemoteBulkWriterOption struct {
+	schema     *entity.Schema
+	remotePath string
+	fileType   BulkFileType
+	chunkSize  int64
+
+	// object storage params
+	endpoint        string
+	accessKeyID     string
+	secretAccessKey string
+	bucketName      string
+	useSSL          bool
+	region          string
+	useIAM          bool
+	// local directory used to stage files before uploading, system temp dir if empty
+	localPath string
+}
+
+// NewRemoteBulkWriterOption returns the option for RemoteBulkWriter, files will be uploaded
+// under `remotePath` of bucket `bucketName` in the S3-compatible storage at `endpoint`.
+func NewRemoteBulkWriterOption(schema *entity.Schema, remotePath string, endpoint string, bucketName string) *RemoteBulkWriterOption {
+	return &RemoteBulkWriterOption{
+		schema:     schema,
+		remotePath: remotePath,
+		fileType:   BulkFileTypeParquet,
+		chunkSize:  DefaultRemoteChunkSize,
+		endpoint:   endpoint,
+		bucketName: bucketName,
+	}
+}
+
+func (opt *RemoteBulkWriterOption) WithFileType(fileType BulkFileType) *RemoteBulkWriterOption {
+	opt.fileType = fileType
+	return opt
+}
+
+// WithChunkSize sets the approximate upper bound in bytes of each output file.
+func (opt *RemoteBulkWriterOption) WithChunkSize(chunkSize int64) *RemoteBulkWriterOption {
+	opt.chunkSize = chunkSize
+	return opt
+}
+
+// WithCredential sets the static access key pair of the object storage.
+func (opt *RemoteBulkWriterOption) WithCredential(accessKeyID, secretAccessKey string) *RemoteBulkWriterOption {
+	opt.accessKeyID = accessKeyID
+	opt.secretAccessKey = secretAccessKey
+	return opt
+}
+
+// WithIAM makes the writer fetch credentials from the IAM environment instead of static keys.
+func (opt *RemoteBulkWriterOption) WithIAM(useIAM bool) *RemoteBulkWriterOption {
+	opt.useIAM = useIAM
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithSSL(useSSL bool) *RemoteBulkWriterOption {
+	opt.useSSL = useSSL
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithRegion(region string) *RemoteBulkWriterOption {
+	opt.region = region
+	return opt
+}
+
+// WithLocalPath sets the local directory where files are staged before uploading.
+func (opt *RemoteBulkWriterOption) WithLocalPath(localPath string) *RemoteBulkWriterOption {
+	opt.localPath = localPath
+	return opt
+}
@@ -0,0 +1,297 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// persist writes the buffered rows into file at path with the given file type.
+func (b *buffer) persist(path string, fileType BulkFileType) error {
+	switch fileType {
+	case BulkFileTypeParquet:
+		return b.persistParquet(path)
+	case BulkFileTypeJSON:
+		return b.persistJSON(path)
+	default:
+		return errors.Newf("unsupported bulk file type %d", fileType)
+	}
+}
+
+// toArrowDataType returns the arrow type for a field, it matches the types accepted by the parquet import reader.
+func toArrowDataType(dataType entity.FieldType, elemType entity.FieldType) (arrow.DataType, error) {
+	switch dataType {
+	case entity.FieldTypeBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case entity.FieldTypeInt8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case entity.FieldTypeInt16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case entity.FieldTypeInt32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case entity.FieldTypeInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case entity.FieldTypeFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case entity.FieldTypeDouble:
+		return arrow.PrimitiveTypes.Float64, nil
+	case entity.FieldTypeString, entity.FieldTypeVarChar, entity.FieldTypeJSON, entity.FieldTypeSparseVector:
+		return arrow.BinaryTypes.String, nil
+	case entity.FieldTypeArray:
+		elem, err := toArrowDataType(elemType, entity.FieldTypeNone)
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOf(elem), nil
+	case entity.FieldTypeFloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Uint8), nil
+	case entity.FieldTypeInt8Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Int8), nil
+	default:
+		return nil, errors.Newf("unsupported data type %s", dataType.Name())
+	}
+}
+
+func (b *buffer) arrowSchema() (*arrow.Schema, error) {
+	fields := make([]arrow.Field, 0, len(b.fields)+1)
+	for _, field := range b.fields {
+		dt, err := toArrowDataType(field.DataType, field.ElementType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", field.Name)
+		}
+		fields = append(fields, arrow.Field{
+			Name:     field.Name,
+			Type:     dt,
+			Nullable: field.Nullable || field.DefaultValue != nil,
+		})
+	}
+	if b.hasDynamic() {
+		fields = append(fields, arrow.Field{
+			Name: b.dynamicField,
+			Type: arrow.BinaryTypes.String,
+		})
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+func (b *buffer) persistParquet(path string) error {
+	schema, err := b.arrowSchema()
+	if err != nil {
+		return err
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i, field := range b.fields {
+		fb := builder.Field(i)
+		for _, v := range b.columns[field.Name] {
+			if err := appendArrowValue(fb, v); err != nil {
+				return errors.Wrapf(err, "field %s", field.Name)
+			}
+		}
+	}
+	if b.hasDynamic() {
+		sb := builder.Field(len(b.fields)).(*array.StringBuilder)
+		for _, m := range b.dynamic {
+			bs, err := json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			sb.Append(string(bs))
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd))
+	fw, err := pqarrow.NewFileWriter(schema, f, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	if err := fw.Write(record); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+func appendArrowValue(fb array.Builder, v any) error {
+	if v == nil {
+		fb.AppendNull()
+		return nil
+	}
+	switch builder := fb.(type) {
+	case *array.BooleanBuilder:
+		builder.Append(v.(bool))
+	case *array.Int8Builder:
+		builder.Append(v.(int8))
+	case *array.Int16Builder:
+		builder.Append(v.(int16))
+	case *array.Int32Builder:
+		builder.Append(v.(int32))
+	case *array.Int64Builder:
+		builder.Append(v.(int64))
+	case *array.Float32Builder:
+		builder.Append(v.(float32))
+	case *array.Float64Builder:
+		builder.Append(v.(float64))
+	case *array.Uint8Builder:
+		builder.Append(v.(uint8))
+	case *array.StringBuilder:
+		switch val := v.(type) {
+		case string:
+			builder.Append(val)
+		case []byte:
+			builder.Append(string(val))
+		case entity.SparseEmbedding:
+			bs, err := json.Marshal(sparseToJSON(val))
+			if err != nil {
+				return err
+			}
+			builder.Append(string(bs))
+		default:
+			return errors.Newf("unexpected value type %T for string column", v)
+		}
+	case *array.ListBuilder:
+		builder.Append(true)
+		vb := builder.ValueBuilder()
+		switch val := v.(type) {
+		case []float32:
+			vb.(*array.Float32Builder).AppendValues(val, nil)
+		case []byte:
+			vb.(*array.Uint8Builder).AppendValues(val, nil)
+		case []int8:
+			vb.(*array.Int8Builder).AppendValues(val, nil)
+		case []any:
+			for _, elem := range val {
+				if err := appendArrowValue(vb, elem); err != nil {
+					return err
+				}
+			}
+		default:
+			return errors.Newf("unexpected value type %T for list column", v)
+		}
+	default:
+		return errors.Newf("unexpected arrow builder %T", fb)
+	}
+	return nil
+}
+
+// sparseJSON is the JSON layout of sparse vectors accepted by the import readers.
+type sparseJSON struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+func sparseToJSON(se entity.SparseEmbedding) sparseJSON {
+	result := sparseJSON{
+		Indices: make([]uint32, 0, se.Len()),
+		Values:  make([]float32, 0, se.Len()),
+	}
+	for i := 0; i < se.Len(); i++ {
+		pos, value, _ := se.Get(i)
+		result.Indices = append(result.Indices, pos)
+		result.Values = append(result.Values, value)
+	}
+	return result
+}
+
+func (b *buffer) persistJSON(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if _, err := w.WriteString("["); err != nil {
+		return err
+	}
+	for i := 0; i < b.rowNum; i++ {
+		row := make(map[string]any, len(b.fields)+1)
+		for _, field := range b.fields {
+			v := b.columns[field.Name][i]
+			if v == nil {
+				continue
+			}
+			row[field.Name] = toJSONValue(field.DataType, v)
+		}
+		if b.hasDynamic() {
+			row[b.dynamicField] = b.dynamic[i]
+		}
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := w.WriteString(",\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(bs); err != nil {
+			return err
+		}
+	}
+	if _, err := w.WriteString("]\n"); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// toJSONValue converts a normalized value into the layout accepted by the JSON import reader.
+func toJSONValue(dataType entity.FieldType, v any) any {
+	switch dataType {
+	case entity.FieldTypeJSON:
+		return json.RawMessage(v.([]byte))
+	case entity.FieldTypeFloat16Vector:
+		return []float32(entity.Float16Vector(v.([]byte)).ToFloat32Vector())
+	case entity.FieldTypeBFloat16Vector:
+		return []float32(entity.BFloat16Vector(v.([]byte)).ToFloat32Vector())
+	case entity.FieldTypeBinaryVector:
+		// []byte is marshaled as base64 string by default, the reader expects a list of uint8
+		bs := v.([]byte)
+		result := make([]int, len(bs))
+		for i, b := range bs {
+			result[i] = int(b)
+		}
+		return result
+	case entity.FieldTypeSparseVector:
+		return sparseToJSON(v.(entity.SparseEmbedding))
+	default:
+		return v
+	}
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// objectUploader is the subset of object storage client used by RemoteBulkWriter.
+type objectUploader interface {
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+}
+
+// RemoteBulkWriter writes files like LocalBulkWriter and uploads each of them
+// into S3-compatible object storage under `<remotePath>/<uuid>/` once persisted.
+// Local files are removed after uploaded.
+type RemoteBulkWriter struct {
+	*LocalBulkWriter
+
+	remotePath string
+	bucketName string
+	client     objectUploader
+}
+
+// NewRemoteBulkWriter creates a RemoteBulkWriter with provided option.
+func NewRemoteBulkWriter(ctx context.Context, opt *RemoteBulkWriterOption) (*RemoteBulkWriter, error) {
+	var creds *credentials.Credentials
+	if opt.useIAM {
+		creds = credentials.NewIAM("")
+	} else {
+		creds = credentials.NewStaticV4(opt.accessKeyID, opt.secretAccessKey, "")
+	}
+	client, err := minio.New(opt.endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: opt.useSSL,
+		Region: opt.region,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create object storage client")
+	}
+	return newRemoteBulkWriter(ctx, opt, client)
+}
+
+func newRemoteBulkWriter(ctx context.Context, opt *RemoteBulkWriterOption, client objectUploader) (*RemoteBulkWriter, error) {
+	exist, err := client.BucketExists(ctx, opt.bucketName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check bucket %s", opt.bucketName)
+	}
+	if !exist {
+		return nil, errors.Newf("bucket %s does not exist", opt.bucketName)
+	}
+
+	localPath := opt.localPath
+	if localPath == "" {
+		localPath = filepath.Join(os.TempDir(), "bulk_writer")
+	}
+	local, err := NewLocalBulkWriter(NewLocalBulkWriterOption(opt.schema, localPath).
+		WithFileType(opt.fileType).
+		WithChunkSize(opt.chunkSize))
+	if err != nil {
+		return nil, err
+	}
+
+	w := &RemoteBulkWriter{
+		LocalBulkWriter: local,
+		remotePath:      path.Join(opt.remotePath, local.UUID()),
+		bucketName:      opt.bucketName,
+		client:          client,
+	}
+	local.onFlush = w.upload
+	return w, nil
+}
+
+// DataPath returns the object key prefix where files are uploaded.
+func (w *RemoteBulkWriter) DataPath() string {
+	return w.remotePath
+}
+
+// Close commits the remaining rows and removes the local staging directory.
+func (w *RemoteBulkWriter) Close(ctx context.Context) error {
+	if err := w.Commit(ctx); err != nil {
+		return err
+	}
+	return os.RemoveAll(w.LocalBulkWriter.DataPath())
+}
+
+// NewBulkImportOption returns the option of BulkImport API for all the files uploaded so far.
+func (w *RemoteBulkWriter) NewBulkImportOption(uri string) *BulkImportOption {
+	return NewBulkImportOption(uri, w.schema.CollectionName, w.BatchFiles())
+}
+
+func (w *RemoteBulkWriter) upload(ctx context.Context, files []string) ([]string, error) {
+	objects := make([]string, 0, len(files))
+	for _, file := range files {
+		object := path.Join(w.remotePath, filepath.Base(file))
+		if _, err := w.client.FPutObject(ctx, w.bucketName, object, file, minio.PutObjectOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "failed to upload file %s to %s", file, object)
+		}
+		if err := os.Remove(file); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
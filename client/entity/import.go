@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entity
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+type ImportState commonpb.ImportState
+
+const (
+	ImportPending   ImportState = ImportState(commonpb.ImportState_ImportPending)
+	ImportStarted   ImportState = ImportState(commonpb.ImportState_ImportStarted)
+	ImportCompleted ImportState = ImportState(commonpb.ImportState_ImportCompleted)
+	ImportFailed    ImportState = ImportState(commonpb.ImportState_ImportFailed)
+)
+
+func (s ImportState) String() string {
+	return commonpb.ImportState(s).String()
+}
+
+// ImportJob is the state of an import job.
+type ImportJob struct {
+	JobID          int64
+	CollectionName string
+	State          ImportState
+	// Reason is the failed reason of the job
+	Reason string
+	// Progress is the percentage of the job, from 0 to 100
+	Progress     int64
+	ImportedRows int64
+	CreateTime   time.Time
+	// Files is the progress of each file, only returned by GetImportProgress
+	Files []*ImportFileProgress
+}
+
+// ImportFileProgress is the progress of a file in the import job.
+type ImportFileProgress struct {
+	FileName     string `json:"file_name"`
+	FileSize     int64  `json:"file_size"`
+	State        string `json:"state"`
+	Reason       string `json:"reason"`
+	Progress     int64  `json:"progress"`
+	CompleteTime string `json:"complete_time"`
+	ImportedRows int64  `json:"imported_rows"`
+	TotalRows    int64  `json:"total_rows"`
+}
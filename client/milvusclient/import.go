@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	importInfoFailedReason    = `failed_reason`
+	importInfoProgressPercent = `progress_percent`
+	importInfoCollectionName  = `collection_name`
+	importInfoFileProgresses  = `file_progresses`
+)
+
+// Import creates an import job to import the files into the collection, returns the job id.
+func (c *Client) Import(ctx context.Context, option ImportOption, callOptions ...grpc.CallOption) (int64, error) {
+	req := option.Request()
+
+	var jobID int64
+	err := c.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.Import(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		if len(resp.GetTasks()) == 0 {
+			return errors.New("import job id not returned")
+		}
+		jobID = resp.GetTasks()[0]
+		return nil
+	})
+	return jobID, err
+}
+
+// GetImportProgress returns the state of the import job, including the progress of each file.
+func (c *Client) GetImportProgress(ctx context.Context, option GetImportProgressOption, callOptions ...grpc.CallOption) (*entity.ImportJob, error) {
+	req := option.Request()
+
+	var job *entity.ImportJob
+	err := c.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.GetImportState(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		job, err = convertImportJob(resp)
+		if job != nil && job.JobID == 0 {
+			job.JobID = req.GetTask()
+		}
+		return err
+	})
+	return job, err
+}
+
+// ListImports lists the import jobs, the progress of each file is not returned.
+func (c *Client) ListImports(ctx context.Context, option ListImportsOption, callOptions ...grpc.CallOption) ([]*entity.ImportJob, error) {
+	req := option.Request()
+
+	var jobs []*entity.ImportJob
+	err := c.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.ListImportTasks(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		jobs = make([]*entity.ImportJob, 0, len(resp.GetTasks()))
+		for _, task := range resp.GetTasks() {
+			job, err := convertImportJob(task)
+			if err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+		}
+		return nil
+	})
+	return jobs, err
+}
+
+// WaitImport polls the progress of the import job until it's completed or failed.
+// If the job failed, the error contains the failed reason of the job and each failed file.
+func (c *Client) WaitImport(ctx context.Context, option GetImportProgressOption, callOptions ...grpc.CallOption) (*entity.ImportJob, error) {
+	ticker := time.NewTicker(option.CheckInterval())
+	defer ticker.Stop()
+	for {
+		job, err := c.GetImportProgress(ctx, option, callOptions...)
+		if err != nil {
+			return nil, err
+		}
+		switch job.State {
+		case entity.ImportCompleted:
+			return job, nil
+		case entity.ImportFailed:
+			return job, importFailedError(job)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return job, ctx.Err()
+		}
+	}
+}
+
+func importFailedError(job *entity.ImportJob) error {
+	reasons := lo.FilterMap(job.Files, func(file *entity.ImportFileProgress, _ int) (string, bool) {
+		return fmt.Sprintf("%s: %s", file.FileName, file.Reason), file.Reason != ""
+	})
+	if len(reasons) == 0 {
+		return errors.Newf("import job %d failed, reason: %s", job.JobID, job.Reason)
+	}
+	return errors.Newf("import job %d failed, reason: %s, failed files: [%s]", job.JobID, job.Reason, strings.Join(reasons, "; "))
+}
+
+func convertImportJob(resp *milvuspb.GetImportStateResponse) (*entity.ImportJob, error) {
+	job := &entity.ImportJob{
+		JobID:        resp.GetId(),
+		State:        entity.ImportState(resp.GetState()),
+		ImportedRows: resp.GetRowCount(),
+	}
+	if resp.GetCreateTs() > 0 {
+		job.CreateTime = time.Unix(resp.GetCreateTs(), 0)
+	}
+	for _, info := range resp.GetInfos() {
+		switch info.GetKey() {
+		case importInfoFailedReason:
+			job.Reason = info.GetValue()
+		case importInfoProgressPercent:
+			job.Progress, _ = strconv.ParseInt(info.GetValue(), 10, 64)
+		case importInfoCollectionName:
+			job.CollectionName = info.GetValue()
+		case importInfoFileProgresses:
+			if err := json.Unmarshal([]byte(info.GetValue()), &job.Files); err != nil {
+				return nil, errors.Wrap(err, "failed to parse file progresses of import job")
+			}
+		}
+	}
+	return job, nil
+}
@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+const (
+	importOptionTimeout  = `timeout`
+	importOptionBackup   = `backup`
+	importOptionL0Import = `l0_import`
+	// the file groups in json, since the import request has only a flat file list
+	importOptionFileGroups = `file_groups`
+)
+
+type ImportOption interface {
+	Request() *milvuspb.ImportRequest
+}
+
+type importOption struct {
+	collectionName string
+	partitionName  string
+	files          [][]string
+	options        map[string]string
+}
+
+func (opt *importOption) Request() *milvuspb.ImportRequest {
+	options := lo.Assign(opt.options)
+	var files []string
+	if len(opt.files) == 1 {
+		// a single group is sent as is, which is understood by all the server versions
+		files = opt.files[0]
+	} else {
+		files = lo.Flatten(opt.files)
+		groups, _ := json.Marshal(opt.files)
+		options[importOptionFileGroups] = string(groups)
+	}
+	return &milvuspb.ImportRequest{
+		CollectionName: opt.collectionName,
+		PartitionName:  opt.partitionName,
+		Files:          files,
+		Options:        entity.MapKvPairs(options),
+	}
+}
+
+func (opt *importOption) WithPartition(partitionName string) *importOption {
+	opt.partitionName = partitionName
+	return opt
+}
+
+// WithBackup marks the files are binlogs of a backup, the file groups are the insert and delta log prefixes.
+func (opt *importOption) WithBackup(backup bool) *importOption {
+	opt.options[importOptionBackup] = strconv.FormatBool(backup)
+	return opt
+}
+
+// WithL0Import imports the delta logs only, used with backup.
+func (opt *importOption) WithL0Import(l0Import bool) *importOption {
+	opt.options[importOptionL0Import] = strconv.FormatBool(l0Import)
+	return opt
+}
+
+// WithTimeout sets the timeout of the import job, the job fails if not finished in time.
+func (opt *importOption) WithTimeout(timeout time.Duration) *importOption {
+	opt.options[importOptionTimeout] = timeout.String()
+	return opt
+}
+
+func (opt *importOption) WithOption(key, value string) *importOption {
+	opt.options[key] = value
+	return opt
+}
+
+// NewImportOption creates the option to import files into a collection,
+// each file group is a row-based file (json, parquet or csv) or the numpy files of all the fields.
+func NewImportOption(collectionName string, files [][]string) *importOption {
+	return &importOption{
+		collectionName: collectionName,
+		files:          files,
+		options:        make(map[string]string),
+	}
+}
+
+type GetImportProgressOption interface {
+	Request() *milvuspb.GetImportStateRequest
+	CheckInterval() time.Duration
+}
+
+type getImportProgressOption struct {
+	jobID    int64
+	interval time.Duration
+}
+
+func (opt *getImportProgressOption) Request() *milvuspb.GetImportStateRequest {
+	return &milvuspb.GetImportStateRequest{
+		Task: opt.jobID,
+	}
+}
+
+func (opt *getImportProgressOption) CheckInterval() time.Duration {
+	return opt.interval
+}
+
+// WithCheckInterval sets the interval to poll the progress in `WaitImport`.
+func (opt *getImportProgressOption) WithCheckInterval(interval time.Duration) *getImportProgressOption {
+	opt.interval = interval
+	return opt
+}
+
+func NewGetImportProgressOption(jobID int64) *getImportProgressOption {
+	return &getImportProgressOption{
+		jobID:    jobID,
+		interval: time.Second,
+	}
+}
+
+type ListImportsOption interface {
+	Request() *milvuspb.ListImportTasksRequest
+}
+
+type listImportsOption struct {
+	collectionName string
+	limit          int64
+}
+
+func (opt *listImportsOption) Request() *milvuspb.ListImportTasksRequest {
+	return &milvuspb.ListImportTasksRequest{
+		CollectionName: opt.collectionName,
+		Limit:          opt.limit,
+	}
+}
+
+func (opt *listImportsOption) WithCollectionName(collectionName string) *listImportsOption {
+	opt.collectionName = collectionName
+	return opt
+}
+
+func (opt *listImportsOption) WithLimit(limit int64) *listImportsOption {
+	opt.limit = limit
+	return opt
+}
+
+func NewListImportsOption() *listImportsOption {
+	return &listImportsOption{}
+}
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type ImportSuite struct {
+	MockSuiteBase
+}
+
+func (s *ImportSuite) TestImport() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		partitionName := fmt.Sprintf("part_%s", s.randString(6))
+		s.mock.EXPECT().Import(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, ir *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+			s.Equal(collectionName, ir.GetCollectionName())
+			s.Equal(partitionName, ir.GetPartitionName())
+			s.Equal([]string{"a/id.npy", "a/vector.npy", "b.parquet"}, ir.GetFiles())
+			groups, err := funcutil.GetAttrByKeyFromRepeatedKV(importOptionFileGroups, ir.GetOptions())
+			s.NoError(err)
+			s.JSONEq(`[["a/id.npy", "a/vector.npy"], ["b.parquet"]]`, groups)
+			timeout, _ := funcutil.GetAttrByKeyFromRepeatedKV(importOptionTimeout, ir.GetOptions())
+			s.Equal("1m0s", timeout)
+			return &milvuspb.ImportResponse{Status: merr.Success(), Tasks: []int64{100}}, nil
+		}).Once()
+
+		jobID, err := s.client.Import(ctx, NewImportOption(collectionName, [][]string{{"a/id.npy", "a/vector.npy"}, {"b.parquet"}}).
+			WithPartition(partitionName).
+			WithTimeout(time.Minute))
+		s.NoError(err)
+		s.EqualValues(100, jobID)
+	})
+
+	s.Run("single_group", func() {
+		s.mock.EXPECT().Import(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, ir *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+			// the insert and delta log prefixes of a backup are one group
+			s.Equal([]string{"backup/insert_log/1/2/3", "backup/delta_log/1/2/3"}, ir.GetFiles())
+			backup, _ := funcutil.GetAttrByKeyFromRepeatedKV(importOptionBackup, ir.GetOptions())
+			s.Equal("true", backup)
+			_, err := funcutil.GetAttrByKeyFromRepeatedKV(importOptionFileGroups, ir.GetOptions())
+			s.Error(err)
+			return &milvuspb.ImportResponse{Status: merr.Success(), Tasks: []int64{101}}, nil
+		}).Once()
+
+		jobID, err := s.client.Import(ctx, NewImportOption("coll", [][]string{{"backup/insert_log/1/2/3", "backup/delta_log/1/2/3"}}).
+			WithBackup(true))
+		s.NoError(err)
+		s.EqualValues(101, jobID)
+	})
+
+	s.Run("failure", func() {
+		s.mock.EXPECT().Import(mock.Anything, mock.Anything).Return(nil, merr.WrapErrServiceInternal("mocked")).Once()
+
+		_, err := s.client.Import(ctx, NewImportOption("coll", [][]string{{"a.json"}}))
+		s.Error(err)
+	})
+}
+
+func (s *ImportSuite) TestGetImportProgress() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		s.mock.EXPECT().GetImportState(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, gr *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+			s.EqualValues(100, gr.GetTask())
+			return &milvuspb.GetImportStateResponse{
+				Status:   merr.Success(),
+				Id:       100,
+				State:    commonpb.ImportState_ImportStarted,
+				RowCount: 10,
+				CreateTs: 1000,
+				Infos: entity.MapKvPairs(map[string]string{
+					importInfoProgressPercent: "50",
+					importInfoCollectionName:  "coll",
+					importInfoFileProgresses:  `[{"file_name":"a.json","file_size":1024,"state":"InProgress","progress":50,"imported_rows":10,"total_rows":20}]`,
+				}),
+			}, nil
+		}).Once()
+
+		job, err := s.client.GetImportProgress(ctx, NewGetImportProgressOption(100))
+		s.Require().NoError(err)
+		s.EqualValues(100, job.JobID)
+		s.Equal(entity.ImportStarted, job.State)
+		s.Equal("coll", job.CollectionName)
+		s.EqualValues(50, job.Progress)
+		s.EqualValues(10, job.ImportedRows)
+		s.Equal(time.Unix(1000, 0), job.CreateTime)
+		s.Require().Len(job.Files, 1)
+		s.Equal("a.json", job.Files[0].FileName)
+		s.EqualValues(20, job.Files[0].TotalRows)
+	})
+
+	s.Run("bad_file_progresses", func() {
+		s.mock.EXPECT().GetImportState(mock.Anything, mock.Anything).Return(&milvuspb.GetImportStateResponse{
+			Status: merr.Success(),
+			Infos:  entity.MapKvPairs(map[string]string{importInfoFileProgresses: "{"}),
+		}, nil).Once()
+
+		_, err := s.client.GetImportProgress(ctx, NewGetImportProgressOption(100))
+		s.Error(err)
+	})
+}
+
+func (s *ImportSuite) TestListImports() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.mock.EXPECT().ListImportTasks(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, lr *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
+		s.Equal("coll", lr.GetCollectionName())
+		s.EqualValues(10, lr.GetLimit())
+		return &milvuspb.ListImportTasksResponse{
+			Status: merr.Success(),
+			Tasks: []*milvuspb.GetImportStateResponse{
+				{Id: 1, State: commonpb.ImportState_ImportCompleted},
+				{Id: 2, State: commonpb.ImportState_ImportFailed, Infos: entity.MapKvPairs(map[string]string{importInfoFailedReason: "mocked"})},
+			},
+		}, nil
+	}).Once()
+
+	jobs, err := s.client.ListImports(ctx, NewListImportsOption().WithCollectionName("coll").WithLimit(10))
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 2)
+	s.Equal(entity.ImportCompleted, jobs[0].State)
+	s.Equal(entity.ImportFailed, jobs[1].State)
+	s.Equal("mocked", jobs[1].Reason)
+}
+
+func (s *ImportSuite) TestWaitImport() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("completed", func() {
+		states := []commonpb.ImportState{commonpb.ImportState_ImportPending, commonpb.ImportState_ImportStarted, commonpb.ImportState_ImportCompleted}
+		call := 0
+		s.mock.EXPECT().GetImportState(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, gr *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+			state := states[call]
+			call++
+			return &milvuspb.GetImportStateResponse{Status: merr.Success(), Id: gr.GetTask(), State: state}, nil
+		}).Times(3)
+
+		job, err := s.client.WaitImport(ctx, NewGetImportProgressOption(100).WithCheckInterval(time.Millisecond))
+		s.NoError(err)
+		s.Equal(entity.ImportCompleted, job.State)
+	})
+
+	s.Run("failed", func() {
+		s.mock.EXPECT().GetImportState(mock.Anything, mock.Anything).Return(&milvuspb.GetImportStateResponse{
+			Status: merr.Success(),
+			Id:     100,
+			State:  commonpb.ImportState_ImportFailed,
+			Infos: entity.MapKvPairs(map[string]string{
+				importInfoFailedReason:   "job failed",
+				importInfoFileProgresses: `[{"file_name":"a.json","reason":"bad row"},{"file_name":"b.json"}]`,
+			}),
+		}, nil).Once()
+
+		job, err := s.client.WaitImport(ctx, NewGetImportProgressOption(100).WithCheckInterval(time.Millisecond))
+		s.Error(err)
+		s.Contains(err.Error(), "job failed")
+		s.Contains(err.Error(), "a.json: bad row")
+		s.NotContains(err.Error(), "b.json")
+		s.Equal(entity.ImportFailed, job.State)
+	})
+
+	s.Run("context_done", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		s.mock.EXPECT().GetImportState(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, gr *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+			cancel()
+			return &milvuspb.GetImportStateResponse{Status: merr.Success(), Id: gr.GetTask(), State: commonpb.ImportState_ImportStarted}, nil
+		}).Once()
+
+		_, err := s.client.WaitImport(ctx, NewGetImportProgressOption(100).WithCheckInterval(time.Minute))
+		s.Error(err)
+	})
+}
+
+func TestImport(t *testing.T) {
+	suite.Run(t, new(ImportSuite))
+}
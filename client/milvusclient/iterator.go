@@ -0,0 +1,311 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// SearchIterator iterates the search results of one vector batch by batch,
+// in the order of the scores, until all the matched entities or the iterator limit are returned.
+type SearchIterator interface {
+	// Next returns the next batch of the results, io.EOF is returned when the iteration is done.
+	Next(ctx context.Context) (ResultSet, error)
+}
+
+// QueryIterator iterates the entities matching the filter batch by batch, in the order of primary key.
+type QueryIterator interface {
+	// Next returns the next batch of entities, io.EOF is returned when the iteration is done.
+	Next(ctx context.Context) (ResultSet, error)
+	// Cursor returns the mvcc timestamp the iterator pinned and the last returned primary key,
+	// which could be used to resume the iteration with `WithMvccTimestamp` and `WithStartAfterPK`.
+	Cursor() (mvccTs uint64, lastPK any)
+}
+
+func (c *Client) SearchIterator(ctx context.Context, option SearchIteratorOption, callOptions ...grpc.CallOption) (SearchIterator, error) {
+	req, err := option.SearchIteratorRequest()
+	if err != nil {
+		return nil, err
+	}
+	collection, err := c.getCollection(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	return &searchIterator{
+		client:      c,
+		collection:  collection,
+		request:     req,
+		callOptions: callOptions,
+		batchSize:   option.BatchSize(),
+		limit:       option.IteratorLimit(),
+		mvccTs:      req.GetGuaranteeTimestamp(),
+	}, nil
+}
+
+func (c *Client) QueryIterator(ctx context.Context, option QueryIteratorOption, callOptions ...grpc.CallOption) (QueryIterator, error) {
+	req, err := option.QueryIteratorRequest()
+	if err != nil {
+		return nil, err
+	}
+	collection, err := c.getCollection(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	pkField := collection.Schema.PKField()
+	if pkField == nil {
+		return nil, errors.Newf("primary key not found in collection %s", req.GetCollectionName())
+	}
+	return &queryIterator{
+		client:      c,
+		collection:  collection,
+		pkField:     pkField,
+		request:     req,
+		callOptions: callOptions,
+		batchSize:   option.BatchSize(),
+		limit:       option.IteratorLimit(),
+		mvccTs:      req.GetGuaranteeTimestamp(),
+		lastPK:      option.StartAfterPK(),
+	}, nil
+}
+
+type searchIterator struct {
+	client      *Client
+	collection  *entity.Collection
+	request     *milvuspb.SearchRequest
+	callOptions []grpc.CallOption
+
+	batchSize int
+	limit     int64
+	returned  int64
+
+	mvccTs    uint64
+	token     string
+	lastBound *float32
+}
+
+func (it *searchIterator) Next(ctx context.Context) (ResultSet, error) {
+	batchSize := nextBatchSize(it.batchSize, it.limit, it.returned)
+	if batchSize == 0 {
+		return ResultSet{}, io.EOF
+	}
+
+	req := proto.Clone(it.request).(*milvuspb.SearchRequest)
+	params := map[string]string{
+		spTopK:                strconv.Itoa(batchSize),
+		spSearchIterBatchSize: strconv.Itoa(batchSize),
+		spCollectionID:        strconv.FormatInt(it.collection.ID, 10),
+	}
+	if it.token != "" {
+		params[spSearchIterID] = it.token
+	}
+	if it.lastBound != nil {
+		params[spSearchIterLastBound] = strconv.FormatFloat(float64(*it.lastBound), 'g', -1, 32)
+	}
+	req.SearchParams = setKvPairs(req.SearchParams, params)
+	req.GuaranteeTimestamp = it.mvccTs
+
+	var resultSet ResultSet
+	err := it.client.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.Search(ctx, req, it.callOptions...)
+		err = merr.CheckRPCCall(resp, err)
+		if err != nil {
+			return err
+		}
+		iterInfo := resp.GetResults().GetSearchIteratorV2Results()
+		if iterInfo == nil {
+			return errors.New("search iterator v2 is not supported by the server")
+		}
+		resultSets, err := it.client.handleSearchResult(it.collection.Schema, req.GetOutputFields(), 1, resp)
+		if err != nil {
+			return err
+		}
+		if len(resultSets) != 1 {
+			return errors.Newf("unexpected nq %d of search iterator result", len(resultSets))
+		}
+		if resultSets[0].Err != nil {
+			return resultSets[0].Err
+		}
+
+		resultSet = resultSets[0]
+		it.token = iterInfo.GetToken()
+		lastBound := iterInfo.GetLastBound()
+		it.lastBound = &lastBound
+		// pin the snapshot of the first batch
+		if it.mvccTs == 0 {
+			it.mvccTs = resp.GetSessionTs()
+		}
+		return nil
+	})
+	if err != nil {
+		return ResultSet{}, err
+	}
+	if resultSet.ResultCount == 0 {
+		return ResultSet{}, io.EOF
+	}
+	if resultSet.ResultCount > batchSize {
+		resultSet = resultSet.slice(0, batchSize)
+	}
+	it.returned += int64(resultSet.ResultCount)
+	return resultSet, nil
+}
+
+type queryIterator struct {
+	client      *Client
+	collection  *entity.Collection
+	pkField     *entity.Field
+	request     *milvuspb.QueryRequest
+	callOptions []grpc.CallOption
+
+	batchSize int
+	limit     int64
+	returned  int64
+
+	mvccTs uint64
+	lastPK any
+}
+
+func (it *queryIterator) Next(ctx context.Context) (ResultSet, error) {
+	batchSize := nextBatchSize(it.batchSize, it.limit, it.returned)
+	if batchSize == 0 {
+		return ResultSet{}, io.EOF
+	}
+
+	req := proto.Clone(it.request).(*milvuspb.QueryRequest)
+	req.Expr = it.composeExpr(req.GetExpr())
+	req.QueryParams = setKvPairs(req.QueryParams, map[string]string{
+		spLimit:        strconv.Itoa(batchSize),
+		spCollectionID: strconv.FormatInt(it.collection.ID, 10),
+	})
+	req.GuaranteeTimestamp = it.mvccTs
+
+	var resultSet ResultSet
+	err := it.client.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.Query(ctx, req, it.callOptions...)
+		err = merr.CheckRPCCall(resp, err)
+		if err != nil {
+			return err
+		}
+
+		columns, err := it.client.parseSearchResult(it.collection.Schema, resp.GetOutputFields(), resp.GetFieldsData(), 0, 0, -1)
+		if err != nil {
+			return err
+		}
+		resultSet = ResultSet{
+			sch:    it.collection.Schema,
+			Fields: columns,
+		}
+		if len(columns) > 0 {
+			resultSet.ResultCount = columns[0].Len()
+		}
+		// pin the snapshot of the first batch
+		if it.mvccTs == 0 {
+			it.mvccTs = resp.GetSessionTs()
+		}
+		return nil
+	})
+	if err != nil {
+		return ResultSet{}, err
+	}
+	if resultSet.ResultCount == 0 {
+		return ResultSet{}, io.EOF
+	}
+
+	pkColumn := resultSet.GetColumn(it.pkField.Name)
+	if pkColumn == nil {
+		return ResultSet{}, errors.Newf("primary key %s not found in query result", it.pkField.Name)
+	}
+	if it.lastPK, err = pkColumn.Get(resultSet.ResultCount - 1); err != nil {
+		return ResultSet{}, err
+	}
+	it.returned += int64(resultSet.ResultCount)
+	return resultSet, nil
+}
+
+func (it *queryIterator) Cursor() (uint64, any) {
+	return it.mvccTs, it.lastPK
+}
+
+// composeExpr appends the primary key range after the last returned entity to the filter.
+func (it *queryIterator) composeExpr(expr string) string {
+	var pkExpr string
+	switch pk := it.lastPK.(type) {
+	case int64:
+		pkExpr = fmt.Sprintf("%s > %d", it.pkField.Name, pk)
+	case string:
+		pkExpr = fmt.Sprintf("%s > %s", it.pkField.Name, strconv.Quote(pk))
+	default:
+		return expr
+	}
+	if expr == "" {
+		return pkExpr
+	}
+	return fmt.Sprintf("(%s) and %s", expr, pkExpr)
+}
+
+// nextBatchSize returns the entity count to fetch next, zero means the iterator limit is reached.
+func nextBatchSize(batchSize int, limit int64, returned int64) int {
+	if limit < 0 {
+		return batchSize
+	}
+	return int(min(int64(batchSize), limit-returned))
+}
+
+// setKvPairs overwrites the values of the existing keys and appends the others.
+func setKvPairs(pairs []*commonpb.KeyValuePair, kvs map[string]string) []*commonpb.KeyValuePair {
+	for _, pair := range pairs {
+		if value, ok := kvs[pair.GetKey()]; ok {
+			pair.Value = value
+			delete(kvs, pair.GetKey())
+		}
+	}
+	return append(pairs, entity.MapKvPairs(kvs)...)
+}
+
+func (rs ResultSet) slice(start, end int) ResultSet {
+	result := ResultSet{
+		sch:         rs.sch,
+		ResultCount: end - start,
+		Recall:      rs.Recall,
+		Err:         rs.Err,
+	}
+	if rs.IDs != nil {
+		result.IDs = rs.IDs.Slice(start, end)
+	}
+	if rs.GroupByValue != nil {
+		result.GroupByValue = rs.GroupByValue.Slice(start, end)
+	}
+	if rs.Scores != nil {
+		result.Scores = rs.Scores[start:end]
+	}
+	result.Fields = make(DataSet, 0, len(rs.Fields))
+	for _, column := range rs.Fields {
+		result.Fields = append(result.Fields, column.Slice(start, end))
+	}
+	return result
+}
@@ -0,0 +1,312 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/index"
+)
+
+const (
+	spIterator            = `iterator`
+	spReduceStopForBest   = `reduce_stop_for_best`
+	spCollectionID        = `collection_id`
+	spSearchIterV2        = `search_iter_v2`
+	spSearchIterBatchSize = `search_iter_batch_size`
+	spSearchIterLastBound = `search_iter_last_bound`
+	spSearchIterID        = `search_iter_id`
+
+	defaultIteratorBatchSize = 1000
+	// unlimitedIteratorLimit means the iterator walks through all the matched entities
+	unlimitedIteratorLimit = -1
+)
+
+type SearchIteratorOption interface {
+	SearchIteratorRequest() (*milvuspb.SearchRequest, error)
+	BatchSize() int
+	IteratorLimit() int64
+}
+
+var _ SearchIteratorOption = (*searchIteratorOption)(nil)
+
+type searchIteratorOption struct {
+	annRequest                 *AnnRequest
+	collectionName             string
+	partitionNames             []string
+	outputFields               []string
+	consistencyLevel           entity.ConsistencyLevel
+	useDefaultConsistencyLevel bool
+
+	batchSize     int
+	iteratorLimit int64
+	mvccTs        uint64
+}
+
+func (opt *searchIteratorOption) SearchIteratorRequest() (*milvuspb.SearchRequest, error) {
+	if len(opt.annRequest.vectors) != 1 {
+		return nil, errors.Newf("search iterator supports only one vector, but got %d", len(opt.annRequest.vectors))
+	}
+	if opt.batchSize <= 0 {
+		return nil, errors.Newf("batch size must be positive, but got %d", opt.batchSize)
+	}
+	if opt.annRequest.groupByField != "" {
+		return nil, errors.New("group by is not supported in search iterator")
+	}
+	if opt.annRequest.offset > 0 {
+		return nil, errors.New("offset is not supported in search iterator")
+	}
+	opt.annRequest.topK = opt.batchSize
+	request, err := opt.annRequest.searchRequest()
+	if err != nil {
+		return nil, err
+	}
+	request.SearchParams = append(request.SearchParams, entity.MapKvPairs(map[string]string{
+		spIterator:            "true",
+		spSearchIterV2:        "true",
+		spSearchIterBatchSize: strconv.Itoa(opt.batchSize),
+	})...)
+
+	request.CollectionName = opt.collectionName
+	request.PartitionNames = opt.partitionNames
+	request.ConsistencyLevel = commonpb.ConsistencyLevel(opt.consistencyLevel)
+	request.UseDefaultConsistency = opt.useDefaultConsistencyLevel
+	request.OutputFields = opt.outputFields
+	request.GuaranteeTimestamp = opt.mvccTs
+
+	return request, nil
+}
+
+func (opt *searchIteratorOption) BatchSize() int {
+	return opt.batchSize
+}
+
+func (opt *searchIteratorOption) IteratorLimit() int64 {
+	return opt.iteratorLimit
+}
+
+// WithBatchSize sets the entity count returned by each `Next`.
+func (opt *searchIteratorOption) WithBatchSize(batchSize int) *searchIteratorOption {
+	opt.batchSize = batchSize
+	return opt
+}
+
+// WithIteratorLimit sets the total entity count returned by the iterator, -1 means no limit.
+func (opt *searchIteratorOption) WithIteratorLimit(limit int64) *searchIteratorOption {
+	opt.iteratorLimit = limit
+	return opt
+}
+
+// WithMvccTimestamp pins the iterator to the snapshot at the timestamp,
+// by default the snapshot is decided by the first batch.
+func (opt *searchIteratorOption) WithMvccTimestamp(ts uint64) *searchIteratorOption {
+	opt.mvccTs = ts
+	return opt
+}
+
+func (opt *searchIteratorOption) WithPartitions(partitionNames ...string) *searchIteratorOption {
+	opt.partitionNames = partitionNames
+	return opt
+}
+
+func (opt *searchIteratorOption) WithFilter(expr string) *searchIteratorOption {
+	opt.annRequest.WithFilter(expr)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithTemplateParam(key string, val any) *searchIteratorOption {
+	opt.annRequest.WithTemplateParam(key, val)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithOutputFields(fieldNames ...string) *searchIteratorOption {
+	opt.outputFields = fieldNames
+	return opt
+}
+
+func (opt *searchIteratorOption) WithConsistencyLevel(consistencyLevel entity.ConsistencyLevel) *searchIteratorOption {
+	opt.consistencyLevel = consistencyLevel
+	opt.useDefaultConsistencyLevel = false
+	return opt
+}
+
+func (opt *searchIteratorOption) WithANNSField(annsField string) *searchIteratorOption {
+	opt.annRequest.WithANNSField(annsField)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithIgnoreGrowing(ignoreGrowing bool) *searchIteratorOption {
+	opt.annRequest.WithIgnoreGrowing(ignoreGrowing)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithAnnParam(ap index.AnnParam) *searchIteratorOption {
+	opt.annRequest.WithAnnParam(ap)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithSearchParam(key, value string) *searchIteratorOption {
+	opt.annRequest.WithSearchParam(key, value)
+	return opt
+}
+
+func NewSearchIteratorOption(collectionName string, vector entity.Vector) *searchIteratorOption {
+	return &searchIteratorOption{
+		annRequest:                 NewAnnRequest("", defaultIteratorBatchSize, vector),
+		collectionName:             collectionName,
+		useDefaultConsistencyLevel: true,
+		consistencyLevel:           entity.ClBounded,
+		batchSize:                  defaultIteratorBatchSize,
+		iteratorLimit:              unlimitedIteratorLimit,
+	}
+}
+
+type QueryIteratorOption interface {
+	QueryIteratorRequest() (*milvuspb.QueryRequest, error)
+	BatchSize() int
+	IteratorLimit() int64
+	StartAfterPK() any
+}
+
+var _ QueryIteratorOption = (*queryIteratorOption)(nil)
+
+type queryIteratorOption struct {
+	collectionName             string
+	partitionNames             []string
+	outputFields               []string
+	consistencyLevel           entity.ConsistencyLevel
+	useDefaultConsistencyLevel bool
+	expr                       string
+	templateParams             map[string]any
+
+	batchSize     int
+	iteratorLimit int64
+	mvccTs        uint64
+	startAfterPK  any
+}
+
+func (opt *queryIteratorOption) QueryIteratorRequest() (*milvuspb.QueryRequest, error) {
+	if opt.batchSize <= 0 {
+		return nil, errors.Newf("batch size must be positive, but got %d", opt.batchSize)
+	}
+	switch opt.startAfterPK.(type) {
+	case nil, int64, string:
+	default:
+		return nil, errors.Newf("start after pk must be int64 or string, but got %T", opt.startAfterPK)
+	}
+	queryOpt := &queryOption{
+		collectionName:             opt.collectionName,
+		partitionNames:             opt.partitionNames,
+		outputFields:               opt.outputFields,
+		consistencyLevel:           opt.consistencyLevel,
+		useDefaultConsistencyLevel: opt.useDefaultConsistencyLevel,
+		expr:                       opt.expr,
+		templateParams:             opt.templateParams,
+		queryParams: map[string]string{
+			spIterator:          "true",
+			spReduceStopForBest: "true",
+			spLimit:             strconv.Itoa(opt.batchSize),
+		},
+	}
+	request, err := queryOpt.Request()
+	if err != nil {
+		return nil, err
+	}
+	request.GuaranteeTimestamp = opt.mvccTs
+	return request, nil
+}
+
+func (opt *queryIteratorOption) BatchSize() int {
+	return opt.batchSize
+}
+
+func (opt *queryIteratorOption) IteratorLimit() int64 {
+	return opt.iteratorLimit
+}
+
+func (opt *queryIteratorOption) StartAfterPK() any {
+	return opt.startAfterPK
+}
+
+// WithBatchSize sets the entity count returned by each `Next`.
+func (opt *queryIteratorOption) WithBatchSize(batchSize int) *queryIteratorOption {
+	opt.batchSize = batchSize
+	return opt
+}
+
+// WithIteratorLimit sets the total entity count returned by the iterator, -1 means no limit.
+func (opt *queryIteratorOption) WithIteratorLimit(limit int64) *queryIteratorOption {
+	opt.iteratorLimit = limit
+	return opt
+}
+
+// WithMvccTimestamp pins the iterator to the snapshot at the timestamp,
+// by default the snapshot is decided by the first batch.
+// Use it with `WithStartAfterPK` to resume an iterator from `QueryIterator.Cursor`.
+func (opt *queryIteratorOption) WithMvccTimestamp(ts uint64) *queryIteratorOption {
+	opt.mvccTs = ts
+	return opt
+}
+
+// WithStartAfterPK makes the iterator start from the entities with primary key greater than pk,
+// pk shall be int64 or string according to the primary key type.
+func (opt *queryIteratorOption) WithStartAfterPK(pk any) *queryIteratorOption {
+	opt.startAfterPK = pk
+	return opt
+}
+
+func (opt *queryIteratorOption) WithFilter(expr string) *queryIteratorOption {
+	opt.expr = expr
+	return opt
+}
+
+func (opt *queryIteratorOption) WithTemplateParam(key string, val any) *queryIteratorOption {
+	opt.templateParams[key] = val
+	return opt
+}
+
+func (opt *queryIteratorOption) WithOutputFields(fieldNames ...string) *queryIteratorOption {
+	opt.outputFields = fieldNames
+	return opt
+}
+
+func (opt *queryIteratorOption) WithConsistencyLevel(consistencyLevel entity.ConsistencyLevel) *queryIteratorOption {
+	opt.consistencyLevel = consistencyLevel
+	opt.useDefaultConsistencyLevel = false
+	return opt
+}
+
+func (opt *queryIteratorOption) WithPartitions(partitionNames ...string) *queryIteratorOption {
+	opt.partitionNames = partitionNames
+	return opt
+}
+
+func NewQueryIteratorOption(collectionName string) *queryIteratorOption {
+	return &queryIteratorOption{
+		collectionName:             collectionName,
+		useDefaultConsistencyLevel: true,
+		consistencyLevel:           entity.ClBounded,
+		templateParams:             make(map[string]any),
+		batchSize:                  defaultIteratorBatchSize,
+		iteratorLimit:              unlimitedIteratorLimit,
+	}
+}
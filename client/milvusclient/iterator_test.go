@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type IteratorSuite struct {
+	MockSuiteBase
+
+	schema *entity.Schema
+}
+
+func (s *IteratorSuite) SetupSuite() {
+	s.MockSuiteBase.SetupSuite()
+	s.schema = entity.NewSchema().
+		WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+}
+
+func (s *IteratorSuite) TestSearchIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	vector := entity.FloatVector(lo.RepeatBy(128, func(_ int) float32 {
+		return rand.Float32()
+	}))
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		batch := 0
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, sr *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			s.Equal(collectionName, sr.GetCollectionName())
+			iterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(spIterator, sr.GetSearchParams())
+			s.Equal("true", iterator)
+			batchSize, _ := funcutil.GetAttrByKeyFromRepeatedKV(spSearchIterBatchSize, sr.GetSearchParams())
+			token, _ := funcutil.GetAttrByKeyFromRepeatedKV(spSearchIterID, sr.GetSearchParams())
+			lastBound, _ := funcutil.GetAttrByKeyFromRepeatedKV(spSearchIterLastBound, sr.GetSearchParams())
+			if batch == 0 {
+				s.Equal("3", batchSize)
+				s.Empty(token)
+				s.Empty(lastBound)
+				s.EqualValues(0, sr.GetGuaranteeTimestamp())
+			} else {
+				// the last batch is trimmed by the iterator limit
+				s.Equal("2", batchSize)
+				s.Equal("token", token)
+				s.Equal("0.3", lastBound)
+				s.EqualValues(100, sr.GetGuaranteeTimestamp())
+			}
+			ids := []int64{int64(batch*3 + 1), int64(batch*3 + 2), int64(batch*3 + 3)}
+			batch++
+			return &milvuspb.SearchResults{
+				Status:    merr.Success(),
+				SessionTs: 100,
+				Results: &schemapb.SearchResultData{
+					NumQueries: 1,
+					TopK:       3,
+					FieldsData: []*schemapb.FieldData{s.getInt64FieldData("ID", ids)},
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+					},
+					Scores: []float32{0.1, 0.2, 0.3},
+					Topks:  []int64{3},
+					SearchIteratorV2Results: &schemapb.SearchIteratorV2Results{
+						Token:     "token",
+						LastBound: 0.3,
+					},
+				},
+			}, nil
+		}).Times(2)
+
+		iter, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector).
+			WithBatchSize(3).
+			WithIteratorLimit(5))
+		s.Require().NoError(err)
+
+		rs, err := iter.Next(ctx)
+		s.NoError(err)
+		s.Equal(3, rs.ResultCount)
+		rs, err = iter.Next(ctx)
+		s.NoError(err)
+		s.Equal(2, rs.ResultCount)
+		s.Equal(2, rs.IDs.Len())
+		s.Equal(2, len(rs.Scores))
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("invalid_option", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		_, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector).WithBatchSize(0))
+		s.Error(err)
+
+		_, err = s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, nil))
+		s.Error(err)
+	})
+
+	s.Run("server_not_support", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).Return(&milvuspb.SearchResults{
+			Status:  merr.Success(),
+			Results: &schemapb.SearchResultData{NumQueries: 1},
+		}, nil).Once()
+
+		iter, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector))
+		s.Require().NoError(err)
+		_, err = iter.Next(ctx)
+		s.Error(err)
+	})
+}
+
+func (s *IteratorSuite) TestQueryIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		exprs := []string{"ID > 10", "(ID > 10) and ID > 12", "(ID > 10) and ID > 14"}
+		batches := [][]int64{{11, 12}, {13, 14}, {}}
+		batch := 0
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal(collectionName, qr.GetCollectionName())
+			s.Equal(exprs[batch], qr.GetExpr())
+			limit, _ := funcutil.GetAttrByKeyFromRepeatedKV(spLimit, qr.GetQueryParams())
+			s.Equal("2", limit)
+			iterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(spIterator, qr.GetQueryParams())
+			s.Equal("true", iterator)
+			if batch > 0 {
+				s.EqualValues(100, qr.GetGuaranteeTimestamp())
+			}
+			ids := batches[batch]
+			batch++
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				SessionTs:    100,
+				OutputFields: []string{"ID"},
+				FieldsData:   []*schemapb.FieldData{s.getInt64FieldData("ID", ids)},
+			}, nil
+		}).Times(3)
+
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).
+			WithFilter("ID > 10").
+			WithBatchSize(2))
+		s.Require().NoError(err)
+
+		rs, err := iter.Next(ctx)
+		s.NoError(err)
+		s.Equal(2, rs.ResultCount)
+		rs, err = iter.Next(ctx)
+		s.NoError(err)
+		s.Equal(2, rs.ResultCount)
+		ts, lastPK := iter.Cursor()
+		s.EqualValues(100, ts)
+		s.EqualValues(14, lastPK)
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("resume", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal("ID > 14", qr.GetExpr())
+			s.EqualValues(200, qr.GetGuaranteeTimestamp())
+			limit, _ := funcutil.GetAttrByKeyFromRepeatedKV(spLimit, qr.GetQueryParams())
+			s.Equal("1", limit)
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				SessionTs:    300,
+				OutputFields: []string{"ID"},
+				FieldsData:   []*schemapb.FieldData{s.getInt64FieldData("ID", []int64{15})},
+			}, nil
+		}).Once()
+
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).
+			WithStartAfterPK(int64(14)).
+			WithMvccTimestamp(200).
+			WithIteratorLimit(1))
+		s.Require().NoError(err)
+
+		rs, err := iter.Next(ctx)
+		s.NoError(err)
+		s.Equal(1, rs.ResultCount)
+		ts, lastPK := iter.Cursor()
+		s.EqualValues(200, ts)
+		s.EqualValues(15, lastPK)
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("invalid_start_pk", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		_, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithStartAfterPK(1.5))
+		s.Error(err)
+	})
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(IteratorSuite))
+}
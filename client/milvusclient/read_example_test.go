@@ -19,7 +19,9 @@ package milvusclient_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 
 	"github.com/milvus-io/milvus/client/v2/column"
@@ -403,3 +405,77 @@ func ExampleClient_HybridSearch() {
 		log.Println("Scores: ", resultSet.Scores)
 	}
 }
+
+func ExampleClient_SearchIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	milvusAddr := "127.0.0.1:19530"
+
+	cli, err := milvusclient.New(ctx, &milvusclient.ClientConfig{
+		Address: milvusAddr,
+	})
+	if err != nil {
+		log.Fatal("failed to connect to milvus server: ", err.Error())
+	}
+
+	defer cli.Close(ctx)
+
+	queryVector := []float32{0.3580376395471989, -0.6023495712049978, 0.18414012509913835, -0.26286205330961354, 0.9029438446296592}
+
+	iter, err := cli.SearchIterator(ctx, milvusclient.NewSearchIteratorOption("quick_setup", entity.FloatVector(queryVector)).
+		WithBatchSize(100).
+		WithIteratorLimit(1000))
+	if err != nil {
+		log.Fatal("failed to create search iterator: ", err.Error())
+	}
+
+	for {
+		rs, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatal("failed to search next batch: ", err.Error())
+		}
+		log.Println("IDs: ", rs.IDs)
+		log.Println("Scores: ", rs.Scores)
+	}
+}
+
+func ExampleClient_QueryIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	milvusAddr := "127.0.0.1:19530"
+
+	cli, err := milvusclient.New(ctx, &milvusclient.ClientConfig{
+		Address: milvusAddr,
+	})
+	if err != nil {
+		log.Fatal("failed to connect to milvus server: ", err.Error())
+	}
+
+	defer cli.Close(ctx)
+
+	iter, err := cli.QueryIterator(ctx, milvusclient.NewQueryIteratorOption("quick_setup").
+		WithFilter("emb_type == 3").
+		WithOutputFields("id", "emb_type").
+		WithBatchSize(1000))
+	if err != nil {
+		log.Fatal("failed to create query iterator: ", err.Error())
+	}
+
+	for {
+		rs, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// the iteration could be resumed from the cursor with WithMvccTimestamp and WithStartAfterPK
+			ts, lastPK := iter.Cursor()
+			log.Fatal("failed to query next batch: ", err.Error(), ts, lastPK)
+		}
+		fmt.Println(rs.GetColumn("id"))
+	}
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+// Binlog meta carries no checksum, so the checksums are recorded into a manifest by the checksum command,
+// and verified later by the verify command, e.g. before and after a migration or a suspected data loss.
+// The manifest is in the format of sha256sum, one "<sha256>  <path>" per line.
+
+func newChecksumCommand() *command {
+	flags := flag.NewFlagSet("checksum", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, false)
+	output := flags.String("output", "", "path of the checksum manifest to write, stdout if not specified")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(false); err != nil {
+				return err
+			}
+			segments, err := ins.listSegments(ctx, sf.collectionID, sf.partitionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+			w := io.Writer(os.Stdout)
+			if *output != "" {
+				f, err := os.Create(*output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			for _, segment := range segments {
+				if segment.GetState() == commonpb.SegmentState_Dropped {
+					continue
+				}
+				for _, logPath := range segmentLogPaths(segment) {
+					data, err := ins.cm.Read(ctx, logPath)
+					if err != nil {
+						return errors.Wrapf(err, "failed to read %s", logPath)
+					}
+					if _, err := fmt.Fprintf(w, "%s  %s\n", checksum(data), logPath); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// segmentLogPaths returns the paths of all the binlogs, deltalogs and statslogs of the segment.
+func segmentLogPaths(segment *datapb.SegmentInfo) []string {
+	paths := make([]string, 0)
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{
+		segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs(), segment.GetBm25Statslogs(),
+	} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, b := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, b.GetLogPath())
+			}
+		}
+	}
+	return paths
+}
+
+// loadChecksums reads the manifest written by the checksum command, returns the checksums by path.
+func loadChecksums(r io.Reader) (map[string]string, error) {
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		sum, logPath, ok := strings.Cut(text, "  ")
+		if !ok || len(sum) != sha256.Size*2 || logPath == "" {
+			return nil, errors.Newf("invalid checksum manifest at line %d: %s", line, text)
+		}
+		checksums[logPath] = strings.ToLower(sum)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return checksums, nil
+}
+
+func loadChecksumsFile(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return loadChecksums(f)
+}
+
+// verifyChecksum checks the data against the manifest, binlogs not in the manifest are skipped,
+// since they may be written after the manifest recorded.
+func verifyChecksum(logPath string, data []byte, checksums map[string]string) []string {
+	expected, ok := checksums[logPath]
+	if !ok {
+		return nil
+	}
+	if actual := checksum(data); actual != expected {
+		return []string{fmt.Sprintf("%s: checksum %s mismatches %s in manifest", logPath, actual, expected)}
+	}
+	return nil
+}
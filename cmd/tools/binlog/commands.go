@@ -0,0 +1,383 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+const tsPrintFormat = "2006-01-02 15:04:05.999 -0700"
+
+// stringsFlag collects the values of a repeated flag.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// segmentFlags are the flags to locate segments shared by most commands.
+type segmentFlags struct {
+	collectionID int64
+	partitionID  int64
+	segmentID    int64
+}
+
+func (f *segmentFlags) register(flags *flag.FlagSet, segmentRequired bool) {
+	flags.Int64Var(&f.collectionID, "collection", 0, "collection id, required")
+	flags.Int64Var(&f.partitionID, "partition", 0, "partition id to filter with")
+	if segmentRequired {
+		flags.Int64Var(&f.segmentID, "segment", 0, "segment id, required")
+	} else {
+		flags.Int64Var(&f.segmentID, "segment", 0, "segment id to filter with")
+	}
+}
+
+func (f *segmentFlags) validate(segmentRequired bool) error {
+	if f.collectionID <= 0 {
+		return errors.New("-collection is required")
+	}
+	if segmentRequired && f.segmentID <= 0 {
+		return errors.New("-segment is required")
+	}
+	return nil
+}
+
+func formatTs(ts uint64) string {
+	physical, _ := tsoutil.ParseTS(ts)
+	return fmt.Sprintf("%d(%s)", ts, physical.Format(tsPrintFormat))
+}
+
+func newPrintCommand() *command {
+	flags := flag.NewFlagSet("print", flag.ExitOnError)
+	var paths stringsFlag
+	flags.Var(&paths, "path", "binlog path in the object storage, could be repeated")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if len(paths) == 0 {
+				return errors.New("-path is required")
+			}
+			for _, p := range paths {
+				data, err := ins.cm.Read(ctx, p)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("binlog %s, size = %d\n", p, len(data))
+				if err := storage.PrintBinlog(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func newListCommand() *command {
+	flags := flag.NewFlagSet("ls", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, false)
+	detail := flags.Bool("detail", false, "print every binlog of segments")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(false); err != nil {
+				return err
+			}
+			segments, err := ins.listSegments(ctx, sf.collectionID, sf.partitionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+			for _, segment := range segments {
+				printSegment(segment, *detail)
+			}
+			fmt.Printf("%d segments in total\n", len(segments))
+			return nil
+		},
+	}
+}
+
+func printSegment(segment *datapb.SegmentInfo, detail bool) {
+	fmt.Println("================================================================================")
+	fmt.Printf("Segment ID: %d\tState: %s\tLevel: %s\tSorted: %v\n",
+		segment.GetID(), segment.GetState(), segment.GetLevel(), segment.GetIsSorted())
+	fmt.Printf("Collection ID: %d\tPartition ID: %d\tChannel: %s\n",
+		segment.GetCollectionID(), segment.GetPartitionID(), segment.GetInsertChannel())
+	fmt.Printf("Num of Rows: %d\tStorage Version: %d\n", segment.GetNumOfRows(), segment.GetStorageVersion())
+	fmt.Printf("Binlog Fields: %d\tStatslog Fields: %d\tDeltalog Fields: %d\tBM25 Statslog Fields: %d\n",
+		len(segment.GetBinlogs()), len(segment.GetStatslogs()), len(segment.GetDeltalogs()), len(segment.GetBm25Statslogs()))
+	if !detail {
+		return
+	}
+	printFieldBinlogs("Binlogs", segment.GetBinlogs())
+	printFieldBinlogs("Statslogs", segment.GetStatslogs())
+	printFieldBinlogs("Deltalogs", segment.GetDeltalogs())
+	printFieldBinlogs("BM25 Statslogs", segment.GetBm25Statslogs())
+}
+
+func printFieldBinlogs(title string, fieldBinlogs []*datapb.FieldBinlog) {
+	if len(fieldBinlogs) == 0 {
+		return
+	}
+	fmt.Println("--------------------------------------------------------------------------------")
+	fmt.Printf("%s:\n", title)
+	for _, fieldBinlog := range fieldBinlogs {
+		fmt.Printf("Field %d:\n", fieldBinlog.GetFieldID())
+		for _, b := range fieldBinlog.GetBinlogs() {
+			fmt.Printf("\t%s\tentries: %d\tsize: %d\tmemory size: %d\tts: [%s, %s]\n",
+				b.GetLogPath(), b.GetEntriesNum(), b.GetLogSize(), b.GetMemorySize(),
+				formatTs(b.GetTimestampFrom()), formatTs(b.GetTimestampTo()))
+		}
+	}
+}
+
+func newSchemaCommand() *command {
+	flags := flag.NewFlagSet("schema", flag.ExitOnError)
+	collectionID := flags.Int64("collection", 0, "collection id, required")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if *collectionID <= 0 {
+				return errors.New("-collection is required")
+			}
+			coll, err := ins.getCollection(ctx, *collectionID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Collection: %s(%d)\tDatabase: %d\tState: %s\n", coll.Name, coll.CollectionID, coll.DBID, coll.State)
+			fmt.Printf("Description: %s\n", coll.Description)
+			fmt.Printf("Shards: %d\tVirtual Channels: %v\n", coll.ShardsNum, coll.VirtualChannelNames)
+			fmt.Printf("Dynamic Field: %v\tConsistency Level: %s\tCreate Time: %s\n",
+				coll.EnableDynamicField, coll.ConsistencyLevel, formatTs(coll.CreateTime))
+			for _, kv := range coll.Properties {
+				fmt.Printf("Property: %s=%s\n", kv.GetKey(), kv.GetValue())
+			}
+			fmt.Println("Fields:")
+			for _, field := range coll.Fields {
+				attrs := make([]string, 0)
+				if field.IsPrimaryKey {
+					attrs = append(attrs, "primary key")
+				}
+				if field.AutoID {
+					attrs = append(attrs, "auto id")
+				}
+				if field.IsPartitionKey {
+					attrs = append(attrs, "partition key")
+				}
+				if field.IsClusteringKey {
+					attrs = append(attrs, "clustering key")
+				}
+				if field.IsDynamic {
+					attrs = append(attrs, "dynamic")
+				}
+				if field.Nullable {
+					attrs = append(attrs, "nullable")
+				}
+				if field.IsFunctionOutput {
+					attrs = append(attrs, "function output")
+				}
+				dataType := field.DataType.String()
+				if field.ElementType != 0 {
+					dataType = fmt.Sprintf("%s<%s>", dataType, field.ElementType)
+				}
+				fmt.Printf("\t%d\t%s\t%s\t%v\ttype params: %v\n", field.FieldID, field.Name, dataType, attrs, field.TypeParams)
+			}
+			for _, function := range coll.Functions {
+				fmt.Printf("Function: %s(%d)\ttype: %s\tinput: %v\toutput: %v\n",
+					function.Name, function.ID, function.Type, function.InputFieldNames, function.OutputFieldNames)
+			}
+			fmt.Println("Partitions:")
+			for _, partition := range coll.Partitions {
+				fmt.Printf("\t%d\t%s\t%s\n", partition.PartitionID, partition.PartitionName, partition.State)
+			}
+			return nil
+		},
+	}
+}
+
+func newDumpCommand() *command {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, true)
+	limit := flags.Int("limit", 100, "max number of rows to print, 0 means no limit")
+	fields := flags.String("fields", "", "comma separated field names to print, all fields if empty")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(true); err != nil {
+				return err
+			}
+			schema, err := ins.getSchema(ctx, sf.collectionID)
+			if err != nil {
+				return err
+			}
+			outputFields, err := selectFields(schema, *fields)
+			if err != nil {
+				return err
+			}
+			segment, err := ins.getSegment(ctx, sf.collectionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+			reader, err := ins.newValueReader(ctx, schema, segment)
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			rows := 0
+			for *limit <= 0 || rows < *limit {
+				value, err := reader.NextValue()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				m := (*value).Value.(map[storage.FieldID]any)
+				values := make([]string, 0, len(outputFields))
+				for _, field := range outputFields {
+					values = append(values, fmt.Sprintf("%s=%s", field.GetName(), formatValue(field, m[field.GetFieldID()])))
+				}
+				fmt.Printf("row %d: pk=%v\tts=%s\t%s\n", rows, pkValue((*value).PK), formatTs(uint64((*value).Timestamp)), strings.Join(values, "\t"))
+				rows++
+			}
+			fmt.Printf("%d rows printed, %d rows in segment meta\n", rows, segment.GetNumOfRows())
+			return nil
+		},
+	}
+}
+
+func newStatsCommand() *command {
+	flags := flag.NewFlagSet("stats", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, true)
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(true); err != nil {
+				return err
+			}
+			segment, err := ins.getSegment(ctx, sf.collectionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+
+			blobs, err := ins.readBlobs(ctx, segment.GetStatslogs())
+			if err != nil {
+				return err
+			}
+			for _, blob := range blobs {
+				var stats []*storage.PrimaryKeyStats
+				if path.Base(blob.Key) == storage.CompoundStatsType.LogIdx() {
+					stats, err = storage.DeserializeStatsList(blob)
+				} else {
+					stats, err = storage.DeserializeStats([]*storage.Blob{blob})
+				}
+				if err != nil {
+					return errors.Wrapf(err, "failed to deserialize stats log %s", blob.Key)
+				}
+				fmt.Printf("stats log %s:\n", blob.Key)
+				for _, stat := range stats {
+					fmt.Printf("\tfield: %d\tpk type: %d\tmin pk: %v\tmax pk: %v",
+						stat.FieldID, stat.PkType, pkValue(stat.MinPk), pkValue(stat.MaxPk))
+					if stat.BF != nil {
+						fmt.Printf("\tbloom filter: %s cap=%d k=%d", stat.BF.Type(), stat.BF.Cap(), stat.BF.K())
+					}
+					fmt.Println()
+				}
+			}
+
+			blobs, err = ins.readBlobs(ctx, segment.GetBm25Statslogs())
+			if err != nil {
+				return err
+			}
+			for _, blob := range blobs {
+				stats := storage.NewBM25Stats()
+				if err := stats.Deserialize(blob.Value); err != nil {
+					return errors.Wrapf(err, "failed to deserialize bm25 stats log %s", blob.Key)
+				}
+				fmt.Printf("bm25 stats log %s:\n\trows: %d\ttokens: %d\n", blob.Key, stats.NumRow(), stats.NumToken())
+			}
+			return nil
+		},
+	}
+}
+
+func newDeltaCommand() *command {
+	flags := flag.NewFlagSet("delta", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, true)
+	limit := flags.Int("limit", 100, "max number of delete records to print, 0 means no limit")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(true); err != nil {
+				return err
+			}
+			segment, err := ins.getSegment(ctx, sf.collectionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+			blobs, err := ins.readBlobs(ctx, segment.GetDeltalogs())
+			if err != nil {
+				return err
+			}
+			if len(blobs) == 0 {
+				fmt.Println("no delta logs")
+				return nil
+			}
+			reader, err := storage.CreateDeltalogReader(blobs)
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			total := 0
+			for {
+				dl, err := reader.NextValue()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				if *limit <= 0 || total < *limit {
+					fmt.Printf("delete %d: pk=%v\tts=%s\n", total, pkValue((*dl).Pk), formatTs((*dl).Ts))
+				}
+				total++
+			}
+			fmt.Printf("%d delete records in %d delta logs\n", total, len(blobs))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+const (
+	formatParquet = "parquet"
+	formatCSV     = "csv"
+)
+
+func newExportCommand() *command {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, true)
+	format := flags.String("format", formatParquet, "output format, parquet or csv")
+	output := flags.String("output", "", "path of the output file")
+	fields := flags.String("fields", "", "comma separated field names to export, all fields if empty")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(true); err != nil {
+				return err
+			}
+			if *output == "" {
+				return errors.New("-output is required")
+			}
+			if *format != formatParquet && *format != formatCSV {
+				return errors.Newf("unsupported format %s", *format)
+			}
+			schema, err := ins.getSchema(ctx, sf.collectionID)
+			if err != nil {
+				return err
+			}
+			outputFields, err := selectFields(schema, *fields)
+			if err != nil {
+				return err
+			}
+			segment, err := ins.getSegment(ctx, sf.collectionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(*output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			var rows int64
+			if *format == formatParquet {
+				rows, err = ins.exportParquet(ctx, schema, segment, outputFields, f)
+			} else {
+				rows, err = ins.exportCSV(ctx, schema, segment, outputFields, f)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d rows of segment %d exported to %s\n", rows, segment.GetID(), *output)
+			return nil
+		},
+	}
+}
+
+// exportParquet writes the records of segment into a parquet file, columns are named by field names.
+// The fields which have no binlogs in the segment, e.g. added after the segment created, are filled with nulls.
+func (ins *inspector) exportParquet(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo,
+	fields []*schemapb.FieldSchema, w io.Writer,
+) (int64, error) {
+	arrowSchema, err := storage.ConvertToArrowSchema(fields)
+	if err != nil {
+		return 0, err
+	}
+	fw, err := pqarrow.NewFileWriter(arrowSchema, w, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return 0, err
+	}
+	defer fw.Close()
+
+	reader, err := ins.newRecordReader(ctx, schema, segment)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	rows := int64(0)
+	for {
+		r, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return rows, err
+		}
+		columns := make([]arrow.Array, 0, len(fields))
+		nulls := make([]arrow.Array, 0)
+		for i, field := range fields {
+			column := r.Column(field.GetFieldID())
+			if column == nil {
+				column = array.MakeArrayOfNull(memory.DefaultAllocator, arrowSchema.Field(i).Type, r.Len())
+				nulls = append(nulls, column)
+			}
+			columns = append(columns, column)
+		}
+		rec := array.NewRecord(arrowSchema, columns, int64(r.Len()))
+		err = fw.Write(rec)
+		rec.Release()
+		for _, column := range nulls {
+			column.Release()
+		}
+		if err != nil {
+			return rows, err
+		}
+		rows += int64(r.Len())
+	}
+	return rows, fw.Close()
+}
+
+// exportCSV writes the rows of segment into a csv file, with a header of field names.
+// Values are formatted the same as the dump command.
+func (ins *inspector) exportCSV(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo,
+	fields []*schemapb.FieldSchema, w io.Writer,
+) (int64, error) {
+	reader, err := ins.newValueReader(ctx, schema, segment)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	cw := csv.NewWriter(w)
+	header := make([]string, 0, len(fields))
+	for _, field := range fields {
+		header = append(header, field.GetName())
+	}
+	if err := cw.Write(header); err != nil {
+		return 0, err
+	}
+
+	rows := int64(0)
+	for {
+		value, err := reader.NextValue()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return rows, err
+		}
+		m := (*value).Value.(map[storage.FieldID]any)
+		record := make([]string, 0, len(fields))
+		for _, field := range fields {
+			record = append(record, formatValue(field, m[field.GetFieldID()]))
+		}
+		if err := cw.Write(record); err != nil {
+			return rows, err
+		}
+		rows++
+	}
+	cw.Flush()
+	return rows, cw.Error()
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func pkValue(pk storage.PrimaryKey) any {
+	if pk == nil {
+		return nil
+	}
+	return pk.GetValue()
+}
+
+// selectFields returns the fields with the comma separated names, or all the user fields if names is empty.
+func selectFields(schema *schemapb.CollectionSchema, names string) ([]*schemapb.FieldSchema, error) {
+	if names == "" {
+		return lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return !common.IsSystemField(field.GetFieldID())
+		}), nil
+	}
+	fields := make([]*schemapb.FieldSchema, 0)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		field := typeutil.GetFieldByName(schema, name)
+		if field == nil {
+			return nil, errors.Newf("field %s not found in schema", name)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// formatValue converts the value deserialized from binlogs into a readable string.
+// JSON is printed as it is, binary vectors & sparse vectors are base64 encoded,
+// the others are printed in JSON format.
+func formatValue(field *schemapb.FieldSchema, v any) string {
+	if v == nil {
+		return "null"
+	}
+	switch value := v.(type) {
+	case string:
+		return value
+	case []byte:
+		if field.GetDataType() == schemapb.DataType_JSON {
+			return string(value)
+		}
+		return base64.StdEncoding.EncodeToString(value)
+	case *schemapb.ScalarField:
+		bs, err := protojson.Marshal(value)
+		if err != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return string(bs)
+	default:
+		bs, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return string(bs)
+	}
+}
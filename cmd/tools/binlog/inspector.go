@@ -0,0 +1,229 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/compaction"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kv_tikv "github.com/milvus-io/milvus/internal/kv/tikv"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tikv"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// inspector reads the collection & segment meta from the meta store
+// and the binlogs from the chunk manager, all in read-only mode.
+type inspector struct {
+	rootCatalog metastore.RootCoordCatalog
+	dataCatalog metastore.DataCoordCatalog
+	cm          storage.ChunkManager
+}
+
+func newInspectorFromConfig(ctx context.Context, yamlFile string) (*inspector, error) {
+	paramtable.Get().Init(paramtable.NewBaseTableFromYamlOnly(yamlFile))
+	params := paramtable.Get()
+
+	metaKV, err := newMetaKV()
+	if err != nil {
+		return nil, err
+	}
+	metaRootPath := params.EtcdCfg.MetaRootPath.GetValue()
+	if params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
+		metaRootPath = params.TiKVCfg.MetaRootPath.GetValue()
+	}
+	ss, err := kvmetestore.NewSnapshotKV(ctx, metaKV, metaRootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create chunk manager")
+	}
+	return &inspector{
+		rootCatalog: kvmetestore.NewCatalog(metaKV, ss),
+		dataCatalog: datacoord.NewCatalog(metaKV, cm.RootPath(), ""),
+		cm:          cm,
+	}, nil
+}
+
+func newMetaKV() (kv.MetaKv, error) {
+	params := paramtable.Get()
+	switch params.MetaStoreCfg.MetaStoreType.GetValue() {
+	case util.MetaStoreTypeTiKV:
+		tikvCli, err := tikv.GetTiKVClient(&params.TiKVCfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to connect to tikv")
+		}
+		return kv_tikv.NewTiKV(tikvCli, params.TiKVCfg.MetaRootPath.GetValue(),
+			kv_tikv.WithRequestTimeout(params.TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+	case util.MetaStoreTypeEtcd:
+		etcdConfig := &params.EtcdCfg
+		etcdCli, err := etcd.CreateEtcdClient(
+			etcdConfig.UseEmbedEtcd.GetAsBool(),
+			etcdConfig.EtcdEnableAuth.GetAsBool(),
+			etcdConfig.EtcdAuthUserName.GetValue(),
+			etcdConfig.EtcdAuthPassword.GetValue(),
+			etcdConfig.EtcdUseSSL.GetAsBool(),
+			etcdConfig.Endpoints.GetAsStrings(),
+			etcdConfig.EtcdTLSCert.GetValue(),
+			etcdConfig.EtcdTLSKey.GetValue(),
+			etcdConfig.EtcdTLSCACert.GetValue(),
+			etcdConfig.EtcdTLSMinVersion.GetValue())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to connect to etcd")
+		}
+		return etcdkv.NewEtcdKV(etcdCli, etcdConfig.MetaRootPath.GetValue(),
+			etcdkv.WithRequestTimeout(etcdConfig.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+	default:
+		return nil, fmt.Errorf("MetaStoreType %s not supported", params.MetaStoreCfg.MetaStoreType.GetValue())
+	}
+}
+
+// getCollection looks for the collection in all databases, since only collection id is provided.
+func (ins *inspector) getCollection(ctx context.Context, collectionID int64) (*model.Collection, error) {
+	dbs, err := ins.rootCatalog.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	dbIDs := []int64{util.DefaultDBID}
+	for _, db := range dbs {
+		if db.ID != util.DefaultDBID {
+			dbIDs = append(dbIDs, db.ID)
+		}
+	}
+	for _, dbID := range dbIDs {
+		coll, err := ins.rootCatalog.GetCollectionByID(ctx, dbID, typeutil.MaxTimestamp, collectionID)
+		if err == nil {
+			return coll, nil
+		}
+	}
+	return nil, merr.WrapErrCollectionNotFound(collectionID)
+}
+
+func (ins *inspector) getSchema(ctx context.Context, collectionID int64) (*schemapb.CollectionSchema, error) {
+	coll, err := ins.getCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return &schemapb.CollectionSchema{
+		Name:               coll.Name,
+		Description:        coll.Description,
+		AutoID:             coll.AutoID,
+		Fields:             model.MarshalFieldModels(coll.Fields),
+		Functions:          model.MarshalFunctionModels(coll.Functions),
+		EnableDynamicField: coll.EnableDynamicField,
+		Properties:         coll.Properties,
+	}, nil
+}
+
+// listSegments returns the segments of the collection with full binlog paths, sorted by segment id.
+// partitionID and segmentID are used as filters if positive.
+func (ins *inspector) listSegments(ctx context.Context, collectionID, partitionID, segmentID int64) ([]*datapb.SegmentInfo, error) {
+	segments, err := ins.dataCatalog.ListSegments(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if partitionID > 0 && segment.GetPartitionID() != partitionID {
+			continue
+		}
+		if segmentID > 0 && segment.GetID() != segmentID {
+			continue
+		}
+		if err := binlog.DecompressBinLogs(segment); err != nil {
+			return nil, err
+		}
+		result = append(result, segment)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetID() < result[j].GetID()
+	})
+	return result, nil
+}
+
+func (ins *inspector) getSegment(ctx context.Context, collectionID, segmentID int64) (*datapb.SegmentInfo, error) {
+	segments, err := ins.listSegments(ctx, collectionID, 0, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, merr.WrapErrSegmentNotFound(segmentID)
+	}
+	return segments[0], nil
+}
+
+// newRecordReader reassembles the insert binlogs of all fields into records.
+func (ins *inspector) newRecordReader(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo) (storage.RecordReader, error) {
+	return storage.NewBinlogRecordReader(ctx, segment.GetBinlogs(), schema,
+		storage.WithDownloader(ins.cm.MultiRead),
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithStorageConfig(compaction.CreateStorageConfig()))
+}
+
+// newValueReader reads the rows of segment one by one.
+func (ins *inspector) newValueReader(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo) (*storage.DeserializeReaderImpl[*storage.Value], error) {
+	rr, err := ins.newRecordReader(ctx, schema, segment)
+	if err != nil {
+		return nil, err
+	}
+	return storage.NewDeserializeReader(rr, func(r storage.Record, v []*storage.Value) error {
+		return storage.ValueDeserializer(r, v, schema.GetFields())
+	}), nil
+}
+
+// readBlobs downloads all the binlogs of the field binlogs.
+func (ins *inspector) readBlobs(ctx context.Context, fieldBinlogs []*datapb.FieldBinlog) ([]*storage.Blob, error) {
+	paths := make([]string, 0)
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, b := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, b.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	values, err := ins.cm.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(paths))
+	for i, path := range paths {
+		blobs = append(blobs, &storage.Blob{Key: path, Value: values[i]})
+	}
+	return blobs, nil
+}
@@ -17,19 +17,84 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
 
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+const usage = `usage:
+  binlog file1 file2 ...                     print local binlog files
+  binlog <command> -config milvus.yaml [options]
+
+commands:
+  print    print binlogs read from the object storage, -path could be repeated
+  ls       list segments and their binlogs of a collection
+  schema   print the collection schema
+  dump     print rows of a segment, reassembled from the insert binlogs of all fields
+  stats    print the pk stats and bm25 stats logs of a segment
+  delta    print the delete records of a segment
+  checksum record the checksums of binlogs of segments into a manifest
+  verify   verify binlogs of segments against the meta, object size, descriptor ids and row counts,
+           and against the checksum manifest if -checksums is provided
+  export   export rows of a segment into a parquet or csv file
+
+run "binlog <command> -h" for the options of each command.
+`
+
+type command struct {
+	flags *flag.FlagSet
+	run   func(ctx context.Context, ins *inspector) error
+}
+
 func main() {
 	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Print(usage)
+		return
+	}
+
+	commands := map[string]*command{
+		"print":    newPrintCommand(),
+		"ls":       newListCommand(),
+		"schema":   newSchemaCommand(),
+		"dump":     newDumpCommand(),
+		"stats":    newStatsCommand(),
+		"delta":    newDeltaCommand(),
+		"checksum": newChecksumCommand(),
+		"verify":   newVerifyCommand(),
+		"export":   newExportCommand(),
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		// compatible with the former usage, which only prints local files
+		if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+		} else {
+			fmt.Printf("print binlog complete.\n")
+		}
+		return
+	}
+
+	configFile := cmd.flags.String("config", "", "path of the milvus.yaml used by the cluster")
+	if err := cmd.flags.Parse(os.Args[2:]); err != nil {
+		os.Exit(1)
+	}
+	if *configFile == "" {
+		fmt.Fprintln(os.Stderr, "-config is required")
+		cmd.flags.Usage()
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	ins, err := newInspectorFromConfig(ctx, *configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(1)
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
-		fmt.Printf("error: %s\n", err.Error())
-	} else {
-		fmt.Printf("print binlog complete.\n")
+	if err := cmd.run(ctx, ins); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(1)
 	}
 }
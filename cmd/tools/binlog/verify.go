@@ -0,0 +1,237 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+func newVerifyCommand() *command {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	sf := &segmentFlags{}
+	sf.register(flags, false)
+	deep := flags.Bool("deep", false, "decode all the rows of segments and check the row count, which is slow")
+	checksumsFile := flags.String("checksums", "", "checksum manifest written by the checksum command to verify binlogs with")
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, ins *inspector) error {
+			if err := sf.validate(false); err != nil {
+				return err
+			}
+			var checksums map[string]string
+			if *checksumsFile != "" {
+				var err error
+				checksums, err = loadChecksumsFile(*checksumsFile)
+				if err != nil {
+					return err
+				}
+			}
+			schema, err := ins.getSchema(ctx, sf.collectionID)
+			if err != nil {
+				return err
+			}
+			segments, err := ins.listSegments(ctx, sf.collectionID, sf.partitionID, sf.segmentID)
+			if err != nil {
+				return err
+			}
+			failed := 0
+			for _, segment := range segments {
+				if segment.GetState() == commonpb.SegmentState_Dropped {
+					continue
+				}
+				issues, err := ins.verifySegment(ctx, schema, segment, *deep, checksums)
+				if err != nil {
+					return err
+				}
+				if len(issues) == 0 {
+					fmt.Printf("segment %d: OK\n", segment.GetID())
+					continue
+				}
+				failed++
+				fmt.Printf("segment %d: %d issues\n", segment.GetID(), len(issues))
+				for _, issue := range issues {
+					fmt.Printf("\t%s\n", issue)
+				}
+			}
+			if failed > 0 {
+				return errors.Newf("%d of %d segments failed to verify", failed, len(segments))
+			}
+			return nil
+		},
+	}
+}
+
+// verifySegment checks binlogs of the segment against the meta, returns the issues found.
+// Binlog meta carries no checksum, so the integrity is checked by object size, descriptor ids
+// and the row count of all events, plus the decoded row count if deep is true,
+// and the checksums recorded in the manifest if provided.
+func (ins *inspector) verifySegment(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo, deep bool, checksums map[string]string) ([]string, error) {
+	issues := make([]string, 0)
+	isV1 := segment.GetStorageVersion() == storage.StorageV1
+
+	fieldRows := make(map[int64]int64)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, b := range fieldBinlog.GetBinlogs() {
+			fieldRows[fieldBinlog.GetFieldID()] += b.GetEntriesNum()
+			if isV1 {
+				issues = append(issues, ins.verifyBinlog(ctx, segment, fieldBinlog.GetFieldID(), b, storage.InsertEventType, checksums)...)
+			} else {
+				issues = append(issues, ins.verifyObject(ctx, b, checksums)...)
+			}
+		}
+	}
+	if isV1 {
+		for _, field := range schema.GetFields() {
+			rows, ok := fieldRows[field.GetFieldID()]
+			if !ok {
+				// field added after the segment created has no binlogs
+				if !field.GetNullable() {
+					issues = append(issues, fmt.Sprintf("no binlogs of field %d(%s)", field.GetFieldID(), field.GetName()))
+				}
+				continue
+			}
+			if rows != segment.GetNumOfRows() {
+				issues = append(issues, fmt.Sprintf("binlogs of field %d(%s) have %d rows, while segment has %d rows",
+					field.GetFieldID(), field.GetName(), rows, segment.GetNumOfRows()))
+			}
+		}
+	}
+
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		for _, b := range fieldBinlog.GetBinlogs() {
+			issues = append(issues, ins.verifyBinlog(ctx, segment, -1, b, storage.DeleteEventType, checksums)...)
+		}
+	}
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetStatslogs(), segment.GetBm25Statslogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, b := range fieldBinlog.GetBinlogs() {
+				issues = append(issues, ins.verifyObject(ctx, b, checksums)...)
+			}
+		}
+	}
+
+	if deep && len(segment.GetBinlogs()) > 0 {
+		rows, err := ins.countRows(ctx, schema, segment)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("failed to decode rows: %s", err.Error()))
+		} else if rows != segment.GetNumOfRows() {
+			issues = append(issues, fmt.Sprintf("decoded %d rows, while segment has %d rows", rows, segment.GetNumOfRows()))
+		}
+	}
+	return issues, nil
+}
+
+// verifyObject checks the object size, and the checksum if it's recorded in the manifest.
+func (ins *inspector) verifyObject(ctx context.Context, b *datapb.Binlog, checksums map[string]string) []string {
+	if _, ok := checksums[b.GetLogPath()]; ok {
+		data, err := ins.cm.Read(ctx, b.GetLogPath())
+		if err != nil {
+			return []string{fmt.Sprintf("%s: failed to read, %s", b.GetLogPath(), err.Error())}
+		}
+		issues := verifyChecksum(b.GetLogPath(), data, checksums)
+		if b.GetLogSize() > 0 && int64(len(data)) != b.GetLogSize() {
+			issues = append(issues, fmt.Sprintf("%s: object size %d mismatches log size %d in meta", b.GetLogPath(), len(data), b.GetLogSize()))
+		}
+		return issues
+	}
+	size, err := ins.cm.Size(ctx, b.GetLogPath())
+	if err != nil {
+		return []string{fmt.Sprintf("%s: failed to stat, %s", b.GetLogPath(), err.Error())}
+	}
+	if b.GetLogSize() > 0 && size != b.GetLogSize() {
+		return []string{fmt.Sprintf("%s: object size %d mismatches log size %d in meta", b.GetLogPath(), size, b.GetLogSize())}
+	}
+	return nil
+}
+
+// verifyBinlog checks the object size, checksum, descriptor and the total rows of all events.
+// fieldID is not checked if negative.
+func (ins *inspector) verifyBinlog(ctx context.Context, segment *datapb.SegmentInfo, fieldID int64, b *datapb.Binlog, eventType storage.EventTypeCode, checksums map[string]string) []string {
+	logPath := b.GetLogPath()
+	data, err := ins.cm.Read(ctx, logPath)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: failed to read, %s", logPath, err.Error())}
+	}
+	issues := verifyChecksum(logPath, data, checksums)
+	if b.GetLogSize() > 0 && int64(len(data)) != b.GetLogSize() {
+		issues = append(issues, fmt.Sprintf("%s: object size %d mismatches log size %d in meta", logPath, len(data), b.GetLogSize()))
+	}
+
+	reader, err := storage.NewBinlogReader(data)
+	if err != nil {
+		return append(issues, fmt.Sprintf("%s: failed to parse, %s", logPath, err.Error()))
+	}
+	defer reader.Close()
+	if reader.CollectionID != segment.GetCollectionID() || reader.SegmentID != segment.GetID() ||
+		(fieldID >= 0 && reader.FieldID != fieldID) {
+		issues = append(issues, fmt.Sprintf("%s: descriptor collection %d segment %d field %d mismatches meta",
+			logPath, reader.CollectionID, reader.SegmentID, reader.FieldID))
+	}
+
+	rows := int64(0)
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			return append(issues, fmt.Sprintf("%s: failed to read event, %s", logPath, err.Error()))
+		}
+		if event == nil {
+			break
+		}
+		if event.TypeCode != eventType {
+			issues = append(issues, fmt.Sprintf("%s: unexpected event type %s", logPath, event.TypeCode))
+		}
+		n, err := event.GetPayloadLengthFromReader()
+		event.Close()
+		if err != nil {
+			return append(issues, fmt.Sprintf("%s: failed to read payload, %s", logPath, err.Error()))
+		}
+		rows += int64(n)
+	}
+	if rows != b.GetEntriesNum() {
+		issues = append(issues, fmt.Sprintf("%s: %d rows in events mismatches entries num %d in meta", logPath, rows, b.GetEntriesNum()))
+	}
+	return issues
+}
+
+func (ins *inspector) countRows(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo) (int64, error) {
+	reader, err := ins.newRecordReader(ctx, schema, segment)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	rows := int64(0)
+	for {
+		r, err := reader.Next()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return rows, err
+		}
+		rows += int64(r.Len())
+	}
+}
@@ -0,0 +1,288 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	testCollectionID = 1
+	testPartitionID  = 2
+	testSegmentID    = 3
+	testPkFieldID    = 100
+	testVarCharID    = 101
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+func testSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: testPkFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: testVarCharID, Name: "text", DataType: schemapb.DataType_VarChar},
+		},
+	}
+}
+
+// writeFixture writes the binlogs and deltalogs of a segment with 3 rows and 1 deletion
+// under the root path of the local chunk manager, returns the segment meta.
+func writeFixture(t *testing.T, ctx context.Context, cm *storage.LocalChunkManager, schema *schemapb.CollectionSchema) *datapb.SegmentInfo {
+	insertCodec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: testCollectionID, Schema: schema})
+	blobs, err := insertCodec.Serialize(testPartitionID, testSegmentID, &storage.InsertData{
+		Data: map[int64]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+			common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 11, 12}},
+			testPkFieldID:         &storage.Int64FieldData{Data: []int64{100, 101, 102}},
+			testVarCharID:         &storage.StringFieldData{Data: []string{"a", "b", "c"}},
+		},
+	})
+	require.NoError(t, err)
+
+	segment := &datapb.SegmentInfo{
+		ID:             testSegmentID,
+		CollectionID:   testCollectionID,
+		PartitionID:    testPartitionID,
+		NumOfRows:      3,
+		StorageVersion: storage.StorageV1,
+	}
+	for _, blob := range blobs {
+		logPath := path.Join(cm.RootPath(), "insert_log", blob.Key, "1")
+		require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+		var fieldID int64
+		_, err := fmt.Sscan(blob.Key, &fieldID)
+		require.NoError(t, err)
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath, LogSize: int64(len(blob.Value)), EntriesNum: blob.RowNum}},
+		})
+	}
+
+	deleteData := storage.NewDeleteData([]storage.PrimaryKey{storage.NewInt64PrimaryKey(101)}, []uint64{20})
+	blob, err := storage.NewDeleteCodec().Serialize(testCollectionID, testPartitionID, testSegmentID, deleteData)
+	require.NoError(t, err)
+	deltaPath := path.Join(cm.RootPath(), "delta_log", "1")
+	require.NoError(t, cm.Write(ctx, deltaPath, blob.Value))
+	segment.Deltalogs = []*datapb.FieldBinlog{{
+		Binlogs: []*datapb.Binlog{{LogPath: deltaPath, LogSize: int64(len(blob.Value)), EntriesNum: 1}},
+	}}
+	return segment
+}
+
+func findBinlog(segment *datapb.SegmentInfo, fieldID int64) *datapb.Binlog {
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() == fieldID {
+			return fieldBinlog.GetBinlogs()[0]
+		}
+	}
+	return nil
+}
+
+func TestVerifySegment(t *testing.T) {
+	ctx := context.Background()
+	schema := testSchema()
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	fixture := writeFixture(t, ctx, cm, schema)
+
+	recorded := make(map[string]string)
+	for _, logPath := range segmentLogPaths(fixture) {
+		data, err := cm.Read(ctx, logPath)
+		require.NoError(t, err)
+		recorded[logPath] = checksum(data)
+	}
+
+	testCases := []struct {
+		name      string
+		mutate    func(segment *datapb.SegmentInfo, checksums map[string]string)
+		checksums bool
+		issues    []string
+	}{
+		{
+			name:   "ok",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {},
+		},
+		{
+			name:      "checksums ok",
+			mutate:    func(segment *datapb.SegmentInfo, checksums map[string]string) {},
+			checksums: true,
+		},
+		{
+			name: "checksum mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				checksums[findBinlog(segment, testPkFieldID).GetLogPath()] = checksum([]byte("other"))
+			},
+			checksums: true,
+			issues:    []string{"checksum"},
+		},
+		{
+			name: "deltalog checksum mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				checksums[segment.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath()] = checksum([]byte("other"))
+			},
+			checksums: true,
+			issues:    []string{"delta_log/1: checksum"},
+		},
+		{
+			name: "binlog not in manifest",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				delete(checksums, findBinlog(segment, testPkFieldID).GetLogPath())
+			},
+			checksums: true,
+		},
+		{
+			name: "log size mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				findBinlog(segment, testVarCharID).LogSize++
+			},
+			issues: []string{"mismatches log size"},
+		},
+		{
+			name: "entries num mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				findBinlog(segment, testVarCharID).EntriesNum = 4
+			},
+			issues: []string{"rows in events mismatches entries num 4", "have 4 rows, while segment has 3 rows"},
+		},
+		{
+			name: "deltalog entries num mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				segment.Deltalogs[0].Binlogs[0].EntriesNum = 2
+			},
+			issues: []string{"delta_log/1: 1 rows in events mismatches entries num 2"},
+		},
+		{
+			name: "segment rows mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				segment.NumOfRows = 5
+			},
+			issues: []string{"rows, while segment has 5 rows"},
+		},
+		{
+			name: "field id mismatch",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				pk, text := findBinlog(segment, testPkFieldID), findBinlog(segment, testVarCharID)
+				pk.LogPath, text.LogPath = text.LogPath, pk.LogPath
+				pk.LogSize, text.LogSize = text.LogSize, pk.LogSize
+			},
+			issues: []string{"descriptor collection 1 segment 3 field 101 mismatches meta", "descriptor collection 1 segment 3 field 100 mismatches meta"},
+		},
+		{
+			name: "missing object",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				findBinlog(segment, testVarCharID).LogPath = path.Join(cm.RootPath(), "insert_log", "missing")
+			},
+			issues: []string{"insert_log/missing: failed to read"},
+		},
+		{
+			name: "missing field",
+			mutate: func(segment *datapb.SegmentInfo, checksums map[string]string) {
+				segment.Binlogs = segment.Binlogs[:len(segment.Binlogs)-1]
+			},
+			issues: []string{"no binlogs of field"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			segment := proto.Clone(fixture).(*datapb.SegmentInfo)
+			checksums := make(map[string]string)
+			for k, v := range recorded {
+				checksums[k] = v
+			}
+			tc.mutate(segment, checksums)
+			if !tc.checksums {
+				checksums = nil
+			}
+
+			ins := &inspector{cm: cm}
+			issues, err := ins.verifySegment(ctx, schema, segment, false, checksums)
+			require.NoError(t, err)
+			if len(tc.issues) == 0 {
+				assert.Empty(t, issues)
+				return
+			}
+			for _, expected := range tc.issues {
+				assert.True(t, lo.SomeBy(issues, func(issue string) bool {
+					return strings.Contains(issue, expected)
+				}), "expect issue %q in %v", expected, issues)
+			}
+		})
+	}
+}
+
+func TestVerifyCorruptedBinlog(t *testing.T) {
+	ctx := context.Background()
+	schema := testSchema()
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	segment := writeFixture(t, ctx, cm, schema)
+
+	b := findBinlog(segment, testVarCharID)
+	data, err := cm.Read(ctx, b.GetLogPath())
+	require.NoError(t, err)
+	checksums := map[string]string{b.GetLogPath(): checksum(data)}
+	// the same size, but the content is overwritten
+	require.NoError(t, cm.Write(ctx, b.GetLogPath(), make([]byte, len(data))))
+
+	ins := &inspector{cm: cm}
+	issues, err := ins.verifySegment(ctx, schema, segment, false, checksums)
+	require.NoError(t, err)
+	require.Len(t, issues, 2, issues)
+	assert.Contains(t, issues[0], "checksum")
+	assert.Contains(t, issues[1], "failed to parse")
+}
+
+func TestLoadChecksums(t *testing.T) {
+	sum := checksum([]byte("binlog"))
+	manifest := fmt.Sprintf("%s  insert_log/1/100/1\n\n%s  delta_log/1\n", sum, strings.ToUpper(sum))
+	checksums, err := loadChecksums(strings.NewReader(manifest))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"insert_log/1/100/1": sum, "delta_log/1": sum}, checksums)
+
+	for _, manifest := range []string{
+		"insert_log/1/100/1",
+		sum + " insert_log/1/100/1",
+		"abc  insert_log/1/100/1",
+		sum + "  ",
+	} {
+		_, err := loadChecksums(strings.NewReader(manifest))
+		assert.Error(t, err, manifest)
+	}
+}
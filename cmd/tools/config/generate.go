@@ -258,6 +258,14 @@ func WriteYaml(w io.Writer) {
 		{
 			name: "rocksmq",
 		},
+		{
+			name:   "filewal",
+			header: "\n# Related configuration of filewal, an embedded file-based wal for standalone deployment, enabled by setting mq.type to filewal.",
+		},
+		{
+			name:   "nats",
+			header: "\n# Related configuration of nats, the jetstream of nats is used as the wal of streaming service by setting mq.type to nats.",
+		},
 		{
 			name:   "mixCoord",
 			header: "\n# Related configuration of mixCoord",
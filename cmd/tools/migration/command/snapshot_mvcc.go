@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
+	"github.com/milvus-io/milvus/cmd/tools/migration/console"
+	"github.com/milvus-io/milvus/cmd/tools/migration/migration"
+)
+
+// SnapshotMVCC migrates the snapshot of rootcoord meta from suffix mode to mvcc mode,
+// set metastore.snapshot.mode to mvcc in milvus.yaml before milvus restarts.
+func SnapshotMVCC(c *configs.Config) {
+	ctx := context.Background()
+	runner := migration.NewRunner(ctx, c)
+	console.ExitIf(runner.CheckSessions())
+	console.ExitIf(runner.RegisterSession())
+	fn := func() { runner.Stop() }
+	defer fn()
+	// double check.
+	console.ExitIf(runner.CheckSessions(), console.AddCallbacks(fn))
+	console.ExitIf(runner.MigrateSnapshotToMVCC(), console.AddCallbacks(fn))
+}
@@ -13,6 +13,8 @@ const (
 	RunCmd      = "run"
 	BackupCmd   = "backup"
 	RollbackCmd = "rollback"
+	// SnapshotMVCCCmd switches the snapshot of rootcoord meta to mvcc mode
+	SnapshotMVCCCmd = "snapshot-mvcc"
 )
 
 type RunConfig struct {
@@ -44,6 +46,8 @@ func (c *RunConfig) String() string {
 	case RollbackCmd:
 		return fmt.Sprintf("Cmd: %s, SourceVersion: %s, TargetVersion: %s, BackupFilePath: %s",
 			c.Cmd, c.SourceVersion, c.TargetVersion, c.BackupFilePath)
+	case SnapshotMVCCCmd:
+		return fmt.Sprintf("Cmd: %s", c.Cmd)
 	default:
 		return fmt.Sprintf("invalid cmd: %s", c.Cmd)
 	}
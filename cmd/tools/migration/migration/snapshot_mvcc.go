@@ -0,0 +1,57 @@
+package migration
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/console"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+// MigrateSnapshotToMVCC switches the snapshot of rootcoord meta from suffix mode to mvcc mode:
+// the keys removed as tombstones are removed actually, the current revision is recorded as the
+// earliest snapshot, then the suffix snapshots are dropped.
+// The time travel before the migration is not supported afterwards.
+// Only etcd is supported, the meta on TiKV should keep the suffix mode.
+func (r *Runner) MigrateSnapshotToMVCC() error {
+	if metaStoreType := r.cfg.MetaStoreCfg.MetaStoreType.GetValue(); metaStoreType != util.MetaStoreTypeEtcd {
+		return fmt.Errorf("snapshot-mvcc migration of meta store %s is not supported now, only %s is supported", metaStoreType, util.MetaStoreTypeEtcd)
+	}
+	rootPath := r.cfg.EtcdCfg.MetaRootPath.GetValue()
+	metaKV := etcdkv.NewEtcdKV(r.etcdCli, rootPath)
+	paginationSize := r.cfg.MetaStoreCfg.PaginationSize.GetAsInt()
+
+	tombstones := make([]string, 0)
+	err := metaKV.WalkWithPrefix(r.ctx, rootcoord.ComponentPrefix+"/", paginationSize, func(k []byte, v []byte) error {
+		if rootcoord.IsTombstone(string(v)) {
+			tombstones = append(tombstones, strings.TrimPrefix(string(k), rootPath+"/"))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = etcd.RemoveByBatchWithLimit(tombstones, util.MaxEtcdTxnNum, func(partialKeys []string) error {
+		return metaKV.MultiRemove(r.ctx, partialKeys)
+	})
+	if err != nil {
+		return err
+	}
+
+	revision, err := metaKV.MultiSaveAndRemoveWithRevision(r.ctx, nil, nil, []string{rootcoord.SnapshotPrefix + "/"})
+	if err != nil {
+		return err
+	}
+	ts := tsoutil.ComposeTSByTime(time.Now(), 0)
+	if err := metaKV.Save(r.ctx, rootcoord.ComposeRevisionKey(rootcoord.SnapshotRevisionPrefix, ts), strconv.FormatInt(revision, 10)); err != nil {
+		return err
+	}
+	console.Success(fmt.Sprintf("snapshot migrated to mvcc, removed tombstones: %d, ts: %d, revision: %d", len(tombstones), ts, revision))
+	return nil
+}
@@ -488,6 +488,18 @@ func (s *mixCoordImpl) RenameCollection(ctx context.Context, req *milvuspb.Renam
 	return s.rootcoordServer.RenameCollection(ctx, req)
 }
 
+func (s *mixCoordImpl) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.CloneCollection(ctx, req)
+}
+
+func (s *mixCoordImpl) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.RestoreSnapshot(ctx, req)
+}
+
+func (s *mixCoordImpl) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.TruncateCollection(ctx, req)
+}
+
 func (s *mixCoordImpl) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return s.rootcoordServer.CreateDatabase(ctx, req)
 }
@@ -1041,6 +1053,34 @@ func (s *mixCoordImpl) GcControl(ctx context.Context, req *datapb.GcControlReque
 	return s.datacoordServer.GcControl(ctx, req)
 }
 
+func (s *mixCoordImpl) GetGcReport(ctx context.Context, req *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	return s.datacoordServer.GetGcReport(ctx, req)
+}
+
+func (s *mixCoordImpl) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.CreateSnapshot(ctx, req)
+}
+
+func (s *mixCoordImpl) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return s.datacoordServer.ListSnapshots(ctx, req)
+}
+
+func (s *mixCoordImpl) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.DropSnapshot(ctx, req)
+}
+
+func (s *mixCoordImpl) RestoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.RestoreSnapshotSegments(ctx, req)
+}
+
+func (s *mixCoordImpl) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.CloneSegments(ctx, req)
+}
+
+func (s *mixCoordImpl) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.TruncateSegments(ctx, req)
+}
+
 func (s *mixCoordImpl) ImportV2(ctx context.Context, req *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return s.datacoordServer.ImportV2(ctx, req)
 }
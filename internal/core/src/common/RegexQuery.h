@@ -17,6 +17,7 @@
 #include <utility>
 
 #include "common/EasyAssert.h"
+#include "pb/plan.pb.h"
 
 namespace milvus {
 bool
@@ -40,6 +41,36 @@ PatternMatchTranslator::operator()<std::string>(const std::string& pattern) {
     return translate_pattern_match_to_regex(pattern);
 }
 
+// The operand of RegexMatch is rewritten by the parser into a regex matching
+// the whole string, which is used as it is.
+struct RegexMatchTranslator {
+    template <typename T>
+    inline std::string
+    operator()(const T& pattern) {
+        ThrowInfo(OpTypeInvalid,
+                  "regex matching is only supported on string type");
+    }
+};
+
+template <>
+inline std::string
+RegexMatchTranslator::operator()<std::string>(const std::string& pattern) {
+    return pattern;
+}
+
+// translate the operand of Match or RegexMatch into a regex matching the
+// whole string.
+template <typename T>
+inline std::string
+translate_to_regex(const T& operand, proto::plan::OpType op) {
+    if (op == proto::plan::OpType::RegexMatch) {
+        RegexMatchTranslator translator;
+        return translator(operand);
+    }
+    PatternMatchTranslator translator;
+    return translator(operand);
+}
+
 struct RegexMatcher {
     template <typename T>
     inline bool
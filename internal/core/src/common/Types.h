@@ -797,6 +797,9 @@ struct fmt::formatter<milvus::OpType> : formatter<string_view> {
             case milvus::OpType::Match:
                 name = "Match";
                 break;
+            case milvus::OpType::RegexMatch:
+                name = "RegexMatch";
+                break;
             case milvus::OpType::Range:
                 name = "Range";
                 break;
@@ -323,6 +323,7 @@ IsLikeExpr(std::shared_ptr<Expr> input) {
             case proto::plan::PostfixMatch:
             case proto::plan::InnerMatch:
             case proto::plan::Match:
+            case proto::plan::RegexMatch:
                 return true;
             default:
                 return false;
@@ -1226,7 +1226,7 @@ class SegmentExpr : public Expr {
 
         using Index = index::ScalarIndex<IndexInnerType>;
         if (op == OpType::Match || op == OpType::InnerMatch ||
-            op == OpType::PostfixMatch) {
+            op == OpType::PostfixMatch || op == OpType::RegexMatch) {
             auto pw = segment_->chunk_scalar_index<IndexInnerType>(
                 field_id_, current_index_chunk_);
             auto* index_ptr = const_cast<Index*>(pw.get());
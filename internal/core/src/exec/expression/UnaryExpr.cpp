@@ -500,6 +500,23 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplArray(EvalCtx& context) {
                          offsets);
                     break;
                 }
+                case proto::plan::RegexMatch: {
+                    UnaryElementFuncForArray<ValueType,
+                                             proto::plan::RegexMatch,
+                                             filter_type>
+                        func;
+                    func(data,
+                         valid_data,
+                         size,
+                         val,
+                         index,
+                         res,
+                         valid_res,
+                         bitmap_input,
+                         processed_cursor,
+                         offsets);
+                    break;
+                }
                 default:
                     ThrowInfo(
                         OpTypeInvalid,
@@ -891,9 +908,9 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplJson(EvalCtx& context) {
                 }
                 break;
             }
-            case proto::plan::Match: {
-                PatternMatchTranslator translator;
-                auto regex_pattern = translator(val);
+            case proto::plan::Match:
+            case proto::plan::RegexMatch: {
+                auto regex_pattern = translate_to_regex(val, op_type);
                 RegexMatcher matcher(regex_pattern);
                 for (size_t i = 0; i < size; ++i) {
                     auto offset = i;
@@ -1423,12 +1440,13 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplJsonForIndex() {
                             }
                         }
                     case proto::plan::Match:
+                    case proto::plan::RegexMatch:
                         if constexpr (std::is_same_v<GetType,
                                                      proto::plan::Array>) {
                             return false;
                         } else {
-                            PatternMatchTranslator translator;
-                            auto regex_pattern = translator(val);
+                            auto regex_pattern =
+                                translate_to_regex(val, op_type);
                             RegexMatcher matcher(regex_pattern);
                             if (!arrayIndex.empty()) {
                                 UnaryRangeJSONIndexCompareWithArrayIndex(
@@ -1573,6 +1591,11 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplForIndex() {
                 res = std::move(func(index_ptr, val));
                 break;
             }
+            case proto::plan::RegexMatch: {
+                UnaryIndexFunc<T, proto::plan::RegexMatch> func;
+                res = std::move(func(index_ptr, val));
+                break;
+            }
             default:
                 ThrowInfo(
                     OpTypeInvalid,
@@ -1810,6 +1833,17 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplForData(EvalCtx& context) {
                      offsets);
                 break;
             }
+            case proto::plan::RegexMatch: {
+                UnaryElementFunc<T, proto::plan::RegexMatch, filter_type> func;
+                func(data,
+                     size,
+                     val,
+                     res,
+                     bitmap_input,
+                     processed_cursor,
+                     offsets);
+                break;
+            }
             default:
                 ThrowInfo(
                     OpTypeInvalid,
@@ -1883,7 +1917,8 @@ PhyUnaryRangeFilterExpr::CanUseIndexForJson(DataType val_type) {
             use_index_ = has_index &&
                          expr_->op_type_ != proto::plan::OpType::Match &&
                          expr_->op_type_ != proto::plan::OpType::PostfixMatch &&
-                         expr_->op_type_ != proto::plan::OpType::InnerMatch;
+                         expr_->op_type_ != proto::plan::OpType::InnerMatch &&
+                         expr_->op_type_ != proto::plan::OpType::RegexMatch;
             break;
         default:
             use_index_ = has_index;
@@ -1965,7 +2000,8 @@ PhyUnaryRangeFilterExpr::CanExecNgramMatch(proto::plan::OpType op_type) {
     return (op_type == proto::plan::OpType::InnerMatch ||
             op_type == proto::plan::OpType::Match ||
             op_type == proto::plan::OpType::PrefixMatch ||
-            op_type == proto::plan::OpType::PostfixMatch) &&
+            op_type == proto::plan::OpType::PostfixMatch ||
+            op_type == proto::plan::OpType::RegexMatch) &&
            !has_offset_input_ && CanUseNgramIndex(field_id_);
 }
 
@@ -35,7 +35,7 @@
 namespace milvus {
 namespace exec {
 
-template <typename T, FilterType filter_type>
+template <typename T, proto::plan::OpType op, FilterType filter_type>
 struct UnaryElementFuncForMatch {
     using IndexInnerType =
         std::conditional_t<std::is_same_v<T, std::string_view>, std::string, T>;
@@ -48,8 +48,7 @@ struct UnaryElementFuncForMatch {
                const TargetBitmap& bitmap_input,
                int start_cursor,
                const int32_t* offsets = nullptr) {
-        PatternMatchTranslator translator;
-        auto regex_pattern = translator(val);
+        auto regex_pattern = translate_to_regex(val, op);
         RegexMatcher matcher(regex_pattern);
         bool has_bitmap_input = !bitmap_input.empty();
         for (int i = 0; i < size; ++i) {
@@ -79,8 +78,9 @@ struct UnaryElementFunc {
                size_t start_cursor,
                const int32_t* offsets = nullptr) {
         bool has_bitmap_input = !bitmap_input.empty();
-        if constexpr (op == proto::plan::OpType::Match) {
-            UnaryElementFuncForMatch<T, filter_type> func;
+        if constexpr (op == proto::plan::OpType::Match ||
+                      op == proto::plan::OpType::RegexMatch) {
+            UnaryElementFuncForMatch<T, op, filter_type> func;
             func(src, size, val, res, bitmap_input, start_cursor, offsets);
             return;
         }
@@ -267,7 +267,8 @@ struct UnaryElementFuncForArray {
                                  op == proto::plan::OpType::PostfixMatch ||
                                  op == proto::plan::OpType::InnerMatch) {
                 UnaryArrayCompare(milvus::query::Match(array_data, val, op));
-            } else if constexpr (op == proto::plan::OpType::Match) {
+            } else if constexpr (op == proto::plan::OpType::Match ||
+                                 op == proto::plan::OpType::RegexMatch) {
                 if constexpr (std::is_same_v<GetType, proto::plan::Array>) {
                     res[i] = false;
                 } else {
@@ -275,8 +276,7 @@ struct UnaryElementFuncForArray {
                         res[i] = false;
                         continue;
                     }
-                    PatternMatchTranslator translator;
-                    auto regex_pattern = translator(val);
+                    auto regex_pattern = translate_to_regex(val, op);
                     RegexMatcher matcher(regex_pattern);
                     auto array_data =
                         src[offset].template get_data<GetType>(index);
@@ -302,9 +302,10 @@ struct UnaryIndexFuncForMatch {
         AssertInfo(op == proto::plan::OpType::Match ||
                        op == proto::plan::OpType::PostfixMatch ||
                        op == proto::plan::OpType::InnerMatch ||
-                       op == proto::plan::OpType::PrefixMatch,
+                       op == proto::plan::OpType::PrefixMatch ||
+                       op == proto::plan::OpType::RegexMatch,
                    "op must be one of the following: Match, PrefixMatch, "
-                   "PostfixMatch, InnerMatch");
+                   "PostfixMatch, InnerMatch, RegexMatch");
 
         if constexpr (std::is_same_v<T, std::string> ||
                       std::is_same_v<T, std::string_view>) {
@@ -333,8 +334,7 @@ struct UnaryIndexFuncForMatch {
                 }
                 return res;
             } else {
-                PatternMatchTranslator translator;
-                auto regex_pattern = translator(val);
+                auto regex_pattern = translate_to_regex(val, op);
                 RegexMatcher matcher(regex_pattern);
                 for (int64_t i = 0; i < cnt; i++) {
                     auto raw = index->Reverse_Lookup(i);
@@ -374,7 +374,8 @@ struct UnaryIndexFunc {
         } else if constexpr (op == proto::plan::OpType::PrefixMatch ||
                              op == proto::plan::OpType::Match ||
                              op == proto::plan::OpType::PostfixMatch ||
-                             op == proto::plan::OpType::InnerMatch) {
+                             op == proto::plan::OpType::InnerMatch ||
+                             op == proto::plan::OpType::RegexMatch) {
             UnaryIndexFuncForMatch<T> func;
             return func(index, val, op);
         } else {
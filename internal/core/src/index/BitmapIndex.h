@@ -147,9 +147,9 @@ class BitmapIndex : public ScalarIndex<T> {
                 dataset->Set(milvus::index::MATCH_VALUE, pattern);
                 return Query(std::move(dataset));
             }
-            case proto::plan::OpType::Match: {
-                PatternMatchTranslator translator;
-                auto regex_pattern = translator(pattern);
+            case proto::plan::OpType::Match:
+            case proto::plan::OpType::RegexMatch: {
+                auto regex_pattern = translate_to_regex(pattern, op);
                 return RegexQuery(regex_pattern);
             }
             default:
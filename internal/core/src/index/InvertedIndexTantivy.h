@@ -210,9 +210,9 @@ class InvertedIndexTantivy : public ScalarIndex<T> {
                 auto regex_pattern = translator(fmt::format("%{}%", pattern));
                 return RegexQuery(regex_pattern);
             }
-            case proto::plan::OpType::Match: {
-                PatternMatchTranslator translator;
-                auto regex_pattern = translator(pattern);
+            case proto::plan::OpType::Match:
+            case proto::plan::OpType::RegexMatch: {
+                auto regex_pattern = translate_to_regex(pattern, op);
                 return RegexQuery(regex_pattern);
             }
             default:
@@ -124,6 +124,8 @@ NgramInvertedIndex::ExecuteQuery(const std::string& literal,
         }
         case proto::plan::OpType::Match:
             return MatchQuery(literal, segment);
+        case proto::plan::OpType::RegexMatch:
+            return RegexMatchQuery(literal, segment);
         case proto::plan::OpType::PrefixMatch: {
             auto predicate = [&literal](const std::string_view& data) {
                 return data.length() >= literal.length() &&
@@ -228,15 +230,200 @@ split_by_wildcard(const std::string& literal) {
     return result;
 }
 
+// regex_escape_length returns the length of the escape sequence starting at
+// pos, or 0 if the escape is unknown. Only the escapes written by the parser
+// and the common 2-character ones are recognized.
+size_t
+regex_escape_length(const std::string& pattern, size_t pos) {
+    if (pos + 1 >= pattern.size()) {
+        return 0;
+    }
+    char next = pattern[pos + 1];
+    if (next == 'x') {
+        // \xHH or \x{H...}
+        if (pos + 2 < pattern.size() && pattern[pos + 2] == '{') {
+            auto end = pattern.find('}', pos + 3);
+            return end == std::string::npos ? 0 : end - pos + 1;
+        }
+        if (pos + 3 >= pattern.size()) {
+            return 0;
+        }
+        auto hex1 = static_cast<unsigned char>(pattern[pos + 2]);
+        auto hex2 = static_cast<unsigned char>(pattern[pos + 3]);
+        return std::isxdigit(hex1) && std::isxdigit(hex2) ? 4 : 0;
+    }
+    if (!std::isalnum(static_cast<unsigned char>(next)) ||
+        std::string("dDwWsSbBAzZntrfv").find(next) != std::string::npos) {
+        return 2;
+    }
+    // octal, back references, unicode properties, \Q...\E and so on
+    return 0;
+}
+
+// skip_regex_class returns the position after the class starting at pos, or
+// npos if the class isn't closed or contains an unknown escape.
+size_t
+skip_regex_class(const std::string& pattern, size_t pos) {
+    // a leading ']' is a member of the class
+    size_t j = pos + 1;
+    if (j < pattern.size() && pattern[j] == '^') {
+        ++j;
+    }
+    if (j < pattern.size() && pattern[j] == ']') {
+        ++j;
+    }
+    while (j < pattern.size() && pattern[j] != ']') {
+        if (pattern[j] == '\\') {
+            auto len = regex_escape_length(pattern, j);
+            if (len == 0) {
+                return std::string::npos;
+            }
+            j += len;
+        } else {
+            ++j;
+        }
+    }
+    return j < pattern.size() ? j + 1 : std::string::npos;
+}
+
+// extract_regex_literals returns literals which every string matching the
+// regex must contain. Groups and classes are skipped as a whole, and it gives
+// up on top level alternations, inline flags, lookarounds and unknown
+// escapes, the returned literals are a conservative subset otherwise.
+std::vector<std::string>
+extract_regex_literals(const std::string& pattern) {
+    std::vector<std::string> result;
+    for (size_t pos = pattern.find("(?"); pos != std::string::npos;
+         pos = pattern.find("(?", pos + 2)) {
+        if (pos > 0 && pattern[pos - 1] == '\\') {
+            continue;
+        }
+        if (pos + 2 >= pattern.size() || pattern[pos + 2] != ':') {
+            return {};
+        }
+    }
+
+    std::string r;
+    auto cut = [&]() {
+        if (!r.empty()) {
+            result.push_back(r);
+            r.clear();
+        }
+    };
+    size_t i = 0;
+    while (i < pattern.size()) {
+        char c = pattern[i];
+        if (c == '\\') {
+            auto len = regex_escape_length(pattern, i);
+            if (len == 0) {
+                return {};
+            }
+            char next = pattern[i + 1];
+            if (len == 2 && !std::isalnum(static_cast<unsigned char>(next))) {
+                r += next;
+            } else {
+                // character class, assertion or a character given by its
+                // code, such as \d, \b or \x41
+                cut();
+            }
+            i += len;
+        } else if (c == '[') {
+            cut();
+            i = skip_regex_class(pattern, i);
+            if (i == std::string::npos) {
+                return {};
+            }
+        } else if (c == '(') {
+            // skip the whole group
+            cut();
+            int depth = 0;
+            while (i < pattern.size()) {
+                if (pattern[i] == '\\') {
+                    auto len = regex_escape_length(pattern, i);
+                    if (len == 0) {
+                        return {};
+                    }
+                    i += len;
+                    continue;
+                }
+                if (pattern[i] == '[') {
+                    i = skip_regex_class(pattern, i);
+                    if (i == std::string::npos) {
+                        return {};
+                    }
+                    continue;
+                }
+                if (pattern[i] == '(') {
+                    ++depth;
+                } else if (pattern[i] == ')' && --depth == 0) {
+                    break;
+                }
+                ++i;
+            }
+            ++i;
+        } else if (c == '|') {
+            // none of the literals is required
+            return {};
+        } else if (c == '*' || c == '?' || c == '{') {
+            // the previous character, maybe multi-byte, is optional
+            while (!r.empty() && (r.back() & 0xC0) == 0x80) {
+                r.pop_back();
+            }
+            if (!r.empty()) {
+                r.pop_back();
+            }
+            cut();
+            if (c == '{') {
+                auto end = pattern.find('}', i);
+                i = end == std::string::npos ? pattern.size() : end;
+            }
+            ++i;
+        } else if (c == '+' || c == '.' || c == '^' || c == '$') {
+            cut();
+            ++i;
+        } else {
+            r += c;
+            ++i;
+        }
+    }
+    cut();
+    return result;
+}
+
 std::optional<TargetBitmap>
 NgramInvertedIndex::MatchQuery(const std::string& literal,
                                exec::SegmentExpr* segment) {
-    TargetBitmap bitset{static_cast<size_t>(Count())};
     auto literals = split_by_wildcard(literal);
     for (const auto& l : literals) {
         if (l.length() < min_gram_) {
             return std::nullopt;
         }
+    }
+    PatternMatchTranslator translator;
+    return ExecuteRegexQuery(literals, translator(literal), segment);
+}
+
+std::optional<TargetBitmap>
+NgramInvertedIndex::RegexMatchQuery(const std::string& pattern,
+                                    exec::SegmentExpr* segment) {
+    std::vector<std::string> literals;
+    for (auto& l : extract_regex_literals(pattern)) {
+        if (l.length() >= min_gram_) {
+            literals.push_back(std::move(l));
+        }
+    }
+    if (literals.empty()) {
+        return std::nullopt;
+    }
+    return ExecuteRegexQuery(literals, pattern, segment);
+}
+
+std::optional<TargetBitmap>
+NgramInvertedIndex::ExecuteRegexQuery(const std::vector<std::string>& literals,
+                                      const std::string& regex_pattern,
+                                      exec::SegmentExpr* segment) {
+    TargetBitmap bitset{static_cast<size_t>(Count())};
+    for (const auto& l : literals) {
         wrapper_->ngram_match_query(l, min_gram_, max_gram_, &bitset);
     }
 
@@ -244,8 +431,6 @@ NgramInvertedIndex::MatchQuery(const std::string& literal,
     TargetBitmap valid(res.size(), true);
     TargetBitmapView valid_res(valid.data(), valid.size());
 
-    PatternMatchTranslator translator;
-    auto regex_pattern = translator(literal);
     RegexMatcher matcher(regex_pattern);
 
     auto predicate = [&matcher](const std::string_view& data) {
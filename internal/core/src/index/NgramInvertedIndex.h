@@ -52,6 +52,15 @@ class NgramInvertedIndex : public InvertedIndexTantivy<std::string> {
     std::optional<TargetBitmap>
     MatchQuery(const std::string& literal, exec::SegmentExpr* segment);
 
+    // RegexMatch prefilters by the literals the regex requires, if any.
+    std::optional<TargetBitmap>
+    RegexMatchQuery(const std::string& pattern, exec::SegmentExpr* segment);
+
+    std::optional<TargetBitmap>
+    ExecuteRegexQuery(const std::vector<std::string>& literals,
+                      const std::string& regex_pattern,
+                      exec::SegmentExpr* segment);
+
  private:
     uintptr_t min_gram_{0};
     uintptr_t max_gram_{0};
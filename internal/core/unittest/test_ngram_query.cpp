@@ -315,6 +315,19 @@ TEST(NgramIndex, TestNgramWikiEpisode) {
         expected_result = {true, false, false, false, false};
         test_ngram_with_data(
             data, "%Alv%y s%", proto::plan::OpType::Match, expected_result);
+
+        // regex match, the patterns are rewritten by the parser to match the
+        // whole string
+        test_ngram_with_data(data,
+                             "[\\s\\S]*Alv(?:[^\\n])*y s[\\s\\S]*",
+                             proto::plan::OpType::RegexMatch,
+                             expected_result);
+
+        // \x41 is A
+        test_ngram_with_data(data,
+                             "[\\s\\S]*\\x41lv(?:[^\\n])*y s[\\s\\S]*",
+                             proto::plan::OpType::RegexMatch,
+                             expected_result);
     }
 
     // exceeds max_gram
@@ -346,6 +359,22 @@ TEST(NgramIndex, TestNgramWikiEpisode) {
                              "%secondary%school%",
                              proto::plan::OpType::Match,
                              expected_result);
+
+        // regex match
+        expected_result = {false, true, true, true, false};
+        test_ngram_with_data(
+            data,
+            "[\\s\\S]*secondary(?:[\\x09-\\x0A\\x0C-\\x0D\\x20])+school[\\s\\S]*",
+            proto::plan::OpType::RegexMatch,
+            expected_result);
+
+        // unknown escapes stop the literal extraction
+        expected_result = {true, true, true, true, false};
+        test_ngram_with_data(data,
+                             "[\\s\\S]*\\Qschool\\E[\\s\\S]*",
+                             proto::plan::OpType::RegexMatch,
+                             expected_result,
+                             true);
     }
 }
 
@@ -380,4 +409,22 @@ TEST(NgramIndex, TestNgramSimple) {
                          "ary",
                          proto::plan::OpType::PostfixMatch,
                          std::vector<bool>(10000, true));
+
+    test_ngram_with_data(data,
+                         "[\\s\\S]*ary(?:[^\\n])*sec[\\s\\S]*",
+                         proto::plan::OpType::RegexMatch,
+                         std::vector<bool>(10000, true));
+
+    // no literal can be extracted from alternations
+    test_ngram_with_data(data,
+                         "[\\s\\S]*(?:school|college)[\\s\\S]*",
+                         proto::plan::OpType::RegexMatch,
+                         std::vector<bool>(10000, true),
+                         true);
+
+    // literals outside of the alternation are still required
+    test_ngram_with_data(data,
+                         "[\\s\\S]*ary (?:school|college)[\\s\\S]*",
+                         proto::plan::OpType::RegexMatch,
+                         std::vector<bool>(10000, true));
 }
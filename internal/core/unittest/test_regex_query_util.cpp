@@ -150,3 +150,29 @@ TEST(RegexMatcherTest, PatternMatchWithNewLine) {
 
     EXPECT_TRUE(matcher(std::string("Hello\n")));
 }
+
+TEST(RegexMatchTranslatorTest, StringTypeTest) {
+    using namespace milvus;
+    RegexMatchTranslator translator;
+
+    ASSERT_ANY_THROW(translator(123));
+    EXPECT_EQ(translator(std::string("a|b")), "a|b");
+
+    // err(or)?\s+\d+ rewritten by the parser
+    RegexMatcher matcher(translator(std::string(
+        "[\\s\\S]*err(?:(?:or))?(?:[\\x09-\\x0A\\x0C-\\x0D\\x20])+(?:[0-9])+"
+        "[\\s\\S]*")));
+    EXPECT_TRUE(matcher(std::string("[WARN] error 404 occurred")));
+    EXPECT_TRUE(matcher(std::string("err\n42")));
+    EXPECT_FALSE(matcher(std::string("error code")));
+}
+
+TEST(RegexMatchTranslatorTest, TranslateByOpType) {
+    using namespace milvus;
+    EXPECT_EQ(
+        translate_to_regex(std::string("a_%"), proto::plan::OpType::Match),
+        "a[\\s\\S][\\s\\S]*");
+    EXPECT_EQ(
+        translate_to_regex(std::string("a_%"), proto::plan::OpType::RegexMatch),
+        "a_%");
+}
@@ -37,9 +37,11 @@ import (
 type Broker interface {
 	DescribeCollectionInternal(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error)
 	ShowPartitionsInternal(ctx context.Context, collectionID int64) ([]int64, error)
+	ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error)
 	ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error)
 	ShowCollectionIDs(ctx context.Context) (*rootcoordpb.ShowCollectionIDsResponse, error)
 	ListDatabases(ctx context.Context) (*milvuspb.ListDatabasesResponse, error)
+	DescribeDatabase(ctx context.Context, dbName string) (*rootcoordpb.DescribeDatabaseResponse, error)
 	HasCollection(ctx context.Context, collectionID int64) (bool, error)
 }
 
@@ -75,6 +77,15 @@ func (b *coordinatorBroker) DescribeCollectionInternal(ctx context.Context, coll
 }
 
 func (b *coordinatorBroker) ShowPartitionsInternal(ctx context.Context, collectionID int64) ([]int64, error) {
+	resp, err := b.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartitionIDs(), nil
+}
+
+// ShowPartitions returns the ids and names of the partitions of the collection.
+func (b *coordinatorBroker) ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
@@ -94,7 +105,7 @@ func (b *coordinatorBroker) ShowPartitionsInternal(ctx context.Context, collecti
 		return nil, err
 	}
 
-	return resp.GetPartitionIDs(), nil
+	return resp, nil
 }
 
 func (b *coordinatorBroker) ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error) {
@@ -150,6 +161,21 @@ func (b *coordinatorBroker) ListDatabases(ctx context.Context) (*milvuspb.ListDa
 	return resp, nil
 }
 
+// DescribeDatabase returns the database info including the properties.
+func (b *coordinatorBroker) DescribeDatabase(ctx context.Context, dbName string) (*rootcoordpb.DescribeDatabaseResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	resp, err := b.mixCoord.DescribeDatabase(ctx, &rootcoordpb.DescribeDatabaseRequest{
+		Base:   commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeDatabase)),
+		DbName: dbName,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("failed to DescribeDatabase", zap.String("dbName", dbName), zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
 // HasCollection communicates with RootCoord and check whether this collection exist from the user's perspective.
 func (b *coordinatorBroker) HasCollection(ctx context.Context, collectionID int64) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
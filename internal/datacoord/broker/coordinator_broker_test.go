@@ -26,8 +26,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -209,6 +211,40 @@ func (s *BrokerSuite) TestListDatabases() {
 	})
 }
 
+func (s *BrokerSuite) TestDescribeDatabase() {
+	s.Run("return_success", func() {
+		s.SetupTest()
+
+		s.mixCoord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest) (*rootcoordpb.DescribeDatabaseResponse, error) {
+			s.Equal("db_1", req.GetDbName())
+			return &rootcoordpb.DescribeDatabaseResponse{
+				Status:     merr.Status(nil),
+				DbName:     "db_1",
+				Properties: []*commonpb.KeyValuePair{{Key: "key", Value: "value"}},
+			}, nil
+		})
+
+		resp, err := s.broker.DescribeDatabase(context.Background(), "db_1")
+		s.NoError(err)
+		s.Len(resp.GetProperties(), 1)
+
+		s.TearDownTest()
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+
+		s.mixCoord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest) (*rootcoordpb.DescribeDatabaseResponse, error) {
+			return nil, errors.New("mocked")
+		})
+
+		_, err := s.broker.DescribeDatabase(context.Background(), "db_1")
+		s.Error(err)
+
+		s.TearDownTest()
+	})
+}
+
 func (s *BrokerSuite) TestHasCollection() {
 	s.Run("return_success", func() {
 		s.SetupTest()
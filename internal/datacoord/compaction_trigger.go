@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/lifetime"
@@ -127,6 +128,7 @@ type compactionTrigger struct {
 	closeWaiter   sync.WaitGroup
 
 	indexEngineVersionManager IndexEngineVersionManager
+	maintenanceChecker        *maintenance.Checker
 
 	estimateNonDiskSegmentPolicy calUpperLimitPolicy
 	estimateDiskSegmentPolicy    calUpperLimitPolicy
@@ -141,6 +143,7 @@ func newCompactionTrigger(
 	allocator allocator.Allocator,
 	handler Handler,
 	indexVersionManager IndexEngineVersionManager,
+	maintenanceChecker *maintenance.Checker,
 ) *compactionTrigger {
 	return &compactionTrigger{
 		meta:                         meta,
@@ -149,6 +152,7 @@ func newCompactionTrigger(
 		manualSignals:                make(chan *compactionSignal, 100),
 		inspector:                    inspector,
 		indexEngineVersionManager:    indexVersionManager,
+		maintenanceChecker:           maintenanceChecker,
 		estimateDiskSegmentPolicy:    calBySchemaPolicyWithDiskIndex,
 		estimateNonDiskSegmentPolicy: calBySchemaPolicy,
 		handler:                      handler,
@@ -371,6 +375,11 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) error {
 			return nil
 		}
 
+		if !signal.isForce && !t.maintenanceChecker.Allow(context.Background(), group.collectionID, maintenance.OperationMix) {
+			log.RatedInfo(60, "skip mix compaction outside the maintenance window")
+			return nil
+		}
+
 		ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
 		if err != nil {
 			log.Warn("get compact time failed, skip to handle compaction")
@@ -1714,7 +1714,7 @@ func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	trigger := newCompactionTrigger(&meta{
 		indexMeta:  indexMeta,
 		channelCPs: newChannelCps(),
-	}, &compactionInspector{}, mock0Allocator, newMockHandler(), newIndexEngineVersionManager())
+	}, &compactionInspector{}, mock0Allocator, newMockHandler(), newIndexEngineVersionManager(), nil)
 
 	// Test too many deltalogs.
 	var binlogs []*datapb.FieldBinlog
@@ -2004,7 +2004,7 @@ func Test_compactionTrigger_new(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newCompactionTrigger(tt.args.meta, tt.args.inspector, tt.args.allocator, newMockHandler(), newMockVersionManager())
+			got := newCompactionTrigger(tt.args.meta, tt.args.inspector, tt.args.allocator, newMockHandler(), newMockVersionManager(), nil)
 			assert.Equal(t, tt.args.meta, got.meta)
 			assert.Equal(t, tt.args.inspector, got.inspector)
 			assert.Equal(t, tt.args.allocator, got.allocator)
@@ -2042,7 +2042,7 @@ func Test_TirggerCompaction_WaitResult(t *testing.T) {
 			&Server{
 				meta: m,
 			},
-		}, newMockVersionManager())
+		}, newMockVersionManager(), nil)
 	got.signals = make(chan *compactionSignal, 1)
 	{
 		_, err := got.TriggerCompaction(context.TODO(), NewCompactionSignal().
@@ -2315,6 +2315,7 @@ func (s *CompactionTriggerSuite) SetupTest() {
 		s.allocator,
 		s.handler,
 		s.versionManager,
+		nil,
 	)
 	s.tr.testingOnly = true
 }
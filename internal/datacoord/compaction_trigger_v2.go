@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -56,11 +57,27 @@ func (t CompactionTriggerType) String() string {
 		return "Clustering"
 	case TriggerTypeSingle:
 		return "Single"
+	case TriggerTypeSort:
+		return "Sort"
 	default:
 		return ""
 	}
 }
 
+// maintenanceOperation returns the operation of the trigger type gated by the maintenance window.
+func (t CompactionTriggerType) maintenanceOperation() maintenance.Operation {
+	switch t {
+	case TriggerTypeLevelZeroViewChange, TriggerTypeLevelZeroViewIDLE:
+		return maintenance.OperationL0
+	case TriggerTypeClustering:
+		return maintenance.OperationClustering
+	case TriggerTypeSort:
+		return maintenance.OperationSort
+	default:
+		return maintenance.OperationMix
+	}
+}
+
 type TriggerManager interface {
 	Start()
 	Stop()
@@ -103,15 +120,19 @@ type CompactionTriggerManager struct {
 	pauseCompactionChanMap  map[int64]chan struct{}
 	resumeCompactionChanMap map[int64]chan struct{}
 	compactionChanLock      sync.Mutex
+
+	// the automatic triggered compactions are gated by the maintenance window, the manual ones are not.
+	maintenanceChecker *maintenance.Checker
 }
 
-func NewCompactionTriggerManager(alloc allocator.Allocator, handler Handler, inspector CompactionInspector, meta *meta, importMeta ImportMeta) *CompactionTriggerManager {
+func NewCompactionTriggerManager(alloc allocator.Allocator, handler Handler, inspector CompactionInspector, meta *meta, importMeta ImportMeta, maintenanceChecker *maintenance.Checker) *CompactionTriggerManager {
 	m := &CompactionTriggerManager{
 		allocator:               alloc,
 		handler:                 handler,
 		inspector:               inspector,
 		meta:                    meta,
 		importMeta:              importMeta,
+		maintenanceChecker:      maintenanceChecker,
 		pauseCompactionChanMap:  make(map[int64]chan struct{}),
 		resumeCompactionChanMap: make(map[int64]chan struct{}),
 	}
@@ -237,7 +258,7 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 			}
 			if len(events) > 0 {
 				for triggerType, views := range events {
-					m.notify(ctx, triggerType, views)
+					m.notify(ctx, triggerType, m.filterByMaintenanceWindow(ctx, triggerType, views))
 				}
 			}
 			m.setL0Triggering(false)
@@ -256,7 +277,7 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 			}
 			if len(events) > 0 {
 				for triggerType, views := range events {
-					m.notify(ctx, triggerType, views)
+					m.notify(ctx, triggerType, m.filterByMaintenanceWindow(ctx, triggerType, views))
 				}
 			}
 		case <-singleTicker.C:
@@ -274,7 +295,7 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 			}
 			if len(events) > 0 {
 				for triggerType, views := range events {
-					m.notify(ctx, triggerType, views)
+					m.notify(ctx, triggerType, m.filterByMaintenanceWindow(ctx, triggerType, views))
 				}
 			}
 		case segID := <-getStatsTaskChSingleton():
@@ -284,7 +305,7 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 				log.Warn("segment no need to do sort compaction", zap.Int64("segmentID", segID))
 				continue
 			}
-			m.notify(ctx, TriggerTypeSort, []CompactionView{view})
+			m.notify(ctx, TriggerTypeSort, m.filterByMaintenanceWindow(ctx, TriggerTypeSort, []CompactionView{view}))
 		}
 	}
 }
@@ -305,6 +326,20 @@ func (m *CompactionTriggerManager) ManualTrigger(ctx context.Context, collection
 	return triggerID, nil
 }
 
+// filterByMaintenanceWindow drops the views of the collections whose maintenance window doesn't allow the trigger type now.
+func (m *CompactionTriggerManager) filterByMaintenanceWindow(ctx context.Context, triggerType CompactionTriggerType, views []CompactionView) []CompactionView {
+	op := triggerType.maintenanceOperation()
+	return lo.Filter(views, func(view CompactionView, _ int) bool {
+		collectionID := view.GetGroupLabel().CollectionID
+		if m.maintenanceChecker.Allow(ctx, collectionID, op) {
+			return true
+		}
+		log.Ctx(ctx).RatedInfo(60, "skip compaction outside the maintenance window",
+			zap.Int64("collectionID", collectionID), zap.String("eventType", triggerType.String()))
+		return false
+	})
+}
+
 func (m *CompactionTriggerManager) notify(ctx context.Context, eventType CompactionTriggerType, views []CompactionView) {
 	log := log.Ctx(ctx)
 	log.Debug("Start to trigger compactions", zap.String("eventType", eventType.String()))
@@ -17,6 +17,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
@@ -63,7 +64,7 @@ func (s *CompactionTriggerManagerSuite) SetupTest() {
 	importMeta, err := NewImportMeta(context.TODO(), catalog, s.mockAlloc, s.meta)
 	s.Require().NoError(err)
 	s.importMeta = importMeta
-	s.triggerManager = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.inspector, s.meta, s.importMeta)
+	s.triggerManager = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.inspector, s.meta, s.importMeta, nil)
 }
 
 func (s *CompactionTriggerManagerSuite) TestNotifyByViewIDLE() {
@@ -115,6 +116,35 @@ func (s *CompactionTriggerManagerSuite) TestNotifyByViewIDLE() {
 	s.triggerManager.notify(context.Background(), TriggerTypeLevelZeroViewIDLE, levelZeroViews)
 }
 
+func (s *CompactionTriggerManagerSuite) TestFilterByMaintenanceWindow() {
+	// the window on february 30th is never active.
+	s.triggerManager.maintenanceChecker = maintenance.NewChecker(
+		func(ctx context.Context, collectionID int64) (string, map[string]string, error) {
+			return "default", map[string]string{
+				common.MaintenanceWindowCronKey:       "0 0 30 2 *",
+				common.MaintenanceWindowDurationKey:   "1h",
+				common.MaintenanceWindowOperationsKey: "l0,sort",
+			}, nil
+		},
+		func(ctx context.Context, dbName string) (map[string]string, error) {
+			return nil, nil
+		},
+		nil,
+	)
+	defer func() {
+		s.triggerManager.maintenanceChecker = nil
+	}()
+
+	collSegs := s.meta.GetCompactableSegmentGroupByCollection()
+	views := s.triggerManager.l0Policy.groupL0ViewsByPartChan(1, GetViewsByInfo(collSegs[1]...))
+	s.Require().NotEmpty(views)
+
+	s.Empty(s.triggerManager.filterByMaintenanceWindow(context.Background(), TriggerTypeLevelZeroViewChange, views))
+	s.Empty(s.triggerManager.filterByMaintenanceWindow(context.Background(), TriggerTypeSort, views))
+	s.Equal(views, s.triggerManager.filterByMaintenanceWindow(context.Background(), TriggerTypeClustering, views))
+	s.Equal(views, s.triggerManager.filterByMaintenanceWindow(context.Background(), TriggerTypeSingle, views))
+}
+
 func (s *CompactionTriggerManagerSuite) TestNotifyByViewChange() {
 	handler := NewNMockHandler(s.T())
 	handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{}, nil)
@@ -368,7 +398,7 @@ func TestCompactionAndImport(t *testing.T) {
 	catalog.EXPECT().SaveImportTask(mock.Anything, mock.Anything).Return(nil)
 	importMeta, err := NewImportMeta(context.TODO(), catalog, mockAlloc, meta)
 	assert.NoError(t, err)
-	triggerManager := NewCompactionTriggerManager(mockAlloc, handler, inspector, meta, importMeta)
+	triggerManager := NewCompactionTriggerManager(mockAlloc, handler, inspector, meta, importMeta, nil)
 
 	Params.Save(Params.DataCoordCfg.L0CompactionTriggerInterval.Key, "1")
 	defer Params.Reset(Params.DataCoordCfg.L0CompactionTriggerInterval.Key)
@@ -41,7 +41,6 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
-	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -74,6 +73,8 @@ type garbageCollector struct {
 	wg         sync.WaitGroup
 	cmdCh      chan gcCmd
 	pauseUntil atomic.Time
+	mode       atomic.String
+	reporter   *gcReporter
 
 	systemMetricsListener *hardware.SystemMetricsListener
 }
@@ -120,15 +121,23 @@ func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageColl
 		zap.Duration("dropTolerance", opt.dropTolerance))
 	opt.removeObjectPool = conc.NewPool[struct{}](Params.DataCoordCfg.GCRemoveConcurrent.GetAsInt(), conc.WithExpiryDuration(time.Minute))
 	ctx, cancel := context.WithCancel(context.Background())
-	return &garbageCollector{
+	gc := &garbageCollector{
 		ctx:                   ctx,
 		cancel:                cancel,
 		meta:                  meta,
 		handler:               handler,
 		option:                opt,
 		cmdCh:                 make(chan gcCmd),
+		reporter:              newGcReporter(),
 		systemMetricsListener: newSystemMetricsListener(&opt),
 	}
+	mode, err := parseGcMode(Params.DataCoordCfg.GCMode.GetValue())
+	if err != nil {
+		log.Warn("invalid gc mode, use delete mode", zap.Error(err))
+		mode = gcModeDelete
+	}
+	gc.mode.Store(string(mode))
+	return gc
 }
 
 // start a goroutine and perform gc check every `checkInterval`
@@ -196,6 +205,7 @@ func (gc *garbageCollector) work(ctx context.Context) {
 			gc.recycleUnusedAnalyzeFiles(ctx)
 			gc.recycleUnusedTextIndexFiles(ctx)
 			gc.recycleUnusedJSONIndexFiles(ctx)
+			gc.recycleTrashFiles(ctx)
 		})
 	}()
 	go func() {
@@ -283,6 +293,12 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 	log.Info("start recycleUnusedBinlogFiles...")
 	defer func() { log.Info("recycleUnusedBinlogFiles done", zap.Duration("timeCost", time.Since(start))) }()
 
+	rec := gc.newRecorder("recycleUnusedBinlogFiles")
+	defer gc.reporter.commit(rec)
+
+	// the files shared by the segments restored from snapshots are valid even if the owner segment is recycled.
+	sharedLogPaths := getSharedLogPaths(gc.meta.SelectSegments(ctx))
+
 	type scanTask struct {
 		prefix  string
 		checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool
@@ -292,7 +308,7 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 		{
 			prefix: path.Join(gc.option.cli.RootPath(), common.SegmentInsertLogPath),
 			checker: func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
-				return segment != nil
+				return segment != nil || sharedLogPaths.Contain(objectInfo.FilePath)
 			},
 			label: metrics.InsertFileLabel,
 		},
@@ -304,7 +320,7 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 					log.Warn("garbageCollector find dirty stats log", zap.String("filePath", objectInfo.FilePath), zap.Error(err))
 					return false
 				}
-				return segment != nil && segment.IsStatsLogExists(logID)
+				return (segment != nil && segment.IsStatsLogExists(logID)) || sharedLogPaths.Contain(objectInfo.FilePath)
 			},
 			label: metrics.StatFileLabel,
 		},
@@ -316,21 +332,21 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 					log.Warn("garbageCollector find dirty dleta log", zap.String("filePath", objectInfo.FilePath), zap.Error(err))
 					return false
 				}
-				return segment != nil && segment.IsDeltaLogExists(logID)
+				return (segment != nil && segment.IsDeltaLogExists(logID)) || sharedLogPaths.Contain(objectInfo.FilePath)
 			},
 			label: metrics.DeleteFileLabel,
 		},
 	}
 
 	for _, task := range scanTasks {
-		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, task.checker)
+		gc.recycleUnusedBinLogWithChecker(ctx, rec, task.prefix, task.label, task.checker)
 	}
 	metrics.GarbageCollectorRunCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(1)
 }
 
 // recycleUnusedBinLogWithChecker scans the prefix and checks the path with checker.
 // GC the file if checker returns false.
-func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context, prefix string, label string, checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool) {
+func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context, rec *gcRecorder, prefix string, label string, checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool) {
 	logger := log.With(zap.String("prefix", prefix))
 	logger.Info("garbageCollector recycleUnusedBinlogFiles start", zap.String("prefix", prefix))
 	lastFilePath := ""
@@ -376,7 +392,7 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 			logger := logger.With(zap.String("file", file))
 			logger.Info("garbageCollector recycleUnusedBinlogFiles remove file...")
 
-			if err = gc.option.cli.Remove(ctx, file); err != nil {
+			if err = gc.recycleObject(ctx, rec, gcCategoryBinlog, file); err != nil {
 				log.Warn("garbageCollector recycleUnusedBinlogFiles remove file failed", zap.Error(err))
 				unexpectedFailure.Inc()
 				return struct{}{}, err
@@ -455,6 +471,9 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	log.Info("start clear dropped segments...")
 	defer func() { log.Info("clear dropped segments done", zap.Duration("timeCost", time.Since(start))) }()
 
+	rec := gc.newRecorder("recycleDroppedSegments")
+	defer gc.reporter.commit(rec)
+
 	all := gc.meta.SelectSegments(ctx)
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
@@ -502,6 +521,7 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	for _, segmentID := range segments {
 		loadedSegments.Insert(segmentID)
 	}
+	sharedLogPaths := getSharedLogPaths(all)
 
 	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
 	for segmentID, segment := range drops {
@@ -516,6 +536,10 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 			log.Info("skip GC segment since it is loaded", zap.Int64("segmentID", segmentID))
 			continue
 		}
+		if gc.meta.snapshotMeta.IsSegmentPinned(segmentID) {
+			log.Info("skip GC segment since it is pinned by snapshot")
+			continue
+		}
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
@@ -524,12 +548,19 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		binlog.DecompressBinLogs(cloned.SegmentInfo)
 
 		logs := getLogs(cloned)
-		for key := range getTextLogs(cloned) {
-			logs[key] = struct{}{}
+		textJSONLogs := getTextLogs(cloned)
+		for key := range getJSONKeyLogs(cloned, gc) {
+			textJSONLogs[key] = struct{}{}
 		}
 
-		for key := range getJSONKeyLogs(cloned, gc) {
-			logs[key] = struct{}{}
+		// the shared files are left to the unused binlog files recycler,
+		// which removes them once no segment refers to them.
+		for _, files := range []map[string]struct{}{logs, textJSONLogs} {
+			for key := range files {
+				if sharedLogPaths.Contain(key) {
+					delete(files, key)
+				}
+			}
 		}
 
 		log.Info("GC segment start...", zap.Int("insert_logs", len(cloned.GetBinlogs())),
@@ -538,11 +569,21 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 			zap.Int("bm25_logs", len(cloned.GetBm25Statslogs())),
 			zap.Int("text_logs", len(cloned.GetTextStatsLogs())),
 			zap.Int("json_key_logs", len(cloned.GetJsonKeyStats())))
-		if err := gc.removeObjectFiles(ctx, logs); err != nil {
+		if err := gc.removeObjectFiles(ctx, rec, gcCategoryBinlog, logs); err != nil {
 			log.Warn("GC segment remove logs failed", zap.Error(err))
 			cloned = nil
 			continue
 		}
+		if err := gc.removeObjectFiles(ctx, rec, gcCategoryTextJSONIndex, textJSONLogs); err != nil {
+			log.Warn("GC segment remove text and json index logs failed", zap.Error(err))
+			cloned = nil
+			continue
+		}
+		if rec.mode == gcModeDryRun {
+			// keep the meta in dry run mode, the files are still referred by the segment.
+			cloned = nil
+			continue
+		}
 
 		if err := gc.meta.DropSegment(ctx, cloned.GetID()); err != nil {
 			log.Warn("GC segment meta failed to drop segment", zap.Error(err))
@@ -670,12 +711,12 @@ func getJSONKeyLogs(sinfo *SegmentInfo, gc *garbageCollector) map[string]struct{
 }
 
 // removeObjectFiles remove file from oss storage, return error if any log failed to remove.
-func (gc *garbageCollector) removeObjectFiles(ctx context.Context, filePaths map[string]struct{}) error {
+func (gc *garbageCollector) removeObjectFiles(ctx context.Context, rec *gcRecorder, category string, filePaths map[string]struct{}) error {
 	futures := make([]*conc.Future[struct{}], 0)
 	for filePath := range filePaths {
 		filePath := filePath
 		future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
-			err := gc.option.cli.Remove(ctx, filePath)
+			err := gc.recycleObject(ctx, rec, category, filePath)
 			// ignore the error Key Not Found
 			if err != nil {
 				if !errors.Is(err, merr.ErrIoKeyNotFound) {
@@ -722,17 +763,29 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 	log.Info("start recycleUnusedSegIndexes...")
 	defer func() { log.Info("recycleUnusedSegIndexes done", zap.Duration("timeCost", time.Since(start))) }()
 
+	rec := gc.newRecorder("recycleUnusedSegIndexes")
+	defer gc.reporter.commit(rec)
+
 	segIndexes := gc.meta.indexMeta.GetAllSegIndexes()
+	sharedIndexes := getSharedIndexBuildIDs(segIndexes)
 	for _, segIdx := range segIndexes {
 		if ctx.Err() != nil {
 			// process canceled.
 			return
 		}
+		if gc.meta.snapshotMeta.IsBuildIDPinned(segIdx.BuildID) {
+			continue
+		}
 
 		// 1. segment belongs to is deleted.
 		// 2. index is deleted.
 		if gc.meta.GetSegment(ctx, segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
 			indexFiles := gc.getAllIndexFilesOfIndex(segIdx)
+			if segIdx.SourceBuildID != 0 || sharedIndexes.Contain(segIdx.BuildID) {
+				// the shared files are left to the unused index files recycler,
+				// which removes them once no segment index refers to them.
+				indexFiles = make(map[string]struct{})
+			}
 			log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
 				zap.Int64("partitionID", segIdx.PartitionID),
 				zap.Int64("segmentID", segIdx.SegmentID),
@@ -743,10 +796,14 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 			log.Info("GC Segment Index file start...")
 
 			// Remove index files first.
-			if err := gc.removeObjectFiles(ctx, indexFiles); err != nil {
+			if err := gc.removeObjectFiles(ctx, rec, gcCategoryIndex, indexFiles); err != nil {
 				log.Warn("fail to remove index files for index", zap.Error(err))
 				continue
 			}
+			if rec.mode == gcModeDryRun {
+				// keep the meta in dry run mode, the files are still referred by the segment index.
+				continue
+			}
 
 			// Remove meta from index meta.
 			if err := gc.meta.indexMeta.RemoveSegmentIndex(ctx, segIdx.BuildID); err != nil {
@@ -764,7 +821,11 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 	log := log.Ctx(ctx).With(zap.String("gcName", "recycleUnusedIndexFiles"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedIndexFiles...")
 
+	rec := gc.newRecorder("recycleUnusedIndexFiles")
+	defer gc.reporter.commit(rec)
+
 	prefix := path.Join(gc.option.cli.RootPath(), common.SegmentIndexPath) + "/"
+	sharedIndexes := getSharedIndexBuildIDs(gc.meta.indexMeta.GetAllSegIndexes())
 	// list dir first
 	keyCount := 0
 	err := gc.option.cli.WalkWithPrefix(ctx, prefix, false, func(indexPathInfo *storage.ChunkObjectInfo) bool {
@@ -786,10 +847,14 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			logger.Info("garbageCollector can not recycle index files")
 			return true
 		}
+		if segIdx == nil && sharedIndexes.Contain(buildID) {
+			logger.Info("garbageCollector can not recycle index files since they are shared by other segment indexes")
+			return true
+		}
 		if segIdx == nil {
 			// buildID no longer exists in meta, remove all index files
 			logger.Info("garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files")
-			err = gc.option.cli.RemoveWithPrefix(ctx, key)
+			err = gc.recycleObjectsWithPrefix(ctx, rec, gcCategoryIndex, key)
 			if err != nil {
 				logger.Warn("garbageCollector recycleUnusedIndexFiles remove index files failed", zap.Error(err))
 				return true
@@ -812,7 +877,7 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 					logger := logger.With(zap.String("file", file))
 					logger.Info("garbageCollector recycleUnusedIndexFiles remove file...")
 
-					if err := gc.option.cli.Remove(ctx, file); err != nil {
+					if err := gc.recycleObject(ctx, rec, gcCategoryIndex, file); err != nil {
 						logger.Warn("garbageCollector recycleUnusedIndexFiles remove file failed", zap.Error(err))
 						return struct{}{}, err
 					}
@@ -849,19 +914,30 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 // getAllIndexFilesOfIndex returns the all index files of index.
 func (gc *garbageCollector) getAllIndexFilesOfIndex(segmentIndex *model.SegmentIndex) map[string]struct{} {
 	filesMap := make(map[string]struct{})
-	for _, fileID := range segmentIndex.IndexFileKeys {
-		filepath := metautil.BuildSegmentIndexFilePath(gc.option.cli.RootPath(), segmentIndex.BuildID, segmentIndex.IndexVersion,
-			segmentIndex.PartitionID, segmentIndex.SegmentID, fileID)
+	for _, filepath := range getSegmentIndexFilePaths(gc.option.cli.RootPath(), segmentIndex) {
 		filesMap[filepath] = struct{}{}
 	}
 	return filesMap
 }
 
+// getSharedIndexBuildIDs returns the build ids whose index files are shared by the segment indexes restored from snapshots.
+func getSharedIndexBuildIDs(segIndexes map[int64]*model.SegmentIndex) typeutil.UniqueSet {
+	buildIDs := make(typeutil.UniqueSet)
+	for _, segIdx := range segIndexes {
+		if segIdx.SourceBuildID != 0 {
+			buildIDs.Insert(segIdx.SourceBuildID)
+		}
+	}
+	return buildIDs
+}
+
 // recycleUnusedAnalyzeFiles is used to delete those analyze stats files that no longer exist in the meta.
 func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context) {
 	log := log.Ctx(ctx)
 	log.Info("start recycleUnusedAnalyzeFiles")
 	startTs := time.Now()
+	rec := gc.newRecorder("recycleUnusedAnalyzeFiles")
+	defer gc.reporter.commit(rec)
 	prefix := path.Join(gc.option.cli.RootPath(), common.AnalyzeStatsPath) + "/"
 	// list dir first
 	keys := make([]string, 0)
@@ -898,7 +974,7 @@ func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context) {
 			// taskID no longer exists in meta, remove all analysis files
 			log.Info("garbageCollector recycleUnusedAnalyzeFiles find meta has not exist, remove index files",
 				zap.Int64("taskID", taskID))
-			err = gc.option.cli.RemoveWithPrefix(ctx, key)
+			err = gc.recycleObjectsWithPrefix(ctx, rec, gcCategoryAnalyze, key)
 			if err != nil {
 				log.Warn("garbageCollector recycleUnusedAnalyzeFiles remove analyze stats files failed",
 					zap.Int64("taskID", taskID), zap.String("prefix", key), zap.Error(err))
@@ -918,7 +994,7 @@ func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context) {
 				return
 			}
 			removePrefix := prefix + fmt.Sprintf("%d/", task.Version)
-			if err := gc.option.cli.RemoveWithPrefix(ctx, removePrefix); err != nil {
+			if err := gc.recycleObjectsWithPrefix(ctx, rec, gcCategoryAnalyze, removePrefix); err != nil {
 				log.Warn("garbageCollector recycleUnusedAnalyzeFiles remove files with prefix failed",
 					zap.Int64("taskID", taskID), zap.String("removePrefix", removePrefix))
 				continue
@@ -936,6 +1012,9 @@ func (gc *garbageCollector) recycleUnusedTextIndexFiles(ctx context.Context) {
 	log.Info("start recycleUnusedTextIndexFiles...")
 	defer func() { log.Info("recycleUnusedTextIndexFiles done", zap.Duration("timeCost", time.Since(start))) }()
 
+	rec := gc.newRecorder("recycleUnusedTextIndexFiles")
+	defer gc.reporter.commit(rec)
+
 	hasTextIndexSegments := gc.meta.SelectSegments(ctx, SegmentFilterFunc(func(info *SegmentInfo) bool {
 		return len(info.GetTextStatsLogs()) != 0
 	}))
@@ -958,7 +1037,7 @@ func (gc *garbageCollector) recycleUnusedTextIndexFiles(ctx context.Context) {
 						log := log.With(zap.String("file", file))
 						log.Info("garbageCollector recycleUnusedTextIndexFiles remove file...")
 
-						if err := gc.option.cli.Remove(ctx, file); err != nil {
+						if err := gc.recycleObject(ctx, rec, gcCategoryTextJSONIndex, file); err != nil {
 							log.Warn("garbageCollector recycleUnusedTextIndexFiles remove file failed", zap.Error(err))
 							return struct{}{}, err
 						}
@@ -997,6 +1076,9 @@ func (gc *garbageCollector) recycleUnusedJSONIndexFiles(ctx context.Context) {
 	log.Info("start recycleUnusedJSONIndexFiles...")
 	defer func() { log.Info("recycleUnusedJSONIndexFiles done", zap.Duration("timeCost", time.Since(start))) }()
 
+	rec := gc.newRecorder("recycleUnusedJSONIndexFiles")
+	defer gc.reporter.commit(rec)
+
 	hasJSONIndexSegments := gc.meta.SelectSegments(ctx, SegmentFilterFunc(func(info *SegmentInfo) bool {
 		return len(info.GetJsonKeyStats()) != 0
 	}))
@@ -1019,7 +1101,7 @@ func (gc *garbageCollector) recycleUnusedJSONIndexFiles(ctx context.Context) {
 						log := log.With(zap.String("file", file))
 						log.Info("garbageCollector recycleUnusedJSONIndexFiles remove file...")
 
-						if err := gc.option.cli.Remove(ctx, file); err != nil {
+						if err := gc.recycleObject(ctx, rec, gcCategoryTextJSONIndex, file); err != nil {
 							log.Warn("garbageCollector recycleUnusedJSONIndexFiles remove file failed", zap.Error(err))
 							return struct{}{}, err
 						}
@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// gcMode decides what the garbage collector does with the objects which are not used anymore.
+type gcMode string
+
+const (
+	// gcModeDelete removes the objects immediately.
+	gcModeDelete gcMode = "delete"
+	// gcModeDryRun keeps the objects and the related meta, only reports the objects that would be removed.
+	gcModeDryRun gcMode = "dryRun"
+	// gcModeQuarantine moves the objects to the trash prefix, they are removed after the trash retention.
+	gcModeQuarantine gcMode = "quarantine"
+)
+
+// the categories of the objects in gc report.
+const (
+	gcCategoryBinlog        = "binlog"
+	gcCategoryIndex         = "index"
+	gcCategoryAnalyze       = "analyze"
+	gcCategoryTextJSONIndex = "text_json_index"
+)
+
+func parseGcMode(mode string) (gcMode, error) {
+	for _, m := range []gcMode{gcModeDelete, gcModeDryRun, gcModeQuarantine} {
+		if strings.EqualFold(mode, string(m)) {
+			return m, nil
+		}
+	}
+	return "", merr.WrapErrParameterInvalid("delete, dryRun or quarantine", mode, "invalid gc mode")
+}
+
+type gcCategoryStat struct {
+	objectNum   int64
+	samplePaths []string
+}
+
+// gcRecorder records the objects recycled by one run of a recycle task.
+// The mode is fixed when the run begins, so a run never mixes the modes.
+type gcRecorder struct {
+	task string
+	mode gcMode
+
+	mu    sync.Mutex
+	stats map[string]*gcCategoryStat
+}
+
+func (r *gcRecorder) record(category string, filePath string) {
+	if r.mode == gcModeDelete {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stat, ok := r.stats[category]
+	if !ok {
+		stat = &gcCategoryStat{}
+		r.stats[category] = stat
+	}
+	stat.objectNum++
+	if len(stat.samplePaths) < paramtable.Get().DataCoordCfg.GCReportSampleNum.GetAsInt() {
+		stat.samplePaths = append(stat.samplePaths, filePath)
+	}
+}
+
+// gcReporter keeps the result of the latest run of each recycle task,
+// the report is only collected in dryRun and quarantine mode.
+type gcReporter struct {
+	mu      sync.RWMutex
+	reports map[string]*gcRecorder
+}
+
+func newGcReporter() *gcReporter {
+	return &gcReporter{
+		reports: make(map[string]*gcRecorder),
+	}
+}
+
+func (r *gcReporter) commit(rec *gcRecorder) {
+	if rec.mode == gcModeDelete {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports[rec.task] = rec
+}
+
+func (r *gcReporter) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports = make(map[string]*gcRecorder)
+}
+
+// report merges the results of the recycle tasks by category.
+func (r *gcReporter) report() []*datapb.GcReportCategory {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	categories := make(map[string]*datapb.GcReportCategory)
+	for _, rec := range r.reports {
+		rec.mu.Lock()
+		for category, stat := range rec.stats {
+			c, ok := categories[category]
+			if !ok {
+				c = &datapb.GcReportCategory{Category: category}
+				categories[category] = c
+			}
+			c.ObjectNum += stat.objectNum
+			c.SamplePaths = append(c.SamplePaths, stat.samplePaths...)
+		}
+		rec.mu.Unlock()
+	}
+	result := make([]*datapb.GcReportCategory, 0, len(categories))
+	for _, c := range categories {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetCategory() < result[j].GetCategory()
+	})
+	return result
+}
+
+// SetMode changes the gc mode, the report of the previous mode is dropped.
+func (gc *garbageCollector) SetMode(mode gcMode) {
+	if old := gcMode(gc.mode.Swap(string(mode))); old != mode {
+		gc.reporter.reset()
+		log.Info("garbage collection mode changed", zap.String("old", string(old)), zap.String("new", string(mode)))
+	}
+}
+
+func (gc *garbageCollector) GetMode() gcMode {
+	return gcMode(gc.mode.Load())
+}
+
+// GetReport returns the objects recycled by the latest run of each recycle task.
+func (gc *garbageCollector) GetReport() []*datapb.GcReportCategory {
+	return gc.reporter.report()
+}
+
+// newRecorder begins a run of the recycle task, the recorder must be committed when the run finishes.
+func (gc *garbageCollector) newRecorder(task string) *gcRecorder {
+	return &gcRecorder{
+		task:  task,
+		mode:  gc.GetMode(),
+		stats: make(map[string]*gcCategoryStat),
+	}
+}
+
+// recycleObject removes, quarantines or only records the object by the mode of the recorder.
+func (gc *garbageCollector) recycleObject(ctx context.Context, rec *gcRecorder, category string, filePath string) error {
+	var err error
+	switch rec.mode {
+	case gcModeDryRun:
+	case gcModeQuarantine:
+		err = gc.quarantineObject(ctx, filePath)
+	default:
+		err = gc.option.cli.Remove(ctx, filePath)
+	}
+	if err != nil {
+		return err
+	}
+	rec.record(category, filePath)
+	return nil
+}
+
+// recycleObjectsWithPrefix recycles all the objects under the prefix.
+func (gc *garbageCollector) recycleObjectsWithPrefix(ctx context.Context, rec *gcRecorder, category string, prefix string) error {
+	if rec.mode == gcModeDelete {
+		return gc.option.cli.RemoveWithPrefix(ctx, prefix)
+	}
+	files := make([]string, 0)
+	err := gc.option.cli.WalkWithPrefix(ctx, prefix, true, func(chunkInfo *storage.ChunkObjectInfo) bool {
+		files = append(files, chunkInfo.FilePath)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := gc.recycleObject(ctx, rec, category, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quarantineObject moves the object to the trash prefix, the relative path under the root path is kept.
+// The object is copied on the server side, so large objects are never loaded into memory.
+func (gc *garbageCollector) quarantineObject(ctx context.Context, filePath string) error {
+	if err := gc.option.cli.Copy(ctx, filePath, gc.getTrashPath(filePath)); err != nil {
+		return err
+	}
+	return gc.option.cli.Remove(ctx, filePath)
+}
+
+func (gc *garbageCollector) getTrashPath(filePath string) string {
+	relativePath := strings.TrimPrefix(filePath, gc.option.cli.RootPath())
+	return path.Join(gc.option.cli.RootPath(), common.TrashPath, relativePath)
+}
+
+// recycleTrashFiles removes the quarantined objects which exceed the trash retention.
+// It works in all modes, so the trash is still cleared after the mode is changed back to delete.
+func (gc *garbageCollector) recycleTrashFiles(ctx context.Context) {
+	start := time.Now()
+	log := log.Ctx(ctx).With(zap.String("gcName", "recycleTrashFiles"), zap.Time("startAt", start))
+	log.Info("start recycleTrashFiles...")
+
+	retention := paramtable.Get().DataCoordCfg.GCTrashRetention.GetAsDuration(time.Second)
+	prefix := path.Join(gc.option.cli.RootPath(), common.TrashPath) + "/"
+	expired := make([]string, 0)
+	err := gc.option.cli.WalkWithPrefix(ctx, prefix, true, func(chunkInfo *storage.ChunkObjectInfo) bool {
+		if time.Since(chunkInfo.ModifyTime) > retention {
+			expired = append(expired, chunkInfo.FilePath)
+		}
+		return true
+	})
+	if err != nil {
+		log.Warn("garbageCollector recycleTrashFiles walk trash failed", zap.Error(err))
+		return
+	}
+
+	removed := 0
+	for _, file := range expired {
+		if ctx.Err() != nil {
+			// process canceled.
+			return
+		}
+		if err := gc.option.cli.Remove(ctx, file); err != nil {
+			log.Warn("garbageCollector recycleTrashFiles remove file failed", zap.String("file", file), zap.Error(err))
+			continue
+		}
+		removed++
+	}
+	log.Info("recycleTrashFiles done", zap.Int("expired", len(expired)), zap.Int("removed", removed),
+		zap.Duration("timeCost", time.Since(start)))
+}
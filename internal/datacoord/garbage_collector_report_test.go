@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestParseGcMode(t *testing.T) {
+	mode, err := parseGcMode("delete")
+	assert.NoError(t, err)
+	assert.Equal(t, gcModeDelete, mode)
+
+	mode, err = parseGcMode("DRYRUN")
+	assert.NoError(t, err)
+	assert.Equal(t, gcModeDryRun, mode)
+
+	mode, err = parseGcMode("quarantine")
+	assert.NoError(t, err)
+	assert.Equal(t, gcModeQuarantine, mode)
+
+	_, err = parseGcMode("unknown")
+	assert.Error(t, err)
+}
+
+func TestGarbageCollector_recycleObject(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("delete", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Remove(mock.Anything, "root/insert_log/1").Return(nil).Once()
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+
+		rec := gc.newRecorder("test")
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryBinlog, "root/insert_log/1"))
+		gc.reporter.commit(rec)
+		assert.Empty(t, gc.GetReport())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+		gc.SetMode(gcModeDryRun)
+
+		rec := gc.newRecorder("test")
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryBinlog, "root/insert_log/1"))
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryIndex, "root/index_files/1"))
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryIndex, "root/index_files/2"))
+		// the report is visible only after the run is committed.
+		assert.Empty(t, gc.GetReport())
+		gc.reporter.commit(rec)
+
+		report := gc.GetReport()
+		assert.Len(t, report, 2)
+		assert.Equal(t, gcCategoryBinlog, report[0].GetCategory())
+		assert.EqualValues(t, 1, report[0].GetObjectNum())
+		assert.Equal(t, gcCategoryIndex, report[1].GetCategory())
+		assert.EqualValues(t, 2, report[1].GetObjectNum())
+		assert.ElementsMatch(t, []string{"root/index_files/1", "root/index_files/2"}, report[1].GetSamplePaths())
+
+		// the next run of the same task replaces the previous result.
+		rec = gc.newRecorder("test")
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryBinlog, "root/insert_log/1"))
+		gc.reporter.commit(rec)
+		assert.Len(t, gc.GetReport(), 1)
+
+		// the report is dropped when the mode changes.
+		gc.SetMode(gcModeQuarantine)
+		assert.Empty(t, gc.GetReport())
+	})
+
+	t.Run("quarantine", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().Copy(mock.Anything, "root/insert_log/1", "root/trash/insert_log/1").Return(nil).Once()
+		cm.EXPECT().Remove(mock.Anything, "root/insert_log/1").Return(nil).Once()
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+		gc.SetMode(gcModeQuarantine)
+
+		rec := gc.newRecorder("test")
+		assert.NoError(t, gc.recycleObject(context.TODO(), rec, gcCategoryBinlog, "root/insert_log/1"))
+		gc.reporter.commit(rec)
+		assert.Len(t, gc.GetReport(), 1)
+	})
+
+	t.Run("quarantine failed", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().Copy(mock.Anything, "root/insert_log/1", "root/trash/insert_log/1").Return(errors.New("mock")).Once()
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+		gc.SetMode(gcModeQuarantine)
+
+		rec := gc.newRecorder("test")
+		assert.Error(t, gc.recycleObject(context.TODO(), rec, gcCategoryBinlog, "root/insert_log/1"))
+		gc.reporter.commit(rec)
+		assert.Empty(t, gc.GetReport())
+	})
+}
+
+func TestGarbageCollector_recycleObjectsWithPrefix(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("delete", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RemoveWithPrefix(mock.Anything, "root/analyze_stats/1").Return(nil).Once()
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+
+		assert.NoError(t, gc.recycleObjectsWithPrefix(context.TODO(), gc.newRecorder("test"), gcCategoryAnalyze, "root/analyze_stats/1"))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().WalkWithPrefix(mock.Anything, "root/analyze_stats/1", true, mock.Anything).RunAndReturn(
+			func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+				walkFunc(&storage.ChunkObjectInfo{FilePath: "root/analyze_stats/1/1/centroids"})
+				walkFunc(&storage.ChunkObjectInfo{FilePath: "root/analyze_stats/1/1/offset_mapping"})
+				return nil
+			})
+		gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+		gc.SetMode(gcModeDryRun)
+
+		rec := gc.newRecorder("test")
+		assert.NoError(t, gc.recycleObjectsWithPrefix(context.TODO(), rec, gcCategoryAnalyze, "root/analyze_stats/1"))
+		gc.reporter.commit(rec)
+		report := gc.GetReport()
+		assert.Len(t, report, 1)
+		assert.EqualValues(t, 2, report[0].GetObjectNum())
+	})
+}
+
+func TestGarbageCollector_recycleTrashFiles(t *testing.T) {
+	paramtable.Init()
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("root")
+	cm.EXPECT().WalkWithPrefix(mock.Anything, "root/trash/", true, mock.Anything).RunAndReturn(
+		func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+			walkFunc(&storage.ChunkObjectInfo{FilePath: "root/trash/insert_log/1", ModifyTime: time.Now().Add(-30 * 24 * time.Hour)})
+			walkFunc(&storage.ChunkObjectInfo{FilePath: "root/trash/insert_log/2", ModifyTime: time.Now()})
+			return nil
+		})
+	cm.EXPECT().Remove(mock.Anything, "root/trash/insert_log/1").Return(nil).Once()
+	gc := newGarbageCollector(nil, nil, GcOption{cli: cm})
+
+	gc.recycleTrashFiles(context.TODO())
+}
@@ -1556,28 +1556,28 @@ func TestGarbageCollector_removeObjectPool(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
 		defer call.Unset()
-		b := gc.removeObjectFiles(context.TODO(), logs)
+		b := gc.removeObjectFiles(context.TODO(), gc.newRecorder("test"), gcCategoryBinlog, logs)
 		assert.NoError(t, b)
 	})
 
 	t.Run("oss not found error", func(t *testing.T) {
 		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(merr.WrapErrIoKeyNotFound("not found"))
 		defer call.Unset()
-		b := gc.removeObjectFiles(context.TODO(), logs)
+		b := gc.removeObjectFiles(context.TODO(), gc.newRecorder("test"), gcCategoryBinlog, logs)
 		assert.NoError(t, b)
 	})
 
 	t.Run("oss server error", func(t *testing.T) {
 		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(merr.WrapErrIoFailed("server error", errors.New("err")))
 		defer call.Unset()
-		b := gc.removeObjectFiles(context.TODO(), logs)
+		b := gc.removeObjectFiles(context.TODO(), gc.newRecorder("test"), gcCategoryBinlog, logs)
 		assert.Error(t, b)
 	})
 
 	t.Run("other type error", func(t *testing.T) {
 		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(errors.New("other error"))
 		defer call.Unset()
-		b := gc.removeObjectFiles(context.TODO(), logs)
+		b := gc.removeObjectFiles(context.TODO(), gc.newRecorder("test"), gcCategoryBinlog, logs)
 		assert.Error(t, b)
 	})
 }
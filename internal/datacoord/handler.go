@@ -603,6 +603,7 @@ func (h *ServerHandler) FinishDropChannel(channel string, collectionID int64) er
 
 	// clean collection info cache when meet drop collection info
 	h.s.meta.DropCollection(collectionID)
+	h.s.maintenanceChecker.Remove(collectionID)
 
 	return nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 )
@@ -45,6 +46,7 @@ type indexInspector struct {
 	handler                   Handler
 	storageCli                storage.ChunkManager
 	indexEngineVersionManager IndexEngineVersionManager
+	maintenanceChecker        *maintenance.Checker
 }
 
 func newIndexInspector(
@@ -56,6 +58,7 @@ func newIndexInspector(
 	handler Handler,
 	storageCli storage.ChunkManager,
 	indexEngineVersionManager IndexEngineVersionManager,
+	maintenanceChecker *maintenance.Checker,
 ) *indexInspector {
 	ctx, cancel := context.WithCancel(ctx)
 	return &indexInspector{
@@ -68,6 +71,7 @@ func newIndexInspector(
 		handler:                   handler,
 		storageCli:                storageCli,
 		indexEngineVersionManager: indexEngineVersionManager,
+		maintenanceChecker:        maintenanceChecker,
 	}
 }
 
@@ -152,6 +156,11 @@ func (i *indexInspector) createIndexesForSegment(ctx context.Context, segment *S
 		log.Ctx(ctx).Debug("segment is level zero, skip create indexes", zap.Int64("segmentID", segment.GetID()))
 		return nil
 	}
+	// the unindexed segments are retried by the ticker, so they are built once the window opens.
+	if !i.maintenanceChecker.Allow(ctx, segment.GetCollectionID(), maintenance.OperationIndex) {
+		log.Ctx(ctx).RatedDebug(60, "outside the maintenance window, skip create indexes", zap.Int64("collectionID", segment.GetCollectionID()))
+		return nil
+	}
 
 	indexes := i.meta.indexMeta.GetIndexesForCollection(segment.CollectionID, "")
 	indexIDToSegIndexes := i.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
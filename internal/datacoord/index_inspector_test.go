@@ -31,6 +31,8 @@ import (
 	mocks2 "github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
@@ -81,7 +83,7 @@ func TestIndexInspector_inspect(t *testing.T) {
 			},
 		}
 
-		inspector := newIndexInspector(ctx, notifyChan, meta, scheduler, alloc, handler, storage, versionManager)
+		inspector := newIndexInspector(ctx, notifyChan, meta, scheduler, alloc, handler, storage, versionManager, nil)
 
 		inspector.Start()
 		defer inspector.Stop()
@@ -110,6 +112,46 @@ func TestIndexInspector_inspect(t *testing.T) {
 	})
 }
 
+func TestIndexInspector_maintenanceWindow(t *testing.T) {
+	meta := &meta{
+		indexMeta: &indexMeta{
+			indexes:        make(map[UniqueID]map[UniqueID]*model.Index),
+			segmentIndexes: typeutil.NewConcurrentMap[UniqueID, *typeutil.ConcurrentMap[UniqueID, *model.SegmentIndex]](),
+		},
+	}
+	meta.indexMeta.indexes[2] = map[UniqueID]*model.Index{
+		5: {CollectionID: 2, FieldID: 101, IndexID: 5, IndexName: indexName},
+	}
+	// the window on february 30th is never active.
+	checker := maintenance.NewChecker(
+		func(ctx context.Context, collectionID int64) (string, map[string]string, error) {
+			return "default", map[string]string{
+				common.MaintenanceWindowCronKey:       "0 0 30 2 *",
+				common.MaintenanceWindowDurationKey:   "1h",
+				common.MaintenanceWindowOperationsKey: "index",
+			}, nil
+		},
+		func(ctx context.Context, dbName string) (map[string]string, error) {
+			return nil, nil
+		},
+		nil,
+	)
+	// the allocator is not called since the index build is skipped.
+	alloc := allocator.NewMockAllocator(t)
+	inspector := newIndexInspector(context.Background(), make(chan int64, 1), meta, task.NewMockGlobalScheduler(t),
+		alloc, NewNMockHandler(t), mocks.NewChunkManager(t), newIndexEngineVersionManager(), checker)
+
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 2,
+			State:        commonpb.SegmentState_Flushed,
+			IsSorted:     true,
+		},
+	}
+	assert.NoError(t, inspector.createIndexesForSegment(context.Background(), segment))
+}
+
 func TestIndexInspector_ReloadFromMeta(t *testing.T) {
 	ctx := context.Background()
 	notifyChan := make(chan int64, 1)
@@ -132,7 +174,7 @@ func TestIndexInspector_ReloadFromMeta(t *testing.T) {
 		},
 	}
 
-	inspector := newIndexInspector(ctx, notifyChan, meta, scheduler, alloc, handler, storage, versionManager)
+	inspector := newIndexInspector(ctx, notifyChan, meta, scheduler, alloc, handler, storage, versionManager, nil)
 
 	catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).Return(nil)
 
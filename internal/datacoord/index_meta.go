@@ -510,6 +510,21 @@ func (m *indexMeta) AddSegmentIndex(ctx context.Context, segIndex *model.Segment
 	return nil
 }
 
+// AddFinishedSegmentIndexes adds the finished segment indexes restored from snapshot,
+// whose index files are shared with the source segment indexes.
+func (m *indexMeta) AddFinishedSegmentIndexes(ctx context.Context, segIdxes []*model.SegmentIndex) error {
+	for _, segIdx := range segIdxes {
+		if segIdx.IndexState != commonpb.IndexState_Finished {
+			return fmt.Errorf("segment index %d is not finished", segIdx.BuildID)
+		}
+	}
+	if err := m.alterSegmentIndexes(segIdxes); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("meta update: adding finished segment indexes success", zap.Int("num", len(segIdxes)))
+	return nil
+}
+
 func (m *indexMeta) GetIndexIDByName(collID int64, indexName string) map[int64]uint64 {
 	m.fieldIndexLock.RLock()
 	defer m.fieldIndexLock.RUnlock()
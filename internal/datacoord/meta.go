@@ -98,6 +98,7 @@ type meta struct {
 	partitionStatsMeta *partitionStatsMeta
 	compactionTaskMeta *compactionTaskMeta
 	statsTaskMeta      *statsTaskMeta
+	snapshotMeta       *snapshotMeta
 }
 
 func (m *meta) GetIndexMeta() *indexMeta {
@@ -178,6 +179,11 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 	if err != nil {
 		return nil, err
 	}
+
+	sm, err := newSnapshotMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
 	mt := &meta{
 		ctx:                ctx,
 		catalog:            catalog,
@@ -190,6 +196,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		partitionStatsMeta: psm,
 		compactionTaskMeta: ctm,
 		statsTaskMeta:      stm,
+		snapshotMeta:       sm,
 	}
 	err = mt.reloadFromKV(ctx, broker)
 	if err != nil {
@@ -2241,6 +2248,60 @@ func (m *meta) DropSegmentsOfPartition(ctx context.Context, partitionIDs []int64
 	return nil
 }
 
+// TruncateSegmentsOfCollection marks the segments of the collection which hold the data before truncateTs as Dropped.
+// A growing segment without start position may be created after truncate, it's kept here,
+// the growing segments before truncate are dropped by the flusher when it consumes the truncate message.
+func (m *meta) TruncateSegmentsOfCollection(ctx context.Context, collectionID int64, truncateTs uint64) ([]int64, error) {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]map[string]map[string]int),
+	}
+	modSegments := make([]*SegmentInfo, 0)
+	segments := make([]*datapb.SegmentInfo, 0)
+	truncated := m.segments.GetSegmentsBySelector(WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentBeforeTruncate(segment, truncateTs)
+	}))
+	for _, seg := range truncated {
+		clonedSeg := seg.Clone()
+		updateSegStateAndPrepareMetrics(clonedSeg, commonpb.SegmentState_Dropped, metricMutation)
+		modSegments = append(modSegments, clonedSeg)
+		segments = append(segments, clonedSeg.SegmentInfo)
+	}
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	if err := m.catalog.SaveDroppedSegmentsInBatch(ctx, segments); err != nil {
+		return nil, err
+	}
+	segmentIDs := make([]int64, 0, len(modSegments))
+	for _, segment := range modSegments {
+		m.segments.SetSegment(segment.GetID(), segment)
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+	metricMutation.commit()
+	return segmentIDs, nil
+}
+
+func isSegmentBeforeTruncate(segment *SegmentInfo, truncateTs uint64) bool {
+	switch segment.GetState() {
+	case commonpb.SegmentState_Dropped, commonpb.SegmentState_NotExist:
+		return false
+	}
+	pos := segment.GetStartPosition()
+	if pos == nil {
+		pos = segment.GetDmlPosition()
+	}
+	if pos != nil {
+		return pos.GetTimestamp() < truncateTs
+	}
+	return segment.GetState() == commonpb.SegmentState_Sealed ||
+		segment.GetState() == commonpb.SegmentState_Flushing ||
+		segment.GetState() == commonpb.SegmentState_Flushed
+}
+
 func contains(arr []int64, target int64) bool {
 	for _, val := range arr {
 		if val == target {
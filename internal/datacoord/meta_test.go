@@ -93,6 +93,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil, brk)
 		suite.Error(err)
@@ -110,6 +111,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil, brk)
 		suite.Error(err)
@@ -133,6 +135,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListSegments(mock.Anything, mock.Anything).Return([]*datapb.SegmentInfo{
 			{
 				ID:           1,
@@ -178,6 +181,7 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
 
 		suite.catalog.EXPECT().ListSegments(mock.Anything, mock.Anything).RunAndReturn(
@@ -1603,3 +1607,42 @@ func Test_meta_DropSegmentsOfPartition(t *testing.T) {
 	segment = meta.GetSegment(context.Background(), 3)
 	assert.NotEqual(t, commonpb.SegmentState_Dropped, segment.GetState())
 }
+
+func Test_meta_TruncateSegmentsOfCollection(t *testing.T) {
+	meta, err := newMemoryMeta(t)
+	assert.NoError(t, err)
+
+	segments := []*datapb.SegmentInfo{
+		// flushed before truncate
+		{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 50}},
+		// growing before truncate
+		{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Growing, StartPosition: &msgpb.MsgPosition{Timestamp: 80}},
+		// growing without start position, may be created after truncate
+		{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Growing},
+		// growing after truncate
+		{ID: 4, CollectionID: 1, State: commonpb.SegmentState_Growing, StartPosition: &msgpb.MsgPosition{Timestamp: 120}},
+		// sealed without start position
+		{ID: 5, CollectionID: 1, State: commonpb.SegmentState_Sealed},
+		// other collection
+		{ID: 6, CollectionID: 2, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 50}},
+	}
+	for _, segment := range segments {
+		err = meta.AddSegment(context.Background(), NewSegmentInfo(segment))
+		assert.NoError(t, err)
+	}
+
+	segmentIDs, err := meta.TruncateSegmentsOfCollection(context.Background(), 1, 100)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 5}, segmentIDs)
+	for _, id := range []int64{1, 2, 5} {
+		assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(context.Background(), id).GetState())
+	}
+	for _, id := range []int64{3, 4, 6} {
+		assert.NotEqual(t, commonpb.SegmentState_Dropped, meta.GetSegment(context.Background(), id).GetState())
+	}
+
+	// truncate again is a no-op.
+	segmentIDs, err = meta.TruncateSegmentsOfCollection(context.Background(), 1, 100)
+	assert.NoError(t, err)
+	assert.Empty(t, segmentIDs)
+}
@@ -871,6 +871,46 @@ func (s *mockMixCoord) GcControl(ctx context.Context, req *datapb.GcControlReque
 	panic("implement me")
 }
 
+func (s *mockMixCoord) GetGcReport(ctx context.Context, req *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) RestoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (s *mockMixCoord) ImportV2(ctx context.Context, req *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	panic("implement me")
 }
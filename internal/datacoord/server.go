@@ -51,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/kv"
@@ -136,6 +137,8 @@ type Server struct {
 	compactionTrigger        trigger
 	compactionInspector      CompactionInspector
 	compactionTriggerManager TriggerManager
+	// maintenanceChecker gates the background compaction and index build by the maintenance windows
+	maintenanceChecker *maintenance.Checker
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -306,6 +309,7 @@ func (s *Server) initDataCoord() error {
 	if err != nil {
 		return err
 	}
+	s.initMaintenanceChecker()
 	s.initCompaction()
 	log.Info("init compaction done")
 
@@ -653,7 +657,7 @@ func (s *Server) initAnalyzeInspector() {
 
 func (s *Server) initIndexInspector(storageCli storage.ChunkManager) {
 	if s.indexInspector == nil {
-		s.indexInspector = newIndexInspector(s.ctx, s.notifyIndexChan, s.meta, s.globalScheduler, s.allocator, s.handler, storageCli, s.indexEngineVersionManager)
+		s.indexInspector = newIndexInspector(s.ctx, s.notifyIndexChan, s.meta, s.globalScheduler, s.allocator, s.handler, storageCli, s.indexEngineVersionManager, s.maintenanceChecker)
 	}
 }
 
@@ -663,12 +667,41 @@ func (s *Server) initStatsInspector() {
 	}
 }
 
+// initMaintenanceChecker resolves the maintenance window from the collection properties,
+// or from the database properties if the collection doesn't configure it.
+func (s *Server) initMaintenanceChecker() {
+	s.maintenanceChecker = maintenance.NewChecker(
+		func(ctx context.Context, collectionID int64) (string, map[string]string, error) {
+			coll, err := s.handler.GetCollection(ctx, collectionID)
+			if err != nil {
+				return "", nil, err
+			}
+			if coll == nil {
+				return "", nil, merr.WrapErrCollectionNotFound(collectionID)
+			}
+			dbName := coll.DatabaseName
+			if dbName == "" {
+				dbName = util.DefaultDBName
+			}
+			return dbName, coll.Properties, nil
+		},
+		func(ctx context.Context, dbName string) (map[string]string, error) {
+			resp, err := s.broker.DescribeDatabase(ctx, dbName)
+			if err != nil {
+				return nil, err
+			}
+			return funcutil.KeyValuePair2Map(resp.GetProperties()), nil
+		},
+		metrics.DataCoordMaintenanceWindowActive,
+	)
+}
+
 func (s *Server) initCompaction() {
 	cph := newCompactionInspector(s.meta, s.allocator, s.handler, s.globalScheduler, s.indexEngineVersionManager)
 	cph.loadMeta()
 	s.compactionInspector = cph
-	s.compactionTriggerManager = NewCompactionTriggerManager(s.allocator, s.handler, s.compactionInspector, s.meta, s.importMeta)
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionInspector, s.allocator, s.handler, s.indexEngineVersionManager)
+	s.compactionTriggerManager = NewCompactionTriggerManager(s.allocator, s.handler, s.compactionInspector, s.meta, s.importMeta, s.maintenanceChecker)
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionInspector, s.allocator, s.handler, s.indexEngineVersionManager, s.maintenanceChecker)
 }
 
 func (s *Server) stopCompaction() {
@@ -1763,6 +1763,22 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 			status.Reason = fmt.Sprintf("failed to pause gc, %s", err.Error())
 			return status, nil
 		}
+	case datapb.GcCommand_SetMode:
+		kv := lo.FindOrElse(request.GetParams(), nil, func(kv *commonpb.KeyValuePair) bool {
+			return kv.GetKey() == "mode"
+		})
+		if kv == nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = "gc mode param not found"
+			return status, nil
+		}
+		mode, err := parseGcMode(kv.GetValue())
+		if err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = fmt.Sprintf("gc mode not valid, %s", err.Error())
+			return status, nil
+		}
+		s.garbageCollector.SetMode(mode)
 	default:
 		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		status.Reason = fmt.Sprintf("unknown gc command: %d", request.GetCommand())
@@ -1772,6 +1788,21 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 	return status, nil
 }
 
+// GetGcReport returns the objects recycled by garbage collection in dryRun and quarantine mode.
+func (s *Server) GetGcReport(ctx context.Context, request *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetGcReportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.GetGcReportResponse{
+		Status:     merr.Success(),
+		Mode:       string(s.garbageCollector.GetMode()),
+		Categories: s.garbageCollector.GetReport(),
+	}, nil
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &internalpb.ImportResponse{
@@ -1950,3 +1981,20 @@ func (s *Server) NotifyDropPartition(ctx context.Context, channel string, partit
 	// release all segments of the partition.
 	return s.meta.DropSegmentsOfPartition(ctx, partitionIDs)
 }
+
+// TruncateSegments marks the segments of the collection which hold the data before the truncate ts as Dropped,
+// the dropped segments will be removed from the query target and recycled by the garbage collector.
+func (s *Server) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Uint64("truncateTs", req.GetTruncateTs()))
+	log.Info("receive TruncateSegments request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	segmentIDs, err := s.meta.TruncateSegmentsOfCollection(ctx, req.GetCollectionID(), req.GetTruncateTs())
+	if err != nil {
+		log.Warn("failed to truncate segments", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("TruncateSegments done", zap.Int64s("segmentIDs", segmentIDs))
+	return merr.Success(), nil
+}
@@ -1637,6 +1637,37 @@ func (s *GcControlServiceSuite) TestResume() {
 	s.True(merr.Ok(resp))
 }
 
+func (s *GcControlServiceSuite) TestSetMode() {
+	resp, err := s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_SetMode,
+	})
+	s.Nil(err)
+	s.False(merr.Ok(resp))
+
+	resp, err = s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_SetMode,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "mode", Value: "unknown"},
+		},
+	})
+	s.Nil(err)
+	s.False(merr.Ok(resp))
+
+	resp, err = s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_SetMode,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "mode", Value: "dryrun"},
+		},
+	})
+	s.Nil(err)
+	s.True(merr.Ok(resp))
+
+	report, err := s.server.GetGcReport(context.TODO(), &datapb.GetGcReportRequest{})
+	s.Nil(err)
+	s.True(merr.Ok(report.GetStatus()))
+	s.Equal(string(gcModeDryRun), report.GetMode())
+}
+
 func (s *GcControlServiceSuite) TestTimeoutCtx() {
 	s.server.garbageCollector.close()
 
@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+)
+
+// snapshotMeta keeps the snapshots of collections,
+// the segments and segment indexes recorded by a live snapshot are pinned, which shall not be recycled by GC.
+type snapshotMeta struct {
+	sync.RWMutex
+	ctx       context.Context
+	catalog   metastore.DataCoordCatalog
+	snapshots map[int64]*datapb.SnapshotInfo // snapshotID -> snapshot
+
+	pinnedSegments map[int64]int // segmentID -> number of snapshots recording it
+	pinnedBuildIDs map[int64]int // buildID -> number of snapshots recording it
+}
+
+func newSnapshotMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*snapshotMeta, error) {
+	sm := &snapshotMeta{
+		ctx:            ctx,
+		catalog:        catalog,
+		snapshots:      make(map[int64]*datapb.SnapshotInfo),
+		pinnedSegments: make(map[int64]int),
+		pinnedBuildIDs: make(map[int64]int),
+	}
+	if err := sm.reloadFromKV(); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+func (sm *snapshotMeta) reloadFromKV() error {
+	record := timerecord.NewTimeRecorder("snapshotMeta-reloadFromKV")
+
+	snapshots, err := sm.catalog.ListSnapshots(sm.ctx)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		sm.addSnapshot(snapshot)
+	}
+	log.Info("DataCoord snapshotMeta reloadFromKV done", zap.Int("snapshots", len(snapshots)),
+		zap.Duration("duration", record.ElapseSpan()))
+	return nil
+}
+
+func (sm *snapshotMeta) addSnapshot(snapshot *datapb.SnapshotInfo) {
+	sm.snapshots[snapshot.GetSnapshotID()] = snapshot
+	for _, segmentID := range snapshot.GetSegmentIDs() {
+		sm.pinnedSegments[segmentID]++
+	}
+	for _, buildID := range snapshot.GetBuildIDs() {
+		sm.pinnedBuildIDs[buildID]++
+	}
+}
+
+func (sm *snapshotMeta) removeSnapshot(snapshot *datapb.SnapshotInfo) {
+	delete(sm.snapshots, snapshot.GetSnapshotID())
+	for _, segmentID := range snapshot.GetSegmentIDs() {
+		if sm.pinnedSegments[segmentID]--; sm.pinnedSegments[segmentID] <= 0 {
+			delete(sm.pinnedSegments, segmentID)
+		}
+	}
+	for _, buildID := range snapshot.GetBuildIDs() {
+		if sm.pinnedBuildIDs[buildID]--; sm.pinnedBuildIDs[buildID] <= 0 {
+			delete(sm.pinnedBuildIDs, buildID)
+		}
+	}
+}
+
+// SaveSnapshot persists the snapshot with its segments, and pins the recorded segments and segment indexes.
+func (sm *snapshotMeta) SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo, segments []*datapb.SnapshotSegment) error {
+	sm.Lock()
+	defer sm.Unlock()
+	for _, s := range sm.snapshots {
+		if s.GetName() == snapshot.GetName() {
+			return merr.WrapErrParameterInvalidMsg("snapshot %s already exists", snapshot.GetName())
+		}
+	}
+	if err := sm.catalog.SaveSnapshot(ctx, snapshot, segments); err != nil {
+		log.Ctx(ctx).Warn("meta update: save snapshot failed", zap.String("name", snapshot.GetName()),
+			zap.Int64("snapshotID", snapshot.GetSnapshotID()), zap.Error(err))
+		return err
+	}
+	sm.addSnapshot(snapshot)
+	log.Ctx(ctx).Info("meta update: save snapshot success", zap.String("name", snapshot.GetName()),
+		zap.Int64("snapshotID", snapshot.GetSnapshotID()), zap.Int64("collectionID", snapshot.GetCollectionID()),
+		zap.Uint64("ts", snapshot.GetTs()), zap.Int("segments", len(segments)))
+	return nil
+}
+
+// DropSnapshot removes the snapshot by name, the pinned segments and segment indexes are released.
+func (sm *snapshotMeta) DropSnapshot(ctx context.Context, name string) error {
+	sm.Lock()
+	defer sm.Unlock()
+	snapshot := sm.getSnapshotByName(name)
+	if snapshot == nil {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s not found", name)
+	}
+	if err := sm.catalog.DropSnapshot(ctx, snapshot.GetSnapshotID()); err != nil {
+		log.Ctx(ctx).Warn("meta update: drop snapshot failed", zap.String("name", name), zap.Error(err))
+		return err
+	}
+	sm.removeSnapshot(snapshot)
+	log.Ctx(ctx).Info("meta update: drop snapshot success", zap.String("name", name),
+		zap.Int64("snapshotID", snapshot.GetSnapshotID()))
+	return nil
+}
+
+// GetSnapshot returns the snapshot by name, nil if not found.
+func (sm *snapshotMeta) GetSnapshot(name string) *datapb.SnapshotInfo {
+	sm.RLock()
+	defer sm.RUnlock()
+	snapshot := sm.getSnapshotByName(name)
+	if snapshot == nil {
+		return nil
+	}
+	return proto.Clone(snapshot).(*datapb.SnapshotInfo)
+}
+
+func (sm *snapshotMeta) getSnapshotByName(name string) *datapb.SnapshotInfo {
+	for _, snapshot := range sm.snapshots {
+		if snapshot.GetName() == name {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// ListSnapshots returns the snapshots of the collection, or of all collections if collectionID is 0.
+func (sm *snapshotMeta) ListSnapshots(collectionID int64) []*datapb.SnapshotInfo {
+	sm.RLock()
+	defer sm.RUnlock()
+	res := make([]*datapb.SnapshotInfo, 0)
+	for _, snapshot := range sm.snapshots {
+		if collectionID == 0 || snapshot.GetCollectionID() == collectionID {
+			res = append(res, proto.Clone(snapshot).(*datapb.SnapshotInfo))
+		}
+	}
+	return res
+}
+
+// GetSnapshotSegments returns the segments recorded by the snapshot.
+func (sm *snapshotMeta) GetSnapshotSegments(ctx context.Context, snapshotID int64) ([]*datapb.SnapshotSegment, error) {
+	return sm.catalog.ListSnapshotSegments(ctx, snapshotID)
+}
+
+// IsSegmentPinned returns true if the segment is recorded by any snapshot.
+func (sm *snapshotMeta) IsSegmentPinned(segmentID int64) bool {
+	if sm == nil {
+		return false
+	}
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.pinnedSegments[segmentID] > 0
+}
+
+// IsBuildIDPinned returns true if the segment index is recorded by any snapshot.
+func (sm *snapshotMeta) IsBuildIDPinned(buildID int64) bool {
+	if sm == nil {
+		return false
+	}
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.pinnedBuildIDs[buildID] > 0
+}
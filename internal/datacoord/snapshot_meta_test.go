@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+func TestSnapshotMeta(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reload failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, errors.New("mock error"))
+
+		sm, err := newSnapshotMeta(ctx, catalog)
+		assert.Error(t, err)
+		assert.Nil(t, sm)
+	})
+
+	t.Run("pin and release", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSnapshots(mock.Anything).Return([]*datapb.SnapshotInfo{
+			{SnapshotID: 1, Name: "s1", CollectionID: 100, SegmentIDs: []int64{10, 11}, BuildIDs: []int64{20}},
+		}, nil)
+
+		sm, err := newSnapshotMeta(ctx, catalog)
+		assert.NoError(t, err)
+		assert.True(t, sm.IsSegmentPinned(10))
+		assert.True(t, sm.IsBuildIDPinned(20))
+		assert.False(t, sm.IsSegmentPinned(12))
+
+		// duplicate name
+		err = sm.SaveSnapshot(ctx, &datapb.SnapshotInfo{SnapshotID: 2, Name: "s1"}, nil)
+		assert.Error(t, err)
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+		err = sm.SaveSnapshot(ctx, &datapb.SnapshotInfo{SnapshotID: 2, Name: "s2", CollectionID: 100, SegmentIDs: []int64{11, 12}}, nil)
+		assert.Error(t, err)
+		assert.False(t, sm.IsSegmentPinned(12))
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		err = sm.SaveSnapshot(ctx, &datapb.SnapshotInfo{SnapshotID: 2, Name: "s2", CollectionID: 100, SegmentIDs: []int64{11, 12}}, nil)
+		assert.NoError(t, err)
+		assert.True(t, sm.IsSegmentPinned(12))
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		err = sm.SaveSnapshot(ctx, &datapb.SnapshotInfo{SnapshotID: 3, Name: "s3", CollectionID: 200}, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, sm.ListSnapshots(0), 3)
+		assert.Len(t, sm.ListSnapshots(100), 2)
+		assert.Len(t, sm.ListSnapshots(300), 0)
+		assert.Equal(t, int64(2), sm.GetSnapshot("s2").GetSnapshotID())
+		assert.Nil(t, sm.GetSnapshot("s4"))
+
+		err = sm.DropSnapshot(ctx, "s4")
+		assert.Error(t, err)
+
+		catalog.EXPECT().DropSnapshot(mock.Anything, int64(1)).Return(errors.New("mock error")).Once()
+		err = sm.DropSnapshot(ctx, "s1")
+		assert.Error(t, err)
+		assert.True(t, sm.IsSegmentPinned(10))
+
+		catalog.EXPECT().DropSnapshot(mock.Anything, int64(1)).Return(nil).Once()
+		err = sm.DropSnapshot(ctx, "s1")
+		assert.NoError(t, err)
+		assert.False(t, sm.IsSegmentPinned(10))
+		assert.False(t, sm.IsBuildIDPinned(20))
+		// still pinned by s2
+		assert.True(t, sm.IsSegmentPinned(11))
+		assert.Nil(t, sm.GetSnapshot("s1"))
+	})
+
+	t.Run("nil meta", func(t *testing.T) {
+		var sm *snapshotMeta
+		assert.False(t, sm.IsSegmentPinned(1))
+		assert.False(t, sm.IsBuildIDPinned(1))
+	})
+}
@@ -0,0 +1,446 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var (
+	snapshotFlushWaitInterval = 200 * time.Millisecond
+	snapshotFlushWaitTimeout  = 10 * time.Minute
+)
+
+// CreateSnapshot records the flushed segments, the segment indexes and the channel checkpoints of the collection
+// as a snapshot, the recorded segments and index files are pinned against GC until the snapshot is dropped.
+// If the collection is flushed at flush ts, it waits until all the data before flush ts, including the deletes,
+// is persisted in flushed segments, and the snapshot is taken at flush ts.
+// Otherwise the data not flushed yet is not included.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("name", req.GetName()), zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("receive CreateSnapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("snapshot name is empty")), nil
+	}
+	if s.meta.snapshotMeta.GetSnapshot(req.GetName()) != nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("snapshot %s already exists", req.GetName())), nil
+	}
+
+	coll, err := s.broker.DescribeCollectionInternal(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to describe collection", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	partitions, err := s.broker.ShowPartitions(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to show partitions", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	snapshotID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	ts := req.GetFlushTs()
+	if ts == 0 {
+		ts, err = s.allocator.AllocTimestamp(ctx)
+		if err != nil {
+			return merr.Status(err), nil
+		}
+	} else if err := s.waitCollectionFlushed(ctx, req.GetCollectionID(), coll.GetVirtualChannelNames(), ts); err != nil {
+		log.Warn("failed to wait for the collection flushed", zap.Uint64("flushTs", ts), zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	snapshot := &datapb.SnapshotInfo{
+		SnapshotID:       snapshotID,
+		Name:             req.GetName(),
+		DbID:             coll.GetDbId(),
+		DbName:           coll.GetDbName(),
+		CollectionID:     req.GetCollectionID(),
+		CollectionName:   coll.GetCollectionName(),
+		Ts:               ts,
+		Schema:           coll.GetSchema(),
+		Vchannels:        coll.GetVirtualChannelNames(),
+		NumPartitions:    coll.GetNumPartitions(),
+		ConsistencyLevel: coll.GetConsistencyLevel(),
+		Properties:       coll.GetProperties(),
+	}
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		snapshot.Partitions = append(snapshot.Partitions, &datapb.SnapshotPartition{
+			PartitionID:   partitionID,
+			PartitionName: partitions.GetPartitionNames()[i],
+		})
+	}
+	for _, index := range s.meta.indexMeta.GetIndexesForCollection(req.GetCollectionID(), "") {
+		snapshot.Indexes = append(snapshot.Indexes, model.MarshalIndexModel(index))
+	}
+	for _, vchannel := range snapshot.GetVchannels() {
+		if cp := s.meta.GetChannelCheckpoint(vchannel); cp != nil {
+			snapshot.ChannelCheckpoints = append(snapshot.ChannelCheckpoints, cp)
+		}
+	}
+
+	snapshotSegments := s.collectSnapshotSegments(ctx, req.GetCollectionID(), snapshotID, ts)
+	for _, snapshotSegment := range snapshotSegments {
+		for _, segIdx := range snapshotSegment.GetSegmentIndexes() {
+			snapshot.BuildIDs = append(snapshot.BuildIDs, segIdx.GetBuildID())
+		}
+		snapshot.SegmentIDs = append(snapshot.SegmentIDs, snapshotSegment.GetSegment().GetID())
+		snapshot.NumRows += snapshotSegment.GetSegment().GetNumOfRows()
+	}
+
+	if err := s.meta.snapshotMeta.SaveSnapshot(ctx, snapshot, snapshotSegments); err != nil {
+		log.Warn("failed to save snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("CreateSnapshot done", zap.Int64("snapshotID", snapshotID), zap.Uint64("ts", ts),
+		zap.Int("segments", len(snapshotSegments)), zap.Int("segmentIndexes", len(snapshot.GetBuildIDs())))
+	return merr.Success(), nil
+}
+
+// ListSnapshots lists the snapshots filtered by collection and name.
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListSnapshotsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	snapshots := s.meta.snapshotMeta.ListSnapshots(req.GetCollectionID())
+	if req.GetName() != "" {
+		filtered := make([]*datapb.SnapshotInfo, 0, 1)
+		for _, snapshot := range snapshots {
+			if snapshot.GetName() == req.GetName() {
+				filtered = append(filtered, snapshot)
+			}
+		}
+		snapshots = filtered
+	}
+	return &datapb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: snapshots,
+	}, nil
+}
+
+// DropSnapshot drops the snapshot, the segments and index files pinned by it will be recycled by GC
+// if they are not used anymore.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("name", req.GetName()))
+	log.Info("receive DropSnapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.meta.snapshotMeta.DropSnapshot(ctx, req.GetName()); err != nil {
+		log.Warn("failed to drop snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// RestoreSnapshotSegments registers the segments and segment indexes of the snapshot into the collection
+// being restored by rootcoord, which has the same fields and shards number as the snapshot.
+// The data is not copied, the restored segments refer to the binlogs and index files of the snapshot segments,
+// which are kept by GC until no segment refers to them.
+func (s *Server) RestoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("name", req.GetName()), zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("receive RestoreSnapshotSegments request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.restoreSnapshotSegments(ctx, req); err != nil {
+		log.Warn("failed to restore snapshot segments", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("RestoreSnapshotSegments done")
+	return merr.Success(), nil
+}
+
+func (s *Server) restoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest) error {
+	snapshot := s.meta.snapshotMeta.GetSnapshot(req.GetName())
+	if snapshot == nil {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s not found", req.GetName())
+	}
+	if err := checkSnapshotRestorable(snapshot, req.GetVchannels(), req.GetSchema()); err != nil {
+		return err
+	}
+	if len(s.meta.SelectSegments(ctx, WithCollection(req.GetCollectionID()))) > 0 {
+		return merr.WrapErrParameterInvalidMsg("collection %d to restore is not empty", req.GetCollectionID())
+	}
+	channelMapping := make(map[string]string, len(snapshot.GetVchannels()))
+	for i, vchannel := range snapshot.GetVchannels() {
+		channelMapping[vchannel] = req.GetVchannels()[i]
+	}
+
+	snapshotSegments, err := s.meta.snapshotMeta.GetSnapshotSegments(ctx, snapshot.GetSnapshotID())
+	if err != nil {
+		return err
+	}
+	return s.registerSharedSegments(ctx, req.GetCollectionID(), req.GetSchema(), channelMapping, req.GetPartitionMapping(),
+		snapshot.GetIndexes(), snapshotSegments, snapshot.GetTs())
+}
+
+// CloneSegments registers the flushed segments and segment indexes of the source collection
+// into the collection being cloned by rootcoord, the data is shared in the same way as RestoreSnapshotSegments.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("sourceCollectionID", req.GetSourceCollectionID()), zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("receive CloneSegments request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.cloneSegments(ctx, req); err != nil {
+		log.Warn("failed to clone segments", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("CloneSegments done")
+	return merr.Success(), nil
+}
+
+func (s *Server) cloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	if len(req.GetSourceVchannels()) != len(req.GetVchannels()) {
+		return merr.WrapErrParameterInvalidMsg("shards number mismatch, source: %d, collection: %d",
+			len(req.GetSourceVchannels()), len(req.GetVchannels()))
+	}
+	channelMapping := make(map[string]string, len(req.GetSourceVchannels()))
+	for i, vchannel := range req.GetSourceVchannels() {
+		channelMapping[vchannel] = req.GetVchannels()[i]
+	}
+	ts := req.GetBase().GetTimestamp()
+	if ts == 0 {
+		var err error
+		if ts, err = s.allocator.AllocTimestamp(ctx); err != nil {
+			return err
+		}
+	}
+
+	indexes := make([]*indexpb.FieldIndex, 0)
+	for _, index := range s.meta.indexMeta.GetIndexesForCollection(req.GetSourceCollectionID(), "") {
+		indexes = append(indexes, model.MarshalIndexModel(index))
+	}
+	segments := s.collectSnapshotSegments(ctx, req.GetSourceCollectionID(), 0, math.MaxUint64)
+	return s.registerSharedSegments(ctx, req.GetCollectionID(), req.GetSchema(), channelMapping, req.GetPartitionMapping(),
+		indexes, segments, ts)
+}
+
+// waitCollectionFlushed waits until the checkpoints of all the channels reach ts
+// and all the segments started before ts are flushed.
+func (s *Server) waitCollectionFlushed(ctx context.Context, collectionID int64, vchannels []string, ts uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, snapshotFlushWaitTimeout)
+	defer cancel()
+	ticker := time.NewTicker(snapshotFlushWaitInterval)
+	defer ticker.Stop()
+	for {
+		unflushedChannels := lo.Filter(vchannels, func(vchannel string, _ int) bool {
+			return s.meta.GetChannelCheckpoint(vchannel).GetTimestamp() < ts
+		})
+		unflushedSegments := s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+			return isSegmentHealthy(segment) && segment.GetState() != commonpb.SegmentState_Flushed &&
+				!segment.GetIsImporting() && segment.GetStartPosition().GetTimestamp() < ts
+		}))
+		if len(unflushedChannels) == 0 && len(unflushedSegments) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "collection %d is not flushed to %d, unflushed channels: %v, unflushed segments: %v",
+				collectionID, ts, unflushedChannels, lo.Map(unflushedSegments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() }))
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectSnapshotSegments returns the flushed segments of the collection with their finished segment indexes,
+// including the L0 segments of deletes, the segments having data after ts are excluded.
+func (s *Server) collectSnapshotSegments(ctx context.Context, collectionID int64, snapshotID int64, ts uint64) []*datapb.SnapshotSegment {
+	segments := s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() && !segment.GetIsInvisible() &&
+			segment.GetDmlPosition().GetTimestamp() <= ts
+	}))
+	snapshotSegments := make([]*datapb.SnapshotSegment, 0, len(segments))
+	for _, segment := range segments {
+		snapshotSegment := &datapb.SnapshotSegment{
+			SnapshotID: snapshotID,
+			Segment:    proto.Clone(segment.SegmentInfo).(*datapb.SegmentInfo),
+		}
+		for _, segIdx := range s.meta.indexMeta.GetSegmentIndexes(collectionID, segment.GetID()) {
+			if segIdx.IndexState != commonpb.IndexState_Finished {
+				continue
+			}
+			snapshotSegment.SegmentIndexes = append(snapshotSegment.SegmentIndexes, model.MarshalSegmentIndexModel(segIdx))
+		}
+		snapshotSegments = append(snapshotSegments, snapshotSegment)
+	}
+	return snapshotSegments
+}
+
+// registerSharedSegments creates the indexes and registers the source segments with their segment indexes
+// into the collection, the registered segments refer to the binlogs and index files of the source segments.
+func (s *Server) registerSharedSegments(ctx context.Context,
+	collectionID int64,
+	schema *schemapb.CollectionSchema,
+	channelMapping map[string]string,
+	partitionMapping map[int64]int64,
+	indexes []*indexpb.FieldIndex,
+	sources []*datapb.SnapshotSegment,
+	ts uint64,
+) error {
+	// create the indexes first, the segment indexes refer to them.
+	indexMapping := make(map[int64]int64, len(indexes))
+	for _, fieldIndex := range indexes {
+		index := model.UnmarshalIndexModel(fieldIndex)
+		allocatedIndexID, err := s.allocator.AllocID(ctx)
+		if err != nil {
+			return err
+		}
+		field := typeutil.GetField(schema, index.FieldID)
+		indexID, err := s.meta.indexMeta.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    collectionID,
+			FieldID:         index.FieldID,
+			IndexName:       index.IndexName,
+			TypeParams:      index.TypeParams,
+			IndexParams:     index.IndexParams,
+			Timestamp:       index.CreateTime,
+			IsAutoIndex:     index.IsAutoIndex,
+			UserIndexParams: index.UserIndexParams,
+		}, allocatedIndexID, field.GetDataType() == schemapb.DataType_JSON)
+		if err != nil {
+			return err
+		}
+		indexMapping[index.IndexID] = indexID
+	}
+
+	segments := make([]*SegmentInfo, 0, len(sources))
+	segIdxes := make([]*model.SegmentIndex, 0)
+	for _, snapshotSegment := range sources {
+		source := snapshotSegment.GetSegment()
+		partitionID := source.GetPartitionID()
+		if partitionID != common.AllPartitionsID {
+			var ok bool
+			if partitionID, ok = partitionMapping[source.GetPartitionID()]; !ok {
+				return merr.WrapErrParameterInvalidMsg("partition %d of source segment %d is not mapped", source.GetPartitionID(), source.GetID())
+			}
+		}
+		channel := channelMapping[source.GetInsertChannel()]
+		segmentID, err := s.allocator.AllocID(ctx)
+		if err != nil {
+			return err
+		}
+
+		segment := proto.Clone(source).(*datapb.SegmentInfo)
+		// record the log paths explicitly since they are built from the ids of the source segment.
+		if err := binlog.DecompressBinLogs(segment); err != nil {
+			return err
+		}
+		segment.ID = segmentID
+		segment.CollectionID = collectionID
+		segment.PartitionID = partitionID
+		segment.InsertChannel = channel
+		segment.HasSharedLogs = true
+		segment.CompactionFrom = nil
+		segment.CreatedByCompaction = false
+		// the text and json key stats are rebuilt by the stats tasks.
+		segment.TextStatsLogs = nil
+		segment.JsonKeyStats = nil
+		segment.PartitionStatsVersion = 0
+		segment.LastPartitionStatsVersion = 0
+		position := s.meta.GetChannelCheckpoint(channel)
+		if position == nil {
+			position = &msgpb.MsgPosition{ChannelName: channel, Timestamp: ts}
+		}
+		segment.StartPosition = proto.Clone(position).(*msgpb.MsgPosition)
+		segment.DmlPosition = proto.Clone(position).(*msgpb.MsgPosition)
+		segments = append(segments, NewSegmentInfo(segment))
+
+		for _, index := range snapshotSegment.GetSegmentIndexes() {
+			segIdx := model.UnmarshalSegmentIndexModel(index)
+			indexID, ok := indexMapping[segIdx.IndexID]
+			if !ok {
+				continue
+			}
+			buildID, err := s.allocator.AllocID(ctx)
+			if err != nil {
+				return err
+			}
+			if segIdx.SourceBuildID == 0 {
+				segIdx.SourceBuildID = segIdx.BuildID
+				segIdx.SourcePartitionID = segIdx.PartitionID
+				segIdx.SourceSegmentID = segIdx.SegmentID
+			}
+			segIdx.CollectionID = collectionID
+			segIdx.PartitionID = partitionID
+			segIdx.SegmentID = segmentID
+			segIdx.IndexID = indexID
+			segIdx.BuildID = buildID
+			segIdx.NodeID = 0
+			segIdx.IndexState = commonpb.IndexState_Finished
+			segIdxes = append(segIdxes, segIdx)
+		}
+	}
+
+	// add the segment indexes before the segments, so that no index task is triggered for the restored segments.
+	if len(segIdxes) > 0 {
+		if err := s.meta.indexMeta.AddFinishedSegmentIndexes(ctx, segIdxes); err != nil {
+			return err
+		}
+	}
+	for _, segment := range segments {
+		if err := s.meta.AddSegment(ctx, segment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSnapshotRestorable checks if the collection has the same shards number and fields as the snapshot.
+func checkSnapshotRestorable(snapshot *datapb.SnapshotInfo, vchannels []string, schema *schemapb.CollectionSchema) error {
+	if len(snapshot.GetVchannels()) != len(vchannels) {
+		return merr.WrapErrParameterInvalidMsg("shards number mismatch, snapshot: %d, collection: %d",
+			len(snapshot.GetVchannels()), len(vchannels))
+	}
+	fields := make(map[string]int64, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fields[field.GetName()] = field.GetFieldID()
+	}
+	for _, field := range snapshot.GetSchema().GetFields() {
+		if fieldID, ok := fields[field.GetName()]; !ok || fieldID != field.GetFieldID() {
+			return merr.WrapErrParameterInvalidMsg("field %s mismatch, snapshot field id: %d, collection field id: %d",
+				field.GetName(), field.GetFieldID(), fieldID)
+		}
+	}
+	return nil
+}
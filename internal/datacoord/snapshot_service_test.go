@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestServer_SnapshotWithDeletes(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	interval, timeout := snapshotFlushWaitInterval, snapshotFlushWaitTimeout
+	snapshotFlushWaitInterval = 10 * time.Millisecond
+	defer func() {
+		snapshotFlushWaitInterval, snapshotFlushWaitTimeout = interval, timeout
+	}()
+
+	const (
+		collectionID = int64(100)
+		partitionID  = int64(10)
+		vchannel     = "ch-0"
+		flushTs      = uint64(1000)
+	)
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	mockBroker := broker.NewMockBroker(t)
+	mockBroker.EXPECT().DescribeCollectionInternal(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{
+		Status:              merr.Success(),
+		CollectionID:        collectionID,
+		CollectionName:      "coll",
+		Schema:              newTestSchema(),
+		VirtualChannelNames: []string{vchannel},
+	}, nil)
+	mockBroker.EXPECT().ShowPartitions(mock.Anything, collectionID).Return(&milvuspb.ShowPartitionsResponse{
+		Status:         merr.Success(),
+		PartitionIDs:   []int64{partitionID},
+		PartitionNames: []string{"_default"},
+	}, nil)
+	s := &Server{meta: meta, broker: mockBroker, allocator: newMockAllocator(t)}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	position := func(ts uint64) *msgpb.MsgPosition {
+		return &msgpb.MsgPosition{ChannelName: vchannel, MsgID: []byte{1}, Timestamp: ts}
+	}
+	addSegment := func(id int64, level datapb.SegmentLevel, state commonpb.SegmentState, startTs, dmlTs uint64) {
+		segment := &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  collectionID,
+			PartitionID:   partitionID,
+			InsertChannel: vchannel,
+			State:         state,
+			Level:         level,
+			NumOfRows:     10,
+			StartPosition: position(startTs),
+			DmlPosition:   position(dmlTs),
+		}
+		if level == datapb.SegmentLevel_L0 {
+			segment.PartitionID = -1
+			segment.NumOfRows = 0
+			segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: id*10 + 1, EntriesNum: 1}}}}
+		} else {
+			segment.Binlogs = []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{{LogID: id*10 + 1, EntriesNum: 10}}}}
+		}
+		require.NoError(t, meta.AddSegment(ctx, NewSegmentInfo(segment)))
+	}
+	// rows inserted and flushed before the snapshot.
+	addSegment(1001, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, 100, 200)
+	// a delete flushed before the snapshot.
+	addSegment(1002, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, 300, 400)
+	// a delete still in the buffer when the snapshot is requested.
+	addSegment(1003, datapb.SegmentLevel_L0, commonpb.SegmentState_Growing, 800, 800)
+	// rows inserted after the snapshot.
+	addSegment(1004, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, 1100, 1200)
+	require.NoError(t, meta.UpdateChannelCheckpoint(ctx, vchannel, position(900)))
+
+	t.Run("wait flush timeout", func(t *testing.T) {
+		snapshotFlushWaitTimeout = 50 * time.Millisecond
+		defer func() { snapshotFlushWaitTimeout = timeout }()
+		status, err := s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{Name: "s0", CollectionID: collectionID, FlushTs: flushTs})
+		assert.Error(t, merr.CheckRPCCall(status, err))
+		assert.Nil(t, meta.snapshotMeta.GetSnapshot("s0"))
+	})
+
+	// the buffered delete is flushed by the flush before the snapshot.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		meta.SetState(ctx, 1003, commonpb.SegmentState_Flushed)
+		meta.UpdateChannelCheckpoint(ctx, vchannel, position(flushTs+1))
+	}()
+	status, err := s.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{Name: "s1", CollectionID: collectionID, FlushTs: flushTs})
+	require.NoError(t, merr.CheckRPCCall(status, err))
+
+	snapshot := meta.snapshotMeta.GetSnapshot("s1")
+	require.NotNil(t, snapshot)
+	assert.Equal(t, flushTs, snapshot.GetTs())
+	assert.ElementsMatch(t, []int64{1001, 1002, 1003}, snapshot.GetSegmentIDs())
+
+	// restore the snapshot into a new collection, the deletes are restored as L0 segments.
+	status, err = s.RestoreSnapshotSegments(ctx, &datapb.RestoreSnapshotSegmentsRequest{
+		Name:             "s1",
+		CollectionID:     200,
+		PartitionMapping: map[int64]int64{partitionID: 20},
+		Vchannels:        []string{"ch-1"},
+		Schema:           newTestSchema(),
+	})
+	require.NoError(t, merr.CheckRPCCall(status, err))
+
+	restored := meta.SelectSegments(ctx, WithCollection(200))
+	require.Len(t, restored, 3)
+	numL0, numRows := 0, int64(0)
+	for _, segment := range restored {
+		assert.Equal(t, "ch-1", segment.GetInsertChannel())
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			numL0++
+			assert.EqualValues(t, -1, segment.GetPartitionID())
+			assert.Len(t, segment.GetDeltalogs(), 1)
+		} else {
+			assert.EqualValues(t, 20, segment.GetPartitionID())
+		}
+		numRows += segment.GetNumOfRows()
+	}
+	assert.Equal(t, 2, numL0)
+	assert.EqualValues(t, 10, numRows)
+}
@@ -315,6 +315,7 @@ func (it *indexBuildTask) prepareJobRequest(ctx context.Context, segment *Segmen
 		FieldType:                 field.GetDataType(),
 		Dim:                       int64(dim),
 		DataIds:                   binlogIDs,
+		DataPaths:                 getSharedBinLogPaths(segment, fieldID),
 		OptionalScalarFields:      optionalFields,
 		Field:                     field,
 		PartitionKeyIsolation:     partitionKeyIsolation,
@@ -343,6 +344,7 @@ func (it *indexBuildTask) prepareOptionalFields(ctx context.Context, collectionI
 				FieldName: partitionKeyField.Name,
 				FieldType: int32(partitionKeyField.DataType),
 				DataIds:   getBinLogIDs(segment, partitionKeyField.FieldID),
+				DataPaths: getSharedBinLogPaths(segment, partitionKeyField.FieldID),
 			})
 
 			iso, isoErr := common.IsPartitionKeyIsolationPropEnabled(collectionInfo.Properties)
@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/util/vecindexmgr"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -36,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -302,6 +304,58 @@ func getTotalBinlogRows(segment *SegmentInfo, fieldID int64) int64 {
 	return total
 }
 
+// getSharedBinLogPaths returns the binlog paths of the field if the segment shares the binlogs of other segments,
+// which are recorded explicitly since they can't be built from the ids of the segment.
+// Returns nil if the segment owns its binlogs.
+func getSharedBinLogPaths(segment *SegmentInfo, fieldID int64) []string {
+	if !segment.GetHasSharedLogs() {
+		return nil
+	}
+	paths := make([]string, 0)
+	for _, fieldBinLog := range segment.GetBinlogs() {
+		if fieldBinLog.GetFieldID() == fieldID {
+			for _, binLog := range fieldBinLog.GetBinlogs() {
+				paths = append(paths, binLog.GetLogPath())
+			}
+			break
+		}
+	}
+	return paths
+}
+
+// getSharedLogPaths returns the explicit log paths of the segments sharing logs,
+// these files shall not be recycled until no segment refers to them.
+func getSharedLogPaths(segments []*SegmentInfo) typeutil.Set[string] {
+	paths := typeutil.NewSet[string]()
+	for _, segment := range segments {
+		if !segment.GetHasSharedLogs() {
+			continue
+		}
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{
+			segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs(), segment.GetBm25Statslogs(),
+		} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, l := range fieldBinlog.GetBinlogs() {
+					if l.GetLogPath() != "" {
+						paths.Insert(l.GetLogPath())
+					}
+				}
+			}
+		}
+	}
+	return paths
+}
+
+// getSegmentIndexFilePaths returns the paths of the index files of the segment index,
+// the files of a segment index restored from snapshot are located at its source.
+func getSegmentIndexFilePaths(rootPath string, segIdx *model.SegmentIndex) []string {
+	buildID, partitionID, segmentID := segIdx.BuildID, segIdx.PartitionID, segIdx.SegmentID
+	if segIdx.SourceBuildID != 0 {
+		buildID, partitionID, segmentID = segIdx.SourceBuildID, segIdx.SourcePartitionID, segIdx.SourceSegmentID
+	}
+	return metautil.BuildSegmentIndexFilePaths(rootPath, buildID, segIdx.IndexVersion, partitionID, segmentID, segIdx.IndexFileKeys)
+}
+
 func CheckCheckPointsHealth(meta *meta) error {
 	for channel, cp := range meta.GetChannelCheckpoints() {
 		collectionID := funcutil.GetCollectionIDFromVChannel(channel)
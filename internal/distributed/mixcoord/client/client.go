@@ -689,6 +689,39 @@ func (c *Client) RenameCollection(ctx context.Context, req *milvuspb.RenameColle
 	})
 }
 
+func (c *Client) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.RootCoordClient.CloneCollection(ctx, req)
+	})
+}
+
+func (c *Client) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.RootCoordClient.RestoreSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.RootCoordClient.TruncateCollection(ctx, req)
+	})
+}
+
 func (c *Client) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	in = typeutil.Clone(in)
 	commonpbutil.UpdateMsgBase(
@@ -1418,6 +1451,48 @@ func (c *Client) GcControl(ctx context.Context, req *datapb.GcControlRequest, op
 	})
 }
 
+func (c *Client) GetGcReport(ctx context.Context, req *datapb.GetGcReportRequest, opts ...grpc.CallOption) (*datapb.GetGcReportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.GetGcReportResponse, error) {
+		return client.GetGcReport(ctx, req)
+	})
+}
+
+func (c *Client) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.CreateSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.ListSnapshotsResponse, error) {
+		return client.ListSnapshots(ctx, req)
+	})
+}
+
+func (c *Client) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DropSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) RestoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DataCoordClient.RestoreSnapshotSegments(ctx, req)
+	})
+}
+
+func (c *Client) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DataCoordClient.CloneSegments(ctx, req)
+	})
+}
+
+func (c *Client) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DataCoordClient.TruncateSegments(ctx, req)
+	})
+}
+
 func (c *Client) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*internalpb.ImportResponse, error) {
 		return client.ImportV2(ctx, in)
@@ -534,6 +534,18 @@ func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameC
 	return s.mixCoord.RenameCollection(ctx, request)
 }
 
+func (s *Server) CloneCollection(ctx context.Context, request *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CloneCollection(ctx, request)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, request *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return s.mixCoord.RestoreSnapshot(ctx, request)
+}
+
+func (s *Server) TruncateCollection(ctx context.Context, request *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.mixCoord.TruncateCollection(ctx, request)
+}
+
 func (s *Server) BackupRBAC(ctx context.Context, request *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
 	return s.mixCoord.BackupRBAC(ctx, request)
 }
@@ -892,6 +904,34 @@ func (s *Server) GcControl(ctx context.Context, req *datapb.GcControlRequest) (*
 	return s.mixCoord.GcControl(ctx, req)
 }
 
+func (s *Server) GetGcReport(ctx context.Context, req *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	return s.mixCoord.GetGcReport(ctx, req)
+}
+
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CreateSnapshot(ctx, req)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return s.mixCoord.ListSnapshots(ctx, req)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.mixCoord.DropSnapshot(ctx, req)
+}
+
+func (s *Server) RestoreSnapshotSegments(ctx context.Context, req *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	return s.mixCoord.RestoreSnapshotSegments(ctx, req)
+}
+
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CloneSegments(ctx, req)
+}
+
+func (s *Server) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return s.mixCoord.TruncateSegments(ctx, req)
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return s.mixCoord.ImportV2(ctx, in)
 }
@@ -229,6 +229,42 @@ func (c *Client) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	})
 }
 
+func (c *Client) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CreateSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListSnapshotsResponse, error) {
+		return client.ListSnapshots(ctx, req)
+	})
+}
+
+func (c *Client) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.DropSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.RestoreSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CloneCollection(ctx, req)
+	})
+}
+
+func (c *Client) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.TruncateCollection(ctx, req)
+	})
+}
+
 func (c *Client) InvalidateShardLeaderCache(ctx context.Context, req *proxypb.InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
 		return client.InvalidateShardLeaderCache(ctx, req)
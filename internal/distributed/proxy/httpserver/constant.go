@@ -32,11 +32,14 @@ const (
 	IndexCategory           = "/indexes/"
 	AliasCategory           = "/aliases/"
 	ImportJobCategory       = "/jobs/import/"
+	SnapshotCategory        = "/snapshots/"
 	PrivilegeGroupCategory  = "/privilege_groups/"
 	CollectionFieldCategory = "/collections/fields/"
 	ResourceGroupCategory   = "/resource_groups/"
 	SegmentCategory         = "/segments/"
 	QuotaCenterCategory     = "/quotacenter/"
+	AnalyzerCategory        = "/analyzers/"
+	RBACCategory            = "/rbac/"
 
 	ListAction           = "list"
 	HasAction            = "has"
@@ -46,6 +49,8 @@ const (
 	StatsAction          = "get_stats"
 	LoadStateAction      = "get_load_state"
 	RenameAction         = "rename"
+	CloneAction          = "clone"
+	TruncateAction       = "truncate"
 	LoadAction           = "load"
 	RefreshLoadAction    = "refresh_load"
 	ReleaseAction        = "release"
@@ -57,6 +62,10 @@ const (
 	SearchAction         = "search"
 	AdvancedSearchAction = "advanced_search"
 	HybridSearchAction   = "hybrid_search"
+	QueryIteratorAction  = "query_iterator"
+	RunAction            = "run"
+	BackupAction         = "backup"
+	RestoreAction        = "restore"
 
 	UpdatePasswordAction            = "update_password"
 	GrantRoleAction                 = "grant_role"
@@ -76,6 +85,7 @@ const (
 	AddPrivilegesToGroupAction      = "add_privileges_to_group"
 	RemovePrivilegesFromGroupAction = "remove_privileges_from_group"
 	TransferReplicaAction           = "transfer_replica"
+	DescribeReplicasAction          = "describe_replicas"
 )
 
 const (
@@ -92,6 +102,7 @@ const (
 	VectorGetPath                 = "/vector/get"
 	VectorQueryPath               = "/vector/query"
 	VectorDeletePath              = "/vector/delete"
+	OpenAPIPath                   = "/openapi.json"
 
 	ShardNumDefault = 1
 
@@ -128,6 +139,7 @@ const (
 	HTTPReturnLoadState      = "loadState"
 	HTTPReturnLoadProgress   = "loadProgress"
 	HTTPReturnTopks          = "topks"
+	HTTPReturnCursor         = "cursor"
 
 	HTTPReturnHas = "has"
 
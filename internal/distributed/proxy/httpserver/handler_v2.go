@@ -34,6 +34,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -58,6 +59,8 @@ import (
 type HandlersV2 struct {
 	proxy     types.ProxyComponent
 	checkAuth bool
+	// openAPIDocument is the marshaled OpenAPI document of the registered routes
+	openAPIDocument []byte
 }
 
 func NewHandlersV2(proxyClient types.ProxyComponent) *HandlersV2 {
@@ -67,148 +70,182 @@ func NewHandlersV2(proxyClient types.ProxyComponent) *HandlersV2 {
 	}
 }
 
-func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
-	router.POST(CollectionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listCollections))))
-	router.POST(CollectionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.hasCollection))))
+func (h *HandlersV2) RegisterRoutesToV2(group gin.IRouter) {
+	router := &openAPIRouteRecorder{IRouter: group}
+
+	router.post(CollectionCategory+ListAction, func() any { return &DatabaseReq{} }, h.listCollections, timeoutMiddleware)
+	router.post(CollectionCategory+HasAction, func() any { return &CollectionNameReq{} }, h.hasCollection, timeoutMiddleware)
 	// todo review the return data
-	router.POST(CollectionCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.getCollectionDetails))))
-	router.POST(CollectionCategory+StatsAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.getCollectionStats))))
-	router.POST(CollectionCategory+LoadStateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.getCollectionLoadState))))
-	router.POST(CollectionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionReq{AutoID: DisableAutoID} }, wrapperTraceLog(h.createCollection))))
-	router.POST(CollectionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.dropCollection))))
-	router.POST(CollectionCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenameCollectionReq{} }, wrapperTraceLog(h.renameCollection))))
-	router.POST(CollectionCategory+LoadAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.loadCollection))))
-	router.POST(CollectionCategory+RefreshLoadAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.refreshLoadCollection))))
-	router.POST(CollectionCategory+ReleaseAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.releaseCollection))))
-	router.POST(CollectionCategory+AlterPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionReqWithProperties{} }, wrapperTraceLog(h.alterCollectionProperties))))
-	router.POST(CollectionCategory+DropPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &DropCollectionPropertiesReq{} }, wrapperTraceLog(h.dropCollectionProperties))))
-	router.POST(CollectionCategory+CompactAction, timeoutMiddleware(wrapperPost(func() any { return &CompactReq{} }, wrapperTraceLog(h.compact))))
-	router.POST(CollectionCategory+CompactionStateAction, timeoutMiddleware(wrapperPost(func() any { return &GetCompactionStateReq{} }, wrapperTraceLog(h.getcompactionState))))
-	router.POST(CollectionCategory+FlushAction, timeoutMiddleware(wrapperPost(func() any { return &FlushReq{} }, wrapperTraceLog(h.flush))))
-
-	router.POST(CollectionFieldCategory+AlterPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionFieldReqWithParams{} }, wrapperTraceLog(h.alterCollectionFieldProperties))))
+	router.post(CollectionCategory+DescribeAction, func() any { return &CollectionNameReq{} }, h.getCollectionDetails, timeoutMiddleware)
+	router.post(CollectionCategory+StatsAction, func() any { return &CollectionNameReq{} }, h.getCollectionStats, timeoutMiddleware)
+	router.post(CollectionCategory+LoadStateAction, func() any { return &CollectionNameReq{} }, h.getCollectionLoadState, timeoutMiddleware)
+	router.post(CollectionCategory+CreateAction, func() any { return &CollectionReq{AutoID: DisableAutoID} }, h.createCollection, timeoutMiddleware)
+	router.post(CollectionCategory+DropAction, func() any { return &CollectionNameReq{} }, h.dropCollection, timeoutMiddleware)
+	router.post(CollectionCategory+RenameAction, func() any { return &RenameCollectionReq{} }, h.renameCollection, timeoutMiddleware)
+	router.post(CollectionCategory+CloneAction, func() any { return &CloneCollectionReq{} }, h.cloneCollection, timeoutMiddleware)
+	router.post(CollectionCategory+TruncateAction, func() any { return &TruncateCollectionReq{} }, h.truncateCollection, timeoutMiddleware)
+	router.post(CollectionCategory+LoadAction, func() any { return &CollectionNameReq{} }, h.loadCollection, timeoutMiddleware)
+	router.post(CollectionCategory+RefreshLoadAction, func() any { return &CollectionNameReq{} }, h.refreshLoadCollection, timeoutMiddleware)
+	router.post(CollectionCategory+ReleaseAction, func() any { return &CollectionNameReq{} }, h.releaseCollection, timeoutMiddleware)
+	router.post(CollectionCategory+AlterPropertiesAction, func() any { return &CollectionReqWithProperties{} }, h.alterCollectionProperties, timeoutMiddleware)
+	router.post(CollectionCategory+DropPropertiesAction, func() any { return &DropCollectionPropertiesReq{} }, h.dropCollectionProperties, timeoutMiddleware)
+	router.post(CollectionCategory+CompactAction, func() any { return &CompactReq{} }, h.compact, timeoutMiddleware)
+	router.post(CollectionCategory+CompactionStateAction, func() any { return &GetCompactionStateReq{} }, h.getcompactionState, timeoutMiddleware)
+	router.post(CollectionCategory+FlushAction, func() any { return &FlushReq{} }, h.flush, timeoutMiddleware)
+	router.post(CollectionCategory+DescribeReplicasAction, func() any { return &CollectionNameReq{} }, h.describeReplicas, timeoutMiddleware)
+
+	router.post(CollectionFieldCategory+AlterPropertiesAction, func() any { return &CollectionFieldReqWithParams{} }, h.alterCollectionFieldProperties, timeoutMiddleware)
 
 	// /collections/fields/add
-	router.POST(CollectionFieldCategory+AddAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionFieldReqWithSchema{} }, wrapperTraceLog(h.addCollectionField))))
-
-	router.POST(DataBaseCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqWithProperties{} }, wrapperTraceLog(h.createDatabase))))
-	router.POST(DataBaseCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqRequiredName{} }, wrapperTraceLog(h.dropDatabase))))
-	router.POST(DataBaseCategory+DropPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &DropDatabasePropertiesReq{} }, wrapperTraceLog(h.dropDatabaseProperties))))
-	router.POST(DataBaseCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &EmptyReq{} }, wrapperTraceLog(h.listDatabases))))
-	router.POST(DataBaseCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqRequiredName{} }, wrapperTraceLog(h.describeDatabase))))
-	router.POST(DataBaseCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqWithProperties{} }, wrapperTraceLog(h.alterDatabase))))
-	router.POST(DataBaseCategory+AlterPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReqWithProperties{} }, wrapperTraceLog(h.alterDatabase))))
+	router.post(CollectionFieldCategory+AddAction, func() any { return &CollectionFieldReqWithSchema{} }, h.addCollectionField, timeoutMiddleware)
+
+	router.post(DataBaseCategory+CreateAction, func() any { return &DatabaseReqWithProperties{} }, h.createDatabase, timeoutMiddleware)
+	router.post(DataBaseCategory+DropAction, func() any { return &DatabaseReqRequiredName{} }, h.dropDatabase, timeoutMiddleware)
+	router.post(DataBaseCategory+DropPropertiesAction, func() any { return &DropDatabasePropertiesReq{} }, h.dropDatabaseProperties, timeoutMiddleware)
+	router.post(DataBaseCategory+ListAction, func() any { return &EmptyReq{} }, h.listDatabases, timeoutMiddleware)
+	router.post(DataBaseCategory+DescribeAction, func() any { return &DatabaseReqRequiredName{} }, h.describeDatabase, timeoutMiddleware)
+	router.post(DataBaseCategory+AlterAction, func() any { return &DatabaseReqWithProperties{} }, h.alterDatabase, timeoutMiddleware)
+	router.post(DataBaseCategory+AlterPropertiesAction, func() any { return &DatabaseReqWithProperties{} }, h.alterDatabase, timeoutMiddleware)
 	// Query
-	router.POST(EntityCategory+QueryAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+QueryAction, func() any {
 		return &QueryReqV2{
 			Limit:        100,
 			OutputFields: []string{DefaultOutputFields},
 		}
-	}, wrapperTraceLog(h.query))), true))
+	}, h.query, restfulSizeTimeoutMiddleware(true))
+	// QueryIterator
+	router.post(EntityCategory+QueryIteratorAction, func() any {
+		return &QueryIteratorReqV2{
+			BatchSize:    100,
+			OutputFields: []string{DefaultOutputFields},
+		}
+	}, h.queryIterator, restfulSizeTimeoutMiddleware(true))
 	// Get
-	router.POST(EntityCategory+GetAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+GetAction, func() any {
 		return &CollectionIDReq{
 			OutputFields: []string{DefaultOutputFields},
 		}
-	}, wrapperTraceLog(h.get))), true))
+	}, h.get, restfulSizeTimeoutMiddleware(true))
 	// Delete
-	router.POST(EntityCategory+DeleteAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+DeleteAction, func() any {
 		return &CollectionFilterReq{}
-	}, wrapperTraceLog(h.delete))), false))
+	}, h.delete, restfulSizeTimeoutMiddleware(false))
 	// Insert
-	router.POST(EntityCategory+InsertAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+InsertAction, func() any {
 		return &CollectionDataReq{}
-	}, wrapperTraceLog(h.insert))), false))
+	}, h.insert, restfulSizeTimeoutMiddleware(false))
 	// Upsert
-	router.POST(EntityCategory+UpsertAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+UpsertAction, func() any {
 		return &CollectionDataReq{}
-	}, wrapperTraceLog(h.upsert))), false))
+	}, h.upsert, restfulSizeTimeoutMiddleware(false))
 	// Search
-	router.POST(EntityCategory+SearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+SearchAction, func() any {
 		return &SearchReqV2{
 			Limit: 100,
 		}
-	}, wrapperTraceLog(h.search))), true))
+	}, h.search, restfulSizeTimeoutMiddleware(true))
 	// advanced_search, backward compatible uri
-	router.POST(EntityCategory+AdvancedSearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+AdvancedSearchAction, func() any {
 		return &HybridSearchReq{
 			Limit: 100,
 		}
-	}, wrapperTraceLog(h.advancedSearch))), true))
+	}, h.advancedSearch, restfulSizeTimeoutMiddleware(true))
 	// HybridSearch
-	router.POST(EntityCategory+HybridSearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+	router.post(EntityCategory+HybridSearchAction, func() any {
 		return &HybridSearchReq{
 			Limit: 100,
 		}
-	}, wrapperTraceLog(h.advancedSearch))), true))
+	}, h.advancedSearch, restfulSizeTimeoutMiddleware(true))
+
+	router.post(PartitionCategory+ListAction, func() any { return &CollectionNameReq{} }, h.listPartitions, timeoutMiddleware)
+	router.post(PartitionCategory+HasAction, func() any { return &PartitionReq{} }, h.hasPartitions, timeoutMiddleware)
+	router.post(PartitionCategory+StatsAction, func() any { return &PartitionReq{} }, h.statsPartition, timeoutMiddleware)
 
-	router.POST(PartitionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.listPartitions))))
-	router.POST(PartitionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.hasPartitions))))
-	router.POST(PartitionCategory+StatsAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.statsPartition))))
+	router.post(PartitionCategory+CreateAction, func() any { return &PartitionReq{} }, h.createPartition, timeoutMiddleware)
+	router.post(PartitionCategory+DropAction, func() any { return &PartitionReq{} }, h.dropPartition, timeoutMiddleware)
+	router.post(PartitionCategory+LoadAction, func() any { return &PartitionsReq{} }, h.loadPartitions, timeoutMiddleware)
+	router.post(PartitionCategory+ReleaseAction, func() any { return &PartitionsReq{} }, h.releasePartitions, timeoutMiddleware)
 
-	router.POST(PartitionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.createPartition))))
-	router.POST(PartitionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.dropPartition))))
-	router.POST(PartitionCategory+LoadAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionsReq{} }, wrapperTraceLog(h.loadPartitions))))
-	router.POST(PartitionCategory+ReleaseAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionsReq{} }, wrapperTraceLog(h.releasePartitions))))
+	router.post(UserCategory+ListAction, func() any { return &DatabaseReq{} }, h.listUsers, timeoutMiddleware)
+	router.post(UserCategory+DescribeAction, func() any { return &UserReq{} }, h.describeUser, timeoutMiddleware)
 
-	router.POST(UserCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listUsers))))
-	router.POST(UserCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &UserReq{} }, wrapperTraceLog(h.describeUser))))
+	router.post(UserCategory+CreateAction, func() any { return &PasswordReq{} }, h.createUser, timeoutMiddleware)
+	router.post(UserCategory+UpdatePasswordAction, func() any { return &NewPasswordReq{} }, h.updateUser, timeoutMiddleware)
+	router.post(UserCategory+DropAction, func() any { return &UserReq{} }, h.dropUser, timeoutMiddleware)
+	router.post(UserCategory+GrantRoleAction, func() any { return &UserRoleReq{} }, h.addRoleToUser, timeoutMiddleware)
+	router.post(UserCategory+RevokeRoleAction, func() any { return &UserRoleReq{} }, h.removeRoleFromUser, timeoutMiddleware)
 
-	router.POST(UserCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &PasswordReq{} }, wrapperTraceLog(h.createUser))))
-	router.POST(UserCategory+UpdatePasswordAction, timeoutMiddleware(wrapperPost(func() any { return &NewPasswordReq{} }, wrapperTraceLog(h.updateUser))))
-	router.POST(UserCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &UserReq{} }, wrapperTraceLog(h.dropUser))))
-	router.POST(UserCategory+GrantRoleAction, timeoutMiddleware(wrapperPost(func() any { return &UserRoleReq{} }, wrapperTraceLog(h.addRoleToUser))))
-	router.POST(UserCategory+RevokeRoleAction, timeoutMiddleware(wrapperPost(func() any { return &UserRoleReq{} }, wrapperTraceLog(h.removeRoleFromUser))))
+	router.post(RoleCategory+ListAction, func() any { return &DatabaseReq{} }, h.listRoles, timeoutMiddleware)
+	router.post(RoleCategory+DescribeAction, func() any { return &RoleReq{} }, h.describeRole, timeoutMiddleware)
 
-	router.POST(RoleCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listRoles))))
-	router.POST(RoleCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.describeRole))))
+	router.post(RoleCategory+CreateAction, func() any { return &RoleReq{} }, h.createRole, timeoutMiddleware)
+	router.post(RoleCategory+DropAction, func() any { return &RoleReq{} }, h.dropRole, timeoutMiddleware)
+	router.post(RoleCategory+GrantPrivilegeAction, func() any { return &GrantReq{} }, h.addPrivilegeToRole, timeoutMiddleware)
+	router.post(RoleCategory+RevokePrivilegeAction, func() any { return &GrantReq{} }, h.removePrivilegeFromRole, timeoutMiddleware)
+	router.post(RoleCategory+GrantPrivilegeActionV2, func() any { return &GrantV2Req{} }, h.grantV2, timeoutMiddleware)
+	router.post(RoleCategory+RevokePrivilegeActionV2, func() any { return &GrantV2Req{} }, h.revokeV2, timeoutMiddleware)
 
-	router.POST(RoleCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.createRole))))
-	router.POST(RoleCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.dropRole))))
-	router.POST(RoleCategory+GrantPrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.addPrivilegeToRole))))
-	router.POST(RoleCategory+RevokePrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.removePrivilegeFromRole))))
-	router.POST(RoleCategory+GrantPrivilegeActionV2, timeoutMiddleware(wrapperPost(func() any { return &GrantV2Req{} }, wrapperTraceLog(h.grantV2))))
-	router.POST(RoleCategory+RevokePrivilegeActionV2, timeoutMiddleware(wrapperPost(func() any { return &GrantV2Req{} }, wrapperTraceLog(h.revokeV2))))
+	// rbac meta
+	router.post(RBACCategory+BackupAction, func() any { return &EmptyReq{} }, h.backupRBAC, timeoutMiddleware)
+	router.post(RBACCategory+RestoreAction, func() any { return &RestoreRBACReq{} }, h.restoreRBAC, timeoutMiddleware)
 
 	// privilege group
-	router.POST(PrivilegeGroupCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &PrivilegeGroupReq{} }, wrapperTraceLog(h.createPrivilegeGroup))))
-	router.POST(PrivilegeGroupCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &PrivilegeGroupReq{} }, wrapperTraceLog(h.dropPrivilegeGroup))))
-	router.POST(PrivilegeGroupCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listPrivilegeGroups))))
-	router.POST(PrivilegeGroupCategory+AddPrivilegesToGroupAction, timeoutMiddleware(wrapperPost(func() any { return &PrivilegeGroupReq{} }, wrapperTraceLog(h.addPrivilegesToGroup))))
-	router.POST(PrivilegeGroupCategory+RemovePrivilegesFromGroupAction, timeoutMiddleware(wrapperPost(func() any { return &PrivilegeGroupReq{} }, wrapperTraceLog(h.removePrivilegesFromGroup))))
+	router.post(PrivilegeGroupCategory+CreateAction, func() any { return &PrivilegeGroupReq{} }, h.createPrivilegeGroup, timeoutMiddleware)
+	router.post(PrivilegeGroupCategory+DropAction, func() any { return &PrivilegeGroupReq{} }, h.dropPrivilegeGroup, timeoutMiddleware)
+	router.post(PrivilegeGroupCategory+ListAction, func() any { return &DatabaseReq{} }, h.listPrivilegeGroups, timeoutMiddleware)
+	router.post(PrivilegeGroupCategory+AddPrivilegesToGroupAction, func() any { return &PrivilegeGroupReq{} }, h.addPrivilegesToGroup, timeoutMiddleware)
+	router.post(PrivilegeGroupCategory+RemovePrivilegesFromGroupAction, func() any { return &PrivilegeGroupReq{} }, h.removePrivilegesFromGroup, timeoutMiddleware)
 
-	router.POST(IndexCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.listIndexes))))
-	router.POST(IndexCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReq{} }, wrapperTraceLog(h.describeIndex))))
+	router.post(IndexCategory+ListAction, func() any { return &CollectionNameReq{} }, h.listIndexes, timeoutMiddleware)
+	router.post(IndexCategory+DescribeAction, func() any { return &IndexReq{} }, h.describeIndex, timeoutMiddleware)
 
-	router.POST(IndexCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &IndexParamReq{} }, wrapperTraceLog(h.createIndex))))
+	router.post(IndexCategory+CreateAction, func() any { return &IndexParamReq{} }, h.createIndex, timeoutMiddleware)
 	// todo cannot drop index before release it ?
-	router.POST(IndexCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReq{} }, wrapperTraceLog(h.dropIndex))))
-	router.POST(IndexCategory+AlterPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReqWithProperties{} }, wrapperTraceLog(h.alterIndexProperties))))
-	router.POST(IndexCategory+DropPropertiesAction, timeoutMiddleware(wrapperPost(func() any { return &DropIndexPropertiesReq{} }, wrapperTraceLog(h.dropIndexProperties))))
+	router.post(IndexCategory+DropAction, func() any { return &IndexReq{} }, h.dropIndex, timeoutMiddleware)
+	router.post(IndexCategory+AlterPropertiesAction, func() any { return &IndexReqWithProperties{} }, h.alterIndexProperties, timeoutMiddleware)
+	router.post(IndexCategory+DropPropertiesAction, func() any { return &DropIndexPropertiesReq{} }, h.dropIndexProperties, timeoutMiddleware)
 
-	router.POST(AliasCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.listAlias))))
-	router.POST(AliasCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &AliasReq{} }, wrapperTraceLog(h.describeAlias))))
+	router.post(AliasCategory+ListAction, func() any { return &OptionalCollectionNameReq{} }, h.listAlias, timeoutMiddleware)
+	router.post(AliasCategory+DescribeAction, func() any { return &AliasReq{} }, h.describeAlias, timeoutMiddleware)
 
-	router.POST(AliasCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &AliasCollectionReq{} }, wrapperTraceLog(h.createAlias))))
-	router.POST(AliasCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &AliasReq{} }, wrapperTraceLog(h.dropAlias))))
-	router.POST(AliasCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &AliasCollectionReq{} }, wrapperTraceLog(h.alterAlias))))
+	router.post(AliasCategory+CreateAction, func() any { return &AliasCollectionReq{} }, h.createAlias, timeoutMiddleware)
+	router.post(AliasCategory+DropAction, func() any { return &AliasReq{} }, h.dropAlias, timeoutMiddleware)
+	router.post(AliasCategory+AlterAction, func() any { return &AliasCollectionReq{} }, h.alterAlias, timeoutMiddleware)
 
-	router.POST(ImportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.listImportJob))))
-	router.POST(ImportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ImportReq{} }, wrapperTraceLog(h.createImportJob))))
-	router.POST(ImportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getImportJobProcess))))
-	router.POST(ImportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getImportJobProcess))))
+	router.post(ImportJobCategory+ListAction, func() any { return &OptionalCollectionNameReq{} }, h.listImportJob, timeoutMiddleware)
+	router.post(ImportJobCategory+CreateAction, func() any { return &ImportReq{} }, h.createImportJob, timeoutMiddleware)
+	router.post(ImportJobCategory+GetProgressAction, func() any { return &JobIDReq{} }, h.getImportJobProcess, timeoutMiddleware)
+	router.post(ImportJobCategory+DescribeAction, func() any { return &JobIDReq{} }, h.getImportJobProcess, timeoutMiddleware)
+
+	router.post(SnapshotCategory+ListAction, func() any { return &OptionalCollectionNameReq{} }, h.listSnapshots, timeoutMiddleware)
+	router.post(SnapshotCategory+CreateAction, func() any { return &SnapshotReq{} }, h.createSnapshot, timeoutMiddleware)
+	router.post(SnapshotCategory+DropAction, func() any { return &SnapshotNameReq{} }, h.dropSnapshot, timeoutMiddleware)
+	router.post(SnapshotCategory+RestoreAction, func() any { return &SnapshotReq{} }, h.restoreSnapshot, timeoutMiddleware)
 
 	// resource group
-	router.POST(ResourceGroupCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.createResourceGroup))))
-	router.POST(ResourceGroupCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.dropResourceGroup))))
-	router.POST(ResourceGroupCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &UpdateResourceGroupReq{} }, wrapperTraceLog(h.updateResourceGroup))))
-	router.POST(ResourceGroupCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.describeResourceGroup))))
-	router.POST(ResourceGroupCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &EmptyReq{} }, wrapperTraceLog(h.listResourceGroups))))
-	router.POST(ResourceGroupCategory+TransferReplicaAction, timeoutMiddleware(wrapperPost(func() any { return &TransferReplicaReq{} }, wrapperTraceLog(h.transferReplica))))
+	router.post(ResourceGroupCategory+CreateAction, func() any { return &ResourceGroupReq{} }, h.createResourceGroup, timeoutMiddleware)
+	router.post(ResourceGroupCategory+DropAction, func() any { return &ResourceGroupReq{} }, h.dropResourceGroup, timeoutMiddleware)
+	router.post(ResourceGroupCategory+AlterAction, func() any { return &UpdateResourceGroupReq{} }, h.updateResourceGroup, timeoutMiddleware)
+	router.post(ResourceGroupCategory+DescribeAction, func() any { return &ResourceGroupReq{} }, h.describeResourceGroup, timeoutMiddleware)
+	router.post(ResourceGroupCategory+ListAction, func() any { return &EmptyReq{} }, h.listResourceGroups, timeoutMiddleware)
+	router.post(ResourceGroupCategory+TransferReplicaAction, func() any { return &TransferReplicaReq{} }, h.transferReplica, timeoutMiddleware)
 
 	// segment group
-	router.POST(SegmentCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &GetSegmentsInfoReq{} }, wrapperTraceLog(h.getSegmentsInfo))))
-	router.POST(QuotaCenterCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &GetQuotaMetricsReq{} }, wrapperTraceLog(h.getQuotaMetrics))))
+	router.post(SegmentCategory+DescribeAction, func() any { return &GetSegmentsInfoReq{} }, h.getSegmentsInfo, timeoutMiddleware)
+	router.post(QuotaCenterCategory+DescribeAction, func() any { return &GetQuotaMetricsReq{} }, h.getQuotaMetrics, timeoutMiddleware)
+
+	router.post(AnalyzerCategory+RunAction, func() any { return &RunAnalyzerReq{} }, h.runAnalyzer, timeoutMiddleware)
+
+	basePath := ""
+	if g, ok := group.(interface{ BasePath() string }); ok {
+		basePath = g.BasePath()
+	}
+	doc, err := buildOpenAPIDocument(basePath, router.routes)
+	if err != nil {
+		log.Warn("high level restful api, the OpenAPI document is incomplete", zap.Error(err))
+	}
+	h.openAPIDocument, _ = json.Marshal(doc)
+	router.GET(OpenAPIPath, h.getOpenAPIDocument)
 }
 
 type (
@@ -276,6 +313,13 @@ func restfulSizeMiddleware(handler gin.HandlerFunc, observeOutbound bool) gin.Ha
 	}
 }
 
+// restfulSizeTimeoutMiddleware applies both the size and timeout middlewares, for the routes of entities.
+func restfulSizeTimeoutMiddleware(observeOutbound bool) func(gin.HandlerFunc) gin.HandlerFunc {
+	return func(handler gin.HandlerFunc) gin.HandlerFunc {
+		return restfulSizeMiddleware(timeoutMiddleware(handler), observeOutbound)
+	}
+}
+
 func wrapperTraceLog(v2 handlerFuncV2) handlerFuncV2 {
 	return func(ctx context.Context, c *gin.Context, req any, dbName string) (interface{}, error) {
 		switch proxy.Params.CommonCfg.TraceLogMode.GetAsInt() {
@@ -614,6 +658,43 @@ func (h *HandlersV2) renameCollection(ctx context.Context, c *gin.Context, anyRe
 	return resp, err
 }
 
+func (h *HandlersV2) cloneCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CloneCollectionReq)
+	req := &internalpb.CloneCollectionRequest{
+		DbName:            dbName,
+		CollectionName:    httpReq.CollectionName,
+		NewDbName:         httpReq.NewDbName,
+		NewCollectionName: httpReq.NewCollectionName,
+	}
+	c.Set(ContextRequest, req)
+	if req.NewDbName == "" {
+		req.NewDbName = dbName
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/CloneCollection", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CloneCollection(reqCtx, req.(*internalpb.CloneCollectionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) truncateCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TruncateCollectionReq)
+	req := &internalpb.TruncateCollectionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/TruncateCollection", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.TruncateCollection(reqCtx, req.(*internalpb.TruncateCollectionRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) refreshLoadCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	getter, _ := anyReq.(requestutil.CollectionNameGetter)
 	req := &milvuspb.LoadCollectionRequest{
@@ -755,6 +836,44 @@ func (h *HandlersV2) flush(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	return resp, err
 }
 
+func (h *HandlersV2) describeReplicas(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(requestutil.CollectionNameGetter)
+	req := &milvuspb.GetReplicasRequest{
+		DbName:         dbName,
+		CollectionName: getter.GetCollectionName(),
+		WithShardNodes: true,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/GetReplicas", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetReplicas(reqCtx, req.(*milvuspb.GetReplicasRequest))
+	})
+	if err == nil {
+		replicas := make([]gin.H, 0)
+		for _, replica := range resp.(*milvuspb.GetReplicasResponse).GetReplicas() {
+			shards := make([]gin.H, 0, len(replica.GetShardReplicas()))
+			for _, shard := range replica.GetShardReplicas() {
+				shards = append(shards, gin.H{
+					"channelName": shard.GetDmChannelName(),
+					"leaderID":    shard.GetLeaderID(),
+					"leaderAddr":  shard.GetLeaderAddr(),
+					"nodeIDs":     shard.GetNodeIds(),
+				})
+			}
+			replicas = append(replicas, gin.H{
+				"replicaID":       replica.GetReplicaID(),
+				"collectionID":    replica.GetCollectionID(),
+				"partitionIDs":    replica.GetPartitionIds(),
+				"resourceGroup":   replica.GetResourceGroupName(),
+				"nodeIDs":         replica.GetNodeIds(),
+				"numOutboundNode": replica.GetNumOutboundNode(),
+				"shards":          shards,
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: replicas})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) alterCollectionFieldProperties(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*CollectionFieldReqWithParams)
 	req := &milvuspb.AlterCollectionFieldRequest{
@@ -866,6 +985,104 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	return resp, err
 }
 
+func (h *HandlersV2) queryIterator(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*QueryIteratorReqV2)
+	if httpReq.BatchSize <= 0 {
+		err := merr.WrapErrParameterInvalidMsg("batchSize must be positive, but got %d", httpReq.BatchSize)
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	cursor, err := decodeQueryIteratorCursor(httpReq.Cursor)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, query iterator with cursor invalid", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	pkField, ok := getPrimaryField(collSchema)
+	if !ok {
+		err := merr.WrapErrCollectionIllegalSchema(httpReq.CollectionName, "primary key not found")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	outputFields := httpReq.OutputFields
+	if !lo.Contains(outputFields, DefaultOutputFields) && !lo.Contains(outputFields, pkField.GetName()) {
+		// the primary key of the last entity makes the cursor
+		outputFields = append(outputFields, pkField.GetName())
+	}
+	req := &milvuspb.QueryRequest{
+		DbName:             dbName,
+		CollectionName:     httpReq.CollectionName,
+		Expr:               cursor.composeExpr(httpReq.Filter, pkField.GetName()),
+		OutputFields:       outputFields,
+		PartitionNames:     httpReq.PartitionNames,
+		GuaranteeTimestamp: cursor.MvccTs,
+		QueryParams: []*commonpb.KeyValuePair{
+			{Key: proxy.IteratorField, Value: "true"},
+			{Key: proxy.ReduceStopForBestKey, Value: "true"},
+			{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.BatchSize), 10)},
+		},
+	}
+	req.ConsistencyLevel, req.UseDefaultConsistency, err = convertConsistencyLevel(httpReq.ConsistencyLevel)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, query iterator with consistency_level invalid", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: "consistencyLevel can only be [Strong, Session, Bounded, Eventually, Customized], default: Bounded, err:" + err.Error(),
+		})
+		return nil, err
+	}
+	req.ExprTemplateValues = generateExpressionTemplate(httpReq.ExprParams)
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Query", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
+	})
+	if err == nil {
+		queryResp := resp.(*milvuspb.QueryResults)
+		allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+		outputData, err := buildQueryResp(int64(0), queryResp.OutputFields, queryResp.FieldsData, nil, nil, allowJS)
+		if err == nil {
+			// pin the snapshot of the first batch
+			if cursor.MvccTs == 0 {
+				cursor.MvccTs = queryResp.GetSessionTs()
+			}
+			err = cursor.moveTo(queryResp.GetFieldsData(), pkField.GetName(), len(outputData))
+		}
+		if err != nil {
+			log.Ctx(ctx).Warn("high level restful api, fail to deal with query iterator result", zap.Any("response", resp), zap.Error(err))
+			HTTPReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+				HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+			})
+			return resp, err
+		}
+		// an incomplete batch means all the entities are returned
+		nextCursor := ""
+		if len(outputData) >= int(httpReq.BatchSize) {
+			nextCursor = cursor.encode()
+		}
+		HTTPReturnStream(c, http.StatusOK, gin.H{
+			HTTPReturnCode:   merr.Code(nil),
+			HTTPReturnData:   outputData,
+			HTTPReturnCursor: nextCursor,
+			HTTPReturnCost:   proxy.GetCostValue(queryResp.GetStatus()),
+		})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) get(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*CollectionIDReq)
 	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
@@ -2159,6 +2376,51 @@ func (h *HandlersV2) removePrivilegeFromRole(ctx context.Context, c *gin.Context
 	return h.operatePrivilegeToRole(ctx, c, anyReq.(*GrantReq), milvuspb.OperatePrivilegeType_Revoke, dbName)
 }
 
+func (h *HandlersV2) backupRBAC(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &milvuspb.BackupRBACMetaRequest{}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/BackupRBAC", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.BackupRBAC(reqCtx, req.(*milvuspb.BackupRBACMetaRequest))
+	})
+	if err == nil {
+		meta, err := protojson.Marshal(resp.(*milvuspb.BackupRBACMetaResponse).GetRBACMeta())
+		if err != nil {
+			log.Ctx(ctx).Warn("high level restful api, fail to marshal rbac meta", zap.Error(err))
+			HTTPReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(err),
+				HTTPReturnMessage: err.Error(),
+			})
+			return resp, err
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{"rbacMeta": json.RawMessage(meta)}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) restoreRBAC(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RestoreRBACReq)
+	meta := &milvuspb.RBACMeta{}
+	if err := protojson.Unmarshal(httpReq.RBACMeta, meta); err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to unmarshal rbac meta", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	req := &milvuspb.RestoreRBACMetaRequest{
+		RBACMeta: meta,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/RestoreRBAC", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RestoreRBAC(reqCtx, req.(*milvuspb.RestoreRBACMetaRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) createPrivilegeGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*PrivilegeGroupReq)
 	req := &milvuspb.CreatePrivilegeGroupRequest{
@@ -2603,6 +2865,92 @@ func (h *HandlersV2) createImportJob(ctx context.Context, c *gin.Context, anyReq
 	return resp, err
 }
 
+func (h *HandlersV2) listSnapshots(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	var collectionName string
+	if collectionGetter, ok := anyReq.(requestutil.CollectionNameGetter); ok {
+		collectionName = collectionGetter.GetCollectionName()
+	}
+	req := &internalpb.ListSnapshotsRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	}
+	c.Set(ContextRequest, req)
+
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/ListSnapshots", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListSnapshots(reqCtx, req.(*internalpb.ListSnapshotsRequest))
+	})
+	if err == nil {
+		records := make([]map[string]interface{}, 0)
+		for _, snapshot := range resp.(*internalpb.ListSnapshotsResponse).GetSnapshots() {
+			records = append(records, map[string]interface{}{
+				"snapshotName":   snapshot.GetSnapshotName(),
+				"snapshotId":     snapshot.GetSnapshotID(),
+				"dbName":         snapshot.GetDbName(),
+				"collectionName": snapshot.GetCollectionName(),
+				"timestamp":      snapshot.GetTs(),
+				"numSegments":    snapshot.GetNumSegments(),
+				"numRows":        snapshot.GetNumRows(),
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{"records": records}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createSnapshot(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
+	snapshotGetter, _ := anyReq.(SnapshotNameGetter)
+	req := &internalpb.CreateSnapshotRequest{
+		DbName:         dbName,
+		CollectionName: collectionGetter.GetCollectionName(),
+		SnapshotName:   snapshotGetter.GetSnapshotName(),
+	}
+	c.Set(ContextRequest, req)
+
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/CreateSnapshot", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateSnapshot(reqCtx, req.(*internalpb.CreateSnapshotRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropSnapshot(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	snapshotGetter, _ := anyReq.(SnapshotNameGetter)
+	req := &internalpb.DropSnapshotRequest{
+		SnapshotName: snapshotGetter.GetSnapshotName(),
+	}
+	c.Set(ContextRequest, req)
+
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/DropSnapshot", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropSnapshot(reqCtx, req.(*internalpb.DropSnapshotRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) restoreSnapshot(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
+	snapshotGetter, _ := anyReq.(SnapshotNameGetter)
+	req := &internalpb.RestoreSnapshotRequest{
+		SnapshotName:   snapshotGetter.GetSnapshotName(),
+		DbName:         dbName,
+		CollectionName: collectionGetter.GetCollectionName(),
+	}
+	c.Set(ContextRequest, req)
+
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/RestoreSnapshot", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RestoreSnapshot(reqCtx, req.(*internalpb.RestoreSnapshotRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	jobIDGetter := anyReq.(JobIDGetter)
 	req := &internalpb.GetImportProgressRequest{
@@ -2739,3 +3087,69 @@ func (h *HandlersV2) getQuotaMetrics(ctx context.Context, c *gin.Context, anyReq
 
 	return resp, err
 }
+
+func (h *HandlersV2) runAnalyzer(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RunAnalyzerReq)
+	if (httpReq.CollectionName == "") != (httpReq.FieldName == "") {
+		err := merr.WrapErrParameterInvalidMsg("collectionName and fieldName must be set together")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	req := &milvuspb.RunAnalyzerRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		FieldName:      httpReq.FieldName,
+		AnalyzerNames:  httpReq.AnalyzerNames,
+		WithDetail:     httpReq.WithDetail,
+		WithHash:       httpReq.WithHash,
+		Placeholder: lo.Map(httpReq.Text, func(text string, _ int) []byte {
+			return []byte(text)
+		}),
+	}
+	if httpReq.AnalyzerParams != nil {
+		params, err := json.Marshal(httpReq.AnalyzerParams)
+		if err != nil {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+		req.AnalyzerParams = string(params)
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/RunAnalyzer", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RunAnalyzer(reqCtx, req.(*milvuspb.RunAnalyzerRequest))
+	})
+	if err == nil {
+		results := make([]gin.H, 0)
+		for _, result := range resp.(*milvuspb.RunAnalyzerResponse).GetResults() {
+			if !httpReq.WithDetail && !httpReq.WithHash {
+				results = append(results, gin.H{"tokens": lo.Map(result.GetTokens(), func(token *milvuspb.AnalyzerToken, _ int) string {
+					return token.GetToken()
+				})})
+				continue
+			}
+			tokens := make([]gin.H, 0, len(result.GetTokens()))
+			for _, token := range result.GetTokens() {
+				tokenInfo := gin.H{"token": token.GetToken()}
+				if httpReq.WithDetail {
+					tokenInfo["startOffset"] = token.GetStartOffset()
+					tokenInfo["endOffset"] = token.GetEndOffset()
+					tokenInfo["position"] = token.GetPosition()
+					tokenInfo["positionLength"] = token.GetPositionLength()
+				}
+				if httpReq.WithHash {
+					tokenInfo["hash"] = token.GetHash()
+				}
+				tokens = append(tokens, tokenInfo)
+			}
+			results = append(results, gin.H{"tokens": tokens})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: results})
+	}
+	return resp, err
+}
@@ -1896,6 +1896,8 @@ func TestMethodPost(t *testing.T) {
 	mp := mocks.NewMockProxy(t)
 	mp.EXPECT().CreateCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().RenameCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().CloneCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().TruncateCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().LoadCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Times(3)
 	mp.EXPECT().ReleaseCollection(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().CreatePartition(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
@@ -1929,6 +1931,15 @@ func TestMethodPost(t *testing.T) {
 		Progresses:      []int64{0, 30, 0, 100},
 		CollectionNames: []string{"AAA", "BBB", "CCC", "DDD"},
 	}, nil).Once()
+	mp.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(&internalpb.ListSnapshotsResponse{
+		Status: &StatusSuccess,
+		Snapshots: []*internalpb.SnapshotSummary{
+			{SnapshotName: "snapshot", SnapshotID: 1, DbName: "default", CollectionName: DefaultCollectionName, NumSegments: 2, NumRows: 100},
+		},
+	}, nil).Once()
+	mp.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().GetImportProgress(mock.Anything, mock.Anything).Return(&internalpb.GetImportProgressResponse{
 		Status:   &StatusSuccess,
 		State:    internalpb.ImportJobState_Completed,
@@ -1988,6 +1999,12 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(CollectionCategory, RenameAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(CollectionCategory, CloneAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(CollectionCategory, TruncateAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(CollectionCategory, LoadAction),
 	})
@@ -2059,6 +2076,18 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(ImportJobCategory, DescribeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(SnapshotCategory, CreateAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(SnapshotCategory, ListAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(SnapshotCategory, DropAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(SnapshotCategory, RestoreAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(PrivilegeGroupCategory, CreateAction),
 	})
@@ -2083,7 +2112,7 @@ func TestMethodPost(t *testing.T) {
 				`"roleName": "` + util.RoleAdmin + `", "objectType": "Global", "objectName": "*", "privilege": "*",` +
 				`"privilegeGroupName": "pg", "privileges": ["create", "drop"],` +
 				`"aliasName": "` + DefaultAliasName + `",` +
-				`"jobId": "1234567890",` +
+				`"jobId": "1234567890", "snapshotName": "snapshot",` +
 				`"files": [["book.json"]]` +
 				`}`))
 			req := httptest.NewRequest(http.MethodPost, testcase.path, bodyReader)
@@ -2755,6 +2784,175 @@ func TestGetQuotaMetrics(t *testing.T) {
 	fmt.Println(w.Body.String())
 }
 
+func TestQueryIterator(t *testing.T) {
+	paramtable.Init()
+	// disable rate limit
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64, false, true),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil)
+	batches := [][]int64{{1, 2}, {3}}
+	exprs := []string{"word_count > 0", "(word_count > 0) and book_id > 2"}
+	call := 0
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		assert.Equal(t, exprs[call], req.GetExpr())
+		assert.Contains(t, req.GetOutputFields(), FieldBookID)
+		if call > 0 {
+			assert.EqualValues(t, 100, req.GetGuaranteeTimestamp())
+		}
+		pks := batches[call]
+		call++
+		return &milvuspb.QueryResults{
+			Status:       commonSuccessStatus,
+			SessionTs:    100,
+			OutputFields: []string{FieldBookID},
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_Int64,
+				FieldName: FieldBookID,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+				}},
+			}},
+		}, nil
+	}).Times(2)
+	testEngine := initHTTPServerV2(mp, false)
+
+	type queryIteratorResp struct {
+		Code   int32                    `json:"code"`
+		Data   []map[string]interface{} `json:"data"`
+		Cursor string                   `json:"cursor"`
+	}
+	doRequest := func(body string) *queryIteratorResp {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryIteratorAction), bytes.NewReader([]byte(body)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := &queryIteratorResp{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		return resp
+	}
+
+	resp := doRequest(`{"collectionName": "book", "filter": "word_count > 0", "outputFields": ["word_count"], "batchSize": 2}`)
+	assert.EqualValues(t, 0, resp.Code)
+	assert.Len(t, resp.Data, 2)
+	assert.NotEmpty(t, resp.Cursor)
+
+	resp = doRequest(`{"collectionName": "book", "filter": "word_count > 0", "outputFields": ["word_count"], "batchSize": 2, "cursor": "` + resp.Cursor + `"}`)
+	assert.EqualValues(t, 0, resp.Code)
+	assert.Len(t, resp.Data, 1)
+	assert.Empty(t, resp.Cursor)
+
+	resp = doRequest(`{"collectionName": "book", "batchSize": 0}`)
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), resp.Code)
+
+	resp = doRequest(`{"collectionName": "book", "cursor": "???"}`)
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), resp.Code)
+}
+
+func TestReplicaAnalyzerAndRBAC(t *testing.T) {
+	paramtable.Init()
+
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().GetReplicas(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		assert.True(t, req.GetWithShardNodes())
+		return &milvuspb.GetReplicasResponse{
+			Status: &StatusSuccess,
+			Replicas: []*milvuspb.ReplicaInfo{{
+				ReplicaID:     1,
+				NodeIds:       []int64{1, 2},
+				ShardReplicas: []*milvuspb.ShardReplica{{LeaderID: 1, DmChannelName: "ch-0", NodeIds: []int64{1, 2}}},
+			}},
+		}, nil
+	}).Once()
+	mp.EXPECT().RunAnalyzer(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
+		assert.Equal(t, `{"tokenizer":"standard"}`, req.GetAnalyzerParams())
+		assert.Equal(t, [][]byte{[]byte("hello world")}, req.GetPlaceholder())
+		return &milvuspb.RunAnalyzerResponse{
+			Status: &StatusSuccess,
+			Results: []*milvuspb.AnalyzerResult{{
+				Tokens: []*milvuspb.AnalyzerToken{{Token: "hello"}, {Token: "world", StartOffset: 6, EndOffset: 11, Position: 1}},
+			}},
+		}, nil
+	}).Twice()
+	mp.EXPECT().BackupRBAC(mock.Anything, mock.Anything).Return(&milvuspb.BackupRBACMetaResponse{
+		Status: &StatusSuccess,
+		RBACMeta: &milvuspb.RBACMeta{
+			Roles: []*milvuspb.RoleEntity{{Name: "role1"}},
+		},
+	}, nil).Once()
+	mp.EXPECT().RestoreRBAC(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
+		assert.Equal(t, "role1", req.GetRBACMeta().GetRoles()[0].GetName())
+		return &StatusSuccess, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	testCases := []struct {
+		path        string
+		requestBody string
+		errCode     int32
+		contains    string
+	}{
+		{
+			path:        versionalV2(CollectionCategory, DescribeReplicasAction),
+			requestBody: `{"collectionName": "book"}`,
+			contains:    `"channelName":"ch-0"`,
+		},
+		{
+			path:        versionalV2(AnalyzerCategory, RunAction),
+			requestBody: `{"analyzerParams": {"tokenizer": "standard"}, "text": ["hello world"]}`,
+			contains:    `"tokens":["hello","world"]`,
+		},
+		{
+			path:        versionalV2(AnalyzerCategory, RunAction),
+			requestBody: `{"analyzerParams": {"tokenizer": "standard"}, "text": ["hello world"], "withDetail": true}`,
+			contains:    `"startOffset":6`,
+		},
+		{
+			path:        versionalV2(AnalyzerCategory, RunAction),
+			requestBody: `{"collectionName": "book", "text": ["hello world"]}`,
+			errCode:     merr.Code(merr.ErrParameterInvalid),
+		},
+		{
+			path:        versionalV2(AnalyzerCategory, RunAction),
+			requestBody: `{}`,
+			errCode:     merr.Code(merr.ErrMissingRequiredParameters),
+		},
+		{
+			path:        versionalV2(RBACCategory, BackupAction),
+			requestBody: `{}`,
+			contains:    `"roles":[{"name":"role1"}]`,
+		},
+		{
+			path:        versionalV2(RBACCategory, RestoreAction),
+			requestBody: `{"rbacMeta": {"roles": [{"name": "role1"}]}}`,
+		},
+		{
+			path:        versionalV2(RBACCategory, RestoreAction),
+			requestBody: `{"rbacMeta": {"roles": 1}}`,
+			errCode:     merr.Code(merr.ErrIncorrectParameterFormat),
+		},
+	}
+	for _, testcase := range testCases {
+		t.Run(testcase.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, testcase.path, bytes.NewReader([]byte(testcase.requestBody)))
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			returnBody := &ReturnErrMsg{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+			assert.Equal(t, testcase.errCode, returnBody.Code, "request body: %s", testcase.requestBody)
+			assert.Contains(t, w.Body.String(), testcase.contains)
+		})
+	}
+}
+
 type AddCollectionFieldSuite struct {
 	suite.Suite
 	testEngine *gin.Engine
@@ -0,0 +1,281 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/milvus-io/milvus/internal/json"
+)
+
+// openAPIDeprecatedRoutes are kept for compatibility only.
+var openAPIDeprecatedRoutes = map[string]bool{
+	ImportJobCategory + GetProgressAction: true,
+	EntityCategory + AdvancedSearchAction: true,
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                      `json:"openapi"`
+	Info       openAPIInfo                 `json:"info"`
+	Paths      map[string]*openAPIPathItem `json:"paths"`
+	Components openAPIComponents           `json:"components"`
+	Security   []map[string][]string       `json:"security"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIPathItem struct {
+	Post *openAPIOperation `json:"post,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+	Parameters  []*openAPIParameter         `json:"parameters"`
+	RequestBody *openAPIRequestBody         `json:"requestBody"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+}
+
+const openAPIResponseSchema = "Response"
+
+var openAPIHeaderParameters = []*openAPIParameter{
+	{Name: HTTPHeaderDBName, In: "header", Description: "the database of the request, used when dbName is not set in the body", Schema: &openAPISchema{Type: "string"}},
+	{Name: HTTPHeaderRequestTimeout, In: "header", Description: "the timeout of the request in seconds", Schema: &openAPISchema{Type: "integer"}},
+	{Name: HTTPHeaderAllowInt64, In: "header", Description: "return int64 values as numbers instead of strings", Schema: &openAPISchema{Type: "boolean"}},
+}
+
+// openAPIRoute is a route of the OpenAPI document, newReq makes the request body of the route.
+type openAPIRoute struct {
+	path   string
+	newReq newReqFunc
+}
+
+// openAPIRouteRecorder records the routes registered through it, so that the OpenAPI document covers all of them.
+type openAPIRouteRecorder struct {
+	gin.IRouter
+	routes []openAPIRoute
+}
+
+// POST records the route without the request body, use post for the v2 handlers instead.
+func (r *openAPIRouteRecorder) POST(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	r.routes = append(r.routes, openAPIRoute{path: relativePath})
+	return r.IRouter.POST(relativePath, handlers...)
+}
+
+// post registers the v2 handler to the route, wrapped by the middlewares of wrap.
+// The request made by newReq is both bound from the body by `wrapperPost` and described by the OpenAPI document,
+// so the two never diverge.
+func (r *openAPIRouteRecorder) post(relativePath string, newReq newReqFunc, v2 handlerFuncV2, wrap func(gin.HandlerFunc) gin.HandlerFunc) gin.IRoutes {
+	r.routes = append(r.routes, openAPIRoute{path: relativePath, newReq: newReq})
+	return r.IRouter.POST(relativePath, wrap(wrapperPost(newReq, wrapperTraceLog(v2))))
+}
+
+// buildOpenAPIDocument generates the OpenAPI 3 document of the routes,
+// an error is returned if any route is registered without its request body.
+func buildOpenAPIDocument(basePath string, routes []openAPIRoute) (*openAPIDocument, error) {
+	doc := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:   "Milvus RESTful API",
+			Version: "v2",
+		},
+		Paths: make(map[string]*openAPIPathItem),
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{
+				openAPIResponseSchema: {
+					Type: "object",
+					Properties: map[string]*openAPISchema{
+						HTTPReturnCode:    {Type: "integer", Format: "int32"},
+						HTTPReturnMessage: {Type: "string"},
+						HTTPReturnData:    {},
+						HTTPReturnCost:    {Type: "integer"},
+					},
+					Required: []string{HTTPReturnCode},
+				},
+			},
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer"},
+			},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+
+	var missing []string
+	for _, r := range routes {
+		route := r.path
+		if r.newReq == nil {
+			missing = append(missing, route)
+			continue
+		}
+		doc.Paths[path.Join(basePath, route)] = &openAPIPathItem{
+			Post: &openAPIOperation{
+				OperationID: strings.ReplaceAll(strings.Trim(route, "/"), "/", "_"),
+				Tags:        []string{strings.Split(strings.Trim(route, "/"), "/")[0]},
+				Deprecated:  openAPIDeprecatedRoutes[route],
+				Parameters:  openAPIHeaderParameters,
+				RequestBody: &openAPIRequestBody{
+					Required: true,
+					Content: map[string]*openAPIMediaType{
+						gin.MIMEJSON: {Schema: openAPITypeSchema(doc.Components.Schemas, reflect.TypeOf(r.newReq()))},
+					},
+				},
+				Responses: map[string]*openAPIResponse{
+					"200": {
+						Description: "the result of the request, code 0 means success",
+						Content: map[string]*openAPIMediaType{
+							gin.MIMEJSON: {Schema: &openAPISchema{Ref: "#/components/schemas/" + openAPIResponseSchema}},
+						},
+					},
+				},
+			},
+		}
+	}
+	if len(missing) > 0 {
+		return doc, errors.Newf("no OpenAPI schema for routes %v, register them by post with the request types", missing)
+	}
+	return doc, nil
+}
+
+// openAPITypeSchema returns the schema of the type, structs are added to the components and referenced.
+func openAPITypeSchema(components map[string]*openAPISchema, t reflect.Type) *openAPISchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(json.RawMessage{}) {
+		return &openAPISchema{}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &openAPISchema{Type: "array", Items: openAPITypeSchema(components, t.Elem())}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: openAPITypeSchema(components, t.Elem())}
+	case reflect.Struct:
+		if _, ok := components[t.Name()]; !ok {
+			// occupy the name first in case the struct references itself
+			components[t.Name()] = &openAPISchema{}
+			components[t.Name()] = openAPIStructSchema(components, t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		// interface, any json value is accepted
+		return &openAPISchema{}
+	}
+}
+
+func openAPIStructSchema(components map[string]*openAPISchema, t reflect.Type) *openAPISchema {
+	schema := &openAPISchema{
+		Type:       "object",
+		Properties: make(map[string]*openAPISchema),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			embedded := openAPIStructSchema(components, fieldType)
+			for propName, prop := range embedded.Properties {
+				schema.Properties[propName] = prop
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = openAPITypeSchema(components, field.Type)
+		if strings.Contains(field.Tag.Get("binding"), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+func (h *HandlersV2) getOpenAPIDocument(c *gin.Context) {
+	c.Data(http.StatusOK, gin.MIMEJSON, h.openAPIDocument)
+}
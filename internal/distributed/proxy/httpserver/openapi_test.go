@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	paramtable.Init()
+
+	engine := gin.New()
+	NewHandlersV2(mocks.NewMockProxy(t)).RegisterRoutesToV2(engine.Group("/v2/vectordb"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/vectordb"+OpenAPIPath, nil)
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	doc := &openAPIDocument{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), doc))
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+
+	// every registered route shall be described
+	for _, route := range engine.Routes() {
+		if route.Method != http.MethodPost {
+			continue
+		}
+		pathItem, ok := doc.Paths[route.Path]
+		if assert.True(t, ok, "route %s has no schema", route.Path) {
+			assert.NotNil(t, pathItem.Post.RequestBody.Content[gin.MIMEJSON].Schema)
+		}
+	}
+
+	queryIterator := doc.Paths["/v2/vectordb"+EntityCategory+QueryIteratorAction].Post
+	assert.Equal(t, "entities_query_iterator", queryIterator.OperationID)
+	assert.Equal(t, []string{"entities"}, queryIterator.Tags)
+	schema := doc.Components.Schemas["QueryIteratorReqV2"]
+	require.NotNil(t, schema)
+	assert.Equal(t, []string{"collectionName"}, schema.Required)
+	assert.Equal(t, "integer", schema.Properties["batchSize"].Type)
+	assert.Equal(t, "array", schema.Properties["outputFields"].Type)
+	assert.Equal(t, "string", schema.Properties["outputFields"].Items.Type)
+
+	assert.True(t, doc.Paths["/v2/vectordb"+ImportJobCategory+GetProgressAction].Post.Deprecated)
+
+	// the request schemas are derived from the registered routes.
+	routeSchemas := map[string]string{
+		CollectionCategory + CloneAction:    "CloneCollectionReq",
+		CollectionCategory + TruncateAction: "TruncateCollectionReq",
+		SnapshotCategory + ListAction:       "OptionalCollectionNameReq",
+		SnapshotCategory + CreateAction:     "SnapshotReq",
+		SnapshotCategory + DropAction:       "SnapshotNameReq",
+		SnapshotCategory + RestoreAction:    "SnapshotReq",
+	}
+	for path, schemaName := range routeSchemas {
+		pathItem, ok := doc.Paths["/v2/vectordb"+path]
+		if assert.True(t, ok, "route %s has no schema", path) {
+			assert.Equal(t, "#/components/schemas/"+schemaName, pathItem.Post.RequestBody.Content[gin.MIMEJSON].Schema.Ref)
+			assert.Contains(t, doc.Components.Schemas, schemaName)
+		}
+	}
+}
+
+func TestOpenAPIDocumentMissingSchema(t *testing.T) {
+	doc, err := buildOpenAPIDocument("/v2/vectordb", []openAPIRoute{
+		{path: CollectionCategory + ListAction, newReq: func() any { return &DatabaseReq{} }},
+		{path: "/unknown/action"},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "/unknown/action")
+	assert.Len(t, doc.Paths, 1)
+}
+
+func TestOpenAPITypeSchema(t *testing.T) {
+	type node struct {
+		Name     string            `json:"name" binding:"required"`
+		Children []*node           `json:"children"`
+		Labels   map[string]string `json:"labels"`
+		Value    interface{}       `json:"value"`
+		Ignored  string            `json:"-"`
+	}
+	components := make(map[string]*openAPISchema)
+	schema := openAPITypeSchema(components, reflect.TypeOf(&node{}))
+	assert.Equal(t, "#/components/schemas/node", schema.Ref)
+
+	nodeSchema := components["node"]
+	require.NotNil(t, nodeSchema)
+	assert.Equal(t, []string{"name"}, nodeSchema.Required)
+	assert.Equal(t, "#/components/schemas/node", nodeSchema.Properties["children"].Items.Ref)
+	assert.Equal(t, "string", nodeSchema.Properties["labels"].AdditionalProperties.Type)
+	assert.Empty(t, nodeSchema.Properties["value"].Type)
+	assert.NotContains(t, nodeSchema.Properties, "Ignored")
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
@@ -111,6 +112,22 @@ type RenameCollectionReq struct {
 
 func (req *RenameCollectionReq) GetDbName() string { return req.DbName }
 
+type CloneCollectionReq struct {
+	DbName            string `json:"dbName"`
+	CollectionName    string `json:"collectionName" binding:"required"`
+	NewCollectionName string `json:"newCollectionName" binding:"required"`
+	NewDbName         string `json:"newDbName"`
+}
+
+func (req *CloneCollectionReq) GetDbName() string { return req.DbName }
+
+type TruncateCollectionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+}
+
+func (req *TruncateCollectionReq) GetDbName() string { return req.DbName }
+
 type DropCollectionPropertiesReq struct {
 	DbName         string   `json:"dbName"`
 	CollectionName string   `json:"collectionName" binding:"required"`
@@ -225,6 +242,24 @@ func (req *ImportReq) GetOptions() map[string]string {
 	return req.Options
 }
 
+type SnapshotReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	SnapshotName   string `json:"snapshotName" binding:"required"`
+}
+
+func (req *SnapshotReq) GetDbName() string { return req.DbName }
+
+func (req *SnapshotReq) GetCollectionName() string { return req.CollectionName }
+
+func (req *SnapshotReq) GetSnapshotName() string { return req.SnapshotName }
+
+type SnapshotNameReq struct {
+	SnapshotName string `json:"snapshotName" binding:"required"`
+}
+
+func (req *SnapshotNameReq) GetSnapshotName() string { return req.SnapshotName }
+
 type JobIDReq struct {
 	JobID string `json:"jobId" binding:"required"`
 }
@@ -245,6 +280,21 @@ type QueryReqV2 struct {
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
 
+type QueryIteratorReqV2 struct {
+	DbName           string                 `json:"dbName"`
+	CollectionName   string                 `json:"collectionName" binding:"required"`
+	PartitionNames   []string               `json:"partitionNames"`
+	OutputFields     []string               `json:"outputFields"`
+	Filter           string                 `json:"filter"`
+	BatchSize        int32                  `json:"batchSize"`
+	ExprParams       map[string]interface{} `json:"exprParams"`
+	ConsistencyLevel string                 `json:"consistencyLevel"`
+	// Cursor is the token returned by the previous batch, empty means starting from the beginning.
+	Cursor string `json:"cursor"`
+}
+
+func (req *QueryIteratorReqV2) GetDbName() string { return req.DbName }
+
 type CollectionIDReq struct {
 	DbName           string      `json:"dbName"`
 	CollectionName   string      `json:"collectionName" binding:"required"`
@@ -377,6 +427,9 @@ type OptionsGetter interface {
 type JobIDGetter interface {
 	GetJobID() string
 }
+type SnapshotNameGetter interface {
+	GetSnapshotName() string
+}
 type TimestampGetter interface {
 	GetTimestamp() uint64
 }
@@ -764,3 +817,25 @@ func (req *GetSegmentsInfoReq) GetSegmentIDs() []int64 {
 }
 
 type GetQuotaMetricsReq struct{}
+
+type RunAnalyzerReq struct {
+	DbName         string                 `json:"dbName"`
+	CollectionName string                 `json:"collectionName"`
+	FieldName      string                 `json:"fieldName"`
+	AnalyzerParams map[string]interface{} `json:"analyzerParams"`
+	AnalyzerNames  []string               `json:"analyzerNames"`
+	Text           []string               `json:"text" binding:"required"`
+	WithDetail     bool                   `json:"withDetail"`
+	WithHash       bool                   `json:"withHash"`
+}
+
+func (req *RunAnalyzerReq) GetDbName() string { return req.DbName }
+
+func (req *RunAnalyzerReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type RestoreRBACReq struct {
+	// RBACMeta is the data returned by `/rbac/backup`
+	RBACMeta json.RawMessage `json:"rbacMeta" binding:"required"`
+}
@@ -19,6 +19,7 @@ package httpserver
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"math"
 	"reflect"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 	"github.com/spf13/cast"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
@@ -1537,7 +1539,7 @@ func CheckLimiter(ctx context.Context, req interface{}, pxy types.ProxyComponent
 	if err != nil {
 		return nil, err
 	}
-	err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+	err = proxy.CheckRateLimit(ctx, limiter, dbID, collectionIDToPartIDs, rt, n)
 	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 	metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 	if err != nil {
@@ -2050,3 +2052,78 @@ func genFunctionScore(ctx context.Context, functionScore *FunctionScore) (*schem
 	}
 	return &fScore, nil
 }
+
+// queryIteratorCursor is the state of a query iterator carried by the client between batches,
+// the entities are iterated in the order of primary key on the snapshot of MvccTs.
+type queryIteratorCursor struct {
+	MvccTs uint64  `json:"mvccTs"`
+	IntPK  *int64  `json:"intPK,omitempty"`
+	StrPK  *string `json:"strPK,omitempty"`
+}
+
+func decodeQueryIteratorCursor(token string) (*queryIteratorCursor, error) {
+	cursor := &queryIteratorCursor{}
+	if token == "" {
+		return cursor, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid cursor %s: %s", token, err.Error())
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid cursor %s: %s", token, err.Error())
+	}
+	return cursor, nil
+}
+
+func (cursor *queryIteratorCursor) encode() string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// composeExpr appends the primary key range after the cursor to the filter.
+func (cursor *queryIteratorCursor) composeExpr(expr string, pkName string) string {
+	var pkExpr string
+	switch {
+	case cursor.IntPK != nil:
+		pkExpr = fmt.Sprintf("%s > %d", pkName, *cursor.IntPK)
+	case cursor.StrPK != nil:
+		pkExpr = fmt.Sprintf("%s > %s", pkName, strconv.Quote(*cursor.StrPK))
+	default:
+		return expr
+	}
+	if expr == "" {
+		return pkExpr
+	}
+	return fmt.Sprintf("(%s) and %s", expr, pkExpr)
+}
+
+// moveTo moves the cursor to the primary key of the last entity in the batch.
+func (cursor *queryIteratorCursor) moveTo(fieldsData []*schemapb.FieldData, pkName string, rowCount int) error {
+	if rowCount == 0 {
+		return nil
+	}
+	pkData, ok := lo.Find(fieldsData, func(fieldData *schemapb.FieldData) bool {
+		return fieldData.GetFieldName() == pkName
+	})
+	if !ok {
+		return fmt.Errorf("primary key %s not found in query result", pkName)
+	}
+	switch pkData.GetType() {
+	case schemapb.DataType_Int64:
+		pks := pkData.GetScalars().GetLongData().GetData()
+		if len(pks) < rowCount {
+			return fmt.Errorf("primary key count %d mismatches row count %d", len(pks), rowCount)
+		}
+		cursor.IntPK, cursor.StrPK = &pks[rowCount-1], nil
+	case schemapb.DataType_VarChar:
+		pks := pkData.GetScalars().GetStringData().GetData()
+		if len(pks) < rowCount {
+			return fmt.Errorf("primary key count %d mismatches row count %d", len(pks), rowCount)
+		}
+		cursor.IntPK, cursor.StrPK = nil, &pks[rowCount-1]
+	default:
+		return fmt.Errorf("unsupported primary key type %s", pkData.GetType().String())
+	}
+	return nil
+}
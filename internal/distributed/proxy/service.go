@@ -1105,6 +1105,30 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	return s.proxy.ListImports(ctx, req)
 }
 
+func (s *Server) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateSnapshot(ctx, req)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	return s.proxy.ListSnapshots(ctx, req)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.DropSnapshot(ctx, req)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.RestoreSnapshot(ctx, req)
+}
+
+func (s *Server) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.CloneCollection(ctx, req)
+}
+
+func (s *Server) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.TruncateCollection(ctx, req)
+}
+
 func (s *Server) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterDatabase(ctx, req)
 }
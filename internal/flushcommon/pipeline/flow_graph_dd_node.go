@@ -303,6 +303,24 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 			fgMsg.updatedSchema = body.GetSchema()
 			fgMsg.schemaVersion = schemaMsg.BeginTs()
 			ddn.msgHandler.HandleSchemaChange(ddn.ctx, schemaMsg.SchemaChangeMessage)
+		case commonpb.MsgType_ReleaseSegments:
+			truncateMsg := msg.(*adaptor.TruncateCollectionMessageBody)
+			header := truncateMsg.TruncateCollectionMessage.Header()
+			if header.GetCollectionId() != ddn.collectionID {
+				continue
+			}
+			logger := log.With(
+				zap.String("vchannel", ddn.Name()),
+				zap.Int32("msgType", int32(msg.Type())),
+				zap.Uint64("timetick", truncateMsg.TruncateCollectionMessage.TimeTick()),
+				zap.Int64s("segmentIDs", header.GetSegmentIds()),
+			)
+			logger.Info("receive truncate collection message")
+			if err := ddn.msgHandler.HandleTruncateCollection(ddn.ctx, truncateMsg.TruncateCollectionMessage); err != nil {
+				logger.Warn("handle truncate collection message failed", zap.Error(err))
+			} else {
+				logger.Info("handle truncate collection message success")
+			}
 		}
 	}
 
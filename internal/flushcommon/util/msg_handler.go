@@ -32,6 +32,8 @@ type MsgHandler interface {
 	HandleManualFlush(flushMsg message.ImmutableManualFlushMessageV2) error
 
 	HandleSchemaChange(ctx context.Context, schemaChangeMsg message.ImmutableSchemaChangeMessageV2) error
+
+	HandleTruncateCollection(ctx context.Context, truncateMsg message.ImmutableTruncateCollectionMessageV2) error
 }
 
 func ConvertInternalImportFile(file *msgpb.ImportFile, _ int) *internalpb.ImportFile {
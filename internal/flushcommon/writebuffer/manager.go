@@ -37,6 +37,8 @@ type BufferManager interface {
 	// DropChannel remove write buffer and perform drop.
 	DropChannel(channel string)
 	DropPartitions(channel string, partitionIDs []int64)
+	// TruncateSegments marks all segments of the channel as dropped when the collection is truncated.
+	TruncateSegments(channel string)
 	// BufferData put data into channel write buffer.
 	BufferData(channel string, insertData []*InsertData, deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error
 	// GetCheckpoint returns checkpoint for provided channel.
@@ -283,3 +285,13 @@ func (m *bufferManager) DropPartitions(channel string, partitionIDs []int64) {
 
 	buf.DropPartitions(partitionIDs)
 }
+
+func (m *bufferManager) TruncateSegments(channel string) {
+	buf, loaded := m.buffers.Get(channel)
+	if !loaded {
+		log.Warn("failed to truncate segments, channel not maintained in manager", zap.String("channel", channel))
+		return
+	}
+
+	buf.TruncateSegments()
+}
@@ -213,6 +213,24 @@ func (s *ManagerSuite) TestDropPartitions() {
 	})
 }
 
+func (s *ManagerSuite) TestTruncateSegments() {
+	manager := s.manager
+
+	s.Run("truncate_not_exist", func() {
+		s.NotPanics(func() {
+			manager.TruncateSegments("not_exist_channel")
+		})
+	})
+
+	s.Run("truncate_segments", func() {
+		wb := NewMockWriteBuffer(s.T())
+		wb.EXPECT().TruncateSegments().Return()
+
+		manager.buffers.Insert(s.channelName, wb)
+		manager.TruncateSegments(s.channelName)
+	})
+}
+
 func (s *ManagerSuite) TestMemoryCheck() {
 	manager := s.manager
 	param := paramtable.Get()
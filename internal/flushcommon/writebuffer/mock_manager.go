@@ -546,6 +546,39 @@ func (_c *MockBufferManager_Stop_Call) RunAndReturn(run func()) *MockBufferManag
 	return _c
 }
 
+// TruncateSegments provides a mock function with given fields: channel
+func (_m *MockBufferManager) TruncateSegments(channel string) {
+	_m.Called(channel)
+}
+
+// MockBufferManager_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MockBufferManager_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+//   - channel string
+func (_e *MockBufferManager_Expecter) TruncateSegments(channel interface{}) *MockBufferManager_TruncateSegments_Call {
+	return &MockBufferManager_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments", channel)}
+}
+
+func (_c *MockBufferManager_TruncateSegments_Call) Run(run func(channel string)) *MockBufferManager_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockBufferManager_TruncateSegments_Call) Return() *MockBufferManager_TruncateSegments_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBufferManager_TruncateSegments_Call) RunAndReturn(run func(string)) *MockBufferManager_TruncateSegments_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockBufferManager creates a new instance of MockBufferManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBufferManager(t interface {
@@ -484,6 +484,38 @@ func (_c *MockWriteBuffer_SetFlushTimestamp_Call) RunAndReturn(run func(uint64))
 	return _c
 }
 
+// TruncateSegments provides a mock function with no fields
+func (_m *MockWriteBuffer) TruncateSegments() {
+	_m.Called()
+}
+
+// MockWriteBuffer_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MockWriteBuffer_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+func (_e *MockWriteBuffer_Expecter) TruncateSegments() *MockWriteBuffer_TruncateSegments_Call {
+	return &MockWriteBuffer_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments")}
+}
+
+func (_c *MockWriteBuffer_TruncateSegments_Call) Run(run func()) *MockWriteBuffer_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_TruncateSegments_Call) Return() *MockWriteBuffer_TruncateSegments_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWriteBuffer_TruncateSegments_Call) RunAndReturn(run func()) *MockWriteBuffer_TruncateSegments_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockWriteBuffer creates a new instance of MockWriteBuffer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteBuffer(t interface {
@@ -51,6 +51,8 @@ type WriteBuffer interface {
 	SealSegments(ctx context.Context, segmentIDs []int64) error
 	// DropPartitions mark segments as Dropped of the partition
 	DropPartitions(partitionIDs []int64)
+	// TruncateSegments mark all segments in the buffer as Dropped when the collection is truncated
+	TruncateSegments()
 	// GetCheckpoint returns current channel checkpoint.
 	// If there are any non-empty segment buffer, returns the earliest buffer start position.
 	// Otherwise, returns latest buffered checkpoint.
@@ -195,6 +197,18 @@ func (wb *writeBufferBase) DropPartitions(partitionIDs []int64) {
 	wb.dropPartitions(partitionIDs)
 }
 
+func (wb *writeBufferBase) TruncateSegments() {
+	wb.mut.RLock()
+	defer wb.mut.RUnlock()
+
+	// all segments in the metacache hold the data before truncate,
+	// the segments created after truncate are not observed yet.
+	segIDs := wb.metaCache.GetSegmentIDsBy()
+	wb.metaCache.UpdateSegments(metacache.UpdateState(commonpb.SegmentState_Dropped),
+		metacache.WithSegmentIDs(segIDs...),
+	)
+}
+
 func (wb *writeBufferBase) SetFlushTimestamp(flushTs uint64) {
 	wb.flushTimestamp.Store(flushTs)
 }
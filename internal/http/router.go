@@ -50,6 +50,8 @@ const (
 const (
 	RouteGcPause  = "/management/datacoord/garbage_collection/pause"
 	RouteGcResume = "/management/datacoord/garbage_collection/resume"
+	RouteGcMode   = "/management/datacoord/garbage_collection/mode"
+	RouteGcReport = "/management/datacoord/garbage_collection/report"
 
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
@@ -64,6 +66,11 @@ const (
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
 )
 
+// streamingcoord management restful api root path
+const (
+	RouteWALBalanceDryRun = "/management/streamingcoord/balance/dry_run"
+)
+
 // for WebUI restful api root path
 const (
 	// ClusterInfoPath is the path to get cluster information.
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdkv
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// implementation assertion
+var (
+	_ kv.MVCCKV = (*etcdKV)(nil)
+	_ kv.MVCCKV = (*EmbedEtcdKV)(nil)
+)
+
+// MultiSaveAndRemoveWithRevision saves and removes in a transaction, returns the revision of the transaction.
+func (kv *etcdKV) MultiSaveAndRemoveWithRevision(ctx context.Context, saves map[string]string, removals []string, removalPrefixes []string) (int64, error) {
+	return multiSaveAndRemoveWithRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, saves, removals, removalPrefixes)
+}
+
+// LoadAtRevision returns the value of the key at the revision.
+func (kv *etcdKV) LoadAtRevision(ctx context.Context, key string, revision int64) (string, error) {
+	return loadAtRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, key, revision)
+}
+
+// LoadWithPrefixAtRevision returns the keys and values with the prefix at the revision.
+func (kv *etcdKV) LoadWithPrefixAtRevision(ctx context.Context, prefix string, revision int64) ([]string, []string, error) {
+	return loadWithPrefixAtRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, prefix, revision)
+}
+
+// MultiSaveAndRemoveWithRevision saves and removes in a transaction, returns the revision of the transaction.
+func (kv *EmbedEtcdKV) MultiSaveAndRemoveWithRevision(ctx context.Context, saves map[string]string, removals []string, removalPrefixes []string) (int64, error) {
+	return multiSaveAndRemoveWithRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, saves, removals, removalPrefixes)
+}
+
+// LoadAtRevision returns the value of the key at the revision.
+func (kv *EmbedEtcdKV) LoadAtRevision(ctx context.Context, key string, revision int64) (string, error) {
+	return loadAtRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, key, revision)
+}
+
+// LoadWithPrefixAtRevision returns the keys and values with the prefix at the revision.
+func (kv *EmbedEtcdKV) LoadWithPrefixAtRevision(ctx context.Context, prefix string, revision int64) ([]string, []string, error) {
+	return loadWithPrefixAtRevision(ctx, kv.client, kv.rootPath, kv.requestTimeout, prefix, revision)
+}
+
+func multiSaveAndRemoveWithRevision(ctx context.Context, client *clientv3.Client, rootPath string, timeout time.Duration,
+	saves map[string]string, removals []string, removalPrefixes []string,
+) (int64, error) {
+	start := time.Now()
+	ops := make([]clientv3.Op, 0, len(saves)+len(removals)+len(removalPrefixes))
+	for _, prefix := range removalPrefixes {
+		ops = append(ops, clientv3.OpDelete(path.Join(rootPath, prefix), clientv3.WithPrefix()))
+	}
+	for _, key := range removals {
+		// a key to save is not removed in the same transaction
+		if _, ok := saves[key]; ok {
+			continue
+		}
+		ops = append(ops, clientv3.OpDelete(path.Join(rootPath, key)))
+	}
+	keys := make([]string, 0, len(saves))
+	for key, value := range saves {
+		keys = append(keys, key)
+		ops = append(ops, clientv3.OpPut(path.Join(rootPath, key), value))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := client.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		log.Warn("Etcd MultiSaveAndRemoveWithRevision error",
+			zap.Strings("saves", keys),
+			zap.Strings("removes", removals),
+			zap.Strings("removePrefixes", removalPrefixes),
+			zap.Error(err))
+		return 0, err
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation multi save and remove with revision", zap.Strings("keys", keys))
+	return resp.Header.GetRevision(), nil
+}
+
+func loadAtRevision(ctx context.Context, client *clientv3.Client, rootPath string, timeout time.Duration, key string, revision int64) (string, error) {
+	key = path.Join(rootPath, key)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := client.Get(ctx, key, clientv3.WithRev(revision))
+	if err != nil {
+		return "", wrapCompactedErr(err, revision)
+	}
+	if resp.Count <= 0 {
+		return "", merr.WrapErrIoKeyNotFound(key)
+	}
+	return string(resp.Kvs[0].Value), nil
+}
+
+func loadWithPrefixAtRevision(ctx context.Context, client *clientv3.Client, rootPath string, timeout time.Duration, prefix string, revision int64) ([]string, []string, error) {
+	prefix = path.Join(rootPath, prefix)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(revision),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, nil, wrapCompactedErr(err, revision)
+	}
+	keys := make([]string, 0, len(resp.Kvs))
+	values := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+		values = append(values, string(kv.Value))
+	}
+	return keys, values, nil
+}
+
+func wrapCompactedErr(err error, revision int64) error {
+	if errors.Is(err, rpctypes.ErrCompacted) {
+		return merr.WrapErrIoFailedReason(fmt.Sprintf("the revision %d has been compacted", revision))
+	}
+	return err
+}
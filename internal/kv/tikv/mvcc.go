@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	tikverr "github.com/tikv/client-go/v2/error"
+	tikv "github.com/tikv/client-go/v2/kv"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// implementation assertion
+var _ kv.MVCCKV = (*txnTiKV)(nil)
+
+// MultiSaveAndRemoveWithRevision saves and removes in a transaction, returns a timestamp after the commit,
+// at which the transaction is visible.
+func (kv *txnTiKV) MultiSaveAndRemoveWithRevision(ctx context.Context, saves map[string]string, removals []string, removalPrefixes []string) (int64, error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	var loggingErr error
+	defer logWarnOnFailure(&loggingErr, "txnTiKV MultiSaveAndRemoveWithRevision error", zap.Int("saveLength", len(saves)),
+		zap.Strings("removes", removals), zap.Strings("removePrefixes", removalPrefixes))
+
+	txn, err := beginTxn(kv.txn)
+	if err != nil {
+		loggingErr = errors.Wrap(err, "Failed to create txn for MultiSaveAndRemoveWithRevision")
+		return 0, loggingErr
+	}
+	defer rollbackOnFailure(&loggingErr, txn)
+
+	for _, prefix := range removalPrefixes {
+		prefix = path.Join(kv.rootPath, prefix)
+		iter, err := txn.Iter([]byte(prefix), tikv.PrefixNextKey([]byte(prefix)))
+		if err != nil {
+			loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to create iterater for %s during MultiSaveAndRemoveWithRevision()", prefix))
+			return 0, loggingErr
+		}
+		for iter.Valid() {
+			if err = txn.Delete(iter.Key()); err != nil {
+				iter.Close()
+				loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to delete %s for MultiSaveAndRemoveWithRevision", string(iter.Key())))
+				return 0, loggingErr
+			}
+			if err = iter.Next(); err != nil {
+				iter.Close()
+				loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to move Iterator for MultiSaveAndRemoveWithRevision, prefix: %s", prefix))
+				return 0, loggingErr
+			}
+		}
+		iter.Close()
+	}
+	for _, key := range removals {
+		// a key to save is not removed in the same transaction
+		if _, ok := saves[key]; ok {
+			continue
+		}
+		if err = txn.Delete([]byte(path.Join(kv.rootPath, key))); err != nil {
+			loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to delete %s for MultiSaveAndRemoveWithRevision", key))
+			return 0, loggingErr
+		}
+	}
+	for key, value := range saves {
+		byteValue, err := convertEmptyStringToByte(value)
+		if err != nil {
+			loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to cast to byte (%s:%s) for MultiSaveAndRemoveWithRevision()", key, value))
+			return 0, loggingErr
+		}
+		if err = txn.Set([]byte(path.Join(kv.rootPath, key)), byteValue); err != nil {
+			loggingErr = errors.Wrap(err, fmt.Sprintf("Failed to set (%s:%s) for MultiSaveAndRemoveWithRevision()", key, value))
+			return 0, loggingErr
+		}
+	}
+
+	if err = kv.executeTxn(ctx, txn); err != nil {
+		loggingErr = errors.Wrap(err, "Failed to commit for MultiSaveAndRemoveWithRevision")
+		return 0, loggingErr
+	}
+	// the commit ts is not exposed by the client, any ts allocated after the commit sees the transaction
+	ts, err := kv.txn.GetTimestamp(ctx)
+	if err != nil {
+		loggingErr = errors.Wrap(err, "Failed to get timestamp after commit for MultiSaveAndRemoveWithRevision")
+		return 0, loggingErr
+	}
+	CheckElapseAndWarn(start, "Slow txnTiKV MultiSaveAndRemoveWithRevision() operation", zap.Int("saveLength", len(saves)))
+	return int64(ts), nil
+}
+
+// LoadAtRevision returns the value of the key at the timestamp.
+func (kv *txnTiKV) LoadAtRevision(ctx context.Context, key string, revision int64) (string, error) {
+	key = path.Join(kv.rootPath, key)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	ss := kv.txn.GetSnapshot(uint64(revision))
+	val, err := ss.Get(ctx, []byte(key))
+	if err != nil {
+		if err == tikverr.ErrNotExist {
+			return "", merr.WrapErrIoKeyNotFound(key)
+		}
+		return "", errors.Wrap(err, fmt.Sprintf("Failed to get value for key %s at ts %d", key, revision))
+	}
+	return convertEmptyByteToString(val), nil
+}
+
+// LoadWithPrefixAtRevision returns the keys and values with the prefix at the timestamp.
+func (kv *txnTiKV) LoadWithPrefixAtRevision(ctx context.Context, prefix string, revision int64) ([]string, []string, error) {
+	prefix = path.Join(kv.rootPath, prefix)
+
+	ss := kv.txn.GetSnapshot(uint64(revision))
+	ss.SetScanBatchSize(SnapshotScanSize)
+	iter, err := ss.Iter([]byte(prefix), tikv.PrefixNextKey([]byte(prefix)))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, fmt.Sprintf("Failed to create iterater for prefix %s at ts %d", prefix, revision))
+	}
+	defer iter.Close()
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	for iter.Valid() {
+		keys = append(keys, string(iter.Key()))
+		values = append(values, convertEmptyByteToString(iter.Value()))
+		if err = iter.Next(); err != nil {
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("Failed to iterate prefix %s at ts %d", prefix, revision))
+		}
+	}
+	return keys, values, nil
+}
@@ -180,6 +180,11 @@ type DataCoordCatalog interface {
 	ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error)
 	SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error
 	DropStatsTask(ctx context.Context, taskID typeutil.UniqueID) error
+
+	SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo, segments []*datapb.SnapshotSegment) error
+	ListSnapshots(ctx context.Context) ([]*datapb.SnapshotInfo, error)
+	ListSnapshotSegments(ctx context.Context, snapshotID int64) ([]*datapb.SnapshotSegment, error)
+	DropSnapshot(ctx context.Context, snapshotID int64) error
 }
 
 type QueryCoordCatalog interface {
@@ -33,6 +33,8 @@ const (
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
 	PartitionStatsCurrentVersionPrefix = MetaPrefix + "/current-partition-stats-version"
 	StatsTaskPrefix                    = MetaPrefix + "/stats-task"
+	SnapshotPrefix                     = MetaPrefix + "/snapshot"
+	SnapshotSegmentPrefix              = MetaPrefix + "/snapshot-segment"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
@@ -218,28 +218,21 @@ func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, d
 		if len(segmentInfo.Binlogs) == 0 {
 			segmentInfo.Binlogs = insertLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Binlogs); err != nil {
-			return err
-		}
-
 		if len(segmentInfo.Deltalogs) == 0 {
 			segmentInfo.Deltalogs = deltaLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Deltalogs); err != nil {
-			return err
-		}
-
 		if len(segmentInfo.Statslogs) == 0 {
 			segmentInfo.Statslogs = statsLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Statslogs); err != nil {
-			return err
-		}
-
 		if len(segmentInfo.Bm25Statslogs) == 0 {
 			segmentInfo.Bm25Statslogs = bm25Logs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Bm25Statslogs); err != nil {
+		if segmentInfo.GetHasSharedLogs() {
+			// the logs shared with other segments can only be located by the log paths.
+			continue
+		}
+		if err = binlog.CompressBinLogs(segmentInfo.Binlogs, segmentInfo.Deltalogs,
+			segmentInfo.Statslogs, segmentInfo.Bm25Statslogs); err != nil {
 			return err
 		}
 	}
@@ -307,7 +300,8 @@ func (kc *Catalog) AlterSegments(ctx context.Context, segments []*datapb.Segment
 		segment := b.Segment
 
 		binlogKvs, err := buildBinlogKvsWithLogID(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(),
-			cloneLogs(segment.GetBinlogs()), cloneLogs(segment.GetDeltalogs()), cloneLogs(segment.GetStatslogs()), cloneLogs(segment.GetBm25Statslogs()))
+			cloneLogs(segment.GetBinlogs()), cloneLogs(segment.GetDeltalogs()), cloneLogs(segment.GetStatslogs()), cloneLogs(segment.GetBm25Statslogs()),
+			segment.GetHasSharedLogs())
 		if err != nil {
 			return err
 		}
@@ -326,7 +320,7 @@ func (kc *Catalog) handleDroppedSegment(ctx context.Context, segment *datapb.Seg
 	}
 	// To be compatible with previous implementation, we have to write binlogs on etcd for correct gc.
 	if !has {
-		kvs, err = buildBinlogKvsWithLogID(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), cloneLogs(segment.GetBinlogs()), cloneLogs(segment.GetDeltalogs()), cloneLogs(segment.GetStatslogs()), cloneLogs(segment.GetBm25Statslogs()))
+		kvs, err = buildBinlogKvsWithLogID(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), cloneLogs(segment.GetBinlogs()), cloneLogs(segment.GetDeltalogs()), cloneLogs(segment.GetStatslogs()), cloneLogs(segment.GetBm25Statslogs()), segment.GetHasSharedLogs())
 		if err != nil {
 			return
 		}
@@ -961,3 +955,70 @@ func (kc *Catalog) DropStatsTask(ctx context.Context, taskID typeutil.UniqueID)
 	key := buildStatsTaskKey(taskID)
 	return kc.MetaKv.Remove(ctx, key)
 }
+
+// SaveSnapshot saves the snapshot with its segments, the segments are saved before the snapshot,
+// so the snapshot is visible only if all its segments are saved.
+func (kc *Catalog) SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo, segments []*datapb.SnapshotSegment) error {
+	kvs := make(map[string]string, len(segments))
+	for _, segment := range segments {
+		value, err := proto.Marshal(segment)
+		if err != nil {
+			return err
+		}
+		kvs[buildSnapshotSegmentKey(snapshot.GetSnapshotID(), segment.GetSegment().GetID())] = string(value)
+	}
+	if err := kc.SaveByBatch(ctx, kvs); err != nil {
+		return err
+	}
+
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, buildSnapshotKey(snapshot.GetSnapshotID()), string(value))
+}
+
+func (kc *Catalog) ListSnapshots(ctx context.Context) ([]*datapb.SnapshotInfo, error) {
+	snapshots := make([]*datapb.SnapshotInfo, 0)
+
+	applyFn := func(key []byte, value []byte) error {
+		snapshot := &datapb.SnapshotInfo{}
+		err := proto.Unmarshal(value, snapshot)
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	}
+
+	// SnapshotPrefix is the prefix of SnapshotSegmentPrefix, so the separator is required.
+	err := kc.MetaKv.WalkWithPrefix(ctx, SnapshotPrefix+"/", kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+func (kc *Catalog) ListSnapshotSegments(ctx context.Context, snapshotID int64) ([]*datapb.SnapshotSegment, error) {
+	segments := make([]*datapb.SnapshotSegment, 0)
+
+	applyFn := func(key []byte, value []byte) error {
+		segment := &datapb.SnapshotSegment{}
+		err := proto.Unmarshal(value, segment)
+		if err != nil {
+			return err
+		}
+		segments = append(segments, segment)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, buildSnapshotSegmentPrefix(snapshotID), kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+func (kc *Catalog) DropSnapshot(ctx context.Context, snapshotID int64) error {
+	return kc.MetaKv.MultiSaveAndRemoveWithPrefix(ctx, nil, []string{buildSnapshotKey(snapshotID), buildSnapshotSegmentPrefix(snapshotID)})
+}
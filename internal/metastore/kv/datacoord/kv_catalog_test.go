@@ -1806,3 +1806,122 @@ func Test_StatsTasks(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_Snapshots(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	snapshot := &datapb.SnapshotInfo{
+		SnapshotID:   1,
+		Name:         "snapshot1",
+		CollectionID: 100,
+		SegmentIDs:   []int64{10, 11},
+		BuildIDs:     []int64{20},
+	}
+	segments := []*datapb.SnapshotSegment{
+		{SnapshotID: 1, Segment: &datapb.SegmentInfo{ID: 10, CollectionID: 100}},
+		{SnapshotID: 1, Segment: &datapb.SegmentInfo{ID: 11, CollectionID: 100}},
+	}
+
+	t.Run("SaveSnapshot", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		err := kc.SaveSnapshot(context.Background(), snapshot, segments)
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, kvs map[string]string) error {
+			assert.Len(t, kvs, 2)
+			assert.Contains(t, kvs, buildSnapshotSegmentKey(1, 10))
+			assert.Contains(t, kvs, buildSnapshotSegmentKey(1, 11))
+			return nil
+		})
+		txn.EXPECT().Save(mock.Anything, buildSnapshotKey(1), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+
+		err = kc.SaveSnapshot(context.Background(), snapshot, segments)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		snapshots, err := kc.ListSnapshots(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, snapshots)
+
+		value, err := proto.Marshal(snapshot)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, SnapshotPrefix+"/", mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f([]byte("key1"), value)
+		})
+		kc.MetaKv = txn
+
+		snapshots, err = kc.ListSnapshots(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(snapshots))
+		assert.Equal(t, "snapshot1", snapshots[0].GetName())
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f([]byte("key1"), []byte("1234"))
+		})
+		kc.MetaKv = txn
+
+		snapshots, err = kc.ListSnapshots(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, snapshots)
+	})
+
+	t.Run("ListSnapshotSegments", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		result, err := kc.ListSnapshotSegments(context.Background(), 1)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, buildSnapshotSegmentPrefix(1), mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			for _, segment := range segments {
+				value, err := proto.Marshal(segment)
+				if err != nil {
+					return err
+				}
+				if err = f([]byte("key"), value); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		kc.MetaKv = txn
+
+		result, err = kc.ListSnapshotSegments(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+
+	t.Run("DropSnapshot", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSaveAndRemoveWithPrefix(mock.Anything, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		err := kc.DropSnapshot(context.Background(), 1)
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSaveAndRemoveWithPrefix(mock.Anything, mock.Anything,
+			[]string{buildSnapshotKey(1), buildSnapshotSegmentPrefix(1)}).Return(nil)
+		kc.MetaKv = txn
+
+		err = kc.DropSnapshot(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+}
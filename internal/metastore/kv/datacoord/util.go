@@ -93,10 +93,10 @@ func hasSpecialStatslog(segment *datapb.SegmentInfo) bool {
 }
 
 func buildBinlogKvsWithLogID(collectionID, partitionID, segmentID typeutil.UniqueID,
-	binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog,
+	binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog, hasSharedLogs bool,
 ) (map[string]string, error) {
-	// all the FieldBinlog will only have logid
-	kvs, err := buildBinlogKvs(collectionID, partitionID, segmentID, binlogs, deltalogs, statslogs, bm25logs)
+	// all the FieldBinlog will only have logid, except the shared ones.
+	kvs, err := buildBinlogKvs(collectionID, partitionID, segmentID, binlogs, deltalogs, statslogs, bm25logs, hasSharedLogs)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,8 @@ func buildSegmentAndBinlogsKvs(segment *datapb.SegmentInfo) (map[string]string,
 	segmentutil.ReCalcRowCount(segment, noBinlogsSegment)
 
 	// save binlogs separately
-	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs, bm25logs)
+	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID,
+		binlogs, deltalogs, statslogs, bm25logs, noBinlogsSegment.GetHasSharedLogs())
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +141,9 @@ func cloneLogs(binlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	return res
 }
 
-func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog) (map[string]string, error) {
+// buildBinlogKvs builds the binlog kvs of segment, the log path is not stored since it can be generated by log id,
+// except the segment has shared logs, whose log paths can't be generated by the segment itself.
+func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog, hasSharedLogs bool) (map[string]string, error) {
 	kv := make(map[string]string)
 
 	checkLogID := func(fieldBinlog *datapb.FieldBinlog) error {
@@ -148,7 +151,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 			if binlog.GetLogID() == 0 {
 				return fmt.Errorf("invalid log id, binlog:%v", binlog)
 			}
-			if binlog.GetLogPath() != "" {
+			if binlog.GetLogPath() != "" && !hasSharedLogs {
 				return fmt.Errorf("fieldBinlog no need to store logpath, binlog:%v", binlog)
 			}
 		}
@@ -352,3 +355,15 @@ func buildAnalyzeTaskKey(taskID int64) string {
 func buildStatsTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", StatsTaskPrefix, taskID)
 }
+
+func buildSnapshotKey(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d", SnapshotPrefix, snapshotID)
+}
+
+func buildSnapshotSegmentPrefix(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d/", SnapshotSegmentPrefix, snapshotID)
+}
+
+func buildSnapshotSegmentKey(snapshotID, segmentID int64) string {
+	return fmt.Sprintf("%s/%d/%d", SnapshotSegmentPrefix, snapshotID, segmentID)
+}
@@ -0,0 +1,359 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// tsRevision maps a timestamp of milvus to the revision of the meta store
+type tsRevision struct {
+	ts       typeutil.Timestamp
+	revision int64
+}
+
+// MVCCSnapshot implements SnapShotKV with the native multi-version support of the meta store,
+// i.e. etcd revisions and TiKV timestamps.
+// Unlike SuffixSnapshot, which keeps a copy of the key for each ts, only the revision of each write
+// is recorded under the revision prefix, and the history is read from the meta store at the revision.
+// So the size of the meta doesn't grow with the versions, and the history is discarded by the meta store itself,
+// i.e. the auto compaction of etcd or the GC of TiKV, which should retain the history for at least the snapshot ttl.
+type MVCCSnapshot struct {
+	// internal kv which MVCCSnapshot based on
+	kv.MVCCKV
+	// rw mutex protects revisions
+	sync.RWMutex
+	// revisions sorted by ts, loaded at start up
+	revisions []tsRevision
+	// rootLen pre calculated offset when hiding root prefix
+	rootLen int
+	// revisionPrefix serves a prefix stores the revision of each ts
+	revisionPrefix string
+
+	paginationSize int
+
+	closeGC   chan struct{}
+	closeOnce sync.Once
+}
+
+// type conversion make sure implementation
+var _ kv.SnapShotKV = (*MVCCSnapshot)(nil)
+
+// NewMVCCSnapshot creates a MVCCSnapshot with provided kv, the recorded revisions are loaded.
+func NewMVCCSnapshot(ctx context.Context, metaKV kv.MVCCKV, root, revisionPrefix string) (*MVCCSnapshot, error) {
+	if metaKV == nil {
+		return nil, retry.Unrecoverable(errors.New("MetaKv is nil"))
+	}
+	tk := path.Join(root, "k")
+
+	ss := &MVCCSnapshot{
+		MVCCKV:         metaKV,
+		rootLen:        len(tk) - 1,
+		revisionPrefix: revisionPrefix,
+		paginationSize: paramtable.Get().MetaStoreCfg.PaginationSize.GetAsInt(),
+		closeGC:        make(chan struct{}),
+	}
+	err := metaKV.WalkWithPrefix(ctx, revisionPrefix+"/", ss.paginationSize, func(k []byte, v []byte) error {
+		ts, err := strconv.ParseUint(path.Base(string(k)), 10, 64)
+		if err != nil {
+			log.Warn("skip invalid revision key", zap.String("key", string(k)))
+			return nil
+		}
+		revision, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid revision %s of key %s", string(v), string(k))
+		}
+		ss.revisions = append(ss.revisions, tsRevision{ts: ts, revision: revision})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ss.revisions, func(i, j int) bool {
+		return ss.revisions[i].ts < ss.revisions[j].ts
+	})
+	go ss.startBackgroundGC(context.TODO())
+	return ss, nil
+}
+
+// ComposeRevisionKey used in migration tool also, zero padded so that the keys are sorted by ts.
+func ComposeRevisionKey(revisionPrefix string, ts typeutil.Timestamp) string {
+	return path.Join(revisionPrefix, fmt.Sprintf("%020d", ts))
+}
+
+func (ss *MVCCSnapshot) hideRootPrefix(value string) string {
+	return value[ss.rootLen:]
+}
+
+// revisionAt returns the revision to read at the ts, latest is true if no write happens after ts.
+func (ss *MVCCSnapshot) revisionAt(ts typeutil.Timestamp) (revision int64, latest bool, err error) {
+	ss.RLock()
+	defer ss.RUnlock()
+	idx := sort.Search(len(ss.revisions), func(i int) bool {
+		return ss.revisions[i].ts > ts
+	})
+	if idx == len(ss.revisions) {
+		return 0, true, nil
+	}
+	if idx == 0 {
+		return 0, false, fmt.Errorf("no snapshot found at ts %d, the earliest snapshot is at ts %d", ts, ss.revisions[0].ts)
+	}
+	return ss.revisions[idx-1].revision, false, nil
+}
+
+// commit executes the saves and removals, and records the revision of ts
+func (ss *MVCCSnapshot) commit(ctx context.Context, saves map[string]string, removals []string, removalPrefixes []string, ts typeutil.Timestamp) error {
+	ss.Lock()
+	defer ss.Unlock()
+
+	removals = lo.Filter(removals, func(key string, _ int) bool {
+		_, ok := saves[key]
+		return !ok
+	})
+	var revision int64
+	var err error
+	if len(saves)+len(removals)+len(removalPrefixes) <= util.MaxEtcdTxnNum {
+		revision, err = ss.MVCCKV.MultiSaveAndRemoveWithRevision(ctx, saves, removals, removalPrefixes)
+	} else {
+		// too many operations for one etcd transaction, the revision of the last batch is recorded
+		err = etcd.RemoveByBatchWithLimit(removals, util.MaxEtcdTxnNum, func(partialKeys []string) error {
+			revision, err = ss.MVCCKV.MultiSaveAndRemoveWithRevision(ctx, nil, partialKeys, nil)
+			return err
+		})
+		if err == nil {
+			err = etcd.SaveByBatchWithLimit(saves, util.MaxEtcdTxnNum, func(partialKvs map[string]string) error {
+				revision, err = ss.MVCCKV.MultiSaveAndRemoveWithRevision(ctx, partialKvs, nil, nil)
+				return err
+			})
+		}
+		if err == nil && len(removalPrefixes) > 0 {
+			revision, err = ss.MVCCKV.MultiSaveAndRemoveWithRevision(ctx, nil, nil, removalPrefixes)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// the write is visible for reading latest even if recording fails,
+	// only the time travel to ts reads the revision of an earlier ts.
+	if err := ss.MVCCKV.Save(ctx, ComposeRevisionKey(ss.revisionPrefix, ts), strconv.FormatInt(revision, 10)); err != nil {
+		log.Ctx(ctx).Warn("MVCCSnapshot failed to record revision", zap.Uint64("ts", ts), zap.Int64("revision", revision), zap.Error(err))
+		return err
+	}
+	idx := sort.Search(len(ss.revisions), func(i int) bool {
+		return ss.revisions[i].ts >= ts
+	})
+	if idx < len(ss.revisions) && ss.revisions[idx].ts == ts {
+		ss.revisions[idx].revision = revision
+	} else {
+		ss.revisions = append(ss.revisions, tsRevision{})
+		copy(ss.revisions[idx+1:], ss.revisions[idx:])
+		ss.revisions[idx] = tsRevision{ts: ts, revision: revision}
+	}
+	return nil
+}
+
+// Save stores key-value pairs with timestamp
+// if ts == 0, MVCCSnapshot works as a MetaKv
+func (ss *MVCCSnapshot) Save(ctx context.Context, key string, value string, ts typeutil.Timestamp) error {
+	if ts == 0 {
+		return ss.MVCCKV.Save(ctx, key, value)
+	}
+	return ss.commit(ctx, map[string]string{key: value}, nil, nil, ts)
+}
+
+func (ss *MVCCSnapshot) Load(ctx context.Context, key string, ts typeutil.Timestamp) (string, error) {
+	var value string
+	var err error
+	revision, latest, err := ss.revisionAt(ts)
+	if ts == 0 || ts == typeutil.MaxTimestamp || latest {
+		value, err = ss.MVCCKV.Load(ctx, key)
+	} else if err == nil {
+		value, err = ss.MVCCKV.LoadAtRevision(ctx, key, revision)
+	}
+	if err != nil {
+		return "", err
+	}
+	// tombstones written by SuffixSnapshot before migration
+	if IsTombstone(value) {
+		return "", errors.New("no value found")
+	}
+	return value, nil
+}
+
+// MultiSave save multiple kvs
+// if ts == 0, act like MetaKv
+func (ss *MVCCSnapshot) MultiSave(ctx context.Context, kvs map[string]string, ts typeutil.Timestamp) error {
+	if ts == 0 {
+		return ss.MVCCKV.MultiSave(ctx, kvs)
+	}
+	return ss.commit(ctx, kvs, nil, nil, ts)
+}
+
+// LoadWithPrefix load keys with provided prefix and returns value in the ts
+func (ss *MVCCSnapshot) LoadWithPrefix(ctx context.Context, key string, ts typeutil.Timestamp) ([]string, []string, error) {
+	fks := make([]string, 0)
+	fvs := make([]string, 0)
+	appendFn := func(key string, value string) {
+		if IsTombstone(value) {
+			return
+		}
+		fks = append(fks, ss.hideRootPrefix(key))
+		fvs = append(fvs, value)
+	}
+
+	revision, latest, err := ss.revisionAt(ts)
+	if ts == 0 || ts == typeutil.MaxTimestamp || latest {
+		err := ss.MVCCKV.WalkWithPrefix(ctx, key, ss.paginationSize, func(k []byte, v []byte) error {
+			appendFn(string(k), string(v))
+			return nil
+		})
+		return fks, fvs, err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, values, err := ss.MVCCKV.LoadWithPrefixAtRevision(ctx, key, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range keys {
+		appendFn(keys[i], values[i])
+	}
+	return fks, fvs, nil
+}
+
+// MultiSaveAndRemove save muiltple kvs and remove as well
+// if ts == 0, act like MetaKv
+func (ss *MVCCSnapshot) MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	if ts == 0 {
+		return ss.MVCCKV.MultiSaveAndRemove(ctx, saves, removals)
+	}
+	return ss.commit(ctx, saves, removals, nil, ts)
+}
+
+// MultiSaveAndRemoveWithPrefix save muiltple kvs and remove as well
+// if ts == 0, act like MetaKv
+func (ss *MVCCSnapshot) MultiSaveAndRemoveWithPrefix(ctx context.Context, saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	if ts == 0 {
+		return ss.MVCCKV.MultiSaveAndRemoveWithPrefix(ctx, saves, removals)
+	}
+	return ss.commit(ctx, saves, nil, removals, ts)
+}
+
+func (ss *MVCCSnapshot) Close() {
+	ss.closeOnce.Do(func() {
+		close(ss.closeGC)
+	})
+}
+
+// startBackgroundGC removes the revisions out of ttl, the history of the meta store is left to its own compaction,
+// since compacting etcd affects the watchers of all the components sharing it.
+func (ss *MVCCSnapshot) startBackgroundGC(ctx context.Context) {
+	log := log.Ctx(ctx)
+	log.Debug("mvcc snapshot GC goroutine start!")
+	ticker := time.NewTicker(60 * time.Minute)
+	defer ticker.Stop()
+
+	if err := ss.removeExpiredRevisions(ctx, time.Now()); err != nil {
+		log.Warn("remove expired revisions fail during GC", zap.Error(err))
+	}
+	for {
+		select {
+		case <-ss.closeGC:
+			log.Warn("quit mvcc snapshot GC goroutine!")
+			return
+		case now := <-ticker.C:
+			if err := ss.removeExpiredRevisions(ctx, now); err != nil {
+				log.Warn("remove expired revisions fail during GC", zap.Error(err))
+			}
+		}
+	}
+}
+
+// removeExpiredRevisions removes the revisions recorded before now - ttl, except the latest one of them,
+// which is still needed to read at the ts after it. Only the revision keys of rootcoord are removed.
+func (ss *MVCCSnapshot) removeExpiredRevisions(ctx context.Context, now time.Time) error {
+	ttlTime := paramtable.Get().ServiceParam.MetaStoreCfg.SnapshotTTLSeconds.GetAsDuration(time.Second)
+	expireTs := tsoutil.ComposeTSByTime(now.Add(-ttlTime), 0)
+
+	ss.RLock()
+	idx := sort.Search(len(ss.revisions), func(i int) bool {
+		return ss.revisions[i].ts > expireTs
+	})
+	if idx == 0 {
+		ss.RUnlock()
+		return nil
+	}
+	expired := lo.Map(ss.revisions[:idx-1], func(r tsRevision, _ int) string {
+		return ComposeRevisionKey(ss.revisionPrefix, r.ts)
+	})
+	earliest := ss.revisions[idx-1]
+	ss.RUnlock()
+
+	err := etcd.RemoveByBatchWithLimit(expired, util.MaxEtcdTxnNum, func(partialKeys []string) error {
+		return ss.MVCCKV.MultiRemove(ctx, partialKeys)
+	})
+	if err != nil {
+		return err
+	}
+	ss.Lock()
+	ss.revisions = lo.Filter(ss.revisions, func(r tsRevision, _ int) bool {
+		return r.ts >= earliest.ts
+	})
+	ss.Unlock()
+
+	log.Ctx(ctx).Info("mvcc snapshot remove expired revisions", zap.Int("removedRevisions", len(expired)),
+		zap.Uint64("earliestTs", earliest.ts), zap.Int64("earliestRevision", earliest.revision))
+	return nil
+}
+
+// NewSnapshotKV creates the SnapShotKV of rootcoord meta by the configured snapshot mode.
+func NewSnapshotKV(ctx context.Context, metaKV kv.MetaKv, root string) (kv.SnapShotKV, error) {
+	mode := paramtable.Get().MetaStoreCfg.SnapshotMode.GetValue()
+	switch mode {
+	case util.MetaSnapshotModeSuffix:
+		return NewSuffixSnapshot(metaKV, SnapshotsSep, root, SnapshotPrefix)
+	case util.MetaSnapshotModeMVCC:
+		mvccKV, ok := metaKV.(kv.MVCCKV)
+		if !ok {
+			return nil, retry.Unrecoverable(fmt.Errorf("the meta store doesn't support snapshot mode %s", mode))
+		}
+		return NewMVCCSnapshot(ctx, mvccKV, root, SnapshotRevisionPrefix)
+	default:
+		return nil, retry.Unrecoverable(fmt.Errorf("not supported snapshot mode: %s", mode))
+	}
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// newTestMVCCKV runs the tests against an embedded etcd, so the history of the shared etcd is never touched.
+func newTestMVCCKV(t *testing.T) (kv.MVCCKV, string) {
+	rootPath := fmt.Sprintf("/test/meta/%d", rand.Int())
+	cfg, err := embed.ConfigFromFile("../../../../configs/advanced/etcd.yaml")
+	require.NoError(t, err)
+	cfg.Dir = t.TempDir()
+	metaKV, err := etcdkv.NewEmbededEtcdKV(cfg, rootPath)
+	require.NoError(t, err)
+	t.Cleanup(metaKV.Close)
+	return metaKV, rootPath
+}
+
+func Test_MVCCSnapshotLoad(t *testing.T) {
+	ctx := context.TODO()
+	metaKV, rootPath := newTestMVCCKV(t)
+
+	ss, err := NewMVCCSnapshot(ctx, metaKV, rootPath, SnapshotRevisionPrefix)
+	require.NoError(t, err)
+	defer ss.Close()
+
+	for i := 0; i < 20; i++ {
+		ts := typeutil.Timestamp(100 + i*5)
+		err = ss.Save(ctx, "key", fmt.Sprintf("value-%d", i), ts)
+		assert.NoError(t, err)
+	}
+	for i := 0; i < 20; i++ {
+		val, err := ss.Load(ctx, "key", typeutil.Timestamp(100+i*5+2))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	val, err := ss.Load(ctx, "key", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-19", val)
+
+	// before the earliest snapshot
+	_, err = ss.Load(ctx, "key", 99)
+	assert.Error(t, err)
+
+	// only the revisions are recorded, no copy of the value
+	keys, _, err := metaKV.LoadWithPrefix(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 21, len(keys))
+
+	// revisions are reloaded
+	ss2, err := NewMVCCSnapshot(ctx, metaKV, rootPath, SnapshotRevisionPrefix)
+	require.NoError(t, err)
+	defer ss2.Close()
+	val, err = ss2.Load(ctx, "key", 107)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-1", val)
+}
+
+func Test_MVCCSnapshotMultiSaveAndRemove(t *testing.T) {
+	ctx := context.TODO()
+	metaKV, rootPath := newTestMVCCKV(t)
+
+	ss, err := NewMVCCSnapshot(ctx, metaKV, rootPath, SnapshotRevisionPrefix)
+	require.NoError(t, err)
+	defer ss.Close()
+
+	for i := 0; i < 10; i++ {
+		err = ss.MultiSave(ctx, map[string]string{
+			fmt.Sprintf("prefix/key-%d", i): fmt.Sprintf("value-%d", i),
+		}, typeutil.Timestamp(100+i*5))
+		assert.NoError(t, err)
+	}
+	err = ss.MultiSaveAndRemove(ctx, map[string]string{"prefix/key-0": "value-new"}, []string{"prefix/key-1"}, 150)
+	assert.NoError(t, err)
+	err = ss.MultiSaveAndRemoveWithPrefix(ctx, map[string]string{"other": "value"}, []string{"prefix/key-2"}, 155)
+	assert.NoError(t, err)
+
+	keys, values, err := ss.LoadWithPrefix(ctx, "prefix", 112)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"prefix/key-0", "prefix/key-1", "prefix/key-2"}, keys)
+	assert.ElementsMatch(t, []string{"value-0", "value-1", "value-2"}, values)
+
+	keys, _, err = ss.LoadWithPrefix(ctx, "prefix", 152)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, len(keys))
+	val, err := ss.Load(ctx, "prefix/key-0", 152)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-new", val)
+
+	keys, _, err = ss.LoadWithPrefix(ctx, "prefix", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(keys))
+	_, err = ss.Load(ctx, "prefix/key-1", 0)
+	assert.Error(t, err)
+	val, err = ss.Load(ctx, "prefix/key-1", 149)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-1", val)
+}
+
+func Test_MVCCSnapshotRemoveExpiredRevisions(t *testing.T) {
+	ctx := context.TODO()
+	metaKV, rootPath := newTestMVCCKV(t)
+
+	ss, err := NewMVCCSnapshot(ctx, metaKV, rootPath, SnapshotRevisionPrefix)
+	require.NoError(t, err)
+	defer ss.Close()
+
+	now := time.Now()
+	ttl := Params.MetaStoreCfg.SnapshotTTLSeconds.GetAsDuration(time.Second)
+	tss := []typeutil.Timestamp{
+		tsoutil.ComposeTSByTime(now.Add(-3*ttl), 0),
+		tsoutil.ComposeTSByTime(now.Add(-2*ttl), 0),
+		tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0),
+	}
+	for i, ts := range tss {
+		err = ss.Save(ctx, "key", fmt.Sprintf("value-%d", i), ts)
+		assert.NoError(t, err)
+	}
+
+	err = ss.removeExpiredRevisions(ctx, now)
+	assert.NoError(t, err)
+
+	// the latest expired revision is kept for the ts after it
+	assert.Equal(t, 2, len(ss.revisions))
+	_, err = ss.Load(ctx, "key", tss[0])
+	assert.Error(t, err)
+	val, err := ss.Load(ctx, "key", tss[1]+1)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-1", val)
+	val, err = ss.Load(ctx, "key", tss[2])
+	assert.NoError(t, err)
+	assert.Equal(t, "value-2", val)
+
+	keys, _, err := metaKV.LoadWithPrefix(ctx, SnapshotRevisionPrefix)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+}
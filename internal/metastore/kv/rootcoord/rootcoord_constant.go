@@ -29,6 +29,9 @@ const (
 	SnapshotPrefix = "snapshots"
 	Aliases        = "aliases"
 
+	// SnapshotRevisionPrefix prefix of the revision of each ts in mvcc snapshot mode
+	SnapshotRevisionPrefix = "snapshot-revisions"
+
 	// CommonCredentialPrefix subpath for common credential
 	/* #nosec G101 */
 	CommonCredentialPrefix = "/credential"
@@ -53,6 +56,9 @@ const (
 
 	// PrivilegeGroupPrefix prefix for privilege group
 	PrivilegeGroupPrefix = ComponentPrefix + "/privilege-group"
+
+	// UserDailyQuotaUsageKey key for the daily quota usage of users
+	UserDailyQuotaUsageKey = ComponentPrefix + "/quota/user-daily-usage"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -944,6 +944,53 @@ func (_c *DataCoordCatalog_DropSegmentIndex_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: ctx, snapshotID
+func (_m *DataCoordCatalog) DropSnapshot(ctx context.Context, snapshotID int64) error {
+	ret := _m.Called(ctx, snapshotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, snapshotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type DataCoordCatalog_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshotID int64
+func (_e *DataCoordCatalog_Expecter) DropSnapshot(ctx interface{}, snapshotID interface{}) *DataCoordCatalog_DropSnapshot_Call {
+	return &DataCoordCatalog_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", ctx, snapshotID)}
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) Run(run func(ctx context.Context, snapshotID int64)) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) Return(_a0 error) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropStatsTask provides a mock function with given fields: ctx, taskID
 func (_m *DataCoordCatalog) DropStatsTask(ctx context.Context, taskID int64) error {
 	ret := _m.Called(ctx, taskID)
@@ -1679,6 +1726,123 @@ func (_c *DataCoordCatalog_ListSegments_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListSnapshotSegments provides a mock function with given fields: ctx, snapshotID
+func (_m *DataCoordCatalog) ListSnapshotSegments(ctx context.Context, snapshotID int64) ([]*datapb.SnapshotSegment, error) {
+	ret := _m.Called(ctx, snapshotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshotSegments")
+	}
+
+	var r0 []*datapb.SnapshotSegment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*datapb.SnapshotSegment, error)); ok {
+		return rf(ctx, snapshotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*datapb.SnapshotSegment); ok {
+		r0 = rf(ctx, snapshotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.SnapshotSegment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListSnapshotSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshotSegments'
+type DataCoordCatalog_ListSnapshotSegments_Call struct {
+	*mock.Call
+}
+
+// ListSnapshotSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshotID int64
+func (_e *DataCoordCatalog_Expecter) ListSnapshotSegments(ctx interface{}, snapshotID interface{}) *DataCoordCatalog_ListSnapshotSegments_Call {
+	return &DataCoordCatalog_ListSnapshotSegments_Call{Call: _e.mock.On("ListSnapshotSegments", ctx, snapshotID)}
+}
+
+func (_c *DataCoordCatalog_ListSnapshotSegments_Call) Run(run func(ctx context.Context, snapshotID int64)) *DataCoordCatalog_ListSnapshotSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshotSegments_Call) Return(_a0 []*datapb.SnapshotSegment, _a1 error) *DataCoordCatalog_ListSnapshotSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshotSegments_Call) RunAndReturn(run func(context.Context, int64) ([]*datapb.SnapshotSegment, error)) *DataCoordCatalog_ListSnapshotSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSnapshots provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListSnapshots(ctx context.Context) ([]*datapb.SnapshotInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 []*datapb.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.SnapshotInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.SnapshotInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type DataCoordCatalog_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListSnapshots(ctx interface{}) *DataCoordCatalog_ListSnapshots_Call {
+	return &DataCoordCatalog_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) Return(_a0 []*datapb.SnapshotInfo, _a1 error) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) RunAndReturn(run func(context.Context) ([]*datapb.SnapshotInfo, error)) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListStatsTasks provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error) {
 	ret := _m.Called(ctx)
@@ -2305,6 +2469,54 @@ func (_c *DataCoordCatalog_SavePreImportTask_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SaveSnapshot provides a mock function with given fields: ctx, snapshot, segments
+func (_m *DataCoordCatalog) SaveSnapshot(ctx context.Context, snapshot *datapb.SnapshotInfo, segments []*datapb.SnapshotSegment) error {
+	ret := _m.Called(ctx, snapshot, segments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SnapshotInfo, []*datapb.SnapshotSegment) error); ok {
+		r0 = rf(ctx, snapshot, segments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSnapshot'
+type DataCoordCatalog_SaveSnapshot_Call struct {
+	*mock.Call
+}
+
+// SaveSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot *datapb.SnapshotInfo
+//   - segments []*datapb.SnapshotSegment
+func (_e *DataCoordCatalog_Expecter) SaveSnapshot(ctx interface{}, snapshot interface{}, segments interface{}) *DataCoordCatalog_SaveSnapshot_Call {
+	return &DataCoordCatalog_SaveSnapshot_Call{Call: _e.mock.On("SaveSnapshot", ctx, snapshot, segments)}
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) Run(run func(ctx context.Context, snapshot *datapb.SnapshotInfo, segments []*datapb.SnapshotSegment)) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SnapshotInfo), args[2].([]*datapb.SnapshotSegment))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) Return(_a0 error) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.SnapshotInfo, []*datapb.SnapshotSegment) error) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveStatsTask provides a mock function with given fields: ctx, task
 func (_m *DataCoordCatalog) SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error {
 	ret := _m.Called(ctx, task)
@@ -32,6 +32,10 @@ type SegmentIndex struct {
 	IndexStoreVersion         int64
 	FinishedUTCTime           uint64
 	CurrentScalarIndexVersion int32
+	// The index files are shared with the source segment index if SourceBuildID is not 0.
+	SourceBuildID     int64
+	SourcePartitionID int64
+	SourceSegmentID   int64
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -58,6 +62,9 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		CurrentIndexVersion:       segIndex.GetCurrentIndexVersion(),
 		FinishedUTCTime:           segIndex.FinishedTime,
 		CurrentScalarIndexVersion: segIndex.CurrentScalarIndexVersion,
+		SourceBuildID:             segIndex.GetSourceBuildID(),
+		SourcePartitionID:         segIndex.GetSourcePartitionID(),
+		SourceSegmentID:           segIndex.GetSourceSegmentID(),
 	}
 }
 
@@ -86,6 +93,9 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		CurrentIndexVersion:       segIdx.CurrentIndexVersion,
 		FinishedTime:              segIdx.FinishedUTCTime,
 		CurrentScalarIndexVersion: segIdx.CurrentScalarIndexVersion,
+		SourceBuildID:             segIdx.SourceBuildID,
+		SourcePartitionID:         segIdx.SourcePartitionID,
+		SourceSegmentID:           segIdx.SourceSegmentID,
 	}
 }
 
@@ -110,5 +120,8 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		CurrentIndexVersion:       segIndex.CurrentIndexVersion,
 		FinishedUTCTime:           segIndex.FinishedUTCTime,
 		CurrentScalarIndexVersion: segIndex.CurrentScalarIndexVersion,
+		SourceBuildID:             segIndex.SourceBuildID,
+		SourcePartitionID:         segIndex.SourcePartitionID,
+		SourceSegmentID:           segIndex.SourceSegmentID,
 	}
 }
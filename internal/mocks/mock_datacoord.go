@@ -331,6 +331,65 @@ func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CloneSegments(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockDataCoord_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CloneSegmentsRequest
+func (_e *MockDataCoord_Expecter) CloneSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_CloneSegments_Call {
+	return &MockDataCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest)) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -390,6 +449,65 @@ func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateSnapshot(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockDataCoord_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CreateSnapshotRequest
+func (_e *MockDataCoord_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateSnapshot_Call {
+	return &MockDataCoord_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest)) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)) *MockDataCoord_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) DescribeIndex(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -508,6 +626,65 @@ func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropSnapshot(_a0 context.Context, _a1 *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockDataCoord_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.DropSnapshotRequest
+func (_e *MockDataCoord_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropSnapshot_Call {
+	return &MockDataCoord_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropSnapshotRequest)) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)) *MockDataCoord_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1157,6 +1334,65 @@ func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetGcReport provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetGcReport(_a0 context.Context, _a1 *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGcReport")
+	}
+
+	var r0 *datapb.GetGcReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest) *datapb.GetGcReportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetGcReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetGcReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetGcReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGcReport'
+type MockDataCoord_GetGcReport_Call struct {
+	*mock.Call
+}
+
+// GetGcReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetGcReportRequest
+func (_e *MockDataCoord_Expecter) GetGcReport(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetGcReport_Call {
+	return &MockDataCoord_GetGcReport_Call{Call: _e.mock.On("GetGcReport", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetGcReport_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetGcReportRequest)) *MockDataCoord_GetGcReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetGcReportRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetGcReport_Call) Return(_a0 *datapb.GetGcReportResponse, _a1 error) *MockDataCoord_GetGcReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetGcReport_Call) RunAndReturn(run func(context.Context, *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error)) *MockDataCoord_GetGcReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2264,6 +2500,65 @@ func (_c *MockDataCoord_ListIndexes_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListSnapshots(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockDataCoord_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListSnapshotsRequest
+func (_e *MockDataCoord_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListSnapshots_Call {
+	return &MockDataCoord_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest)) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)) *MockDataCoord_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2486,6 +2781,65 @@ func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// RestoreSnapshotSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RestoreSnapshotSegments(_a0 context.Context, _a1 *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshotSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RestoreSnapshotSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshotSegments'
+type MockDataCoord_RestoreSnapshotSegments_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshotSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RestoreSnapshotSegmentsRequest
+func (_e *MockDataCoord_Expecter) RestoreSnapshotSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_RestoreSnapshotSegments_Call {
+	return &MockDataCoord_RestoreSnapshotSegments_Call{Call: _e.mock.On("RestoreSnapshotSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_RestoreSnapshotSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreSnapshotSegmentsRequest)) *MockDataCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreSnapshotSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreSnapshotSegments_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error)) *MockDataCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2918,6 +3272,65 @@ func (_c *MockDataCoord_Stop_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// TruncateSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) TruncateSegments(_a0 context.Context, _a1 *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TruncateSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MockDataCoord_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.TruncateSegmentsRequest
+func (_e *MockDataCoord_Expecter) TruncateSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_TruncateSegments_Call {
+	return &MockDataCoord_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_TruncateSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.TruncateSegmentsRequest)) *MockDataCoord_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.TruncateSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_TruncateSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_TruncateSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_TruncateSegments_Call) RunAndReturn(run func(context.Context, *datapb.TruncateSegmentsRequest) (*commonpb.Status, error)) *MockDataCoord_TruncateSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) UpdateChannelCheckpoint(_a0 context.Context, _a1 *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
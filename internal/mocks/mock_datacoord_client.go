@@ -403,6 +403,80 @@ func (_c *MockDataCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CloneSegments(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockDataCoordClient_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CloneSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CloneSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CloneSegments_Call {
+	return &MockDataCoordClient_CloneSegments_Call{Call: _e.mock.On("CloneSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) Run(run func(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockDataCoordClient) Close() error {
 	ret := _m.Called()
@@ -522,6 +596,80 @@ func (_c *MockDataCoordClient_CreateIndex_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CreateSnapshot(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockDataCoordClient_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CreateSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CreateSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CreateSnapshot_Call {
+	return &MockDataCoordClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -670,6 +818,80 @@ func (_c *MockDataCoordClient_DropIndex_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) DropSnapshot(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockDataCoordClient_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.DropSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) DropSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_DropSnapshot_Call {
+	return &MockDataCoordClient_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) Run(run func(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption)) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) DropVirtualChannel(ctx context.Context, in *datapb.DropVirtualChannelRequest, opts ...grpc.CallOption) (*datapb.DropVirtualChannelResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1484,6 +1706,80 @@ func (_c *MockDataCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetGcReport provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetGcReport(ctx context.Context, in *datapb.GetGcReportRequest, opts ...grpc.CallOption) (*datapb.GetGcReportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGcReport")
+	}
+
+	var r0 *datapb.GetGcReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) (*datapb.GetGcReportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) *datapb.GetGcReportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetGcReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetGcReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGcReport'
+type MockDataCoordClient_GetGcReport_Call struct {
+	*mock.Call
+}
+
+// GetGcReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetGcReportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetGcReport(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetGcReport_Call {
+	return &MockDataCoordClient_GetGcReport_Call{Call: _e.mock.On("GetGcReport",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetGcReport_Call) Run(run func(ctx context.Context, in *datapb.GetGcReportRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetGcReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetGcReportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetGcReport_Call) Return(_a0 *datapb.GetGcReportResponse, _a1 error) *MockDataCoordClient_GetGcReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetGcReport_Call) RunAndReturn(run func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) (*datapb.GetGcReportResponse, error)) *MockDataCoordClient_GetGcReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2816,6 +3112,80 @@ func (_c *MockDataCoordClient_ListIndexes_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListSnapshots(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockDataCoordClient_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListSnapshotsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListSnapshots(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListSnapshots_Call {
+	return &MockDataCoordClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)) *MockDataCoordClient_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3038,6 +3408,80 @@ func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RestoreSnapshotSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) RestoreSnapshotSegments(ctx context.Context, in *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshotSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_RestoreSnapshotSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshotSegments'
+type MockDataCoordClient_RestoreSnapshotSegments_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshotSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.RestoreSnapshotSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) RestoreSnapshotSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_RestoreSnapshotSegments_Call {
+	return &MockDataCoordClient_RestoreSnapshotSegments_Call{Call: _e.mock.On("RestoreSnapshotSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshotSegments_Call) Run(run func(ctx context.Context, in *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshotSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_RestoreSnapshotSegments_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) SaveBinlogPaths(ctx context.Context, in *datapb.SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3260,6 +3704,80 @@ func (_c *MockDataCoordClient_ShowConfigurations_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// TruncateSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) TruncateSegments(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MockDataCoordClient_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.TruncateSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) TruncateSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_TruncateSegments_Call {
+	return &MockDataCoordClient_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_TruncateSegments_Call) Run(run func(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.TruncateSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_TruncateSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_TruncateSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_TruncateSegments_Call) RunAndReturn(run func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_TruncateSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *datapb.UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -988,6 +988,124 @@ func (_c *MixCoord_CheckQueryNodeDistribution_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CloneCollection(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MixCoord_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CloneCollectionRequest
+func (_e *MixCoord_Expecter) CloneCollection(_a0 interface{}, _a1 interface{}) *MixCoord_CloneCollection_Call {
+	return &MixCoord_CloneCollection_Call{Call: _e.mock.On("CloneCollection", _a0, _a1)}
+}
+
+func (_c *MixCoord_CloneCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest)) *MixCoord_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)) *MixCoord_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneSegments provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CloneSegments(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MixCoord_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CloneSegmentsRequest
+func (_e *MixCoord_Expecter) CloneSegments(_a0 interface{}, _a1 interface{}) *MixCoord_CloneSegments_Call {
+	return &MixCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", _a0, _a1)}
+}
+
+func (_c *MixCoord_CloneSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest)) *MixCoord_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)) *MixCoord_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CreateAlias(_a0 context.Context, _a1 *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1519,6 +1637,65 @@ func (_c *MixCoord_CreateRole_Call) RunAndReturn(run func(context.Context, *milv
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CreateSnapshot(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MixCoord_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CreateSnapshotRequest
+func (_e *MixCoord_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MixCoord_CreateSnapshot_Call {
+	return &MixCoord_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *MixCoord_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest)) *MixCoord_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)) *MixCoord_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeactivateChecker provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) DeactivateChecker(_a0 context.Context, _a1 *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2463,6 +2640,65 @@ func (_c *MixCoord_DropRole_Call) RunAndReturn(run func(context.Context, *milvus
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) DropSnapshot(_a0 context.Context, _a1 *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MixCoord_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.DropSnapshotRequest
+func (_e *MixCoord_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MixCoord_DropSnapshot_Call {
+	return &MixCoord_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *MixCoord_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropSnapshotRequest)) *MixCoord_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)) *MixCoord_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3289,6 +3525,65 @@ func (_c *MixCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetGcReport provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetGcReport(_a0 context.Context, _a1 *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGcReport")
+	}
+
+	var r0 *datapb.GetGcReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest) *datapb.GetGcReportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetGcReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetGcReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetGcReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGcReport'
+type MixCoord_GetGcReport_Call struct {
+	*mock.Call
+}
+
+// GetGcReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetGcReportRequest
+func (_e *MixCoord_Expecter) GetGcReport(_a0 interface{}, _a1 interface{}) *MixCoord_GetGcReport_Call {
+	return &MixCoord_GetGcReport_Call{Call: _e.mock.On("GetGcReport", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetGcReport_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetGcReportRequest)) *MixCoord_GetGcReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetGcReportRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetGcReport_Call) Return(_a0 *datapb.GetGcReportResponse, _a1 error) *MixCoord_GetGcReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetGcReport_Call) RunAndReturn(run func(context.Context, *datapb.GetGcReportRequest) (*datapb.GetGcReportResponse, error)) *MixCoord_GetGcReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5726,6 +6021,65 @@ func (_c *MixCoord_ListResourceGroups_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListSnapshots(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MixCoord_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListSnapshotsRequest
+func (_e *MixCoord_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MixCoord_ListSnapshots_Call {
+	return &MixCoord_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest)) *MixCoord_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MixCoord_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)) *MixCoord_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBalance provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) LoadBalance(_a0 context.Context, _a1 *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6619,6 +6973,124 @@ func (_c *MixCoord_RestoreRBAC_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) RestoreSnapshot(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MixCoord_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RestoreSnapshotRequest
+func (_e *MixCoord_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *MixCoord_RestoreSnapshot_Call {
+	return &MixCoord_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
+}
+
+func (_c *MixCoord_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest)) *MixCoord_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)) *MixCoord_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSnapshotSegments provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) RestoreSnapshotSegments(_a0 context.Context, _a1 *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshotSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_RestoreSnapshotSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshotSegments'
+type MixCoord_RestoreSnapshotSegments_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshotSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RestoreSnapshotSegmentsRequest
+func (_e *MixCoord_Expecter) RestoreSnapshotSegments(_a0 interface{}, _a1 interface{}) *MixCoord_RestoreSnapshotSegments_Call {
+	return &MixCoord_RestoreSnapshotSegments_Call{Call: _e.mock.On("RestoreSnapshotSegments", _a0, _a1)}
+}
+
+func (_c *MixCoord_RestoreSnapshotSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreSnapshotSegmentsRequest)) *MixCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_RestoreSnapshotSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_RestoreSnapshotSegments_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotSegmentsRequest) (*commonpb.Status, error)) *MixCoord_RestoreSnapshotSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeBalance provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -8139,6 +8611,124 @@ func (_c *MixCoord_TransferSegment_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// TruncateCollection provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) TruncateCollection(_a0 context.Context, _a1 *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.TruncateCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_TruncateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateCollection'
+type MixCoord_TruncateCollection_Call struct {
+	*mock.Call
+}
+
+// TruncateCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.TruncateCollectionRequest
+func (_e *MixCoord_Expecter) TruncateCollection(_a0 interface{}, _a1 interface{}) *MixCoord_TruncateCollection_Call {
+	return &MixCoord_TruncateCollection_Call{Call: _e.mock.On("TruncateCollection", _a0, _a1)}
+}
+
+func (_c *MixCoord_TruncateCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.TruncateCollectionRequest)) *MixCoord_TruncateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.TruncateCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_TruncateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_TruncateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_TruncateCollection_Call) RunAndReturn(run func(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)) *MixCoord_TruncateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TruncateSegments provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) TruncateSegments(_a0 context.Context, _a1 *datapb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TruncateSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MixCoord_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.TruncateSegmentsRequest
+func (_e *MixCoord_Expecter) TruncateSegments(_a0 interface{}, _a1 interface{}) *MixCoord_TruncateSegments_Call {
+	return &MixCoord_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments", _a0, _a1)}
+}
+
+func (_c *MixCoord_TruncateSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.TruncateSegmentsRequest)) *MixCoord_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.TruncateSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_TruncateSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_TruncateSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_TruncateSegments_Call) RunAndReturn(run func(context.Context, *datapb.TruncateSegmentsRequest) (*commonpb.Status, error)) *MixCoord_TruncateSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) UpdateChannelCheckpoint(_a0 context.Context, _a1 *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1223,6 +1223,154 @@ func (_c *MockMixCoordClient_CheckQueryNodeDistribution_Call) RunAndReturn(run f
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockMixCoordClient_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CloneCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CloneCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CloneCollection_Call {
+	return &MockMixCoordClient_CloneCollection_Call{Call: _e.mock.On("CloneCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CloneCollection_Call) Run(run func(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CloneSegments(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockMixCoordClient_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CloneSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CloneSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CloneSegments_Call {
+	return &MockMixCoordClient_CloneSegments_Call{Call: _e.mock.On("CloneSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CloneSegments_Call) Run(run func(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockMixCoordClient) Close() error {
 	ret := _m.Called()
@@ -1934,6 +2082,80 @@ func (_c *MockMixCoordClient_CreateRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CreateSnapshot(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockMixCoordClient_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CreateSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CreateSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CreateSnapshot_Call {
+	return &MockMixCoordClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeactivateChecker provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) DeactivateChecker(ctx context.Context, in *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3118,6 +3340,80 @@ func (_c *MockMixCoordClient_DropRole_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) DropSnapshot(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockMixCoordClient_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.DropSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) DropSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_DropSnapshot_Call {
+	return &MockMixCoordClient_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_DropSnapshot_Call) Run(run func(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption)) *MockMixCoordClient_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) DropVirtualChannel(ctx context.Context, in *datapb.DropVirtualChannelRequest, opts ...grpc.CallOption) (*datapb.DropVirtualChannelResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4080,8 +4376,8 @@ func (_c *MockMixCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// GetImportProgress provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
+// GetGcReport provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetGcReport(ctx context.Context, in *datapb.GetGcReportRequest, opts ...grpc.CallOption) (*datapb.GetGcReportResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -4092,23 +4388,23 @@ func (_m *MockMixCoordClient) GetImportProgress(ctx context.Context, in *interna
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetImportProgress")
+		panic("no return value specified for GetGcReport")
 	}
 
-	var r0 *internalpb.GetImportProgressResponse
+	var r0 *datapb.GetGcReportResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) (*datapb.GetGcReportResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) *datapb.GetGcReportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetGcReportResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -4117,21 +4413,21 @@ func (_m *MockMixCoordClient) GetImportProgress(ctx context.Context, in *interna
 	return r0, r1
 }
 
-// MockMixCoordClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
-type MockMixCoordClient_GetImportProgress_Call struct {
+// MockMixCoordClient_GetGcReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGcReport'
+type MockMixCoordClient_GetGcReport_Call struct {
 	*mock.Call
 }
 
-// GetImportProgress is a helper method to define mock.On call
+// GetGcReport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetImportProgressRequest
+//   - in *datapb.GetGcReportRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetImportProgress_Call {
-	return &MockMixCoordClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
+func (_e *MockMixCoordClient_Expecter) GetGcReport(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetGcReport_Call {
+	return &MockMixCoordClient_GetGcReport_Call{Call: _e.mock.On("GetGcReport",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetImportProgress_Call {
+func (_c *MockMixCoordClient_GetGcReport_Call) Run(run func(ctx context.Context, in *datapb.GetGcReportRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetGcReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -4139,23 +4435,23 @@ func (_c *MockMixCoordClient_GetImportProgress_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetGcReportRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockMixCoordClient_GetImportProgress_Call {
+func (_c *MockMixCoordClient_GetGcReport_Call) Return(_a0 *datapb.GetGcReportResponse, _a1 error) *MockMixCoordClient_GetGcReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockMixCoordClient_GetImportProgress_Call {
+func (_c *MockMixCoordClient_GetGcReport_Call) RunAndReturn(run func(context.Context, *datapb.GetGcReportRequest, ...grpc.CallOption) (*datapb.GetGcReportResponse, error)) *MockMixCoordClient_GetGcReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexBuildProgress provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
+// GetImportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -4166,7 +4462,81 @@ func (_m *MockMixCoordClient) GetIndexBuildProgress(ctx context.Context, in *ind
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIndexBuildProgress")
+		panic("no return value specified for GetImportProgress")
+	}
+
+	var r0 *internalpb.GetImportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
+type MockMixCoordClient_GetImportProgress_Call struct {
+	*mock.Call
+}
+
+// GetImportProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetImportProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetImportProgress_Call {
+	return &MockMixCoordClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetImportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockMixCoordClient_GetImportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockMixCoordClient_GetImportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexBuildProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexBuildProgress")
 	}
 
 	var r0 *indexpb.GetIndexBuildProgressResponse
@@ -6966,6 +7336,80 @@ func (_c *MockMixCoordClient_ListResourceGroups_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListSnapshots(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *datapb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) *datapb.ListSnapshotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockMixCoordClient_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListSnapshotsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListSnapshots(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListSnapshots_Call {
+	return &MockMixCoordClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockMixCoordClient_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest, ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error)) *MockMixCoordClient_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBalance provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) LoadBalance(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -7928,6 +8372,154 @@ func (_c *MockMixCoordClient_RestoreRBAC_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockMixCoordClient_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.RestoreSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) RestoreSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_RestoreSnapshot_Call {
+	return &MockMixCoordClient_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption)) *MockMixCoordClient_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSnapshotSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) RestoreSnapshotSegments(ctx context.Context, in *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshotSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_RestoreSnapshotSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshotSegments'
+type MockMixCoordClient_RestoreSnapshotSegments_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshotSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.RestoreSnapshotSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) RestoreSnapshotSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_RestoreSnapshotSegments_Call {
+	return &MockMixCoordClient_RestoreSnapshotSegments_Call{Call: _e.mock.On("RestoreSnapshotSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshotSegments_Call) Run(run func(ctx context.Context, in *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshotSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_RestoreSnapshotSegments_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_RestoreSnapshotSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeBalance provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ResumeBalance(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -9556,6 +10148,154 @@ func (_c *MockMixCoordClient_TransferSegment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// TruncateCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_TruncateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateCollection'
+type MockMixCoordClient_TruncateCollection_Call struct {
+	*mock.Call
+}
+
+// TruncateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.TruncateCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) TruncateCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_TruncateCollection_Call {
+	return &MockMixCoordClient_TruncateCollection_Call{Call: _e.mock.On("TruncateCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_TruncateCollection_Call) Run(run func(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_TruncateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.TruncateCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_TruncateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_TruncateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_TruncateCollection_Call) RunAndReturn(run func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_TruncateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TruncateSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) TruncateSegments(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateSegments")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_TruncateSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateSegments'
+type MockMixCoordClient_TruncateSegments_Call struct {
+	*mock.Call
+}
+
+// TruncateSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.TruncateSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) TruncateSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_TruncateSegments_Call {
+	return &MockMixCoordClient_TruncateSegments_Call{Call: _e.mock.On("TruncateSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_TruncateSegments_Call) Run(run func(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_TruncateSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.TruncateSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_TruncateSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_TruncateSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_TruncateSegments_Call) RunAndReturn(run func(context.Context, *datapb.TruncateSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_TruncateSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelCheckpoint provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *datapb.UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
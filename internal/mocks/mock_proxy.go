@@ -683,6 +683,65 @@ func (_c *MockProxy_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CloneCollection(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxy_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CloneCollectionRequest
+func (_e *MockProxy_Expecter) CloneCollection(_a0 interface{}, _a1 interface{}) *MockProxy_CloneCollection_Call {
+	return &MockProxy_CloneCollection_Call{Call: _e.mock.On("CloneCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_CloneCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest)) *MockProxy_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Connect(_a0 context.Context, _a1 *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1332,6 +1391,65 @@ func (_c *MockProxy_CreateRowPolicy_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateSnapshot(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockProxy_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateSnapshotRequest
+func (_e *MockProxy_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_CreateSnapshot_Call {
+	return &MockProxy_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest)) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Delete(_a0 context.Context, _a1 *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2394,6 +2512,65 @@ func (_c *MockProxy_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropSnapshot(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockProxy_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropSnapshotRequest
+func (_e *MockProxy_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_DropSnapshot_Call {
+	return &MockProxy_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest)) *MockProxy_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)) *MockProxy_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dummy provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Dummy(_a0 context.Context, _a1 *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5314,6 +5491,65 @@ func (_c *MockProxy_ListRowPolicies_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListSnapshots(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *internalpb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) *internalpb.ListSnapshotsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListSnapshotsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockProxy_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListSnapshotsRequest
+func (_e *MockProxy_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MockProxy_ListSnapshots_Call {
+	return &MockProxy_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest)) *MockProxy_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListSnapshotsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListSnapshots_Call) Return(_a0 *internalpb.ListSnapshotsResponse, _a1 error) *MockProxy_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListSnapshots_Call) RunAndReturn(run func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)) *MockProxy_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUsersWithTag provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListUsersWithTag(_a0 context.Context, _a1 *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6362,6 +6598,65 @@ func (_c *MockProxy_RestoreRBAC_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RestoreSnapshot(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockProxy_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RestoreSnapshotRequest
+func (_e *MockProxy_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_RestoreSnapshot_Call {
+	return &MockProxy_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest)) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunAnalyzer provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) RunAnalyzer(_a0 context.Context, _a1 *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7174,6 +7469,65 @@ func (_c *MockProxy_TransferReplica_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// TruncateCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) TruncateCollection(_a0 context.Context, _a1 *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.TruncateCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_TruncateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateCollection'
+type MockProxy_TruncateCollection_Call struct {
+	*mock.Call
+}
+
+// TruncateCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.TruncateCollectionRequest
+func (_e *MockProxy_Expecter) TruncateCollection(_a0 interface{}, _a1 interface{}) *MockProxy_TruncateCollection_Call {
+	return &MockProxy_TruncateCollection_Call{Call: _e.mock.On("TruncateCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_TruncateCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.TruncateCollectionRequest)) *MockProxy_TruncateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.TruncateCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_TruncateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_TruncateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_TruncateCollection_Call) RunAndReturn(run func(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)) *MockProxy_TruncateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) UpdateCredential(_a0 context.Context, _a1 *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -31,6 +31,80 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// CloneCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxyClient_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CloneCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CloneCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CloneCollection_Call {
+	return &MockProxyClient_CloneCollection_Call{Call: _e.mock.On("CloneCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) Run(run func(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -76,6 +150,154 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockProxyClient_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CreateSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CreateSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CreateSnapshot_Call {
+	return &MockProxyClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption)) *MockProxyClient_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CreateSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockProxyClient_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropSnapshot_Call {
+	return &MockProxyClient_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption)) *MockProxyClient_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -1038,6 +1260,80 @@ func (_c *MockProxyClient_ListImports_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 *internalpb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) *internalpb.ListSnapshotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockProxyClient_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListSnapshotsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListSnapshots(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListSnapshots_Call {
+	return &MockProxyClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListSnapshotsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListSnapshots_Call) Return(_a0 *internalpb.ListSnapshotsResponse, _a1 error) *MockProxyClient_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListSnapshots_Call) RunAndReturn(run func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error)) *MockProxyClient_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1112,6 +1408,80 @@ func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockProxyClient_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.RestoreSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) RestoreSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RestoreSnapshot_Call {
+	return &MockProxyClient_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_RestoreSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption)) *MockProxyClient_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1186,6 +1556,80 @@ func (_c *MockProxyClient_SetRates_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// TruncateCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateCollection")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_TruncateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateCollection'
+type MockProxyClient_TruncateCollection_Call struct {
+	*mock.Call
+}
+
+// TruncateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.TruncateCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) TruncateCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_TruncateCollection_Call {
+	return &MockProxyClient_TruncateCollection_Call{Call: _e.mock.On("TruncateCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_TruncateCollection_Call) Run(run func(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_TruncateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.TruncateCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_TruncateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_TruncateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_TruncateCollection_Call) RunAndReturn(run func(context.Context, *internalpb.TruncateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_TruncateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredentialCache provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) UpdateCredentialCache(ctx context.Context, in *proxypb.UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -5,9 +5,12 @@ package mock_balancer
 import (
 	context "context"
 
-	syncutil "github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+	balancer "github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+
 	mock "github.com/stretchr/testify/mock"
 
+	syncutil "github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+
 	types "github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
 
 	typeutil "github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -58,6 +61,65 @@ func (_c *MockBalancer_Close_Call) RunAndReturn(run func()) *MockBalancer_Close_
 	return _c
 }
 
+// DryRunBalance provides a mock function with given fields: ctx, policyName
+func (_m *MockBalancer) DryRunBalance(ctx context.Context, policyName string) (*balancer.DryRunResult, error) {
+	ret := _m.Called(ctx, policyName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunBalance")
+	}
+
+	var r0 *balancer.DryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*balancer.DryRunResult, error)); ok {
+		return rf(ctx, policyName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *balancer.DryRunResult); ok {
+		r0 = rf(ctx, policyName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*balancer.DryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, policyName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_DryRunBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunBalance'
+type MockBalancer_DryRunBalance_Call struct {
+	*mock.Call
+}
+
+// DryRunBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyName string
+func (_e *MockBalancer_Expecter) DryRunBalance(ctx interface{}, policyName interface{}) *MockBalancer_DryRunBalance_Call {
+	return &MockBalancer_DryRunBalance_Call{Call: _e.mock.On("DryRunBalance", ctx, policyName)}
+}
+
+func (_c *MockBalancer_DryRunBalance_Call) Run(run func(ctx context.Context, policyName string)) *MockBalancer_DryRunBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_DryRunBalance_Call) Return(_a0 *balancer.DryRunResult, _a1 error) *MockBalancer_DryRunBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_DryRunBalance_Call) RunAndReturn(run func(context.Context, string) (*balancer.DryRunResult, error)) *MockBalancer_DryRunBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestWALLocated provides a mock function with given fields: ctx, pchannel
 func (_m *MockBalancer) GetLatestWALLocated(ctx context.Context, pchannel string) (int64, bool) {
 	ret := _m.Called(ctx, pchannel)
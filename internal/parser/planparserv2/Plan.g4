@@ -5,7 +5,7 @@ expr:
 	| FloatingConstant										                     # Floating
 	| BooleanConstant										                     # Boolean
 	| StringLiteral											                     # String
-	| (Identifier|Meta)           			      							     # Identifier
+	| (name|Meta)           			      							         # Identifier
 	| JSONIdentifier                                                             # JSONIdentifier
 	| LBRACE Identifier RBRACE                                                   # TemplateVariable
 	| '(' expr ')'											                     # Parens
@@ -13,12 +13,12 @@ expr:
 	| EmptyArray                                                                 # EmptyArray
 	| EXISTS expr                                                                # Exists
 	| expr LIKE StringLiteral                                                    # Like
-	| TEXTMATCH'('Identifier',' StringLiteral')'                                 # TextMatch
-	| PHRASEMATCH'('Identifier',' StringLiteral (',' expr)? ')'       			 # PhraseMatch
+	| expr '=~' StringLiteral                                                    # RegexMatch
+	| TEXTMATCH'('name',' StringLiteral')'                                       # TextMatch
+	| PHRASEMATCH'('name',' StringLiteral (',' expr)? ')'       			     # PhraseMatch
 	| RANDOMSAMPLE'(' expr ')'						     						 # RandomSample
 	| expr POW expr											                     # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                     # Unary
-//	| '(' typeName ')' expr									                     # Cast
 	| expr op = (MUL | DIV | MOD) expr						                     # MulDivMod
 	| expr op = (ADD | SUB) expr							                     # AddSub
 	| expr op = (SHL | SHR) expr							                     # Shift
@@ -26,10 +26,11 @@ expr:
 	| (JSONContains | ArrayContains)'('expr',' expr')'                           # JSONContains
 	| (JSONContainsAll | ArrayContainsAll)'('expr',' expr')'                     # JSONContainsAll
 	| (JSONContainsAny | ArrayContainsAny)'('expr',' expr')'                     # JSONContainsAny
-	| ArrayLength'('(Identifier | JSONIdentifier)')'                             # ArrayLength
+	| ArrayLength'('(name | JSONIdentifier)')'                                   # ArrayLength
 	| Identifier '(' ( expr (',' expr )* ','? )? ')'                             # Call
-	| expr op1 = (LT | LE) (Identifier | JSONIdentifier) op2 = (LT | LE) expr	 # Range
-	| expr op1 = (GT | GE) (Identifier | JSONIdentifier) op2 = (GT | GE) expr    # ReverseRange
+	| CAST '(' expr AS name ')'                                                  # Cast
+	| expr op1 = (LT | LE) (name | JSONIdentifier) op2 = (LT | LE) expr	         # Range
+	| expr op1 = (GT | GE) (name | JSONIdentifier) op2 = (GT | GE) expr          # ReverseRange
 	| expr op = (LT | LE | GT | GE) expr					                     # Relational
 	| expr op = (EQ | NE) expr								                     # Equality
 	| expr BAND expr										                     # BitAnd
@@ -37,18 +38,12 @@ expr:
 	| expr BOR expr											                     # BitOr
 	| expr AND expr											                     # LogicalAnd
 	| expr OR expr											                     # LogicalOr
-	| (Identifier | JSONIdentifier) ISNULL                                                          # IsNull
-	| (Identifier | JSONIdentifier) ISNOTNULL                                                       # IsNotNull;
-
-// typeName: ty = (BOOL | INT8 | INT16 | INT32 | INT64 | FLOAT | DOUBLE);
-
-// BOOL: 'bool';
-// INT8: 'int8';
-// INT16: 'int16';
-// INT32: 'int32';
-// INT64: 'int64';
-// FLOAT: 'float';
-// DOUBLE: 'double';
+	| (name | JSONIdentifier) ISNULL                                                                # IsNull
+	| (name | JSONIdentifier) ISNOTNULL                                                             # IsNotNull;
+
+// the keywords are accepted as names, so the fields named as the keywords can still be referenced.
+name: Identifier | CAST | AS;
+
 LBRACE: '{';
 RBRACE: '}';
 
@@ -98,6 +93,9 @@ ArrayContainsAll: 'array_contains_all' | 'ARRAY_CONTAINS_ALL';
 ArrayContainsAny: 'array_contains_any' | 'ARRAY_CONTAINS_ANY';
 ArrayLength: 'array_length' | 'ARRAY_LENGTH';
 
+CAST: 'cast' | 'CAST';
+AS: 'as' | 'AS';
+
 BooleanConstant: 'true' | 'True' | 'TRUE' | 'false' | 'False' | 'FALSE';
 
 IntegerConstant:
@@ -43,173 +43,184 @@ func planlexerLexerInit() {
 		"DEFAULT_MODE",
 	}
 	staticData.LiteralNames = []string{
-		"", "'('", "')'", "'['", "','", "']'", "'{'", "'}'", "'<'", "'<='",
-		"'>'", "'>='", "'=='", "'!='", "", "", "", "", "", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'**'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "", "", "",
-		"", "'~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'$meta'",
+		"", "'('", "')'", "'['", "','", "']'", "'=~'", "'{'", "'}'", "'<'",
+		"'<='", "'>'", "'>='", "'=='", "'!='", "", "", "", "", "", "'+'", "'-'",
+		"'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "", "",
+		"", "", "'~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "'$meta'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "", "", "", "", "LBRACE", "RBRACE", "LT", "LE", "GT", "GE",
+		"", "", "", "", "", "", "", "LBRACE", "RBRACE", "LT", "LE", "GT", "GE",
 		"EQ", "NE", "LIKE", "EXISTS", "TEXTMATCH", "PHRASEMATCH", "RANDOMSAMPLE",
 		"ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR",
-		"BXOR", "AND", "OR", "ISNULL", "ISNOTNULL", "BNOT", "NOT", "IN", "EmptyArray",
-		"JSONContains", "JSONContainsAll", "JSONContainsAny", "ArrayContains",
-		"ArrayContainsAll", "ArrayContainsAny", "ArrayLength", "BooleanConstant",
-		"IntegerConstant", "FloatingConstant", "Identifier", "Meta", "StringLiteral",
-		"JSONIdentifier", "Whitespace", "Newline",
+		"BXOR", "AND", "OR", "ISNULL", "ISNOTNULL", "BNOT", "NOT", "IN",
+		"EmptyArray", "JSONContains", "JSONContainsAll", "JSONContainsAny",
+		"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+		"CAST", "AS", "BooleanConstant", "IntegerConstant", "FloatingConstant",
+		"Identifier", "Meta", "StringLiteral", "JSONIdentifier", "Whitespace",
+		"Newline",
 	}
 	staticData.RuleNames = []string{
-		"T__0", "T__1", "T__2", "T__3", "T__4", "LBRACE", "RBRACE", "LT", "LE",
-		"GT", "GE", "EQ", "NE", "LIKE", "EXISTS", "TEXTMATCH", "PHRASEMATCH",
-		"RANDOMSAMPLE", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR",
-		"BAND", "BOR", "BXOR", "AND", "OR", "ISNULL", "ISNOTNULL", "BNOT", "NOT",
-		"IN", "EmptyArray", "JSONContains", "JSONContainsAll", "JSONContainsAny",
-		"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
-		"BooleanConstant", "IntegerConstant", "FloatingConstant", "Identifier",
-		"Meta", "StringLiteral", "JSONIdentifier", "EncodingPrefix", "DoubleSCharSequence",
-		"SingleSCharSequence", "DoubleSChar", "SingleSChar", "Nondigit", "Digit",
-		"BinaryConstant", "DecimalConstant", "OctalConstant", "HexadecimalConstant",
-		"NonzeroDigit", "OctalDigit", "HexadecimalDigit", "HexQuad", "UniversalCharacterName",
-		"DecimalFloatingConstant", "HexadecimalFloatingConstant", "FractionalConstant",
-		"ExponentPart", "DigitSequence", "HexadecimalFractionalConstant", "HexadecimalDigitSequence",
+		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "LBRACE", "RBRACE", "LT",
+		"LE", "GT", "GE", "EQ", "NE", "LIKE", "EXISTS", "TEXTMATCH",
+		"PHRASEMATCH", "RANDOMSAMPLE", "ADD", "SUB", "MUL", "DIV", "MOD", "POW",
+		"SHL", "SHR", "BAND", "BOR", "BXOR", "AND", "OR", "ISNULL", "ISNOTNULL",
+		"BNOT", "NOT", "IN", "EmptyArray", "JSONContains", "JSONContainsAll",
+		"JSONContainsAny", "ArrayContains", "ArrayContainsAll",
+		"ArrayContainsAny", "ArrayLength", "CAST", "AS", "BooleanConstant",
+		"IntegerConstant", "FloatingConstant", "Identifier", "Meta",
+		"StringLiteral", "JSONIdentifier", "EncodingPrefix",
+		"DoubleSCharSequence", "SingleSCharSequence", "DoubleSChar",
+		"SingleSChar", "Nondigit", "Digit", "BinaryConstant", "DecimalConstant",
+		"OctalConstant", "HexadecimalConstant", "NonzeroDigit", "OctalDigit",
+		"HexadecimalDigit", "HexQuad", "UniversalCharacterName",
+		"DecimalFloatingConstant", "HexadecimalFloatingConstant",
+		"FractionalConstant", "ExponentPart", "DigitSequence",
+		"HexadecimalFractionalConstant", "HexadecimalDigitSequence",
 		"BinaryExponentPart", "EscapeSequence", "Whitespace", "Newline",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 53, 892, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
-		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
-		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
-		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
-		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
-		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
-		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
-		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
-		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
-		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
-		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
-		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 1, 0,
-		1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6,
-		1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1,
-		11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
-		1, 13, 1, 13, 1, 13, 3, 13, 196, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1,
-		14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 3, 14, 210, 8, 14,
-		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 3, 15,
-		232, 8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16,
-		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 258, 8, 16, 1, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		4, 0, 56, 917, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 7, 4, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 10,
+		2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 2,
+		16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
+		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
+		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2,
+		36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2,
+		41, 7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 47, 7, 47, 2,
+		48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7, 52, 2,
+		53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57, 2,
+		58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
+		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
+		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2,
+		78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1,
+		2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1,
+		9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 13,
+		1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 3,
+		14, 196, 8, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 3, 15, 210, 8, 15, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 232, 8, 16, 1, 17,
 		1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 3, 17, 286, 8, 17, 1, 18, 1, 18, 1, 19, 1, 19, 1, 20,
-		1, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1,
-		24, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29,
-		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 321, 8, 29, 1,
-		30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 329, 8, 30, 1, 31, 1, 31,
-		1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1,
-		31, 1, 31, 3, 31, 345, 8, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32,
-		1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1,
-		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 369, 8, 32, 1, 33, 1, 33,
-		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 3, 34, 380, 8, 34, 1,
-		35, 1, 35, 1, 35, 1, 35, 3, 35, 386, 8, 35, 1, 36, 1, 36, 1, 36, 5, 36,
-		391, 8, 36, 10, 36, 12, 36, 394, 9, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1,
-		37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37,
-		1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1,
-		37, 1, 37, 1, 37, 3, 37, 424, 8, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
-		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 460, 8,
-		38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
+		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1,
+		17, 1, 17, 1, 17, 3, 17, 258, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
+		18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
+		18, 3, 18, 286, 8, 18, 1, 19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22,
+		1, 22, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 26, 1,
+		26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1,
+		30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 321, 8, 30, 1, 31, 1, 31,
+		1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 329, 8, 31, 1, 32, 1, 32, 1, 32, 1,
+		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1,
+		32, 3, 32, 345, 8, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 369, 8, 33, 1, 34, 1, 34, 1, 35,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 380, 8, 35, 1, 36, 1,
+		36, 1, 36, 1, 36, 3, 36, 386, 8, 36, 1, 37, 1, 37, 1, 37, 5, 37, 391, 8,
+		37, 10, 37, 12, 37, 394, 9, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 3, 38, 424, 8, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
 		1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1,
-		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 3, 39, 496, 8, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1,
-		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40,
-		1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
-		40, 1, 40, 1, 40, 3, 40, 526, 8, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
+		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 460,
+		8, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 496, 8, 40, 1, 41, 1, 41, 1, 41,
 		1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
-		1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 3,
-		41, 564, 8, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
+		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
+		41, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 526, 8, 41, 1, 42, 1, 42, 1, 42,
 		1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
-		42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
-		1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 602, 8, 42, 1,
-		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43,
+		42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
+		42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
+		42, 1, 42, 1, 42, 3, 42, 564, 8, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43,
 		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
-		43, 1, 43, 1, 43, 3, 43, 628, 8, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
+		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		43, 3, 43, 602, 8, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
 		1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
-		1, 44, 3, 44, 657, 8, 44, 1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 663, 8, 45,
-		1, 46, 1, 46, 3, 46, 667, 8, 46, 1, 47, 1, 47, 1, 47, 5, 47, 672, 8, 47,
-		10, 47, 12, 47, 675, 9, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
-		49, 3, 49, 684, 8, 49, 1, 49, 1, 49, 3, 49, 688, 8, 49, 1, 49, 1, 49, 1,
-		49, 3, 49, 693, 8, 49, 1, 49, 3, 49, 696, 8, 49, 1, 50, 1, 50, 3, 50, 700,
-		8, 50, 1, 50, 1, 50, 1, 50, 3, 50, 705, 8, 50, 1, 50, 1, 50, 4, 50, 709,
-		8, 50, 11, 50, 12, 50, 710, 1, 51, 1, 51, 1, 51, 3, 51, 716, 8, 51, 1,
-		52, 4, 52, 719, 8, 52, 11, 52, 12, 52, 720, 1, 53, 4, 53, 724, 8, 53, 11,
-		53, 12, 53, 725, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 3, 54,
-		735, 8, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 744,
-		8, 55, 1, 56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 4, 58, 753, 8,
-		58, 11, 58, 12, 58, 754, 1, 59, 1, 59, 5, 59, 759, 8, 59, 10, 59, 12, 59,
-		762, 9, 59, 1, 59, 3, 59, 765, 8, 59, 1, 60, 1, 60, 5, 60, 769, 8, 60,
-		10, 60, 12, 60, 772, 9, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1,
-		63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66,
-		1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 3, 66, 799, 8,
-		66, 1, 67, 1, 67, 3, 67, 803, 8, 67, 1, 67, 1, 67, 1, 67, 3, 67, 808, 8,
-		67, 1, 68, 1, 68, 1, 68, 1, 68, 3, 68, 814, 8, 68, 1, 68, 1, 68, 1, 69,
-		3, 69, 819, 8, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 826, 8, 69,
-		1, 70, 1, 70, 3, 70, 830, 8, 70, 1, 70, 1, 70, 1, 71, 4, 71, 835, 8, 71,
-		11, 71, 12, 71, 836, 1, 72, 3, 72, 840, 8, 72, 1, 72, 1, 72, 1, 72, 1,
-		72, 1, 72, 3, 72, 847, 8, 72, 1, 73, 4, 73, 850, 8, 73, 11, 73, 12, 73,
-		851, 1, 74, 1, 74, 3, 74, 856, 8, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75,
-		1, 75, 1, 75, 3, 75, 865, 8, 75, 1, 75, 3, 75, 868, 8, 75, 1, 75, 1, 75,
-		1, 75, 1, 75, 1, 75, 3, 75, 875, 8, 75, 1, 76, 4, 76, 878, 8, 76, 11, 76,
-		12, 76, 879, 1, 76, 1, 76, 1, 77, 1, 77, 3, 77, 886, 8, 77, 1, 77, 3, 77,
-		889, 8, 77, 1, 77, 1, 77, 0, 0, 78, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6,
-		13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31,
-		16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49,
-		25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67,
-		34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85,
-		43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103,
-		0, 105, 0, 107, 0, 109, 0, 111, 0, 113, 0, 115, 0, 117, 0, 119, 0, 121,
-		0, 123, 0, 125, 0, 127, 0, 129, 0, 131, 0, 133, 0, 135, 0, 137, 0, 139,
-		0, 141, 0, 143, 0, 145, 0, 147, 0, 149, 0, 151, 0, 153, 52, 155, 53, 1,
-		0, 16, 3, 0, 76, 76, 85, 85, 117, 117, 4, 0, 10, 10, 13, 13, 34, 34, 92,
-		92, 4, 0, 10, 10, 13, 13, 39, 39, 92, 92, 3, 0, 65, 90, 95, 95, 97, 122,
-		1, 0, 48, 57, 2, 0, 66, 66, 98, 98, 1, 0, 48, 49, 2, 0, 88, 88, 120, 120,
-		1, 0, 49, 57, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70, 97, 102, 2, 0, 69, 69,
-		101, 101, 2, 0, 43, 43, 45, 45, 2, 0, 80, 80, 112, 112, 10, 0, 34, 34,
-		39, 39, 63, 63, 92, 92, 97, 98, 102, 102, 110, 110, 114, 114, 116, 116,
-		118, 118, 2, 0, 9, 9, 32, 32, 940, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0,
-		0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0,
-		0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0,
-		0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0,
-		0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1,
-		0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43,
-		1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0,
-		51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0,
-		0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0,
-		0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0,
-		0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1,
-		0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89,
-		1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0,
-		97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 153, 1, 0, 0,
-		0, 0, 155, 1, 0, 0, 0, 1, 157, 1, 0, 0, 0, 3, 159, 1, 0, 0, 0, 5, 161,
-		1, 0, 0, 0, 7, 163, 1, 0, 0, 0, 9, 165, 1, 0, 0, 0, 11, 167, 1, 0, 0, 0,
-		13, 169, 1, 0, 0, 0, 15, 171, 1, 0, 0, 0, 17, 173, 1, 0, 0, 0, 19, 176,
-		1, 0, 0, 0, 21, 178, 1, 0, 0, 0, 23, 181, 1, 0, 0, 0, 25, 184, 1, 0, 0,
-		0, 27, 195, 1, 0, 0, 0, 29, 209, 1, 0, 0, 0, 31, 231, 1, 0, 0, 0, 33, 257,
-		1, 0, 0, 0, 35, 285, 1, 0, 0, 0, 37, 287, 1, 0, 0, 0, 39, 289, 1, 0, 0,
-		0, 41, 291, 1, 0, 0, 0, 43, 293, 1, 0, 0, 0, 45, 295, 1, 0, 0, 0, 47, 297,
-		1, 0, 0, 0, 49, 300, 1, 0, 0, 0, 51, 303, 1, 0, 0, 0, 53, 306, 1, 0, 0,
-		0, 55, 308, 1, 0, 0, 0, 57, 310, 1, 0, 0, 0, 59, 320, 1, 0, 0, 0, 61, 328,
-		1, 0, 0, 0, 63, 344, 1, 0, 0, 0, 65, 368, 1, 0, 0, 0, 67, 370, 1, 0, 0,
-		0, 69, 379, 1, 0, 0, 0, 71, 385, 1, 0, 0, 0, 73, 387, 1, 0, 0, 0, 75, 423,
-		1, 0, 0, 0, 77, 459, 1, 0, 0, 0, 79, 495, 1, 0, 0, 0, 81, 525, 1, 0, 0,
-		0, 83, 563, 1, 0, 0, 0, 85, 601, 1, 0, 0, 0, 87, 627, 1, 0, 0, 0, 89, 656,
-		1, 0, 0, 0, 91, 662, 1, 0, 0, 0, 93, 666, 1, 0, 0, 0, 95, 668, 1, 0, 0,
-		0, 97, 676, 1, 0, 0, 0, 99, 683, 1, 0, 0, 0, 101, 699, 1, 0, 0, 0, 103,
+		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 628, 8, 44, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1,
+		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1,
+		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 3, 47, 657, 8, 47, 1, 48, 1, 48,
+		1, 48, 1, 48, 3, 48, 663, 8, 48, 1, 49, 1, 49, 3, 49, 667, 8, 49, 1, 50,
+		1, 50, 1, 50, 5, 50, 672, 8, 50, 10, 50, 12, 50, 675, 9, 50, 1, 51, 1,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 3, 52, 684, 8, 52, 1, 52, 1, 52,
+		3, 52, 688, 8, 52, 1, 52, 1, 52, 1, 52, 3, 52, 693, 8, 52, 1, 52, 3, 52,
+		696, 8, 52, 1, 53, 1, 53, 3, 53, 700, 8, 53, 1, 53, 1, 53, 1, 53, 3, 53,
+		705, 8, 53, 1, 53, 1, 53, 4, 53, 709, 8, 53, 11, 53, 12, 53, 710, 1, 54,
+		1, 54, 1, 54, 3, 54, 716, 8, 54, 1, 55, 4, 55, 719, 8, 55, 11, 55, 12,
+		55, 720, 1, 56, 4, 56, 724, 8, 56, 11, 56, 12, 56, 725, 1, 57, 1, 57, 1,
+		57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 735, 8, 57, 1, 58, 1, 58, 1, 58,
+		1, 58, 1, 58, 1, 58, 1, 58, 3, 58, 744, 8, 58, 1, 59, 1, 59, 1, 60, 1,
+		60, 1, 61, 1, 61, 1, 61, 4, 61, 753, 8, 61, 11, 61, 12, 61, 754, 1, 62,
+		1, 62, 5, 62, 759, 8, 62, 10, 62, 12, 62, 762, 9, 62, 1, 62, 3, 62, 765,
+		8, 62, 1, 63, 1, 63, 5, 63, 769, 8, 63, 10, 63, 12, 63, 772, 9, 63, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 67, 1, 67, 1,
+		68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1,
+		69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 799, 8, 69, 1, 70, 1, 70, 3, 70,
+		803, 8, 70, 1, 70, 1, 70, 1, 70, 3, 70, 808, 8, 70, 1, 71, 1, 71, 1, 71,
+		1, 71, 3, 71, 814, 8, 71, 1, 71, 1, 71, 1, 72, 3, 72, 819, 8, 72, 1, 72,
+		1, 72, 1, 72, 1, 72, 1, 72, 3, 72, 826, 8, 72, 1, 73, 1, 73, 3, 73, 830,
+		8, 73, 1, 73, 1, 73, 1, 74, 4, 74, 835, 8, 74, 11, 74, 12, 74, 836, 1,
+		75, 3, 75, 840, 8, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 3, 75, 847, 8,
+		75, 1, 76, 4, 76, 850, 8, 76, 11, 76, 12, 76, 851, 1, 77, 1, 77, 3, 77,
+		856, 8, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 3, 78, 865,
+		8, 78, 1, 78, 3, 78, 868, 8, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 3,
+		78, 875, 8, 78, 1, 79, 4, 79, 878, 8, 79, 11, 79, 12, 79, 879, 1, 79, 1,
+		79, 1, 80, 1, 80, 3, 80, 886, 8, 80, 1, 80, 3, 80, 889, 8, 80, 1, 80, 1,
+		80, 2, 45, 7, 45, 3, 45, 895, 8, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 2, 46, 7, 46, 3, 46, 907, 8, 46, 1, 46, 1, 46, 1,
+		46, 1, 46, 2, 5, 7, 5, 1, 5, 1, 5, 1, 5, 0, 0, 81, 1, 1, 3, 2, 5, 3, 7,
+		4, 9, 5, 912, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13,
+		25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22,
+		43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31,
+		61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40,
+		79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 892, 46, 904, 47, 89, 48, 91,
+		49, 93, 50, 95, 51, 97, 52, 99, 53, 101, 54, 103, 0, 105, 0, 107, 0,
+		109, 0, 111, 0, 113, 0, 115, 0, 117, 0, 119, 0, 121, 0, 123, 0, 125, 0,
+		127, 0, 129, 0, 131, 0, 133, 0, 135, 0, 137, 0, 139, 0, 141, 0, 143, 0,
+		145, 0, 147, 0, 149, 0, 151, 0, 153, 55, 155, 56, 1, 0, 16, 3, 0, 76,
+		76, 85, 85, 117, 117, 4, 0, 10, 10, 13, 13, 34, 34, 92, 92, 4, 0, 10,
+		10, 13, 13, 39, 39, 92, 92, 3, 0, 65, 90, 95, 95, 97, 122, 1, 0, 48, 57,
+		2, 0, 66, 66, 98, 98, 1, 0, 48, 49, 2, 0, 88, 88, 120, 120, 1, 0, 49,
+		57, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70, 97, 102, 2, 0, 69, 69, 101, 101,
+		2, 0, 43, 43, 45, 45, 2, 0, 80, 80, 112, 112, 10, 0, 34, 34, 39, 39, 63,
+		63, 92, 92, 97, 98, 102, 102, 110, 110, 114, 114, 116, 116, 118, 118, 2,
+		0, 9, 9, 32, 32, 967, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0,
+		0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 912, 1, 0, 0, 0, 0, 11, 1, 0,
+		0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1,
+		0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27,
+		1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0,
+		35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0,
+		0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0,
+		0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0,
+		0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1,
+		0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73,
+		1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0,
+		81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0,
+		0, 892, 1, 0, 0, 0, 0, 904, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0,
+		0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1,
+		0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 1,
+		157, 1, 0, 0, 0, 3, 159, 1, 0, 0, 0, 5, 161, 1, 0, 0, 0, 7, 163, 1, 0,
+		0, 0, 9, 165, 1, 0, 0, 0, 11, 167, 1, 0, 0, 0, 13, 169, 1, 0, 0, 0, 15,
+		171, 1, 0, 0, 0, 17, 173, 1, 0, 0, 0, 19, 176, 1, 0, 0, 0, 21, 178, 1,
+		0, 0, 0, 23, 181, 1, 0, 0, 0, 25, 184, 1, 0, 0, 0, 27, 195, 1, 0, 0, 0,
+		29, 209, 1, 0, 0, 0, 31, 231, 1, 0, 0, 0, 33, 257, 1, 0, 0, 0, 35, 285,
+		1, 0, 0, 0, 37, 287, 1, 0, 0, 0, 39, 289, 1, 0, 0, 0, 41, 291, 1, 0, 0,
+		0, 43, 293, 1, 0, 0, 0, 45, 295, 1, 0, 0, 0, 47, 297, 1, 0, 0, 0, 49,
+		300, 1, 0, 0, 0, 51, 303, 1, 0, 0, 0, 53, 306, 1, 0, 0, 0, 55, 308, 1,
+		0, 0, 0, 57, 310, 1, 0, 0, 0, 59, 320, 1, 0, 0, 0, 61, 328, 1, 0, 0, 0,
+		63, 344, 1, 0, 0, 0, 65, 368, 1, 0, 0, 0, 67, 370, 1, 0, 0, 0, 69, 379,
+		1, 0, 0, 0, 71, 385, 1, 0, 0, 0, 73, 387, 1, 0, 0, 0, 75, 423, 1, 0, 0,
+		0, 77, 459, 1, 0, 0, 0, 79, 495, 1, 0, 0, 0, 81, 525, 1, 0, 0, 0, 83,
+		563, 1, 0, 0, 0, 85, 601, 1, 0, 0, 0, 87, 627, 1, 0, 0, 0, 89, 656, 1,
+		0, 0, 0, 91, 662, 1, 0, 0, 0, 93, 666, 1, 0, 0, 0, 95, 668, 1, 0, 0, 0,
+		97, 676, 1, 0, 0, 0, 99, 683, 1, 0, 0, 0, 101, 699, 1, 0, 0, 0, 103,
 		715, 1, 0, 0, 0, 105, 718, 1, 0, 0, 0, 107, 723, 1, 0, 0, 0, 109, 734,
 		1, 0, 0, 0, 111, 743, 1, 0, 0, 0, 113, 745, 1, 0, 0, 0, 115, 747, 1, 0,
 		0, 0, 117, 749, 1, 0, 0, 0, 119, 764, 1, 0, 0, 0, 121, 766, 1, 0, 0, 0,
@@ -226,99 +237,101 @@ func planlexerLexerInit() {
 		0, 0, 173, 174, 5, 60, 0, 0, 174, 175, 5, 61, 0, 0, 175, 18, 1, 0, 0, 0,
 		176, 177, 5, 62, 0, 0, 177, 20, 1, 0, 0, 0, 178, 179, 5, 62, 0, 0, 179,
 		180, 5, 61, 0, 0, 180, 22, 1, 0, 0, 0, 181, 182, 5, 61, 0, 0, 182, 183,
-		5, 61, 0, 0, 183, 24, 1, 0, 0, 0, 184, 185, 5, 33, 0, 0, 185, 186, 5, 61,
-		0, 0, 186, 26, 1, 0, 0, 0, 187, 188, 5, 108, 0, 0, 188, 189, 5, 105, 0,
-		0, 189, 190, 5, 107, 0, 0, 190, 196, 5, 101, 0, 0, 191, 192, 5, 76, 0,
-		0, 192, 193, 5, 73, 0, 0, 193, 194, 5, 75, 0, 0, 194, 196, 5, 69, 0, 0,
-		195, 187, 1, 0, 0, 0, 195, 191, 1, 0, 0, 0, 196, 28, 1, 0, 0, 0, 197, 198,
-		5, 101, 0, 0, 198, 199, 5, 120, 0, 0, 199, 200, 5, 105, 0, 0, 200, 201,
-		5, 115, 0, 0, 201, 202, 5, 116, 0, 0, 202, 210, 5, 115, 0, 0, 203, 204,
-		5, 69, 0, 0, 204, 205, 5, 88, 0, 0, 205, 206, 5, 73, 0, 0, 206, 207, 5,
-		83, 0, 0, 207, 208, 5, 84, 0, 0, 208, 210, 5, 83, 0, 0, 209, 197, 1, 0,
-		0, 0, 209, 203, 1, 0, 0, 0, 210, 30, 1, 0, 0, 0, 211, 212, 5, 116, 0, 0,
-		212, 213, 5, 101, 0, 0, 213, 214, 5, 120, 0, 0, 214, 215, 5, 116, 0, 0,
-		215, 216, 5, 95, 0, 0, 216, 217, 5, 109, 0, 0, 217, 218, 5, 97, 0, 0, 218,
-		219, 5, 116, 0, 0, 219, 220, 5, 99, 0, 0, 220, 232, 5, 104, 0, 0, 221,
-		222, 5, 84, 0, 0, 222, 223, 5, 69, 0, 0, 223, 224, 5, 88, 0, 0, 224, 225,
-		5, 84, 0, 0, 225, 226, 5, 95, 0, 0, 226, 227, 5, 77, 0, 0, 227, 228, 5,
-		65, 0, 0, 228, 229, 5, 84, 0, 0, 229, 230, 5, 67, 0, 0, 230, 232, 5, 72,
-		0, 0, 231, 211, 1, 0, 0, 0, 231, 221, 1, 0, 0, 0, 232, 32, 1, 0, 0, 0,
-		233, 234, 5, 112, 0, 0, 234, 235, 5, 104, 0, 0, 235, 236, 5, 114, 0, 0,
-		236, 237, 5, 97, 0, 0, 237, 238, 5, 115, 0, 0, 238, 239, 5, 101, 0, 0,
-		239, 240, 5, 95, 0, 0, 240, 241, 5, 109, 0, 0, 241, 242, 5, 97, 0, 0, 242,
-		243, 5, 116, 0, 0, 243, 244, 5, 99, 0, 0, 244, 258, 5, 104, 0, 0, 245,
-		246, 5, 80, 0, 0, 246, 247, 5, 72, 0, 0, 247, 248, 5, 82, 0, 0, 248, 249,
-		5, 65, 0, 0, 249, 250, 5, 83, 0, 0, 250, 251, 5, 69, 0, 0, 251, 252, 5,
-		95, 0, 0, 252, 253, 5, 77, 0, 0, 253, 254, 5, 65, 0, 0, 254, 255, 5, 84,
-		0, 0, 255, 256, 5, 67, 0, 0, 256, 258, 5, 72, 0, 0, 257, 233, 1, 0, 0,
-		0, 257, 245, 1, 0, 0, 0, 258, 34, 1, 0, 0, 0, 259, 260, 5, 114, 0, 0, 260,
-		261, 5, 97, 0, 0, 261, 262, 5, 110, 0, 0, 262, 263, 5, 100, 0, 0, 263,
-		264, 5, 111, 0, 0, 264, 265, 5, 109, 0, 0, 265, 266, 5, 95, 0, 0, 266,
-		267, 5, 115, 0, 0, 267, 268, 5, 97, 0, 0, 268, 269, 5, 109, 0, 0, 269,
-		270, 5, 112, 0, 0, 270, 271, 5, 108, 0, 0, 271, 286, 5, 101, 0, 0, 272,
-		273, 5, 82, 0, 0, 273, 274, 5, 65, 0, 0, 274, 275, 5, 78, 0, 0, 275, 276,
-		5, 68, 0, 0, 276, 277, 5, 79, 0, 0, 277, 278, 5, 77, 0, 0, 278, 279, 5,
-		95, 0, 0, 279, 280, 5, 83, 0, 0, 280, 281, 5, 65, 0, 0, 281, 282, 5, 77,
-		0, 0, 282, 283, 5, 80, 0, 0, 283, 284, 5, 76, 0, 0, 284, 286, 5, 69, 0,
-		0, 285, 259, 1, 0, 0, 0, 285, 272, 1, 0, 0, 0, 286, 36, 1, 0, 0, 0, 287,
-		288, 5, 43, 0, 0, 288, 38, 1, 0, 0, 0, 289, 290, 5, 45, 0, 0, 290, 40,
-		1, 0, 0, 0, 291, 292, 5, 42, 0, 0, 292, 42, 1, 0, 0, 0, 293, 294, 5, 47,
-		0, 0, 294, 44, 1, 0, 0, 0, 295, 296, 5, 37, 0, 0, 296, 46, 1, 0, 0, 0,
-		297, 298, 5, 42, 0, 0, 298, 299, 5, 42, 0, 0, 299, 48, 1, 0, 0, 0, 300,
-		301, 5, 60, 0, 0, 301, 302, 5, 60, 0, 0, 302, 50, 1, 0, 0, 0, 303, 304,
-		5, 62, 0, 0, 304, 305, 5, 62, 0, 0, 305, 52, 1, 0, 0, 0, 306, 307, 5, 38,
-		0, 0, 307, 54, 1, 0, 0, 0, 308, 309, 5, 124, 0, 0, 309, 56, 1, 0, 0, 0,
-		310, 311, 5, 94, 0, 0, 311, 58, 1, 0, 0, 0, 312, 313, 5, 38, 0, 0, 313,
-		321, 5, 38, 0, 0, 314, 315, 5, 97, 0, 0, 315, 316, 5, 110, 0, 0, 316, 321,
-		5, 100, 0, 0, 317, 318, 5, 65, 0, 0, 318, 319, 5, 78, 0, 0, 319, 321, 5,
-		68, 0, 0, 320, 312, 1, 0, 0, 0, 320, 314, 1, 0, 0, 0, 320, 317, 1, 0, 0,
-		0, 321, 60, 1, 0, 0, 0, 322, 323, 5, 124, 0, 0, 323, 329, 5, 124, 0, 0,
-		324, 325, 5, 111, 0, 0, 325, 329, 5, 114, 0, 0, 326, 327, 5, 79, 0, 0,
-		327, 329, 5, 82, 0, 0, 328, 322, 1, 0, 0, 0, 328, 324, 1, 0, 0, 0, 328,
-		326, 1, 0, 0, 0, 329, 62, 1, 0, 0, 0, 330, 331, 5, 105, 0, 0, 331, 332,
-		5, 115, 0, 0, 332, 333, 5, 32, 0, 0, 333, 334, 5, 110, 0, 0, 334, 335,
-		5, 117, 0, 0, 335, 336, 5, 108, 0, 0, 336, 345, 5, 108, 0, 0, 337, 338,
-		5, 73, 0, 0, 338, 339, 5, 83, 0, 0, 339, 340, 5, 32, 0, 0, 340, 341, 5,
-		78, 0, 0, 341, 342, 5, 85, 0, 0, 342, 343, 5, 76, 0, 0, 343, 345, 5, 76,
-		0, 0, 344, 330, 1, 0, 0, 0, 344, 337, 1, 0, 0, 0, 345, 64, 1, 0, 0, 0,
-		346, 347, 5, 105, 0, 0, 347, 348, 5, 115, 0, 0, 348, 349, 5, 32, 0, 0,
-		349, 350, 5, 110, 0, 0, 350, 351, 5, 111, 0, 0, 351, 352, 5, 116, 0, 0,
-		352, 353, 5, 32, 0, 0, 353, 354, 5, 110, 0, 0, 354, 355, 5, 117, 0, 0,
-		355, 356, 5, 108, 0, 0, 356, 369, 5, 108, 0, 0, 357, 358, 5, 73, 0, 0,
-		358, 359, 5, 83, 0, 0, 359, 360, 5, 32, 0, 0, 360, 361, 5, 78, 0, 0, 361,
-		362, 5, 79, 0, 0, 362, 363, 5, 84, 0, 0, 363, 364, 5, 32, 0, 0, 364, 365,
-		5, 78, 0, 0, 365, 366, 5, 85, 0, 0, 366, 367, 5, 76, 0, 0, 367, 369, 5,
-		76, 0, 0, 368, 346, 1, 0, 0, 0, 368, 357, 1, 0, 0, 0, 369, 66, 1, 0, 0,
-		0, 370, 371, 5, 126, 0, 0, 371, 68, 1, 0, 0, 0, 372, 380, 5, 33, 0, 0,
-		373, 374, 5, 110, 0, 0, 374, 375, 5, 111, 0, 0, 375, 380, 5, 116, 0, 0,
-		376, 377, 5, 78, 0, 0, 377, 378, 5, 79, 0, 0, 378, 380, 5, 84, 0, 0, 379,
-		372, 1, 0, 0, 0, 379, 373, 1, 0, 0, 0, 379, 376, 1, 0, 0, 0, 380, 70, 1,
-		0, 0, 0, 381, 382, 5, 105, 0, 0, 382, 386, 5, 110, 0, 0, 383, 384, 5, 73,
-		0, 0, 384, 386, 5, 78, 0, 0, 385, 381, 1, 0, 0, 0, 385, 383, 1, 0, 0, 0,
-		386, 72, 1, 0, 0, 0, 387, 392, 5, 91, 0, 0, 388, 391, 3, 153, 76, 0, 389,
-		391, 3, 155, 77, 0, 390, 388, 1, 0, 0, 0, 390, 389, 1, 0, 0, 0, 391, 394,
-		1, 0, 0, 0, 392, 390, 1, 0, 0, 0, 392, 393, 1, 0, 0, 0, 393, 395, 1, 0,
-		0, 0, 394, 392, 1, 0, 0, 0, 395, 396, 5, 93, 0, 0, 396, 74, 1, 0, 0, 0,
-		397, 398, 5, 106, 0, 0, 398, 399, 5, 115, 0, 0, 399, 400, 5, 111, 0, 0,
-		400, 401, 5, 110, 0, 0, 401, 402, 5, 95, 0, 0, 402, 403, 5, 99, 0, 0, 403,
-		404, 5, 111, 0, 0, 404, 405, 5, 110, 0, 0, 405, 406, 5, 116, 0, 0, 406,
-		407, 5, 97, 0, 0, 407, 408, 5, 105, 0, 0, 408, 409, 5, 110, 0, 0, 409,
-		424, 5, 115, 0, 0, 410, 411, 5, 74, 0, 0, 411, 412, 5, 83, 0, 0, 412, 413,
-		5, 79, 0, 0, 413, 414, 5, 78, 0, 0, 414, 415, 5, 95, 0, 0, 415, 416, 5,
-		67, 0, 0, 416, 417, 5, 79, 0, 0, 417, 418, 5, 78, 0, 0, 418, 419, 5, 84,
-		0, 0, 419, 420, 5, 65, 0, 0, 420, 421, 5, 73, 0, 0, 421, 422, 5, 78, 0,
-		0, 422, 424, 5, 83, 0, 0, 423, 397, 1, 0, 0, 0, 423, 410, 1, 0, 0, 0, 424,
-		76, 1, 0, 0, 0, 425, 426, 5, 106, 0, 0, 426, 427, 5, 115, 0, 0, 427, 428,
-		5, 111, 0, 0, 428, 429, 5, 110, 0, 0, 429, 430, 5, 95, 0, 0, 430, 431,
-		5, 99, 0, 0, 431, 432, 5, 111, 0, 0, 432, 433, 5, 110, 0, 0, 433, 434,
-		5, 116, 0, 0, 434, 435, 5, 97, 0, 0, 435, 436, 5, 105, 0, 0, 436, 437,
-		5, 110, 0, 0, 437, 438, 5, 115, 0, 0, 438, 439, 5, 95, 0, 0, 439, 440,
-		5, 97, 0, 0, 440, 441, 5, 108, 0, 0, 441, 460, 5, 108, 0, 0, 442, 443,
-		5, 74, 0, 0, 443, 444, 5, 83, 0, 0, 444, 445, 5, 79, 0, 0, 445, 446, 5,
-		78, 0, 0, 446, 447, 5, 95, 0, 0, 447, 448, 5, 67, 0, 0, 448, 449, 5, 79,
-		0, 0, 449, 450, 5, 78, 0, 0, 450, 451, 5, 84, 0, 0, 451, 452, 5, 65, 0,
-		0, 452, 453, 5, 73, 0, 0, 453, 454, 5, 78, 0, 0, 454, 455, 5, 83, 0, 0,
-		455, 456, 5, 95, 0, 0, 456, 457, 5, 65, 0, 0, 457, 458, 5, 76, 0, 0, 458,
+		5, 61, 0, 0, 183, 24, 1, 0, 0, 0, 184, 185, 5, 33, 0, 0, 185, 186, 5,
+		61, 0, 0, 186, 26, 1, 0, 0, 0, 187, 188, 5, 108, 0, 0, 188, 189, 5, 105,
+		0, 0, 189, 190, 5, 107, 0, 0, 190, 196, 5, 101, 0, 0, 191, 192, 5, 76,
+		0, 0, 192, 193, 5, 73, 0, 0, 193, 194, 5, 75, 0, 0, 194, 196, 5, 69, 0,
+		0, 195, 187, 1, 0, 0, 0, 195, 191, 1, 0, 0, 0, 196, 28, 1, 0, 0, 0, 197,
+		198, 5, 101, 0, 0, 198, 199, 5, 120, 0, 0, 199, 200, 5, 105, 0, 0, 200,
+		201, 5, 115, 0, 0, 201, 202, 5, 116, 0, 0, 202, 210, 5, 115, 0, 0, 203,
+		204, 5, 69, 0, 0, 204, 205, 5, 88, 0, 0, 205, 206, 5, 73, 0, 0, 206,
+		207, 5, 83, 0, 0, 207, 208, 5, 84, 0, 0, 208, 210, 5, 83, 0, 0, 209,
+		197, 1, 0, 0, 0, 209, 203, 1, 0, 0, 0, 210, 30, 1, 0, 0, 0, 211, 212, 5,
+		116, 0, 0, 212, 213, 5, 101, 0, 0, 213, 214, 5, 120, 0, 0, 214, 215, 5,
+		116, 0, 0, 215, 216, 5, 95, 0, 0, 216, 217, 5, 109, 0, 0, 217, 218, 5,
+		97, 0, 0, 218, 219, 5, 116, 0, 0, 219, 220, 5, 99, 0, 0, 220, 232, 5,
+		104, 0, 0, 221, 222, 5, 84, 0, 0, 222, 223, 5, 69, 0, 0, 223, 224, 5,
+		88, 0, 0, 224, 225, 5, 84, 0, 0, 225, 226, 5, 95, 0, 0, 226, 227, 5, 77,
+		0, 0, 227, 228, 5, 65, 0, 0, 228, 229, 5, 84, 0, 0, 229, 230, 5, 67, 0,
+		0, 230, 232, 5, 72, 0, 0, 231, 211, 1, 0, 0, 0, 231, 221, 1, 0, 0, 0,
+		232, 32, 1, 0, 0, 0, 233, 234, 5, 112, 0, 0, 234, 235, 5, 104, 0, 0,
+		235, 236, 5, 114, 0, 0, 236, 237, 5, 97, 0, 0, 237, 238, 5, 115, 0, 0,
+		238, 239, 5, 101, 0, 0, 239, 240, 5, 95, 0, 0, 240, 241, 5, 109, 0, 0,
+		241, 242, 5, 97, 0, 0, 242, 243, 5, 116, 0, 0, 243, 244, 5, 99, 0, 0,
+		244, 258, 5, 104, 0, 0, 245, 246, 5, 80, 0, 0, 246, 247, 5, 72, 0, 0,
+		247, 248, 5, 82, 0, 0, 248, 249, 5, 65, 0, 0, 249, 250, 5, 83, 0, 0,
+		250, 251, 5, 69, 0, 0, 251, 252, 5, 95, 0, 0, 252, 253, 5, 77, 0, 0,
+		253, 254, 5, 65, 0, 0, 254, 255, 5, 84, 0, 0, 255, 256, 5, 67, 0, 0,
+		256, 258, 5, 72, 0, 0, 257, 233, 1, 0, 0, 0, 257, 245, 1, 0, 0, 0, 258,
+		34, 1, 0, 0, 0, 259, 260, 5, 114, 0, 0, 260, 261, 5, 97, 0, 0, 261, 262,
+		5, 110, 0, 0, 262, 263, 5, 100, 0, 0, 263, 264, 5, 111, 0, 0, 264, 265,
+		5, 109, 0, 0, 265, 266, 5, 95, 0, 0, 266, 267, 5, 115, 0, 0, 267, 268,
+		5, 97, 0, 0, 268, 269, 5, 109, 0, 0, 269, 270, 5, 112, 0, 0, 270, 271,
+		5, 108, 0, 0, 271, 286, 5, 101, 0, 0, 272, 273, 5, 82, 0, 0, 273, 274,
+		5, 65, 0, 0, 274, 275, 5, 78, 0, 0, 275, 276, 5, 68, 0, 0, 276, 277, 5,
+		79, 0, 0, 277, 278, 5, 77, 0, 0, 278, 279, 5, 95, 0, 0, 279, 280, 5, 83,
+		0, 0, 280, 281, 5, 65, 0, 0, 281, 282, 5, 77, 0, 0, 282, 283, 5, 80, 0,
+		0, 283, 284, 5, 76, 0, 0, 284, 286, 5, 69, 0, 0, 285, 259, 1, 0, 0, 0,
+		285, 272, 1, 0, 0, 0, 286, 36, 1, 0, 0, 0, 287, 288, 5, 43, 0, 0, 288,
+		38, 1, 0, 0, 0, 289, 290, 5, 45, 0, 0, 290, 40, 1, 0, 0, 0, 291, 292, 5,
+		42, 0, 0, 292, 42, 1, 0, 0, 0, 293, 294, 5, 47, 0, 0, 294, 44, 1, 0, 0,
+		0, 295, 296, 5, 37, 0, 0, 296, 46, 1, 0, 0, 0, 297, 298, 5, 42, 0, 0,
+		298, 299, 5, 42, 0, 0, 299, 48, 1, 0, 0, 0, 300, 301, 5, 60, 0, 0, 301,
+		302, 5, 60, 0, 0, 302, 50, 1, 0, 0, 0, 303, 304, 5, 62, 0, 0, 304, 305,
+		5, 62, 0, 0, 305, 52, 1, 0, 0, 0, 306, 307, 5, 38, 0, 0, 307, 54, 1, 0,
+		0, 0, 308, 309, 5, 124, 0, 0, 309, 56, 1, 0, 0, 0, 310, 311, 5, 94, 0,
+		0, 311, 58, 1, 0, 0, 0, 312, 313, 5, 38, 0, 0, 313, 321, 5, 38, 0, 0,
+		314, 315, 5, 97, 0, 0, 315, 316, 5, 110, 0, 0, 316, 321, 5, 100, 0, 0,
+		317, 318, 5, 65, 0, 0, 318, 319, 5, 78, 0, 0, 319, 321, 5, 68, 0, 0,
+		320, 312, 1, 0, 0, 0, 320, 314, 1, 0, 0, 0, 320, 317, 1, 0, 0, 0, 321,
+		60, 1, 0, 0, 0, 322, 323, 5, 124, 0, 0, 323, 329, 5, 124, 0, 0, 324,
+		325, 5, 111, 0, 0, 325, 329, 5, 114, 0, 0, 326, 327, 5, 79, 0, 0, 327,
+		329, 5, 82, 0, 0, 328, 322, 1, 0, 0, 0, 328, 324, 1, 0, 0, 0, 328, 326,
+		1, 0, 0, 0, 329, 62, 1, 0, 0, 0, 330, 331, 5, 105, 0, 0, 331, 332, 5,
+		115, 0, 0, 332, 333, 5, 32, 0, 0, 333, 334, 5, 110, 0, 0, 334, 335, 5,
+		117, 0, 0, 335, 336, 5, 108, 0, 0, 336, 345, 5, 108, 0, 0, 337, 338, 5,
+		73, 0, 0, 338, 339, 5, 83, 0, 0, 339, 340, 5, 32, 0, 0, 340, 341, 5, 78,
+		0, 0, 341, 342, 5, 85, 0, 0, 342, 343, 5, 76, 0, 0, 343, 345, 5, 76, 0,
+		0, 344, 330, 1, 0, 0, 0, 344, 337, 1, 0, 0, 0, 345, 64, 1, 0, 0, 0, 346,
+		347, 5, 105, 0, 0, 347, 348, 5, 115, 0, 0, 348, 349, 5, 32, 0, 0, 349,
+		350, 5, 110, 0, 0, 350, 351, 5, 111, 0, 0, 351, 352, 5, 116, 0, 0, 352,
+		353, 5, 32, 0, 0, 353, 354, 5, 110, 0, 0, 354, 355, 5, 117, 0, 0, 355,
+		356, 5, 108, 0, 0, 356, 369, 5, 108, 0, 0, 357, 358, 5, 73, 0, 0, 358,
+		359, 5, 83, 0, 0, 359, 360, 5, 32, 0, 0, 360, 361, 5, 78, 0, 0, 361,
+		362, 5, 79, 0, 0, 362, 363, 5, 84, 0, 0, 363, 364, 5, 32, 0, 0, 364,
+		365, 5, 78, 0, 0, 365, 366, 5, 85, 0, 0, 366, 367, 5, 76, 0, 0, 367,
+		369, 5, 76, 0, 0, 368, 346, 1, 0, 0, 0, 368, 357, 1, 0, 0, 0, 369, 66,
+		1, 0, 0, 0, 370, 371, 5, 126, 0, 0, 371, 68, 1, 0, 0, 0, 372, 380, 5,
+		33, 0, 0, 373, 374, 5, 110, 0, 0, 374, 375, 5, 111, 0, 0, 375, 380, 5,
+		116, 0, 0, 376, 377, 5, 78, 0, 0, 377, 378, 5, 79, 0, 0, 378, 380, 5,
+		84, 0, 0, 379, 372, 1, 0, 0, 0, 379, 373, 1, 0, 0, 0, 379, 376, 1, 0, 0,
+		0, 380, 70, 1, 0, 0, 0, 381, 382, 5, 105, 0, 0, 382, 386, 5, 110, 0, 0,
+		383, 384, 5, 73, 0, 0, 384, 386, 5, 78, 0, 0, 385, 381, 1, 0, 0, 0, 385,
+		383, 1, 0, 0, 0, 386, 72, 1, 0, 0, 0, 387, 392, 5, 91, 0, 0, 388, 391,
+		3, 153, 79, 0, 389, 391, 3, 155, 80, 0, 390, 388, 1, 0, 0, 0, 390, 389,
+		1, 0, 0, 0, 391, 394, 1, 0, 0, 0, 392, 390, 1, 0, 0, 0, 392, 393, 1, 0,
+		0, 0, 393, 395, 1, 0, 0, 0, 394, 392, 1, 0, 0, 0, 395, 396, 5, 93, 0, 0,
+		396, 74, 1, 0, 0, 0, 397, 398, 5, 106, 0, 0, 398, 399, 5, 115, 0, 0,
+		399, 400, 5, 111, 0, 0, 400, 401, 5, 110, 0, 0, 401, 402, 5, 95, 0, 0,
+		402, 403, 5, 99, 0, 0, 403, 404, 5, 111, 0, 0, 404, 405, 5, 110, 0, 0,
+		405, 406, 5, 116, 0, 0, 406, 407, 5, 97, 0, 0, 407, 408, 5, 105, 0, 0,
+		408, 409, 5, 110, 0, 0, 409, 424, 5, 115, 0, 0, 410, 411, 5, 74, 0, 0,
+		411, 412, 5, 83, 0, 0, 412, 413, 5, 79, 0, 0, 413, 414, 5, 78, 0, 0,
+		414, 415, 5, 95, 0, 0, 415, 416, 5, 67, 0, 0, 416, 417, 5, 79, 0, 0,
+		417, 418, 5, 78, 0, 0, 418, 419, 5, 84, 0, 0, 419, 420, 5, 65, 0, 0,
+		420, 421, 5, 73, 0, 0, 421, 422, 5, 78, 0, 0, 422, 424, 5, 83, 0, 0,
+		423, 397, 1, 0, 0, 0, 423, 410, 1, 0, 0, 0, 424, 76, 1, 0, 0, 0, 425,
+		426, 5, 106, 0, 0, 426, 427, 5, 115, 0, 0, 427, 428, 5, 111, 0, 0, 428,
+		429, 5, 110, 0, 0, 429, 430, 5, 95, 0, 0, 430, 431, 5, 99, 0, 0, 431,
+		432, 5, 111, 0, 0, 432, 433, 5, 110, 0, 0, 433, 434, 5, 116, 0, 0, 434,
+		435, 5, 97, 0, 0, 435, 436, 5, 105, 0, 0, 436, 437, 5, 110, 0, 0, 437,
+		438, 5, 115, 0, 0, 438, 439, 5, 95, 0, 0, 439, 440, 5, 97, 0, 0, 440,
+		441, 5, 108, 0, 0, 441, 460, 5, 108, 0, 0, 442, 443, 5, 74, 0, 0, 443,
+		444, 5, 83, 0, 0, 444, 445, 5, 79, 0, 0, 445, 446, 5, 78, 0, 0, 446,
+		447, 5, 95, 0, 0, 447, 448, 5, 67, 0, 0, 448, 449, 5, 79, 0, 0, 449,
+		450, 5, 78, 0, 0, 450, 451, 5, 84, 0, 0, 451, 452, 5, 65, 0, 0, 452,
+		453, 5, 73, 0, 0, 453, 454, 5, 78, 0, 0, 454, 455, 5, 83, 0, 0, 455,
+		456, 5, 95, 0, 0, 456, 457, 5, 65, 0, 0, 457, 458, 5, 76, 0, 0, 458,
 		460, 5, 76, 0, 0, 459, 425, 1, 0, 0, 0, 459, 442, 1, 0, 0, 0, 460, 78,
 		1, 0, 0, 0, 461, 462, 5, 106, 0, 0, 462, 463, 5, 115, 0, 0, 463, 464, 5,
 		111, 0, 0, 464, 465, 5, 110, 0, 0, 465, 466, 5, 95, 0, 0, 466, 467, 5,
@@ -329,156 +342,167 @@ func planlexerLexerInit() {
 		74, 0, 0, 479, 480, 5, 83, 0, 0, 480, 481, 5, 79, 0, 0, 481, 482, 5, 78,
 		0, 0, 482, 483, 5, 95, 0, 0, 483, 484, 5, 67, 0, 0, 484, 485, 5, 79, 0,
 		0, 485, 486, 5, 78, 0, 0, 486, 487, 5, 84, 0, 0, 487, 488, 5, 65, 0, 0,
-		488, 489, 5, 73, 0, 0, 489, 490, 5, 78, 0, 0, 490, 491, 5, 83, 0, 0, 491,
-		492, 5, 95, 0, 0, 492, 493, 5, 65, 0, 0, 493, 494, 5, 78, 0, 0, 494, 496,
-		5, 89, 0, 0, 495, 461, 1, 0, 0, 0, 495, 478, 1, 0, 0, 0, 496, 80, 1, 0,
-		0, 0, 497, 498, 5, 97, 0, 0, 498, 499, 5, 114, 0, 0, 499, 500, 5, 114,
-		0, 0, 500, 501, 5, 97, 0, 0, 501, 502, 5, 121, 0, 0, 502, 503, 5, 95, 0,
-		0, 503, 504, 5, 99, 0, 0, 504, 505, 5, 111, 0, 0, 505, 506, 5, 110, 0,
-		0, 506, 507, 5, 116, 0, 0, 507, 508, 5, 97, 0, 0, 508, 509, 5, 105, 0,
-		0, 509, 510, 5, 110, 0, 0, 510, 526, 5, 115, 0, 0, 511, 512, 5, 65, 0,
-		0, 512, 513, 5, 82, 0, 0, 513, 514, 5, 82, 0, 0, 514, 515, 5, 65, 0, 0,
-		515, 516, 5, 89, 0, 0, 516, 517, 5, 95, 0, 0, 517, 518, 5, 67, 0, 0, 518,
-		519, 5, 79, 0, 0, 519, 520, 5, 78, 0, 0, 520, 521, 5, 84, 0, 0, 521, 522,
-		5, 65, 0, 0, 522, 523, 5, 73, 0, 0, 523, 524, 5, 78, 0, 0, 524, 526, 5,
-		83, 0, 0, 525, 497, 1, 0, 0, 0, 525, 511, 1, 0, 0, 0, 526, 82, 1, 0, 0,
-		0, 527, 528, 5, 97, 0, 0, 528, 529, 5, 114, 0, 0, 529, 530, 5, 114, 0,
-		0, 530, 531, 5, 97, 0, 0, 531, 532, 5, 121, 0, 0, 532, 533, 5, 95, 0, 0,
-		533, 534, 5, 99, 0, 0, 534, 535, 5, 111, 0, 0, 535, 536, 5, 110, 0, 0,
-		536, 537, 5, 116, 0, 0, 537, 538, 5, 97, 0, 0, 538, 539, 5, 105, 0, 0,
-		539, 540, 5, 110, 0, 0, 540, 541, 5, 115, 0, 0, 541, 542, 5, 95, 0, 0,
-		542, 543, 5, 97, 0, 0, 543, 544, 5, 108, 0, 0, 544, 564, 5, 108, 0, 0,
-		545, 546, 5, 65, 0, 0, 546, 547, 5, 82, 0, 0, 547, 548, 5, 82, 0, 0, 548,
-		549, 5, 65, 0, 0, 549, 550, 5, 89, 0, 0, 550, 551, 5, 95, 0, 0, 551, 552,
-		5, 67, 0, 0, 552, 553, 5, 79, 0, 0, 553, 554, 5, 78, 0, 0, 554, 555, 5,
-		84, 0, 0, 555, 556, 5, 65, 0, 0, 556, 557, 5, 73, 0, 0, 557, 558, 5, 78,
-		0, 0, 558, 559, 5, 83, 0, 0, 559, 560, 5, 95, 0, 0, 560, 561, 5, 65, 0,
-		0, 561, 562, 5, 76, 0, 0, 562, 564, 5, 76, 0, 0, 563, 527, 1, 0, 0, 0,
-		563, 545, 1, 0, 0, 0, 564, 84, 1, 0, 0, 0, 565, 566, 5, 97, 0, 0, 566,
-		567, 5, 114, 0, 0, 567, 568, 5, 114, 0, 0, 568, 569, 5, 97, 0, 0, 569,
-		570, 5, 121, 0, 0, 570, 571, 5, 95, 0, 0, 571, 572, 5, 99, 0, 0, 572, 573,
-		5, 111, 0, 0, 573, 574, 5, 110, 0, 0, 574, 575, 5, 116, 0, 0, 575, 576,
-		5, 97, 0, 0, 576, 577, 5, 105, 0, 0, 577, 578, 5, 110, 0, 0, 578, 579,
-		5, 115, 0, 0, 579, 580, 5, 95, 0, 0, 580, 581, 5, 97, 0, 0, 581, 582, 5,
-		110, 0, 0, 582, 602, 5, 121, 0, 0, 583, 584, 5, 65, 0, 0, 584, 585, 5,
-		82, 0, 0, 585, 586, 5, 82, 0, 0, 586, 587, 5, 65, 0, 0, 587, 588, 5, 89,
-		0, 0, 588, 589, 5, 95, 0, 0, 589, 590, 5, 67, 0, 0, 590, 591, 5, 79, 0,
-		0, 591, 592, 5, 78, 0, 0, 592, 593, 5, 84, 0, 0, 593, 594, 5, 65, 0, 0,
-		594, 595, 5, 73, 0, 0, 595, 596, 5, 78, 0, 0, 596, 597, 5, 83, 0, 0, 597,
-		598, 5, 95, 0, 0, 598, 599, 5, 65, 0, 0, 599, 600, 5, 78, 0, 0, 600, 602,
-		5, 89, 0, 0, 601, 565, 1, 0, 0, 0, 601, 583, 1, 0, 0, 0, 602, 86, 1, 0,
-		0, 0, 603, 604, 5, 97, 0, 0, 604, 605, 5, 114, 0, 0, 605, 606, 5, 114,
-		0, 0, 606, 607, 5, 97, 0, 0, 607, 608, 5, 121, 0, 0, 608, 609, 5, 95, 0,
-		0, 609, 610, 5, 108, 0, 0, 610, 611, 5, 101, 0, 0, 611, 612, 5, 110, 0,
-		0, 612, 613, 5, 103, 0, 0, 613, 614, 5, 116, 0, 0, 614, 628, 5, 104, 0,
-		0, 615, 616, 5, 65, 0, 0, 616, 617, 5, 82, 0, 0, 617, 618, 5, 82, 0, 0,
-		618, 619, 5, 65, 0, 0, 619, 620, 5, 89, 0, 0, 620, 621, 5, 95, 0, 0, 621,
-		622, 5, 76, 0, 0, 622, 623, 5, 69, 0, 0, 623, 624, 5, 78, 0, 0, 624, 625,
-		5, 71, 0, 0, 625, 626, 5, 84, 0, 0, 626, 628, 5, 72, 0, 0, 627, 603, 1,
-		0, 0, 0, 627, 615, 1, 0, 0, 0, 628, 88, 1, 0, 0, 0, 629, 630, 5, 116, 0,
-		0, 630, 631, 5, 114, 0, 0, 631, 632, 5, 117, 0, 0, 632, 657, 5, 101, 0,
-		0, 633, 634, 5, 84, 0, 0, 634, 635, 5, 114, 0, 0, 635, 636, 5, 117, 0,
-		0, 636, 657, 5, 101, 0, 0, 637, 638, 5, 84, 0, 0, 638, 639, 5, 82, 0, 0,
-		639, 640, 5, 85, 0, 0, 640, 657, 5, 69, 0, 0, 641, 642, 5, 102, 0, 0, 642,
-		643, 5, 97, 0, 0, 643, 644, 5, 108, 0, 0, 644, 645, 5, 115, 0, 0, 645,
-		657, 5, 101, 0, 0, 646, 647, 5, 70, 0, 0, 647, 648, 5, 97, 0, 0, 648, 649,
-		5, 108, 0, 0, 649, 650, 5, 115, 0, 0, 650, 657, 5, 101, 0, 0, 651, 652,
-		5, 70, 0, 0, 652, 653, 5, 65, 0, 0, 653, 654, 5, 76, 0, 0, 654, 655, 5,
-		83, 0, 0, 655, 657, 5, 69, 0, 0, 656, 629, 1, 0, 0, 0, 656, 633, 1, 0,
-		0, 0, 656, 637, 1, 0, 0, 0, 656, 641, 1, 0, 0, 0, 656, 646, 1, 0, 0, 0,
-		656, 651, 1, 0, 0, 0, 657, 90, 1, 0, 0, 0, 658, 663, 3, 119, 59, 0, 659,
-		663, 3, 121, 60, 0, 660, 663, 3, 123, 61, 0, 661, 663, 3, 117, 58, 0, 662,
+		488, 489, 5, 73, 0, 0, 489, 490, 5, 78, 0, 0, 490, 491, 5, 83, 0, 0,
+		491, 492, 5, 95, 0, 0, 492, 493, 5, 65, 0, 0, 493, 494, 5, 78, 0, 0,
+		494, 496, 5, 89, 0, 0, 495, 461, 1, 0, 0, 0, 495, 478, 1, 0, 0, 0, 496,
+		80, 1, 0, 0, 0, 497, 498, 5, 97, 0, 0, 498, 499, 5, 114, 0, 0, 499, 500,
+		5, 114, 0, 0, 500, 501, 5, 97, 0, 0, 501, 502, 5, 121, 0, 0, 502, 503,
+		5, 95, 0, 0, 503, 504, 5, 99, 0, 0, 504, 505, 5, 111, 0, 0, 505, 506, 5,
+		110, 0, 0, 506, 507, 5, 116, 0, 0, 507, 508, 5, 97, 0, 0, 508, 509, 5,
+		105, 0, 0, 509, 510, 5, 110, 0, 0, 510, 526, 5, 115, 0, 0, 511, 512, 5,
+		65, 0, 0, 512, 513, 5, 82, 0, 0, 513, 514, 5, 82, 0, 0, 514, 515, 5, 65,
+		0, 0, 515, 516, 5, 89, 0, 0, 516, 517, 5, 95, 0, 0, 517, 518, 5, 67, 0,
+		0, 518, 519, 5, 79, 0, 0, 519, 520, 5, 78, 0, 0, 520, 521, 5, 84, 0, 0,
+		521, 522, 5, 65, 0, 0, 522, 523, 5, 73, 0, 0, 523, 524, 5, 78, 0, 0,
+		524, 526, 5, 83, 0, 0, 525, 497, 1, 0, 0, 0, 525, 511, 1, 0, 0, 0, 526,
+		82, 1, 0, 0, 0, 527, 528, 5, 97, 0, 0, 528, 529, 5, 114, 0, 0, 529, 530,
+		5, 114, 0, 0, 530, 531, 5, 97, 0, 0, 531, 532, 5, 121, 0, 0, 532, 533,
+		5, 95, 0, 0, 533, 534, 5, 99, 0, 0, 534, 535, 5, 111, 0, 0, 535, 536, 5,
+		110, 0, 0, 536, 537, 5, 116, 0, 0, 537, 538, 5, 97, 0, 0, 538, 539, 5,
+		105, 0, 0, 539, 540, 5, 110, 0, 0, 540, 541, 5, 115, 0, 0, 541, 542, 5,
+		95, 0, 0, 542, 543, 5, 97, 0, 0, 543, 544, 5, 108, 0, 0, 544, 564, 5,
+		108, 0, 0, 545, 546, 5, 65, 0, 0, 546, 547, 5, 82, 0, 0, 547, 548, 5,
+		82, 0, 0, 548, 549, 5, 65, 0, 0, 549, 550, 5, 89, 0, 0, 550, 551, 5, 95,
+		0, 0, 551, 552, 5, 67, 0, 0, 552, 553, 5, 79, 0, 0, 553, 554, 5, 78, 0,
+		0, 554, 555, 5, 84, 0, 0, 555, 556, 5, 65, 0, 0, 556, 557, 5, 73, 0, 0,
+		557, 558, 5, 78, 0, 0, 558, 559, 5, 83, 0, 0, 559, 560, 5, 95, 0, 0,
+		560, 561, 5, 65, 0, 0, 561, 562, 5, 76, 0, 0, 562, 564, 5, 76, 0, 0,
+		563, 527, 1, 0, 0, 0, 563, 545, 1, 0, 0, 0, 564, 84, 1, 0, 0, 0, 565,
+		566, 5, 97, 0, 0, 566, 567, 5, 114, 0, 0, 567, 568, 5, 114, 0, 0, 568,
+		569, 5, 97, 0, 0, 569, 570, 5, 121, 0, 0, 570, 571, 5, 95, 0, 0, 571,
+		572, 5, 99, 0, 0, 572, 573, 5, 111, 0, 0, 573, 574, 5, 110, 0, 0, 574,
+		575, 5, 116, 0, 0, 575, 576, 5, 97, 0, 0, 576, 577, 5, 105, 0, 0, 577,
+		578, 5, 110, 0, 0, 578, 579, 5, 115, 0, 0, 579, 580, 5, 95, 0, 0, 580,
+		581, 5, 97, 0, 0, 581, 582, 5, 110, 0, 0, 582, 602, 5, 121, 0, 0, 583,
+		584, 5, 65, 0, 0, 584, 585, 5, 82, 0, 0, 585, 586, 5, 82, 0, 0, 586,
+		587, 5, 65, 0, 0, 587, 588, 5, 89, 0, 0, 588, 589, 5, 95, 0, 0, 589,
+		590, 5, 67, 0, 0, 590, 591, 5, 79, 0, 0, 591, 592, 5, 78, 0, 0, 592,
+		593, 5, 84, 0, 0, 593, 594, 5, 65, 0, 0, 594, 595, 5, 73, 0, 0, 595,
+		596, 5, 78, 0, 0, 596, 597, 5, 83, 0, 0, 597, 598, 5, 95, 0, 0, 598,
+		599, 5, 65, 0, 0, 599, 600, 5, 78, 0, 0, 600, 602, 5, 89, 0, 0, 601,
+		565, 1, 0, 0, 0, 601, 583, 1, 0, 0, 0, 602, 86, 1, 0, 0, 0, 603, 604, 5,
+		97, 0, 0, 604, 605, 5, 114, 0, 0, 605, 606, 5, 114, 0, 0, 606, 607, 5,
+		97, 0, 0, 607, 608, 5, 121, 0, 0, 608, 609, 5, 95, 0, 0, 609, 610, 5,
+		108, 0, 0, 610, 611, 5, 101, 0, 0, 611, 612, 5, 110, 0, 0, 612, 613, 5,
+		103, 0, 0, 613, 614, 5, 116, 0, 0, 614, 628, 5, 104, 0, 0, 615, 616, 5,
+		65, 0, 0, 616, 617, 5, 82, 0, 0, 617, 618, 5, 82, 0, 0, 618, 619, 5, 65,
+		0, 0, 619, 620, 5, 89, 0, 0, 620, 621, 5, 95, 0, 0, 621, 622, 5, 76, 0,
+		0, 622, 623, 5, 69, 0, 0, 623, 624, 5, 78, 0, 0, 624, 625, 5, 71, 0, 0,
+		625, 626, 5, 84, 0, 0, 626, 628, 5, 72, 0, 0, 627, 603, 1, 0, 0, 0, 627,
+		615, 1, 0, 0, 0, 628, 88, 1, 0, 0, 0, 629, 630, 5, 116, 0, 0, 630, 631,
+		5, 114, 0, 0, 631, 632, 5, 117, 0, 0, 632, 657, 5, 101, 0, 0, 633, 634,
+		5, 84, 0, 0, 634, 635, 5, 114, 0, 0, 635, 636, 5, 117, 0, 0, 636, 657,
+		5, 101, 0, 0, 637, 638, 5, 84, 0, 0, 638, 639, 5, 82, 0, 0, 639, 640, 5,
+		85, 0, 0, 640, 657, 5, 69, 0, 0, 641, 642, 5, 102, 0, 0, 642, 643, 5,
+		97, 0, 0, 643, 644, 5, 108, 0, 0, 644, 645, 5, 115, 0, 0, 645, 657, 5,
+		101, 0, 0, 646, 647, 5, 70, 0, 0, 647, 648, 5, 97, 0, 0, 648, 649, 5,
+		108, 0, 0, 649, 650, 5, 115, 0, 0, 650, 657, 5, 101, 0, 0, 651, 652, 5,
+		70, 0, 0, 652, 653, 5, 65, 0, 0, 653, 654, 5, 76, 0, 0, 654, 655, 5, 83,
+		0, 0, 655, 657, 5, 69, 0, 0, 656, 629, 1, 0, 0, 0, 656, 633, 1, 0, 0, 0,
+		656, 637, 1, 0, 0, 0, 656, 641, 1, 0, 0, 0, 656, 646, 1, 0, 0, 0, 656,
+		651, 1, 0, 0, 0, 657, 90, 1, 0, 0, 0, 658, 663, 3, 119, 62, 0, 659, 663,
+		3, 121, 63, 0, 660, 663, 3, 123, 64, 0, 661, 663, 3, 117, 61, 0, 662,
 		658, 1, 0, 0, 0, 662, 659, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 662, 661,
-		1, 0, 0, 0, 663, 92, 1, 0, 0, 0, 664, 667, 3, 135, 67, 0, 665, 667, 3,
-		137, 68, 0, 666, 664, 1, 0, 0, 0, 666, 665, 1, 0, 0, 0, 667, 94, 1, 0,
-		0, 0, 668, 673, 3, 113, 56, 0, 669, 672, 3, 113, 56, 0, 670, 672, 3, 115,
-		57, 0, 671, 669, 1, 0, 0, 0, 671, 670, 1, 0, 0, 0, 672, 675, 1, 0, 0, 0,
-		673, 671, 1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 96, 1, 0, 0, 0, 675, 673,
-		1, 0, 0, 0, 676, 677, 5, 36, 0, 0, 677, 678, 5, 109, 0, 0, 678, 679, 5,
-		101, 0, 0, 679, 680, 5, 116, 0, 0, 680, 681, 5, 97, 0, 0, 681, 98, 1, 0,
-		0, 0, 682, 684, 3, 103, 51, 0, 683, 682, 1, 0, 0, 0, 683, 684, 1, 0, 0,
-		0, 684, 695, 1, 0, 0, 0, 685, 687, 5, 34, 0, 0, 686, 688, 3, 105, 52, 0,
-		687, 686, 1, 0, 0, 0, 687, 688, 1, 0, 0, 0, 688, 689, 1, 0, 0, 0, 689,
-		696, 5, 34, 0, 0, 690, 692, 5, 39, 0, 0, 691, 693, 3, 107, 53, 0, 692,
-		691, 1, 0, 0, 0, 692, 693, 1, 0, 0, 0, 693, 694, 1, 0, 0, 0, 694, 696,
-		5, 39, 0, 0, 695, 685, 1, 0, 0, 0, 695, 690, 1, 0, 0, 0, 696, 100, 1, 0,
-		0, 0, 697, 700, 3, 95, 47, 0, 698, 700, 3, 97, 48, 0, 699, 697, 1, 0, 0,
-		0, 699, 698, 1, 0, 0, 0, 700, 708, 1, 0, 0, 0, 701, 704, 5, 91, 0, 0, 702,
-		705, 3, 99, 49, 0, 703, 705, 3, 119, 59, 0, 704, 702, 1, 0, 0, 0, 704,
-		703, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 707, 5, 93, 0, 0, 707, 709,
-		1, 0, 0, 0, 708, 701, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 710, 708, 1, 0,
-		0, 0, 710, 711, 1, 0, 0, 0, 711, 102, 1, 0, 0, 0, 712, 713, 5, 117, 0,
-		0, 713, 716, 5, 56, 0, 0, 714, 716, 7, 0, 0, 0, 715, 712, 1, 0, 0, 0, 715,
-		714, 1, 0, 0, 0, 716, 104, 1, 0, 0, 0, 717, 719, 3, 109, 54, 0, 718, 717,
-		1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 718, 1, 0, 0, 0, 720, 721, 1, 0,
-		0, 0, 721, 106, 1, 0, 0, 0, 722, 724, 3, 111, 55, 0, 723, 722, 1, 0, 0,
-		0, 724, 725, 1, 0, 0, 0, 725, 723, 1, 0, 0, 0, 725, 726, 1, 0, 0, 0, 726,
-		108, 1, 0, 0, 0, 727, 735, 8, 1, 0, 0, 728, 735, 3, 151, 75, 0, 729, 730,
-		5, 92, 0, 0, 730, 735, 5, 10, 0, 0, 731, 732, 5, 92, 0, 0, 732, 733, 5,
-		13, 0, 0, 733, 735, 5, 10, 0, 0, 734, 727, 1, 0, 0, 0, 734, 728, 1, 0,
-		0, 0, 734, 729, 1, 0, 0, 0, 734, 731, 1, 0, 0, 0, 735, 110, 1, 0, 0, 0,
-		736, 744, 8, 2, 0, 0, 737, 744, 3, 151, 75, 0, 738, 739, 5, 92, 0, 0, 739,
-		744, 5, 10, 0, 0, 740, 741, 5, 92, 0, 0, 741, 742, 5, 13, 0, 0, 742, 744,
-		5, 10, 0, 0, 743, 736, 1, 0, 0, 0, 743, 737, 1, 0, 0, 0, 743, 738, 1, 0,
-		0, 0, 743, 740, 1, 0, 0, 0, 744, 112, 1, 0, 0, 0, 745, 746, 7, 3, 0, 0,
-		746, 114, 1, 0, 0, 0, 747, 748, 7, 4, 0, 0, 748, 116, 1, 0, 0, 0, 749,
-		750, 5, 48, 0, 0, 750, 752, 7, 5, 0, 0, 751, 753, 7, 6, 0, 0, 752, 751,
-		1, 0, 0, 0, 753, 754, 1, 0, 0, 0, 754, 752, 1, 0, 0, 0, 754, 755, 1, 0,
-		0, 0, 755, 118, 1, 0, 0, 0, 756, 760, 3, 125, 62, 0, 757, 759, 3, 115,
-		57, 0, 758, 757, 1, 0, 0, 0, 759, 762, 1, 0, 0, 0, 760, 758, 1, 0, 0, 0,
-		760, 761, 1, 0, 0, 0, 761, 765, 1, 0, 0, 0, 762, 760, 1, 0, 0, 0, 763,
-		765, 5, 48, 0, 0, 764, 756, 1, 0, 0, 0, 764, 763, 1, 0, 0, 0, 765, 120,
-		1, 0, 0, 0, 766, 770, 5, 48, 0, 0, 767, 769, 3, 127, 63, 0, 768, 767, 1,
-		0, 0, 0, 769, 772, 1, 0, 0, 0, 770, 768, 1, 0, 0, 0, 770, 771, 1, 0, 0,
-		0, 771, 122, 1, 0, 0, 0, 772, 770, 1, 0, 0, 0, 773, 774, 5, 48, 0, 0, 774,
-		775, 7, 7, 0, 0, 775, 776, 3, 147, 73, 0, 776, 124, 1, 0, 0, 0, 777, 778,
-		7, 8, 0, 0, 778, 126, 1, 0, 0, 0, 779, 780, 7, 9, 0, 0, 780, 128, 1, 0,
-		0, 0, 781, 782, 7, 10, 0, 0, 782, 130, 1, 0, 0, 0, 783, 784, 3, 129, 64,
-		0, 784, 785, 3, 129, 64, 0, 785, 786, 3, 129, 64, 0, 786, 787, 3, 129,
-		64, 0, 787, 132, 1, 0, 0, 0, 788, 789, 5, 92, 0, 0, 789, 790, 5, 117, 0,
-		0, 790, 791, 1, 0, 0, 0, 791, 799, 3, 131, 65, 0, 792, 793, 5, 92, 0, 0,
-		793, 794, 5, 85, 0, 0, 794, 795, 1, 0, 0, 0, 795, 796, 3, 131, 65, 0, 796,
-		797, 3, 131, 65, 0, 797, 799, 1, 0, 0, 0, 798, 788, 1, 0, 0, 0, 798, 792,
-		1, 0, 0, 0, 799, 134, 1, 0, 0, 0, 800, 802, 3, 139, 69, 0, 801, 803, 3,
-		141, 70, 0, 802, 801, 1, 0, 0, 0, 802, 803, 1, 0, 0, 0, 803, 808, 1, 0,
-		0, 0, 804, 805, 3, 143, 71, 0, 805, 806, 3, 141, 70, 0, 806, 808, 1, 0,
-		0, 0, 807, 800, 1, 0, 0, 0, 807, 804, 1, 0, 0, 0, 808, 136, 1, 0, 0, 0,
-		809, 810, 5, 48, 0, 0, 810, 813, 7, 7, 0, 0, 811, 814, 3, 145, 72, 0, 812,
-		814, 3, 147, 73, 0, 813, 811, 1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814, 815,
-		1, 0, 0, 0, 815, 816, 3, 149, 74, 0, 816, 138, 1, 0, 0, 0, 817, 819, 3,
-		143, 71, 0, 818, 817, 1, 0, 0, 0, 818, 819, 1, 0, 0, 0, 819, 820, 1, 0,
-		0, 0, 820, 821, 5, 46, 0, 0, 821, 826, 3, 143, 71, 0, 822, 823, 3, 143,
-		71, 0, 823, 824, 5, 46, 0, 0, 824, 826, 1, 0, 0, 0, 825, 818, 1, 0, 0,
-		0, 825, 822, 1, 0, 0, 0, 826, 140, 1, 0, 0, 0, 827, 829, 7, 11, 0, 0, 828,
-		830, 7, 12, 0, 0, 829, 828, 1, 0, 0, 0, 829, 830, 1, 0, 0, 0, 830, 831,
-		1, 0, 0, 0, 831, 832, 3, 143, 71, 0, 832, 142, 1, 0, 0, 0, 833, 835, 3,
-		115, 57, 0, 834, 833, 1, 0, 0, 0, 835, 836, 1, 0, 0, 0, 836, 834, 1, 0,
-		0, 0, 836, 837, 1, 0, 0, 0, 837, 144, 1, 0, 0, 0, 838, 840, 3, 147, 73,
-		0, 839, 838, 1, 0, 0, 0, 839, 840, 1, 0, 0, 0, 840, 841, 1, 0, 0, 0, 841,
-		842, 5, 46, 0, 0, 842, 847, 3, 147, 73, 0, 843, 844, 3, 147, 73, 0, 844,
-		845, 5, 46, 0, 0, 845, 847, 1, 0, 0, 0, 846, 839, 1, 0, 0, 0, 846, 843,
-		1, 0, 0, 0, 847, 146, 1, 0, 0, 0, 848, 850, 3, 129, 64, 0, 849, 848, 1,
-		0, 0, 0, 850, 851, 1, 0, 0, 0, 851, 849, 1, 0, 0, 0, 851, 852, 1, 0, 0,
-		0, 852, 148, 1, 0, 0, 0, 853, 855, 7, 13, 0, 0, 854, 856, 7, 12, 0, 0,
-		855, 854, 1, 0, 0, 0, 855, 856, 1, 0, 0, 0, 856, 857, 1, 0, 0, 0, 857,
-		858, 3, 143, 71, 0, 858, 150, 1, 0, 0, 0, 859, 860, 5, 92, 0, 0, 860, 875,
-		7, 14, 0, 0, 861, 862, 5, 92, 0, 0, 862, 864, 3, 127, 63, 0, 863, 865,
-		3, 127, 63, 0, 864, 863, 1, 0, 0, 0, 864, 865, 1, 0, 0, 0, 865, 867, 1,
-		0, 0, 0, 866, 868, 3, 127, 63, 0, 867, 866, 1, 0, 0, 0, 867, 868, 1, 0,
-		0, 0, 868, 875, 1, 0, 0, 0, 869, 870, 5, 92, 0, 0, 870, 871, 5, 120, 0,
-		0, 871, 872, 1, 0, 0, 0, 872, 875, 3, 147, 73, 0, 873, 875, 3, 133, 66,
-		0, 874, 859, 1, 0, 0, 0, 874, 861, 1, 0, 0, 0, 874, 869, 1, 0, 0, 0, 874,
-		873, 1, 0, 0, 0, 875, 152, 1, 0, 0, 0, 876, 878, 7, 15, 0, 0, 877, 876,
-		1, 0, 0, 0, 878, 879, 1, 0, 0, 0, 879, 877, 1, 0, 0, 0, 879, 880, 1, 0,
-		0, 0, 880, 881, 1, 0, 0, 0, 881, 882, 6, 76, 0, 0, 882, 154, 1, 0, 0, 0,
-		883, 885, 5, 13, 0, 0, 884, 886, 5, 10, 0, 0, 885, 884, 1, 0, 0, 0, 885,
-		886, 1, 0, 0, 0, 886, 889, 1, 0, 0, 0, 887, 889, 5, 10, 0, 0, 888, 883,
-		1, 0, 0, 0, 888, 887, 1, 0, 0, 0, 889, 890, 1, 0, 0, 0, 890, 891, 6, 77,
-		0, 0, 891, 156, 1, 0, 0, 0, 60, 0, 195, 209, 231, 257, 285, 320, 328, 344,
-		368, 379, 385, 390, 392, 423, 459, 495, 525, 563, 601, 627, 656, 662, 666,
-		671, 673, 683, 687, 692, 695, 699, 704, 710, 715, 720, 725, 734, 743, 754,
-		760, 764, 770, 798, 802, 807, 813, 818, 825, 829, 836, 839, 846, 851, 855,
-		864, 867, 874, 879, 885, 888, 1, 6, 0, 0,
+		1, 0, 0, 0, 663, 92, 1, 0, 0, 0, 664, 667, 3, 135, 70, 0, 665, 667, 3,
+		137, 71, 0, 666, 664, 1, 0, 0, 0, 666, 665, 1, 0, 0, 0, 667, 94, 1, 0,
+		0, 0, 668, 673, 3, 113, 59, 0, 669, 672, 3, 113, 59, 0, 670, 672, 3,
+		115, 60, 0, 671, 669, 1, 0, 0, 0, 671, 670, 1, 0, 0, 0, 672, 675, 1, 0,
+		0, 0, 673, 671, 1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 96, 1, 0, 0, 0,
+		675, 673, 1, 0, 0, 0, 676, 677, 5, 36, 0, 0, 677, 678, 5, 109, 0, 0,
+		678, 679, 5, 101, 0, 0, 679, 680, 5, 116, 0, 0, 680, 681, 5, 97, 0, 0,
+		681, 98, 1, 0, 0, 0, 682, 684, 3, 103, 54, 0, 683, 682, 1, 0, 0, 0, 683,
+		684, 1, 0, 0, 0, 684, 695, 1, 0, 0, 0, 685, 687, 5, 34, 0, 0, 686, 688,
+		3, 105, 55, 0, 687, 686, 1, 0, 0, 0, 687, 688, 1, 0, 0, 0, 688, 689, 1,
+		0, 0, 0, 689, 696, 5, 34, 0, 0, 690, 692, 5, 39, 0, 0, 691, 693, 3, 107,
+		56, 0, 692, 691, 1, 0, 0, 0, 692, 693, 1, 0, 0, 0, 693, 694, 1, 0, 0, 0,
+		694, 696, 5, 39, 0, 0, 695, 685, 1, 0, 0, 0, 695, 690, 1, 0, 0, 0, 696,
+		100, 1, 0, 0, 0, 697, 700, 3, 95, 50, 0, 698, 700, 3, 97, 51, 0, 699,
+		697, 1, 0, 0, 0, 699, 698, 1, 0, 0, 0, 700, 708, 1, 0, 0, 0, 701, 704,
+		5, 91, 0, 0, 702, 705, 3, 99, 52, 0, 703, 705, 3, 119, 62, 0, 704, 702,
+		1, 0, 0, 0, 704, 703, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 707, 5, 93,
+		0, 0, 707, 709, 1, 0, 0, 0, 708, 701, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0,
+		710, 708, 1, 0, 0, 0, 710, 711, 1, 0, 0, 0, 711, 102, 1, 0, 0, 0, 712,
+		713, 5, 117, 0, 0, 713, 716, 5, 56, 0, 0, 714, 716, 7, 0, 0, 0, 715,
+		712, 1, 0, 0, 0, 715, 714, 1, 0, 0, 0, 716, 104, 1, 0, 0, 0, 717, 719,
+		3, 109, 57, 0, 718, 717, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 718, 1,
+		0, 0, 0, 720, 721, 1, 0, 0, 0, 721, 106, 1, 0, 0, 0, 722, 724, 3, 111,
+		58, 0, 723, 722, 1, 0, 0, 0, 724, 725, 1, 0, 0, 0, 725, 723, 1, 0, 0, 0,
+		725, 726, 1, 0, 0, 0, 726, 108, 1, 0, 0, 0, 727, 735, 8, 1, 0, 0, 728,
+		735, 3, 151, 78, 0, 729, 730, 5, 92, 0, 0, 730, 735, 5, 10, 0, 0, 731,
+		732, 5, 92, 0, 0, 732, 733, 5, 13, 0, 0, 733, 735, 5, 10, 0, 0, 734,
+		727, 1, 0, 0, 0, 734, 728, 1, 0, 0, 0, 734, 729, 1, 0, 0, 0, 734, 731,
+		1, 0, 0, 0, 735, 110, 1, 0, 0, 0, 736, 744, 8, 2, 0, 0, 737, 744, 3,
+		151, 78, 0, 738, 739, 5, 92, 0, 0, 739, 744, 5, 10, 0, 0, 740, 741, 5,
+		92, 0, 0, 741, 742, 5, 13, 0, 0, 742, 744, 5, 10, 0, 0, 743, 736, 1, 0,
+		0, 0, 743, 737, 1, 0, 0, 0, 743, 738, 1, 0, 0, 0, 743, 740, 1, 0, 0, 0,
+		744, 112, 1, 0, 0, 0, 745, 746, 7, 3, 0, 0, 746, 114, 1, 0, 0, 0, 747,
+		748, 7, 4, 0, 0, 748, 116, 1, 0, 0, 0, 749, 750, 5, 48, 0, 0, 750, 752,
+		7, 5, 0, 0, 751, 753, 7, 6, 0, 0, 752, 751, 1, 0, 0, 0, 753, 754, 1, 0,
+		0, 0, 754, 752, 1, 0, 0, 0, 754, 755, 1, 0, 0, 0, 755, 118, 1, 0, 0, 0,
+		756, 760, 3, 125, 65, 0, 757, 759, 3, 115, 60, 0, 758, 757, 1, 0, 0, 0,
+		759, 762, 1, 0, 0, 0, 760, 758, 1, 0, 0, 0, 760, 761, 1, 0, 0, 0, 761,
+		765, 1, 0, 0, 0, 762, 760, 1, 0, 0, 0, 763, 765, 5, 48, 0, 0, 764, 756,
+		1, 0, 0, 0, 764, 763, 1, 0, 0, 0, 765, 120, 1, 0, 0, 0, 766, 770, 5, 48,
+		0, 0, 767, 769, 3, 127, 66, 0, 768, 767, 1, 0, 0, 0, 769, 772, 1, 0, 0,
+		0, 770, 768, 1, 0, 0, 0, 770, 771, 1, 0, 0, 0, 771, 122, 1, 0, 0, 0,
+		772, 770, 1, 0, 0, 0, 773, 774, 5, 48, 0, 0, 774, 775, 7, 7, 0, 0, 775,
+		776, 3, 147, 76, 0, 776, 124, 1, 0, 0, 0, 777, 778, 7, 8, 0, 0, 778,
+		126, 1, 0, 0, 0, 779, 780, 7, 9, 0, 0, 780, 128, 1, 0, 0, 0, 781, 782,
+		7, 10, 0, 0, 782, 130, 1, 0, 0, 0, 783, 784, 3, 129, 67, 0, 784, 785, 3,
+		129, 67, 0, 785, 786, 3, 129, 67, 0, 786, 787, 3, 129, 67, 0, 787, 132,
+		1, 0, 0, 0, 788, 789, 5, 92, 0, 0, 789, 790, 5, 117, 0, 0, 790, 791, 1,
+		0, 0, 0, 791, 799, 3, 131, 68, 0, 792, 793, 5, 92, 0, 0, 793, 794, 5,
+		85, 0, 0, 794, 795, 1, 0, 0, 0, 795, 796, 3, 131, 68, 0, 796, 797, 3,
+		131, 68, 0, 797, 799, 1, 0, 0, 0, 798, 788, 1, 0, 0, 0, 798, 792, 1, 0,
+		0, 0, 799, 134, 1, 0, 0, 0, 800, 802, 3, 139, 72, 0, 801, 803, 3, 141,
+		73, 0, 802, 801, 1, 0, 0, 0, 802, 803, 1, 0, 0, 0, 803, 808, 1, 0, 0, 0,
+		804, 805, 3, 143, 74, 0, 805, 806, 3, 141, 73, 0, 806, 808, 1, 0, 0, 0,
+		807, 800, 1, 0, 0, 0, 807, 804, 1, 0, 0, 0, 808, 136, 1, 0, 0, 0, 809,
+		810, 5, 48, 0, 0, 810, 813, 7, 7, 0, 0, 811, 814, 3, 145, 75, 0, 812,
+		814, 3, 147, 76, 0, 813, 811, 1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814,
+		815, 1, 0, 0, 0, 815, 816, 3, 149, 77, 0, 816, 138, 1, 0, 0, 0, 817,
+		819, 3, 143, 74, 0, 818, 817, 1, 0, 0, 0, 818, 819, 1, 0, 0, 0, 819,
+		820, 1, 0, 0, 0, 820, 821, 5, 46, 0, 0, 821, 826, 3, 143, 74, 0, 822,
+		823, 3, 143, 74, 0, 823, 824, 5, 46, 0, 0, 824, 826, 1, 0, 0, 0, 825,
+		818, 1, 0, 0, 0, 825, 822, 1, 0, 0, 0, 826, 140, 1, 0, 0, 0, 827, 829,
+		7, 11, 0, 0, 828, 830, 7, 12, 0, 0, 829, 828, 1, 0, 0, 0, 829, 830, 1,
+		0, 0, 0, 830, 831, 1, 0, 0, 0, 831, 832, 3, 143, 74, 0, 832, 142, 1, 0,
+		0, 0, 833, 835, 3, 115, 60, 0, 834, 833, 1, 0, 0, 0, 835, 836, 1, 0, 0,
+		0, 836, 834, 1, 0, 0, 0, 836, 837, 1, 0, 0, 0, 837, 144, 1, 0, 0, 0,
+		838, 840, 3, 147, 76, 0, 839, 838, 1, 0, 0, 0, 839, 840, 1, 0, 0, 0,
+		840, 841, 1, 0, 0, 0, 841, 842, 5, 46, 0, 0, 842, 847, 3, 147, 76, 0,
+		843, 844, 3, 147, 76, 0, 844, 845, 5, 46, 0, 0, 845, 847, 1, 0, 0, 0,
+		846, 839, 1, 0, 0, 0, 846, 843, 1, 0, 0, 0, 847, 146, 1, 0, 0, 0, 848,
+		850, 3, 129, 67, 0, 849, 848, 1, 0, 0, 0, 850, 851, 1, 0, 0, 0, 851,
+		849, 1, 0, 0, 0, 851, 852, 1, 0, 0, 0, 852, 148, 1, 0, 0, 0, 853, 855,
+		7, 13, 0, 0, 854, 856, 7, 12, 0, 0, 855, 854, 1, 0, 0, 0, 855, 856, 1,
+		0, 0, 0, 856, 857, 1, 0, 0, 0, 857, 858, 3, 143, 74, 0, 858, 150, 1, 0,
+		0, 0, 859, 860, 5, 92, 0, 0, 860, 875, 7, 14, 0, 0, 861, 862, 5, 92, 0,
+		0, 862, 864, 3, 127, 66, 0, 863, 865, 3, 127, 66, 0, 864, 863, 1, 0, 0,
+		0, 864, 865, 1, 0, 0, 0, 865, 867, 1, 0, 0, 0, 866, 868, 3, 127, 66, 0,
+		867, 866, 1, 0, 0, 0, 867, 868, 1, 0, 0, 0, 868, 875, 1, 0, 0, 0, 869,
+		870, 5, 92, 0, 0, 870, 871, 5, 120, 0, 0, 871, 872, 1, 0, 0, 0, 872,
+		875, 3, 147, 76, 0, 873, 875, 3, 133, 69, 0, 874, 859, 1, 0, 0, 0, 874,
+		861, 1, 0, 0, 0, 874, 869, 1, 0, 0, 0, 874, 873, 1, 0, 0, 0, 875, 152,
+		1, 0, 0, 0, 876, 878, 7, 15, 0, 0, 877, 876, 1, 0, 0, 0, 878, 879, 1, 0,
+		0, 0, 879, 877, 1, 0, 0, 0, 879, 880, 1, 0, 0, 0, 880, 881, 1, 0, 0, 0,
+		881, 882, 6, 79, 0, 0, 882, 154, 1, 0, 0, 0, 883, 885, 5, 13, 0, 0, 884,
+		886, 5, 10, 0, 0, 885, 884, 1, 0, 0, 0, 885, 886, 1, 0, 0, 0, 886, 889,
+		1, 0, 0, 0, 887, 889, 5, 10, 0, 0, 888, 883, 1, 0, 0, 0, 888, 887, 1, 0,
+		0, 0, 889, 890, 1, 0, 0, 0, 890, 891, 6, 80, 0, 0, 891, 156, 1, 0, 0, 0,
+		892, 894, 1, 0, 0, 0, 894, 896, 1, 0, 0, 0, 894, 900, 1, 0, 0, 0, 895,
+		893, 1, 0, 0, 0, 896, 897, 5, 99, 0, 0, 897, 898, 5, 97, 0, 0, 898, 899,
+		5, 115, 0, 0, 899, 895, 5, 116, 0, 0, 900, 901, 5, 67, 0, 0, 901, 902,
+		5, 65, 0, 0, 902, 903, 5, 83, 0, 0, 903, 895, 5, 84, 0, 0, 904, 906, 1,
+		0, 0, 0, 906, 908, 1, 0, 0, 0, 906, 910, 1, 0, 0, 0, 907, 905, 1, 0, 0,
+		0, 908, 909, 5, 97, 0, 0, 909, 907, 5, 115, 0, 0, 910, 911, 5, 65, 0, 0,
+		911, 907, 5, 83, 0, 0, 912, 914, 1, 0, 0, 0, 914, 915, 5, 61, 0, 0, 915,
+		916, 5, 126, 0, 0, 916, 913, 1, 0, 0, 0, 62, 0, 195, 209, 231, 257, 285,
+		320, 328, 344, 368, 379, 385, 390, 392, 423, 459, 495, 525, 563, 601,
+		627, 656, 662, 666, 671, 673, 683, 687, 692, 695, 699, 704, 710, 715,
+		720, 725, 734, 743, 754, 760, 764, 770, 798, 802, 807, 813, 818, 825,
+		829, 836, 839, 846, 851, 855, 864, 867, 874, 879, 885, 888, 894, 906, 1,
+		6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -524,52 +548,55 @@ const (
 	PlanLexerT__2             = 3
 	PlanLexerT__3             = 4
 	PlanLexerT__4             = 5
-	PlanLexerLBRACE           = 6
-	PlanLexerRBRACE           = 7
-	PlanLexerLT               = 8
-	PlanLexerLE               = 9
-	PlanLexerGT               = 10
-	PlanLexerGE               = 11
-	PlanLexerEQ               = 12
-	PlanLexerNE               = 13
-	PlanLexerLIKE             = 14
-	PlanLexerEXISTS           = 15
-	PlanLexerTEXTMATCH        = 16
-	PlanLexerPHRASEMATCH      = 17
-	PlanLexerRANDOMSAMPLE     = 18
-	PlanLexerADD              = 19
-	PlanLexerSUB              = 20
-	PlanLexerMUL              = 21
-	PlanLexerDIV              = 22
-	PlanLexerMOD              = 23
-	PlanLexerPOW              = 24
-	PlanLexerSHL              = 25
-	PlanLexerSHR              = 26
-	PlanLexerBAND             = 27
-	PlanLexerBOR              = 28
-	PlanLexerBXOR             = 29
-	PlanLexerAND              = 30
-	PlanLexerOR               = 31
-	PlanLexerISNULL           = 32
-	PlanLexerISNOTNULL        = 33
-	PlanLexerBNOT             = 34
-	PlanLexerNOT              = 35
-	PlanLexerIN               = 36
-	PlanLexerEmptyArray       = 37
-	PlanLexerJSONContains     = 38
-	PlanLexerJSONContainsAll  = 39
-	PlanLexerJSONContainsAny  = 40
-	PlanLexerArrayContains    = 41
-	PlanLexerArrayContainsAll = 42
-	PlanLexerArrayContainsAny = 43
-	PlanLexerArrayLength      = 44
-	PlanLexerBooleanConstant  = 45
-	PlanLexerIntegerConstant  = 46
-	PlanLexerFloatingConstant = 47
-	PlanLexerIdentifier       = 48
-	PlanLexerMeta             = 49
-	PlanLexerStringLiteral    = 50
-	PlanLexerJSONIdentifier   = 51
-	PlanLexerWhitespace       = 52
-	PlanLexerNewline          = 53
+	PlanLexerT__5             = 6
+	PlanLexerLBRACE           = 7
+	PlanLexerRBRACE           = 8
+	PlanLexerLT               = 9
+	PlanLexerLE               = 10
+	PlanLexerGT               = 11
+	PlanLexerGE               = 12
+	PlanLexerEQ               = 13
+	PlanLexerNE               = 14
+	PlanLexerLIKE             = 15
+	PlanLexerEXISTS           = 16
+	PlanLexerTEXTMATCH        = 17
+	PlanLexerPHRASEMATCH      = 18
+	PlanLexerRANDOMSAMPLE     = 19
+	PlanLexerADD              = 20
+	PlanLexerSUB              = 21
+	PlanLexerMUL              = 22
+	PlanLexerDIV              = 23
+	PlanLexerMOD              = 24
+	PlanLexerPOW              = 25
+	PlanLexerSHL              = 26
+	PlanLexerSHR              = 27
+	PlanLexerBAND             = 28
+	PlanLexerBOR              = 29
+	PlanLexerBXOR             = 30
+	PlanLexerAND              = 31
+	PlanLexerOR               = 32
+	PlanLexerISNULL           = 33
+	PlanLexerISNOTNULL        = 34
+	PlanLexerBNOT             = 35
+	PlanLexerNOT              = 36
+	PlanLexerIN               = 37
+	PlanLexerEmptyArray       = 38
+	PlanLexerJSONContains     = 39
+	PlanLexerJSONContainsAll  = 40
+	PlanLexerJSONContainsAny  = 41
+	PlanLexerArrayContains    = 42
+	PlanLexerArrayContainsAll = 43
+	PlanLexerArrayContainsAny = 44
+	PlanLexerArrayLength      = 45
+	PlanLexerCAST             = 46
+	PlanLexerAS               = 47
+	PlanLexerBooleanConstant  = 48
+	PlanLexerIntegerConstant  = 49
+	PlanLexerFloatingConstant = 50
+	PlanLexerIdentifier       = 51
+	PlanLexerMeta             = 52
+	PlanLexerStringLiteral    = 53
+	PlanLexerJSONIdentifier   = 54
+	PlanLexerWhitespace       = 55
+	PlanLexerNewline          = 56
 )
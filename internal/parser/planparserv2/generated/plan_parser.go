@@ -32,27 +32,29 @@ var PlanParserStaticData struct {
 func planParserInit() {
 	staticData := &PlanParserStaticData
 	staticData.LiteralNames = []string{
-		"", "'('", "')'", "'['", "','", "']'", "'{'", "'}'", "'<'", "'<='",
-		"'>'", "'>='", "'=='", "'!='", "", "", "", "", "", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'**'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "", "", "",
-		"", "'~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'$meta'",
+		"", "'('", "')'", "'['", "','", "']'", "'=~'", "'{'", "'}'", "'<'",
+		"'<='", "'>'", "'>='", "'=='", "'!='", "", "", "", "", "", "'+'", "'-'",
+		"'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "", "",
+		"", "", "'~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "'$meta'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "", "", "", "", "LBRACE", "RBRACE", "LT", "LE", "GT", "GE",
+		"", "", "", "", "", "", "", "LBRACE", "RBRACE", "LT", "LE", "GT", "GE",
 		"EQ", "NE", "LIKE", "EXISTS", "TEXTMATCH", "PHRASEMATCH", "RANDOMSAMPLE",
 		"ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR",
-		"BXOR", "AND", "OR", "ISNULL", "ISNOTNULL", "BNOT", "NOT", "IN", "EmptyArray",
-		"JSONContains", "JSONContainsAll", "JSONContainsAny", "ArrayContains",
-		"ArrayContainsAll", "ArrayContainsAny", "ArrayLength", "BooleanConstant",
-		"IntegerConstant", "FloatingConstant", "Identifier", "Meta", "StringLiteral",
-		"JSONIdentifier", "Whitespace", "Newline",
+		"BXOR", "AND", "OR", "ISNULL", "ISNOTNULL", "BNOT", "NOT", "IN",
+		"EmptyArray", "JSONContains", "JSONContainsAll", "JSONContainsAny",
+		"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+		"CAST", "AS", "BooleanConstant", "IntegerConstant", "FloatingConstant",
+		"Identifier", "Meta", "StringLiteral", "JSONIdentifier", "Whitespace",
+		"Newline",
 	}
 	staticData.RuleNames = []string{
-		"expr",
+		"expr", "name",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 53, 161, 2, 0, 7, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		4, 1, 56, 199, 2, 0, 7, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
 		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 5, 0, 21,
 		8, 0, 10, 0, 12, 0, 24, 9, 0, 1, 0, 3, 0, 27, 8, 0, 1, 0, 1, 0, 1, 0, 1,
 		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
@@ -66,70 +68,89 @@ func planParserInit() {
 		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
 		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
 		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 5, 0, 156, 8, 0,
-		10, 0, 12, 0, 159, 9, 0, 1, 0, 0, 1, 0, 1, 0, 0, 13, 1, 0, 48, 49, 2, 0,
-		19, 20, 34, 35, 2, 0, 38, 38, 41, 41, 2, 0, 39, 39, 42, 42, 2, 0, 40, 40,
-		43, 43, 2, 0, 48, 48, 51, 51, 1, 0, 21, 23, 1, 0, 19, 20, 1, 0, 25, 26,
-		1, 0, 8, 9, 1, 0, 10, 11, 1, 0, 8, 11, 1, 0, 12, 13, 202, 0, 101, 1, 0,
-		0, 0, 2, 3, 6, 0, -1, 0, 3, 102, 5, 46, 0, 0, 4, 102, 5, 47, 0, 0, 5, 102,
-		5, 45, 0, 0, 6, 102, 5, 50, 0, 0, 7, 102, 7, 0, 0, 0, 8, 102, 5, 51, 0,
-		0, 9, 10, 5, 6, 0, 0, 10, 11, 5, 48, 0, 0, 11, 102, 5, 7, 0, 0, 12, 13,
-		5, 1, 0, 0, 13, 14, 3, 0, 0, 0, 14, 15, 5, 2, 0, 0, 15, 102, 1, 0, 0, 0,
-		16, 17, 5, 3, 0, 0, 17, 22, 3, 0, 0, 0, 18, 19, 5, 4, 0, 0, 19, 21, 3,
-		0, 0, 0, 20, 18, 1, 0, 0, 0, 21, 24, 1, 0, 0, 0, 22, 20, 1, 0, 0, 0, 22,
-		23, 1, 0, 0, 0, 23, 26, 1, 0, 0, 0, 24, 22, 1, 0, 0, 0, 25, 27, 5, 4, 0,
-		0, 26, 25, 1, 0, 0, 0, 26, 27, 1, 0, 0, 0, 27, 28, 1, 0, 0, 0, 28, 29,
-		5, 5, 0, 0, 29, 102, 1, 0, 0, 0, 30, 102, 5, 37, 0, 0, 31, 32, 5, 15, 0,
-		0, 32, 102, 3, 0, 0, 27, 33, 34, 5, 16, 0, 0, 34, 35, 5, 1, 0, 0, 35, 36,
-		5, 48, 0, 0, 36, 37, 5, 4, 0, 0, 37, 38, 5, 50, 0, 0, 38, 102, 5, 2, 0,
-		0, 39, 40, 5, 17, 0, 0, 40, 41, 5, 1, 0, 0, 41, 42, 5, 48, 0, 0, 42, 43,
-		5, 4, 0, 0, 43, 46, 5, 50, 0, 0, 44, 45, 5, 4, 0, 0, 45, 47, 3, 0, 0, 0,
-		46, 44, 1, 0, 0, 0, 46, 47, 1, 0, 0, 0, 47, 48, 1, 0, 0, 0, 48, 102, 5,
-		2, 0, 0, 49, 50, 5, 18, 0, 0, 50, 51, 5, 1, 0, 0, 51, 52, 3, 0, 0, 0, 52,
-		53, 5, 2, 0, 0, 53, 102, 1, 0, 0, 0, 54, 55, 7, 1, 0, 0, 55, 102, 3, 0,
-		0, 21, 56, 57, 7, 2, 0, 0, 57, 58, 5, 1, 0, 0, 58, 59, 3, 0, 0, 0, 59,
-		60, 5, 4, 0, 0, 60, 61, 3, 0, 0, 0, 61, 62, 5, 2, 0, 0, 62, 102, 1, 0,
-		0, 0, 63, 64, 7, 3, 0, 0, 64, 65, 5, 1, 0, 0, 65, 66, 3, 0, 0, 0, 66, 67,
-		5, 4, 0, 0, 67, 68, 3, 0, 0, 0, 68, 69, 5, 2, 0, 0, 69, 102, 1, 0, 0, 0,
-		70, 71, 7, 4, 0, 0, 71, 72, 5, 1, 0, 0, 72, 73, 3, 0, 0, 0, 73, 74, 5,
-		4, 0, 0, 74, 75, 3, 0, 0, 0, 75, 76, 5, 2, 0, 0, 76, 102, 1, 0, 0, 0, 77,
-		78, 5, 44, 0, 0, 78, 79, 5, 1, 0, 0, 79, 80, 7, 5, 0, 0, 80, 102, 5, 2,
-		0, 0, 81, 82, 5, 48, 0, 0, 82, 94, 5, 1, 0, 0, 83, 88, 3, 0, 0, 0, 84,
-		85, 5, 4, 0, 0, 85, 87, 3, 0, 0, 0, 86, 84, 1, 0, 0, 0, 87, 90, 1, 0, 0,
-		0, 88, 86, 1, 0, 0, 0, 88, 89, 1, 0, 0, 0, 89, 92, 1, 0, 0, 0, 90, 88,
-		1, 0, 0, 0, 91, 93, 5, 4, 0, 0, 92, 91, 1, 0, 0, 0, 92, 93, 1, 0, 0, 0,
-		93, 95, 1, 0, 0, 0, 94, 83, 1, 0, 0, 0, 94, 95, 1, 0, 0, 0, 95, 96, 1,
-		0, 0, 0, 96, 102, 5, 2, 0, 0, 97, 98, 7, 5, 0, 0, 98, 102, 5, 32, 0, 0,
-		99, 100, 7, 5, 0, 0, 100, 102, 5, 33, 0, 0, 101, 2, 1, 0, 0, 0, 101, 4,
-		1, 0, 0, 0, 101, 5, 1, 0, 0, 0, 101, 6, 1, 0, 0, 0, 101, 7, 1, 0, 0, 0,
-		101, 8, 1, 0, 0, 0, 101, 9, 1, 0, 0, 0, 101, 12, 1, 0, 0, 0, 101, 16, 1,
-		0, 0, 0, 101, 30, 1, 0, 0, 0, 101, 31, 1, 0, 0, 0, 101, 33, 1, 0, 0, 0,
-		101, 39, 1, 0, 0, 0, 101, 49, 1, 0, 0, 0, 101, 54, 1, 0, 0, 0, 101, 56,
-		1, 0, 0, 0, 101, 63, 1, 0, 0, 0, 101, 70, 1, 0, 0, 0, 101, 77, 1, 0, 0,
-		0, 101, 81, 1, 0, 0, 0, 101, 97, 1, 0, 0, 0, 101, 99, 1, 0, 0, 0, 102,
-		157, 1, 0, 0, 0, 103, 104, 10, 22, 0, 0, 104, 105, 5, 24, 0, 0, 105, 156,
-		3, 0, 0, 23, 106, 107, 10, 20, 0, 0, 107, 108, 7, 6, 0, 0, 108, 156, 3,
-		0, 0, 21, 109, 110, 10, 19, 0, 0, 110, 111, 7, 7, 0, 0, 111, 156, 3, 0,
-		0, 20, 112, 113, 10, 18, 0, 0, 113, 114, 7, 8, 0, 0, 114, 156, 3, 0, 0,
-		19, 115, 117, 10, 17, 0, 0, 116, 118, 5, 35, 0, 0, 117, 116, 1, 0, 0, 0,
-		117, 118, 1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119, 120, 5, 36, 0, 0, 120,
-		156, 3, 0, 0, 18, 121, 122, 10, 11, 0, 0, 122, 123, 7, 9, 0, 0, 123, 124,
-		7, 5, 0, 0, 124, 125, 7, 9, 0, 0, 125, 156, 3, 0, 0, 12, 126, 127, 10,
-		10, 0, 0, 127, 128, 7, 10, 0, 0, 128, 129, 7, 5, 0, 0, 129, 130, 7, 10,
-		0, 0, 130, 156, 3, 0, 0, 11, 131, 132, 10, 9, 0, 0, 132, 133, 7, 11, 0,
-		0, 133, 156, 3, 0, 0, 10, 134, 135, 10, 8, 0, 0, 135, 136, 7, 12, 0, 0,
-		136, 156, 3, 0, 0, 9, 137, 138, 10, 7, 0, 0, 138, 139, 5, 27, 0, 0, 139,
-		156, 3, 0, 0, 8, 140, 141, 10, 6, 0, 0, 141, 142, 5, 29, 0, 0, 142, 156,
-		3, 0, 0, 7, 143, 144, 10, 5, 0, 0, 144, 145, 5, 28, 0, 0, 145, 156, 3,
-		0, 0, 6, 146, 147, 10, 4, 0, 0, 147, 148, 5, 30, 0, 0, 148, 156, 3, 0,
-		0, 5, 149, 150, 10, 3, 0, 0, 150, 151, 5, 31, 0, 0, 151, 156, 3, 0, 0,
-		4, 152, 153, 10, 26, 0, 0, 153, 154, 5, 14, 0, 0, 154, 156, 5, 50, 0, 0,
-		155, 103, 1, 0, 0, 0, 155, 106, 1, 0, 0, 0, 155, 109, 1, 0, 0, 0, 155,
-		112, 1, 0, 0, 0, 155, 115, 1, 0, 0, 0, 155, 121, 1, 0, 0, 0, 155, 126,
-		1, 0, 0, 0, 155, 131, 1, 0, 0, 0, 155, 134, 1, 0, 0, 0, 155, 137, 1, 0,
-		0, 0, 155, 140, 1, 0, 0, 0, 155, 143, 1, 0, 0, 0, 155, 146, 1, 0, 0, 0,
-		155, 149, 1, 0, 0, 0, 155, 152, 1, 0, 0, 0, 156, 159, 1, 0, 0, 0, 157,
-		155, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 1, 1, 0, 0, 0, 159, 157, 1,
-		0, 0, 0, 10, 22, 26, 46, 88, 92, 94, 101, 117, 155, 157,
+		10, 0, 12, 0, 159, 9, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		2, 1, 7, 1, 1, 1, 1, 1, 3, 0, 173, 8, 0, 1, 0, 1, 0, 3, 0, 177, 8, 0, 1,
+		0, 1, 0, 3, 0, 181, 8, 0, 1, 0, 1, 0, 3, 0, 185, 8, 0, 1, 0, 1, 0, 3, 0,
+		189, 8, 0, 1, 0, 1, 0, 3, 0, 193, 8, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 0,
+		1, 0, 2, 0, 168, 0, 12, 2, 0, 20, 21, 35, 36, 2, 0, 39, 39, 42, 42, 2,
+		0, 40, 40, 43, 43, 2, 0, 41, 41, 44, 44, 1, 0, 22, 24, 1, 0, 20, 21, 1,
+		0, 26, 27, 1, 0, 9, 10, 1, 0, 11, 12, 1, 0, 9, 12, 1, 0, 13, 14, 2, 0,
+		46, 47, 51, 51, 247, 0, 101, 1, 0, 0, 0, 2, 3, 6, 0, -1, 0, 3, 102, 5,
+		49, 0, 0, 4, 102, 5, 50, 0, 0, 5, 102, 5, 48, 0, 0, 6, 102, 5, 53, 0, 0,
+		7, 172, 1, 0, 0, 0, 8, 102, 5, 54, 0, 0, 9, 10, 5, 7, 0, 0, 10, 11, 5,
+		51, 0, 0, 11, 102, 5, 8, 0, 0, 12, 13, 5, 1, 0, 0, 13, 14, 3, 0, 0, 0,
+		14, 15, 5, 2, 0, 0, 15, 102, 1, 0, 0, 0, 16, 17, 5, 3, 0, 0, 17, 22, 3,
+		0, 0, 0, 18, 19, 5, 4, 0, 0, 19, 21, 3, 0, 0, 0, 20, 18, 1, 0, 0, 0, 21,
+		24, 1, 0, 0, 0, 22, 20, 1, 0, 0, 0, 22, 23, 1, 0, 0, 0, 23, 26, 1, 0, 0,
+		0, 24, 22, 1, 0, 0, 0, 25, 27, 5, 4, 0, 0, 26, 25, 1, 0, 0, 0, 26, 27,
+		1, 0, 0, 0, 27, 28, 1, 0, 0, 0, 28, 29, 5, 5, 0, 0, 29, 102, 1, 0, 0, 0,
+		30, 102, 5, 38, 0, 0, 31, 32, 5, 16, 0, 0, 32, 102, 3, 0, 0, 28, 33, 34,
+		5, 17, 0, 0, 34, 35, 5, 1, 0, 0, 35, 36, 3, 168, 1, 0, 36, 37, 5, 4, 0,
+		0, 37, 38, 5, 53, 0, 0, 38, 102, 5, 2, 0, 0, 39, 40, 5, 18, 0, 0, 40,
+		41, 5, 1, 0, 0, 41, 42, 3, 168, 1, 0, 42, 43, 5, 4, 0, 0, 43, 46, 5, 53,
+		0, 0, 44, 45, 5, 4, 0, 0, 45, 47, 3, 0, 0, 0, 46, 44, 1, 0, 0, 0, 46,
+		47, 1, 0, 0, 0, 47, 48, 1, 0, 0, 0, 48, 102, 5, 2, 0, 0, 49, 50, 5, 19,
+		0, 0, 50, 51, 5, 1, 0, 0, 51, 52, 3, 0, 0, 0, 52, 53, 5, 2, 0, 0, 53,
+		102, 1, 0, 0, 0, 54, 55, 7, 0, 0, 0, 55, 102, 3, 0, 0, 21, 56, 57, 7, 1,
+		0, 0, 57, 58, 5, 1, 0, 0, 58, 59, 3, 0, 0, 0, 59, 60, 5, 4, 0, 0, 60,
+		61, 3, 0, 0, 0, 61, 62, 5, 2, 0, 0, 62, 102, 1, 0, 0, 0, 63, 64, 7, 2,
+		0, 0, 64, 65, 5, 1, 0, 0, 65, 66, 3, 0, 0, 0, 66, 67, 5, 4, 0, 0, 67,
+		68, 3, 0, 0, 0, 68, 69, 5, 2, 0, 0, 69, 102, 1, 0, 0, 0, 70, 71, 7, 3,
+		0, 0, 71, 72, 5, 1, 0, 0, 72, 73, 3, 0, 0, 0, 73, 74, 5, 4, 0, 0, 74,
+		75, 3, 0, 0, 0, 75, 76, 5, 2, 0, 0, 76, 102, 1, 0, 0, 0, 77, 78, 5, 45,
+		0, 0, 78, 79, 5, 1, 0, 0, 79, 176, 1, 0, 0, 0, 80, 102, 5, 2, 0, 0, 81,
+		82, 5, 51, 0, 0, 82, 94, 5, 1, 0, 0, 83, 88, 3, 0, 0, 0, 84, 85, 5, 4,
+		0, 0, 85, 87, 3, 0, 0, 0, 86, 84, 1, 0, 0, 0, 87, 90, 1, 0, 0, 0, 88,
+		86, 1, 0, 0, 0, 88, 89, 1, 0, 0, 0, 89, 92, 1, 0, 0, 0, 90, 88, 1, 0, 0,
+		0, 91, 93, 5, 4, 0, 0, 92, 91, 1, 0, 0, 0, 92, 93, 1, 0, 0, 0, 93, 95,
+		1, 0, 0, 0, 94, 83, 1, 0, 0, 0, 94, 95, 1, 0, 0, 0, 95, 96, 1, 0, 0, 0,
+		96, 102, 5, 2, 0, 0, 97, 180, 1, 0, 0, 0, 98, 102, 5, 33, 0, 0, 99, 184,
+		1, 0, 0, 0, 100, 102, 5, 34, 0, 0, 101, 2, 1, 0, 0, 0, 101, 4, 1, 0, 0,
+		0, 101, 5, 1, 0, 0, 0, 101, 6, 1, 0, 0, 0, 101, 7, 1, 0, 0, 0, 101, 8,
+		1, 0, 0, 0, 101, 9, 1, 0, 0, 0, 101, 12, 1, 0, 0, 0, 101, 16, 1, 0, 0,
+		0, 101, 30, 1, 0, 0, 0, 101, 31, 1, 0, 0, 0, 101, 33, 1, 0, 0, 0, 101,
+		39, 1, 0, 0, 0, 101, 49, 1, 0, 0, 0, 101, 54, 1, 0, 0, 0, 101, 56, 1, 0,
+		0, 0, 101, 63, 1, 0, 0, 0, 101, 70, 1, 0, 0, 0, 101, 77, 1, 0, 0, 0,
+		101, 81, 1, 0, 0, 0, 101, 161, 1, 0, 0, 0, 101, 97, 1, 0, 0, 0, 101, 99,
+		1, 0, 0, 0, 102, 157, 1, 0, 0, 0, 103, 104, 10, 22, 0, 0, 104, 105, 5,
+		25, 0, 0, 105, 156, 3, 0, 0, 23, 106, 107, 10, 20, 0, 0, 107, 108, 7, 4,
+		0, 0, 108, 156, 3, 0, 0, 21, 109, 110, 10, 19, 0, 0, 110, 111, 7, 5, 0,
+		0, 111, 156, 3, 0, 0, 20, 112, 113, 10, 18, 0, 0, 113, 114, 7, 6, 0, 0,
+		114, 156, 3, 0, 0, 19, 115, 117, 10, 17, 0, 0, 116, 118, 5, 36, 0, 0,
+		117, 116, 1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119,
+		120, 5, 37, 0, 0, 120, 156, 3, 0, 0, 18, 121, 122, 10, 11, 0, 0, 122,
+		123, 7, 7, 0, 0, 123, 188, 1, 0, 0, 0, 124, 125, 7, 7, 0, 0, 125, 156,
+		3, 0, 0, 12, 126, 127, 10, 10, 0, 0, 127, 128, 7, 8, 0, 0, 128, 192, 1,
+		0, 0, 0, 129, 130, 7, 8, 0, 0, 130, 156, 3, 0, 0, 11, 131, 132, 10, 9,
+		0, 0, 132, 133, 7, 9, 0, 0, 133, 156, 3, 0, 0, 10, 134, 135, 10, 8, 0,
+		0, 135, 136, 7, 10, 0, 0, 136, 156, 3, 0, 0, 9, 137, 138, 10, 7, 0, 0,
+		138, 139, 5, 28, 0, 0, 139, 156, 3, 0, 0, 8, 140, 141, 10, 6, 0, 0, 141,
+		142, 5, 30, 0, 0, 142, 156, 3, 0, 0, 7, 143, 144, 10, 5, 0, 0, 144, 145,
+		5, 29, 0, 0, 145, 156, 3, 0, 0, 6, 146, 147, 10, 4, 0, 0, 147, 148, 5,
+		31, 0, 0, 148, 156, 3, 0, 0, 5, 149, 150, 10, 3, 0, 0, 150, 151, 5, 32,
+		0, 0, 151, 156, 3, 0, 0, 4, 152, 153, 10, 27, 0, 0, 153, 154, 5, 15, 0,
+		0, 154, 156, 5, 53, 0, 0, 155, 103, 1, 0, 0, 0, 155, 106, 1, 0, 0, 0,
+		155, 109, 1, 0, 0, 0, 155, 112, 1, 0, 0, 0, 155, 115, 1, 0, 0, 0, 155,
+		121, 1, 0, 0, 0, 155, 126, 1, 0, 0, 0, 155, 131, 1, 0, 0, 0, 155, 134,
+		1, 0, 0, 0, 155, 137, 1, 0, 0, 0, 155, 140, 1, 0, 0, 0, 155, 143, 1, 0,
+		0, 0, 155, 146, 1, 0, 0, 0, 155, 149, 1, 0, 0, 0, 155, 152, 1, 0, 0, 0,
+		155, 196, 1, 0, 0, 0, 156, 159, 1, 0, 0, 0, 157, 155, 1, 0, 0, 0, 157,
+		158, 1, 0, 0, 0, 158, 1, 1, 0, 0, 0, 159, 157, 1, 0, 0, 0, 161, 162, 5,
+		46, 0, 0, 162, 163, 5, 1, 0, 0, 163, 164, 3, 0, 0, 0, 164, 165, 5, 47,
+		0, 0, 165, 166, 3, 168, 1, 0, 166, 167, 5, 2, 0, 0, 167, 102, 1, 0, 0,
+		0, 172, 174, 1, 0, 0, 0, 172, 175, 1, 0, 0, 0, 174, 173, 3, 168, 1, 0,
+		175, 173, 5, 52, 0, 0, 173, 102, 1, 0, 0, 0, 176, 178, 1, 0, 0, 0, 176,
+		179, 1, 0, 0, 0, 178, 177, 3, 168, 1, 0, 179, 177, 5, 54, 0, 0, 177, 80,
+		1, 0, 0, 0, 180, 182, 1, 0, 0, 0, 180, 183, 1, 0, 0, 0, 182, 181, 3,
+		168, 1, 0, 183, 181, 5, 54, 0, 0, 181, 98, 1, 0, 0, 0, 184, 186, 1, 0,
+		0, 0, 184, 187, 1, 0, 0, 0, 186, 185, 3, 168, 1, 0, 187, 185, 5, 54, 0,
+		0, 185, 100, 1, 0, 0, 0, 188, 190, 1, 0, 0, 0, 188, 191, 1, 0, 0, 0,
+		190, 189, 3, 168, 1, 0, 191, 189, 5, 54, 0, 0, 189, 124, 1, 0, 0, 0,
+		192, 194, 1, 0, 0, 0, 192, 195, 1, 0, 0, 0, 194, 193, 3, 168, 1, 0, 195,
+		193, 5, 54, 0, 0, 193, 129, 1, 0, 0, 0, 168, 170, 1, 0, 0, 0, 170, 171,
+		7, 11, 0, 0, 171, 169, 1, 0, 0, 0, 196, 197, 10, 26, 0, 0, 197, 198, 5,
+		6, 0, 0, 198, 156, 5, 53, 0, 0, 16, 22, 26, 46, 88, 92, 94, 101, 117,
+		155, 157, 172, 176, 180, 184, 188, 192,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -173,58 +194,64 @@ const (
 	PlanParserT__2             = 3
 	PlanParserT__3             = 4
 	PlanParserT__4             = 5
-	PlanParserLBRACE           = 6
-	PlanParserRBRACE           = 7
-	PlanParserLT               = 8
-	PlanParserLE               = 9
-	PlanParserGT               = 10
-	PlanParserGE               = 11
-	PlanParserEQ               = 12
-	PlanParserNE               = 13
-	PlanParserLIKE             = 14
-	PlanParserEXISTS           = 15
-	PlanParserTEXTMATCH        = 16
-	PlanParserPHRASEMATCH      = 17
-	PlanParserRANDOMSAMPLE     = 18
-	PlanParserADD              = 19
-	PlanParserSUB              = 20
-	PlanParserMUL              = 21
-	PlanParserDIV              = 22
-	PlanParserMOD              = 23
-	PlanParserPOW              = 24
-	PlanParserSHL              = 25
-	PlanParserSHR              = 26
-	PlanParserBAND             = 27
-	PlanParserBOR              = 28
-	PlanParserBXOR             = 29
-	PlanParserAND              = 30
-	PlanParserOR               = 31
-	PlanParserISNULL           = 32
-	PlanParserISNOTNULL        = 33
-	PlanParserBNOT             = 34
-	PlanParserNOT              = 35
-	PlanParserIN               = 36
-	PlanParserEmptyArray       = 37
-	PlanParserJSONContains     = 38
-	PlanParserJSONContainsAll  = 39
-	PlanParserJSONContainsAny  = 40
-	PlanParserArrayContains    = 41
-	PlanParserArrayContainsAll = 42
-	PlanParserArrayContainsAny = 43
-	PlanParserArrayLength      = 44
-	PlanParserBooleanConstant  = 45
-	PlanParserIntegerConstant  = 46
-	PlanParserFloatingConstant = 47
-	PlanParserIdentifier       = 48
-	PlanParserMeta             = 49
-	PlanParserStringLiteral    = 50
-	PlanParserJSONIdentifier   = 51
-	PlanParserWhitespace       = 52
-	PlanParserNewline          = 53
+	PlanParserT__5             = 6
+	PlanParserLBRACE           = 7
+	PlanParserRBRACE           = 8
+	PlanParserLT               = 9
+	PlanParserLE               = 10
+	PlanParserGT               = 11
+	PlanParserGE               = 12
+	PlanParserEQ               = 13
+	PlanParserNE               = 14
+	PlanParserLIKE             = 15
+	PlanParserEXISTS           = 16
+	PlanParserTEXTMATCH        = 17
+	PlanParserPHRASEMATCH      = 18
+	PlanParserRANDOMSAMPLE     = 19
+	PlanParserADD              = 20
+	PlanParserSUB              = 21
+	PlanParserMUL              = 22
+	PlanParserDIV              = 23
+	PlanParserMOD              = 24
+	PlanParserPOW              = 25
+	PlanParserSHL              = 26
+	PlanParserSHR              = 27
+	PlanParserBAND             = 28
+	PlanParserBOR              = 29
+	PlanParserBXOR             = 30
+	PlanParserAND              = 31
+	PlanParserOR               = 32
+	PlanParserISNULL           = 33
+	PlanParserISNOTNULL        = 34
+	PlanParserBNOT             = 35
+	PlanParserNOT              = 36
+	PlanParserIN               = 37
+	PlanParserEmptyArray       = 38
+	PlanParserJSONContains     = 39
+	PlanParserJSONContainsAll  = 40
+	PlanParserJSONContainsAny  = 41
+	PlanParserArrayContains    = 42
+	PlanParserArrayContainsAll = 43
+	PlanParserArrayContainsAny = 44
+	PlanParserArrayLength      = 45
+	PlanParserCAST             = 46
+	PlanParserAS               = 47
+	PlanParserBooleanConstant  = 48
+	PlanParserIntegerConstant  = 49
+	PlanParserFloatingConstant = 50
+	PlanParserIdentifier       = 51
+	PlanParserMeta             = 52
+	PlanParserStringLiteral    = 53
+	PlanParserJSONIdentifier   = 54
+	PlanParserWhitespace       = 55
+	PlanParserNewline          = 56
 )
 
-// PlanParserRULE_expr is the PlanParser rule.
-const PlanParserRULE_expr = 0
+// PlanParser rules.
+const (
+	PlanParserRULE_expr = 0
+	PlanParserRULE_name = 1
+)
 
 // IExprContext is an interface to support dynamic dispatch.
 type IExprContext interface {
@@ -640,8 +667,20 @@ func (s *IsNotNullContext) ISNOTNULL() antlr.TerminalNode {
 	return s.GetToken(PlanParserISNOTNULL, 0)
 }
 
-func (s *IsNotNullContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *IsNotNullContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *IsNotNullContext) JSONIdentifier() antlr.TerminalNode {
@@ -762,8 +801,20 @@ func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *IdentifierContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *IdentifierContext) Meta() antlr.TerminalNode {
@@ -832,6 +883,54 @@ func (s *LikeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type RegexMatchContext struct {
+	ExprContext
+}
+
+func NewRegexMatchContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RegexMatchContext {
+	var p = new(RegexMatchContext)
+
+	InitEmptyExprContext(&p.ExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *RegexMatchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RegexMatchContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *RegexMatchContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *RegexMatchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitRegexMatch(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type LogicalAndContext struct {
 	ExprContext
 }
@@ -1214,6 +1313,74 @@ func (s *CallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type CastContext struct {
+	ExprContext
+}
+
+func NewCastContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CastContext {
+	var p = new(CastContext)
+
+	InitEmptyExprContext(&p.ExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CastContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CastContext) CAST() antlr.TerminalNode {
+	return s.GetToken(PlanParserCAST, 0)
+}
+
+func (s *CastContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CastContext) AS() antlr.TerminalNode {
+	return s.GetToken(PlanParserAS, 0)
+}
+
+func (s *CastContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *CastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitCast(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type ReverseRangeContext struct {
 	ExprContext
 	op1 antlr.Token
@@ -1283,8 +1450,20 @@ func (s *ReverseRangeContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ReverseRangeContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *ReverseRangeContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *ReverseRangeContext) JSONIdentifier() antlr.TerminalNode {
@@ -1526,8 +1705,20 @@ func (s *PhraseMatchContext) PHRASEMATCH() antlr.TerminalNode {
 	return s.GetToken(PlanParserPHRASEMATCH, 0)
 }
 
-func (s *PhraseMatchContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *PhraseMatchContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *PhraseMatchContext) StringLiteral() antlr.TerminalNode {
@@ -1672,8 +1863,20 @@ func (s *ArrayLengthContext) ArrayLength() antlr.TerminalNode {
 	return s.GetToken(PlanParserArrayLength, 0)
 }
 
-func (s *ArrayLengthContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *ArrayLengthContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *ArrayLengthContext) JSONIdentifier() antlr.TerminalNode {
@@ -1712,8 +1915,20 @@ func (s *TextMatchContext) TEXTMATCH() antlr.TerminalNode {
 	return s.GetToken(PlanParserTEXTMATCH, 0)
 }
 
-func (s *TextMatchContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *TextMatchContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *TextMatchContext) StringLiteral() antlr.TerminalNode {
@@ -1958,8 +2173,20 @@ func (s *RangeContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *RangeContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *RangeContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *RangeContext) JSONIdentifier() antlr.TerminalNode {
@@ -2451,8 +2678,20 @@ func (s *IsNullContext) ISNULL() antlr.TerminalNode {
 	return s.GetToken(PlanParserISNULL, 0)
 }
 
-func (s *IsNullContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *IsNullContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
 }
 
 func (s *IsNullContext) JSONIdentifier() antlr.TerminalNode {
@@ -2624,16 +2863,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		localctx = NewIdentifierContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
-		{
-			p.SetState(7)
-			_la = p.GetTokenStream().LA(1)
+		p.SetState(172)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 
-			if !(_la == PlanParserIdentifier || _la == PlanParserMeta) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+		switch p.GetTokenStream().LA(1) {
+		case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+			{
+				p.SetState(174)
+				p.Name()
+			}
+
+		case PlanParserMeta:
+			{
+				p.SetState(175)
+				p.Match(PlanParserMeta)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
 	case 6:
@@ -2808,7 +3063,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 		{
 			p.SetState(32)
-			p.expr(27)
+			p.expr(28)
 		}
 
 	case 12:
@@ -2833,11 +3088,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 		{
 			p.SetState(35)
-			p.Match(PlanParserIdentifier)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.Name()
 		}
 		{
 			p.SetState(36)
@@ -2886,11 +3137,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 		{
 			p.SetState(41)
-			p.Match(PlanParserIdentifier)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.Name()
 		}
 		{
 			p.SetState(42)
@@ -2985,7 +3232,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&51541180416) != 0) {
+			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&103082360832) != 0) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
 				localctx.(*UnaryContext).op = _ri
@@ -3163,16 +3410,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(79)
-			_la = p.GetTokenStream().LA(1)
+		p.SetState(176)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 
-			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+		switch p.GetTokenStream().LA(1) {
+		case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+			{
+				p.SetState(178)
+				p.Name()
+			}
+
+		case PlanParserJSONIdentifier:
+			{
+				p.SetState(179)
+				p.Match(PlanParserJSONIdentifier)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 		{
 			p.SetState(80)
@@ -3210,7 +3473,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4503513730089034) != 0 {
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&36028625224401034) != 0 {
 			{
 				p.SetState(83)
 				p.expr(0)
@@ -3280,19 +3543,80 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 
 	case 21:
-		localctx = NewIsNullContext(p, localctx)
+		localctx = NewCastContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(97)
-			_la = p.GetTokenStream().LA(1)
+			p.SetState(161)
+			p.Match(PlanParserCAST)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(162)
+			p.Match(PlanParserT__0)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(163)
+			p.expr(0)
+		}
+		{
+			p.SetState(164)
+			p.Match(PlanParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(165)
+			p.Name()
+		}
+		{
+			p.SetState(166)
+			p.Match(PlanParserT__1)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
 
-			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+	case 22:
+		localctx = NewIsNullContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		p.SetState(180)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+			{
+				p.SetState(182)
+				p.Name()
+			}
+
+		case PlanParserJSONIdentifier:
+			{
+				p.SetState(183)
+				p.Match(PlanParserJSONIdentifier)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 		{
 			p.SetState(98)
@@ -3303,20 +3627,36 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 
-	case 22:
+	case 23:
 		localctx = NewIsNotNullContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
-		{
-			p.SetState(99)
-			_la = p.GetTokenStream().LA(1)
+		p.SetState(184)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 
-			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+		switch p.GetTokenStream().LA(1) {
+		case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+			{
+				p.SetState(186)
+				p.Name()
+			}
+
+		case PlanParserJSONIdentifier:
+			{
+				p.SetState(187)
+				p.Match(PlanParserJSONIdentifier)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 		{
 			p.SetState(100)
@@ -3393,7 +3733,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&14680064) != 0) {
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&29360128) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*MulDivModContext).op = _ri
@@ -3541,16 +3881,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 						p.Consume()
 					}
 				}
-				{
-					p.SetState(123)
-					_la = p.GetTokenStream().LA(1)
+				p.SetState(188)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
 
-					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
+				switch p.GetTokenStream().LA(1) {
+				case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+					{
+						p.SetState(190)
+						p.Name()
+					}
+
+				case PlanParserJSONIdentifier:
+					{
+						p.SetState(191)
+						p.Match(PlanParserJSONIdentifier)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
 					}
+
+				default:
+					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					goto errorExit
 				}
 				{
 					p.SetState(124)
@@ -3602,16 +3958,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 						p.Consume()
 					}
 				}
-				{
-					p.SetState(128)
-					_la = p.GetTokenStream().LA(1)
+				p.SetState(192)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
 
-					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
+				switch p.GetTokenStream().LA(1) {
+				case PlanParserCAST, PlanParserAS, PlanParserIdentifier:
+					{
+						p.SetState(194)
+						p.Name()
+					}
+
+				case PlanParserJSONIdentifier:
+					{
+						p.SetState(195)
+						p.Match(PlanParserJSONIdentifier)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
 					}
+
+				default:
+					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					goto errorExit
 				}
 				{
 					p.SetState(129)
@@ -3654,7 +4026,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3840) != 0) {
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&7680) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*RelationalContext).op = _ri
@@ -3815,8 +4187,8 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
 				p.SetState(152)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 26)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 26)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 27)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 27)", ""))
 					goto errorExit
 				}
 				{
@@ -3836,6 +4208,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 
+			case 16:
+				localctx = NewRegexMatchContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
+				p.SetState(196)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 26)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 26)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(197)
+					p.Match(PlanParserT__5)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(198)
+					p.Match(PlanParserStringLiteral)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
 			case antlr.ATNInvalidAltNumber:
 				goto errorExit
 			}
@@ -3865,6 +4263,115 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// INameContext is an interface to support dynamic dispatch.
+type INameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() antlr.TerminalNode
+	CAST() antlr.TerminalNode
+	AS() antlr.TerminalNode
+
+	// IsNameContext differentiates from other interfaces.
+	IsNameContext()
+}
+
+type NameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNameContext() *NameContext {
+	var p = new(NameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = PlanParserRULE_name
+	return p
+}
+
+func InitEmptyNameContext(p *NameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = PlanParserRULE_name
+}
+
+func (*NameContext) IsNameContext() {}
+
+func NewNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NameContext {
+	var p = new(NameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PlanParserRULE_name
+
+	return p
+}
+
+func (s *NameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NameContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *NameContext) CAST() antlr.TerminalNode {
+	return s.GetToken(PlanParserCAST, 0)
+}
+
+func (s *NameContext) AS() antlr.TerminalNode {
+	return s.GetToken(PlanParserAS, 0)
+}
+
+func (s *NameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PlanParser) Name() (localctx INameContext) {
+	localctx = NewNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, PlanParserRULE_name)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(170)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2462906046218240) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 func (p *PlanParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
 	case 0:
@@ -3924,6 +4431,9 @@ func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) boo
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	case 14:
+		return p.Precpred(p.GetParserRuleContext(), 27)
+
+	case 15:
 		return p.Precpred(p.GetParserRuleContext(), 26)
 
 	default:
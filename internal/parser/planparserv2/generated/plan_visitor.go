@@ -40,6 +40,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Like.
 	VisitLike(ctx *LikeContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#RegexMatch.
+	VisitRegexMatch(ctx *RegexMatchContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#LogicalAnd.
 	VisitLogicalAnd(ctx *LogicalAndContext) interface{}
 
@@ -58,6 +61,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Call.
 	VisitCall(ctx *CallContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#Cast.
+	VisitCast(ctx *CastContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#ReverseRange.
 	VisitReverseRange(ctx *ReverseRangeContext) interface{}
 
@@ -117,4 +123,7 @@ type PlanVisitor interface {
 
 	// Visit a parse tree produced by PlanParser#Power.
 	VisitPower(ctx *PowerContext) interface{}
+
+	// Visit a parse tree produced by PlanParser#name.
+	VisitName(ctx *NameContext) interface{}
 }
@@ -12,6 +12,9 @@ type ExprWithType struct {
 	// For example, a column expression or a value expression itself cannot be an expression node independently.
 	// Unless our execution backend can support them.
 	nodeDependent bool
+	// call is set if the expression is a scalar function applied to a field,
+	// it's only valid as an operand of comparisons with constants.
+	call *scalarFunctionCall
 }
 
 func getError(obj interface{}) error {
@@ -383,6 +383,13 @@ func (v *ParserVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
 	}
 
 	leftExpr, rightExpr := getExpr(left), getExpr(right)
+	if leftExpr.call != nil || rightExpr.call != nil {
+		lowered, err := lowerScalarFunctionCompare(cmpOpMap[ctx.GetOp().GetTokenType()], leftExpr, rightExpr)
+		if err != nil {
+			return err
+		}
+		return lowered
+	}
 
 	expr, err := HandleCompare(ctx.GetOp().GetTokenType(), leftExpr, rightExpr)
 	if err != nil {
@@ -432,6 +439,13 @@ func (v *ParserVisitor) VisitRelational(ctx *parser.RelationalContext) interface
 	}
 
 	leftExpr, rightExpr := getExpr(left), getExpr(right)
+	if leftExpr.call != nil || rightExpr.call != nil {
+		lowered, err := lowerScalarFunctionCompare(cmpOpMap[ctx.GetOp().GetTokenType()], leftExpr, rightExpr)
+		if err != nil {
+			return err
+		}
+		return lowered
+	}
 	if err := checkDirectComparisonBinaryField(toColumnInfo(leftExpr)); err != nil {
 		return err
 	}
@@ -499,8 +513,27 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 	}
 }
 
+// VisitRegexMatch translates `expr =~ pattern`, which is the same as regex_match(expr, pattern).
+func (v *ParserVisitor) VisitRegexMatch(ctx *parser.RegexMatchContext) interface{} {
+	left := ctx.Expr().Accept(v)
+	if err := getError(left); err != nil {
+		return err
+	}
+	leftExpr := getExpr(left)
+	if leftExpr == nil {
+		return fmt.Errorf("invalid left operand of =~: %s", ctx.Expr().GetText())
+	}
+
+	pattern, err := convertEscapeSingle(ctx.StringLiteral().GetText())
+	if err != nil {
+		return err
+	}
+	call := &scalarFunctionCall{name: funcRegexMatch, args: []*planpb.GenericValue{NewString(pattern)}}
+	return bindScalarFunction(call, scalarFunctions[funcRegexMatch], leftExpr, ctx.Expr().GetText())
+}
+
 func (v *ParserVisitor) VisitTextMatch(ctx *parser.TextMatchContext) interface{} {
-	column, err := v.translateIdentifier(ctx.Identifier().GetText())
+	column, err := v.translateIdentifier(ctx.Name().GetText())
 	if err != nil {
 		return err
 	}
@@ -535,7 +568,7 @@ func (v *ParserVisitor) VisitTextMatch(ctx *parser.TextMatchContext) interface{}
 }
 
 func (v *ParserVisitor) VisitPhraseMatch(ctx *parser.PhraseMatchContext) interface{} {
-	column, err := v.translateIdentifier(ctx.Identifier().GetText())
+	column, err := v.translateIdentifier(ctx.Name().GetText())
 	if err != nil {
 		return err
 	}
@@ -697,7 +730,7 @@ func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 	}
 }
 
-func (v *ParserVisitor) getChildColumnInfo(identifier, child antlr.TerminalNode) (*planpb.ColumnInfo, error) {
+func (v *ParserVisitor) getChildColumnInfo(identifier parser.INameContext, child antlr.TerminalNode) (*planpb.ColumnInfo, error) {
 	if identifier != nil {
 		childExpr, err := v.translateIdentifier(identifier.GetText())
 		if err != nil {
@@ -712,6 +745,9 @@ func (v *ParserVisitor) getChildColumnInfo(identifier, child antlr.TerminalNode)
 // VisitCall parses the expr to call plan.
 func (v *ParserVisitor) VisitCall(ctx *parser.CallContext) interface{} {
 	functionName := strings.ToLower(ctx.Identifier().GetText())
+	if fn, ok := scalarFunctions[functionName]; ok {
+		return v.visitScalarFunction(&scalarFunctionCall{name: functionName}, fn, ctx.AllExpr())
+	}
 	numParams := len(ctx.AllExpr())
 	funcParameters := make([]*planpb.Expr, 0, numParams)
 	for _, param := range ctx.AllExpr() {
@@ -734,9 +770,19 @@ func (v *ParserVisitor) VisitCall(ctx *parser.CallContext) interface{} {
 	}
 }
 
+// VisitCast parses `cast(expr as type)`, which is folded on constants or lowered with comparisons on fields.
+func (v *ParserVisitor) VisitCast(ctx *parser.CastContext) interface{} {
+	castType, err := parseCastType(ctx.Name().GetText())
+	if err != nil {
+		return err
+	}
+	call := &scalarFunctionCall{name: funcCast, castType: castType}
+	return v.visitScalarFunction(call, castFunction(castType), []parser.IExprContext{ctx.Expr()})
+}
+
 // VisitRange translates expr to range plan.
 func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
-	columnInfo, err := v.getChildColumnInfo(ctx.Identifier(), ctx.JSONIdentifier())
+	columnInfo, err := v.getChildColumnInfo(ctx.Name(), ctx.JSONIdentifier())
 	if err != nil {
 		return err
 	}
@@ -817,7 +863,7 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 
 // VisitReverseRange parses the expression like "1 > a > 0".
 func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) interface{} {
-	columnInfo, err := v.getChildColumnInfo(ctx.Identifier(), ctx.JSONIdentifier())
+	columnInfo, err := v.getChildColumnInfo(ctx.Name(), ctx.JSONIdentifier())
 	if err != nil {
 		return err
 	}
@@ -1317,7 +1363,7 @@ func (v *ParserVisitor) VisitEmptyArray(ctx *parser.EmptyArrayContext) interface
 }
 
 func (v *ParserVisitor) VisitIsNotNull(ctx *parser.IsNotNullContext) interface{} {
-	column, err := v.getChildColumnInfo(ctx.Identifier(), ctx.JSONIdentifier())
+	column, err := v.getChildColumnInfo(ctx.Name(), ctx.JSONIdentifier())
 	if err != nil {
 		return err
 	}
@@ -1357,7 +1403,7 @@ func (v *ParserVisitor) VisitIsNotNull(ctx *parser.IsNotNullContext) interface{}
 }
 
 func (v *ParserVisitor) VisitIsNull(ctx *parser.IsNullContext) interface{} {
-	column, err := v.getChildColumnInfo(ctx.Identifier(), ctx.JSONIdentifier())
+	column, err := v.getChildColumnInfo(ctx.Name(), ctx.JSONIdentifier())
 	if err != nil {
 		return err
 	}
@@ -1560,7 +1606,7 @@ func (v *ParserVisitor) VisitJSONContainsAny(ctx *parser.JSONContainsAnyContext)
 }
 
 func (v *ParserVisitor) VisitArrayLength(ctx *parser.ArrayLengthContext) interface{} {
-	columnInfo, err := v.getChildColumnInfo(ctx.Identifier(), ctx.JSONIdentifier())
+	columnInfo, err := v.getChildColumnInfo(ctx.Name(), ctx.JSONIdentifier())
 	if err != nil {
 		return err
 	}
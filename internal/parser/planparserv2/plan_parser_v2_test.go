@@ -550,6 +550,54 @@ func TestExpr_Identifier(t *testing.T) {
 	}
 }
 
+func TestExpr_KeywordFieldName(t *testing.T) {
+	schema := newTestSchema(true)
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 301, Name: "as", DataType: schemapb.DataType_Int64, Nullable: true},
+		&schemapb.FieldSchema{FieldID: 302, Name: "cast", DataType: schemapb.DataType_Double, Nullable: true},
+		&schemapb.FieldSchema{FieldID: 303, Name: "CAST", DataType: schemapb.DataType_VarChar, Nullable: true},
+	)
+	enableMatch(schema)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		exprStr string
+		fieldID int64
+	}{
+		{`as > 1`, 301},
+		{`cast == 2`, 302},
+		{`as in [1, 2]`, 301},
+		{`1 < as < 3`, 301},
+		{`3.0 > cast > 1.0`, 302},
+		{`as is null`, 301},
+		{`cast is not null`, 302},
+		{`text_match(CAST, "milvus")`, 303},
+		{`cast(cast as int64) == 2`, 302},
+	}
+	for _, tc := range testcases {
+		expr, err := ParseExpr(helper, tc.exprStr, nil)
+		require.NoError(t, err, tc.exprStr)
+		var columns []*planpb.ColumnInfo
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_UnaryRangeExpr:
+			columns = append(columns, e.UnaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_BinaryRangeExpr:
+			columns = append(columns, e.BinaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_TermExpr:
+			columns = append(columns, e.TermExpr.GetColumnInfo())
+		case *planpb.Expr_NullExpr:
+			columns = append(columns, e.NullExpr.GetColumnInfo())
+		}
+		require.Len(t, columns, 1, tc.exprStr)
+		assert.Equal(t, tc.fieldID, columns[0].GetFieldId(), tc.exprStr)
+	}
+
+	assertValidExpr(t, helper, `as > 1 and cast < 2.0`)
+	assertInvalidExpr(t, helper, `cast(as as cast) > 1`)
+	assertInvalidExpr(t, helper, `as as > 1`)
+}
+
 func TestExpr_Constant(t *testing.T) {
 	schema := newTestSchema(true)
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -0,0 +1,325 @@
+package planparserv2
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// regex_match(s, pattern) is true if the regular expression is found anywhere in s,
+// anchor the pattern with ^ and $ to match the whole string.
+//
+// The pattern is parsed with RE2 syntax, but it's evaluated by boost regex in segcore and by
+// the regex query of tantivy for inverted indexes. So it's rewritten into a regex matching the
+// whole string, which only uses the syntax all of them interpret the same way:
+//   - ^ and $ are only supported at the beginning and the end of the pattern,
+//     multi-line mode and word boundaries are not supported.
+//   - Perl classes such as \d and \s are expanded into ASCII classes,
+//     and case-insensitive literals are expanded into their case variants.
+//   - character classes can't contain non-ASCII characters, unless it's a small set of
+//     characters, or it contains all of them like [^a].
+
+// maxRegexClassNonASCII is the max number of non-ASCII characters in a character class.
+const maxRegexClassNonASCII = 64
+
+// regexSpecialBytes are escaped in literals, the same as the translation of like patterns in segcore.
+const regexSpecialBytes = `\.+*?()|[]{}^$`
+
+func compileRegexMatchPattern(value *planpb.GenericValue) (*regexp.Regexp, error) {
+	if !IsString(value) {
+		return nil, fmt.Errorf("pattern of regex_match must be a string, got: %s", value)
+	}
+	re, err := regexp.Compile(value.GetStringVal())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern of regex_match: %w", err)
+	}
+	return re, nil
+}
+
+func evalRegexMatch(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	if !IsString(args[0]) {
+		return nil, fmt.Errorf("expect string, got: %s", args[0])
+	}
+	re, err := compileRegexMatchPattern(args[1])
+	if err != nil {
+		return nil, err
+	}
+	return NewBool(re.MatchString(args[0].GetStringVal())), nil
+}
+
+func bindRegexMatch(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	column := call.column
+	if !typeutil.IsStringType(column.GetDataType()) && !typeutil.IsJSONType(column.GetDataType()) &&
+		!(typeutil.IsArrayType(column.GetDataType()) && typeutil.IsStringType(column.GetElementType())) {
+		return nil, schemapb.DataType_None, fmt.Errorf("function regex_match is only supported on string or json fields, got: %s",
+			column.GetDataType())
+	}
+	if _, err := compileRegexMatchPattern(call.args[0]); err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	pattern, err := rewriteRegexMatchPattern(call.args[0].GetStringVal())
+	if err != nil {
+		return nil, schemapb.DataType_None, fmt.Errorf("unsupported pattern of regex_match: %w", err)
+	}
+	return &ExprWithType{
+		expr:     unaryRangeExpr(column, planpb.OpType_RegexMatch, NewString(pattern)),
+		dataType: schemapb.DataType_Bool,
+	}, schemapb.DataType_Bool, nil
+}
+
+// rewriteRegexMatchPattern rewrites the searching pattern into a regex matching the whole string.
+func rewriteRegexMatchPattern(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	anchorBegin := len(subs) > 0 && subs[0].Op == syntax.OpBeginText
+	if anchorBegin {
+		subs = subs[1:]
+	}
+	anchorEnd := len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText
+	if anchorEnd {
+		subs = subs[:len(subs)-1]
+	}
+
+	var buf strings.Builder
+	if !anchorBegin {
+		buf.WriteString(`[\s\S]*`)
+	}
+	for _, sub := range subs {
+		if err := writeRegex(&buf, sub); err != nil {
+			return "", err
+		}
+	}
+	if !anchorEnd {
+		buf.WriteString(`[\s\S]*`)
+	}
+	return buf.String(), nil
+}
+
+func writeRegex(buf *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		buf.WriteString("(?:)")
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				writeRegexRuneVariants(buf, r, caseFoldOrbit(r))
+			} else {
+				writeRegexRune(buf, r)
+			}
+		}
+	case syntax.OpCharClass:
+		return writeRegexClass(buf, re.Rune)
+	case syntax.OpAnyCharNotNL:
+		buf.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		buf.WriteString(`[\s\S]`)
+	case syntax.OpCapture:
+		return writeRegexGroup(buf, re.Sub[0], "")
+	case syntax.OpStar:
+		return writeRegexGroup(buf, re.Sub[0], "*")
+	case syntax.OpPlus:
+		return writeRegexGroup(buf, re.Sub[0], "+")
+	case syntax.OpQuest:
+		return writeRegexGroup(buf, re.Sub[0], "?")
+	case syntax.OpRepeat:
+		switch {
+		case re.Max < 0:
+			return writeRegexGroup(buf, re.Sub[0], fmt.Sprintf("{%d,}", re.Min))
+		case re.Min == re.Max:
+			return writeRegexGroup(buf, re.Sub[0], fmt.Sprintf("{%d}", re.Min))
+		default:
+			return writeRegexGroup(buf, re.Sub[0], fmt.Sprintf("{%d,%d}", re.Min, re.Max))
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := writeRegex(buf, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		buf.WriteString("(?:")
+		for i, sub := range re.Sub {
+			if i > 0 {
+				buf.WriteByte('|')
+			}
+			if err := writeRegex(buf, sub); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(')')
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return fmt.Errorf("^ and $ are only supported at the beginning and the end of the pattern")
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return fmt.Errorf("word boundary is not supported")
+	default:
+		return fmt.Errorf("%s is not supported", re)
+	}
+	return nil
+}
+
+// writeRegexGroup writes the sub expression as a non-capturing group, so the quantifier
+// applies to the whole of it, even a single multi-byte character.
+func writeRegexGroup(buf *strings.Builder, sub *syntax.Regexp, quantifier string) error {
+	buf.WriteString("(?:")
+	if err := writeRegex(buf, sub); err != nil {
+		return err
+	}
+	buf.WriteByte(')')
+	buf.WriteString(quantifier)
+	return nil
+}
+
+func writeRegexRune(buf *strings.Builder, r rune) {
+	switch {
+	case r >= utf8.RuneSelf:
+		buf.WriteRune(r)
+	case strings.ContainsRune(regexSpecialBytes, r):
+		buf.WriteByte('\\')
+		buf.WriteRune(r)
+	case unicode.IsPrint(r):
+		buf.WriteRune(r)
+	default:
+		fmt.Fprintf(buf, `\x%02X`, r)
+	}
+}
+
+// writeRegexClassRune writes a character of an ASCII class.
+func writeRegexClassRune(buf *strings.Builder, r rune) {
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		buf.WriteRune(r)
+	} else {
+		fmt.Fprintf(buf, `\x%02X`, r)
+	}
+}
+
+// writeRegexRuneVariants writes any one of the characters.
+func writeRegexRuneVariants(buf *strings.Builder, r rune, variants []rune) {
+	if len(variants) <= 1 {
+		writeRegexRune(buf, r)
+		return
+	}
+	ascii := true
+	for _, v := range variants {
+		ascii = ascii && v < utf8.RuneSelf
+	}
+	if ascii {
+		buf.WriteByte('[')
+		for _, v := range variants {
+			writeRegexClassRune(buf, v)
+		}
+		buf.WriteByte(']')
+		return
+	}
+	buf.WriteString("(?:")
+	for i, v := range variants {
+		if i > 0 {
+			buf.WriteByte('|')
+		}
+		writeRegexRune(buf, v)
+	}
+	buf.WriteByte(')')
+}
+
+// caseFoldOrbit returns the characters equivalent to r under simple case folding, including r itself.
+func caseFoldOrbit(r rune) []rune {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	return orbit
+}
+
+// writeRegexClass writes the class given as sorted rune ranges [lo, hi, lo, hi, ...].
+func writeRegexClass(buf *strings.Builder, ranges []rune) error {
+	var ascii, nonASCII []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < utf8.RuneSelf {
+			ascii = append(ascii, lo, min(hi, utf8.RuneSelf-1))
+		}
+		if hi >= utf8.RuneSelf {
+			nonASCII = append(nonASCII, max(lo, utf8.RuneSelf), hi)
+		}
+	}
+
+	if len(nonASCII) == 2 && nonASCII[0] == utf8.RuneSelf && nonASCII[1] == unicode.MaxRune {
+		// all non-ASCII characters, write the complement of the ASCII part.
+		complement := make([]rune, 0, len(ascii)+2)
+		next := rune(0)
+		for i := 0; i < len(ascii); i += 2 {
+			if ascii[i] > next {
+				complement = append(complement, next, ascii[i]-1)
+			}
+			next = ascii[i+1] + 1
+		}
+		if next < utf8.RuneSelf {
+			complement = append(complement, next, utf8.RuneSelf-1)
+		}
+		if len(complement) == 0 {
+			buf.WriteString(`[\s\S]`)
+			return nil
+		}
+		buf.WriteString("[^")
+		writeRegexClassRanges(buf, complement)
+		buf.WriteByte(']')
+		return nil
+	}
+
+	count := 0
+	for i := 0; i < len(nonASCII); i += 2 {
+		count += int(nonASCII[i+1]-nonASCII[i]) + 1
+	}
+	if count > maxRegexClassNonASCII {
+		return fmt.Errorf("character class with more than %d non-ASCII characters is not supported", maxRegexClassNonASCII)
+	}
+	if count == 0 {
+		if len(ascii) == 0 {
+			return fmt.Errorf("empty character class is not supported")
+		}
+		buf.WriteByte('[')
+		writeRegexClassRanges(buf, ascii)
+		buf.WriteByte(']')
+		return nil
+	}
+
+	buf.WriteString("(?:")
+	if len(ascii) > 0 {
+		buf.WriteByte('[')
+		writeRegexClassRanges(buf, ascii)
+		buf.WriteString("]|")
+	}
+	for i := 0; i < len(nonASCII); i += 2 {
+		for r := nonASCII[i]; r <= nonASCII[i+1]; r++ {
+			if i > 0 || r > nonASCII[0] {
+				buf.WriteByte('|')
+			}
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte(')')
+	return nil
+}
+
+func writeRegexClassRanges(buf *strings.Builder, ranges []rune) {
+	for i := 0; i < len(ranges); i += 2 {
+		writeRegexClassRune(buf, ranges[i])
+		if ranges[i+1] > ranges[i] {
+			buf.WriteByte('-')
+			writeRegexClassRune(buf, ranges[i+1])
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+)
+
+func TestExpr_RegexMatch(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	testcases := []struct {
+		expr    string
+		pattern string
+	}{
+		{`regex_match(VarCharField, "^err(or)?\\s+\\d+$")`, `err(?:(?:or))?(?:[\x09-\x0A\x0C-\x0D\x20])+(?:[0-9])+`},
+		{`regex_match(VarCharField, 'ab|cd')`, `[\s\S]*(?:ab|cd)[\s\S]*`},
+		{`regex_match(JSONField["log"], "timeout")`, `[\s\S]*timeout[\s\S]*`},
+		{`regex_match(StringArrayField[0], "^[a-z]+")`, `(?:[a-z])+[\s\S]*`},
+		{`regex_match(A, "x.*y$")`, `[\s\S]*x(?:[^\n])*y`},
+		{`regex_match(VarCharField, "a\\.b{2,3}")`, `[\s\S]*a\.(?:b){2,3}[\s\S]*`},
+		{`regex_match(VarCharField, "\\x41[^a]")`, `[\s\S]*A[^a][\s\S]*`},
+		{`regex_match(VarCharField, "(?i)ab")`, `[\s\S]*[Aa][Bb][\s\S]*`},
+		{`regex_match(VarCharField, "北京+")`, `[\s\S]*北(?:京)+[\s\S]*`},
+		{`regex_match(VarCharField, "[a北京]")`, `[\s\S]*(?:[a]|京|北)[\s\S]*`},
+	}
+	for _, c := range testcases {
+		expr, err := ParseExpr(helper, c.expr, nil)
+		require.NoError(t, err, c.expr)
+		unaryRange := expr.GetUnaryRangeExpr()
+		require.NotNil(t, unaryRange, c.expr)
+		assert.Equal(t, planpb.OpType_RegexMatch, unaryRange.GetOp(), c.expr)
+		assert.Equal(t, c.pattern, unaryRange.GetValue().GetStringVal(), c.expr)
+	}
+
+	expr, err := ParseExpr(helper, `not regex_match(VarCharField, "abc")`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryExpr().GetChild().GetUnaryRangeExpr().GetOp())
+
+	expr, err = ParseExpr(helper, `BoolField == regex_match("error 42", "\\d+")`, nil)
+	require.NoError(t, err)
+	assert.True(t, expr.GetUnaryRangeExpr().GetValue().GetBoolVal())
+
+	invalidExprs := []string{
+		`regex_match(VarCharField, "a(")`,
+		`regex_match(VarCharField, "(?<=a)b")`,
+		`regex_match(VarCharField, "a^b")`,
+		`regex_match(VarCharField, "(?m)^a")`,
+		`regex_match(VarCharField, "a|^b")`,
+		`regex_match(VarCharField, "\\ba")`,
+		`regex_match(VarCharField, "\\pL")`,
+		`regex_match(VarCharField, "[^北]")`,
+		`regex_match(VarCharField, 1)`,
+		`regex_match(VarCharField)`,
+		`regex_match(Int64Field, "a")`,
+		`regex_match(ArrayField, "a")`,
+		`regex_match(StringArrayField, "a")`,
+		`regex_match(VarCharField, VarCharField)`,
+		`regex_match(VarCharField, {pattern})`,
+		`regex_match(VarCharField, "a") == true`,
+	}
+	for _, expr := range invalidExprs {
+		assertInvalidExpr(t, helper, expr)
+	}
+}
+
+func TestExpr_RegexMatchOperator(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	testcases := []struct {
+		expr     string
+		function string
+	}{
+		{`VarCharField =~ "^err(or)?\\s+\\d+$"`, `regex_match(VarCharField, "^err(or)?\\s+\\d+$")`},
+		{`VarCharField =~ 'ab|cd'`, `regex_match(VarCharField, 'ab|cd')`},
+		{`JSONField["log"] =~ "timeout"`, `regex_match(JSONField["log"], "timeout")`},
+		{`StringArrayField[0] =~ "^[a-z]+"`, `regex_match(StringArrayField[0], "^[a-z]+")`},
+		{`A =~ "x.*y$"`, `regex_match(A, "x.*y$")`},
+	}
+	for _, c := range testcases {
+		expr, err := ParseExpr(helper, c.expr, nil)
+		require.NoError(t, err, c.expr)
+		expected, err := ParseExpr(helper, c.function, nil)
+		require.NoError(t, err, c.function)
+		assert.Equal(t, expected.String(), expr.String(), c.expr)
+		assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryRangeExpr().GetOp(), c.expr)
+	}
+
+	expr, err := ParseExpr(helper, `not (VarCharField =~ "abc")`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryExpr().GetChild().GetUnaryRangeExpr().GetOp())
+
+	expr, err = ParseExpr(helper, `VarCharField =~ "a" && Int64Field > 1`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetOp())
+
+	expr, err = ParseExpr(helper, `BoolField == ("error 42" =~ "\\d+")`, nil)
+	require.NoError(t, err)
+	assert.True(t, expr.GetUnaryRangeExpr().GetValue().GetBoolVal())
+
+	invalidExprs := []string{
+		`VarCharField =~ "a("`,
+		`VarCharField =~ "(?<=a)b"`,
+		`VarCharField =~ 1`,
+		`VarCharField =~ VarCharField`,
+		`VarCharField =~ {pattern}`,
+		`Int64Field =~ "a"`,
+		`ArrayField =~ "a"`,
+		`StringArrayField =~ "a"`,
+		`lower(VarCharField) =~ "a"`,
+		`=~ "a"`,
+		`(VarCharField =~ "a") == true`,
+	}
+	for _, expr := range invalidExprs {
+		assertInvalidExpr(t, helper, expr)
+	}
+}
@@ -0,0 +1,936 @@
+package planparserv2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// Scalar functions are evaluated by the parser instead of the execution backend.
+// A function over constant arguments is folded into a constant.
+// A function over a field, which must be its first argument, can only be compared with a constant,
+// and the comparison is lowered into plan nodes on the field itself, e.g.
+//
+//	floor(a) > 2.5            =>  a >= 3
+//	abs(a) < 3                =>  -3 < a < 3
+//	substr(s, 1, 3) == "abc"  =>  s like "abc%"
+//	length(s) == 3            =>  s like "___"
+//	lower(s) == "abc"         =>  regex_match(s, "^[aA][bB][cC]$")
+//
+// String functions work on characters rather than bytes, the same as the `_` wildcard of like.
+// cast(x as type) has its own grammar rule, see VisitCast.
+const (
+	funcCast       = "cast"
+	funcLower      = "lower"
+	funcUpper      = "upper"
+	funcLength     = "length"
+	funcSubstr     = "substr"
+	funcAbs        = "abs"
+	funcFloor      = "floor"
+	funcCeil       = "ceil"
+	funcStartsWith = "starts_with"
+	funcRegexMatch = "regex_match"
+)
+
+// maxLoweredStringLength is the max string length supported when lowering string functions into patterns.
+const maxLoweredStringLength = 65535
+
+type scalarFunction struct {
+	minArgs int
+	maxArgs int
+	// eval evaluates the function over constant arguments.
+	eval func(args []*planpb.GenericValue) (*planpb.GenericValue, error)
+	// bind type checks the function applied to a field, it returns either an executable
+	// expression directly, or the result type of the function if the call must be lowered
+	// with a comparison.
+	bind func(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error)
+}
+
+// scalarFunctionCall is a scalar function applied to a field, which is lowered when compared with a constant.
+type scalarFunctionCall struct {
+	name   string
+	column *planpb.ColumnInfo
+	// constant arguments following the field
+	args []*planpb.GenericValue
+	// target type of cast
+	castType schemapb.DataType
+}
+
+var scalarFunctions = map[string]*scalarFunction{
+	funcLower:      {minArgs: 1, maxArgs: 1, eval: evalCase(strings.ToLower), bind: bindCase},
+	funcUpper:      {minArgs: 1, maxArgs: 1, eval: evalCase(strings.ToUpper), bind: bindCase},
+	funcLength:     {minArgs: 1, maxArgs: 1, eval: evalLength, bind: bindLength},
+	funcSubstr:     {minArgs: 2, maxArgs: 3, eval: evalSubstr, bind: bindSubstr},
+	funcAbs:        {minArgs: 1, maxArgs: 1, eval: evalAbs, bind: bindNumeric},
+	funcFloor:      {minArgs: 1, maxArgs: 1, eval: evalRound(math.Floor), bind: bindNumeric},
+	funcCeil:       {minArgs: 1, maxArgs: 1, eval: evalRound(math.Ceil), bind: bindNumeric},
+	funcStartsWith: {minArgs: 2, maxArgs: 2, eval: evalStartsWith, bind: bindStartsWith},
+	funcRegexMatch: {minArgs: 2, maxArgs: 2, eval: evalRegexMatch, bind: bindRegexMatch},
+}
+
+var castTypeNames = map[string]schemapb.DataType{
+	"bool":    schemapb.DataType_Bool,
+	"int8":    schemapb.DataType_Int8,
+	"int16":   schemapb.DataType_Int16,
+	"int32":   schemapb.DataType_Int32,
+	"int64":   schemapb.DataType_Int64,
+	"float":   schemapb.DataType_Float,
+	"double":  schemapb.DataType_Double,
+	"varchar": schemapb.DataType_VarChar,
+	"string":  schemapb.DataType_VarChar,
+}
+
+func parseCastType(text string) (schemapb.DataType, error) {
+	name := strings.ToLower(text)
+	dataType, ok := castTypeNames[name]
+	if !ok {
+		return schemapb.DataType_None, fmt.Errorf("unsupported cast type: %s", text)
+	}
+	return dataType, nil
+}
+
+// castFunction returns the function casting its argument to the target type.
+func castFunction(target schemapb.DataType) *scalarFunction {
+	return &scalarFunction{
+		minArgs: 1,
+		maxArgs: 1,
+		eval: func(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+			return castConstant(args[0], target)
+		},
+		bind: bindCast,
+	}
+}
+
+// visitScalarFunction type checks the function call, folds constant or binds the call to a field.
+func (v *ParserVisitor) visitScalarFunction(call *scalarFunctionCall, fn *scalarFunction, params []parser.IExprContext) interface{} {
+	name := call.name
+	if len(params) < fn.minArgs || len(params) > fn.maxArgs {
+		if fn.minArgs == fn.maxArgs {
+			return fmt.Errorf("function %s expects %d arguments, got %d", name, fn.minArgs, len(params))
+		}
+		return fmt.Errorf("function %s expects %d to %d arguments, got %d", name, fn.minArgs, fn.maxArgs, len(params))
+	}
+
+	first := params[0].Accept(v)
+	if err := getError(first); err != nil {
+		return err
+	}
+	firstExpr := getExpr(first)
+	if firstExpr == nil {
+		return fmt.Errorf("invalid argument of function %s: %s", name, params[0].GetText())
+	}
+	for _, param := range params[1:] {
+		arg := param.Accept(v)
+		if err := getError(arg); err != nil {
+			return err
+		}
+		valueExpr := getValueExpr(arg)
+		if valueExpr == nil || isTemplateExpr(valueExpr) {
+			return fmt.Errorf("argument of function %s must be a constant: %s", name, param.GetText())
+		}
+		call.args = append(call.args, valueExpr.GetValue())
+	}
+	return bindScalarFunction(call, fn, firstExpr, params[0].GetText())
+}
+
+// bindScalarFunction folds the call on a constant or binds it to the field of the first argument.
+func bindScalarFunction(call *scalarFunctionCall, fn *scalarFunction, firstExpr *ExprWithType, firstText string) interface{} {
+	name := call.name
+	if valueExpr := firstExpr.expr.GetValueExpr(); valueExpr != nil {
+		if isTemplateExpr(valueExpr) {
+			return fmt.Errorf("placeholder is not supported as argument of function %s", name)
+		}
+		args := append([]*planpb.GenericValue{valueExpr.GetValue()}, call.args...)
+		value, err := fn.eval(args)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate function %s: %w", name, err)
+		}
+		return toValueExpr(value)
+	}
+
+	column := toColumnInfo(firstExpr)
+	if column == nil || firstExpr.call != nil {
+		return fmt.Errorf("the first argument of function %s must be a field or a constant, got: %s", name, firstText)
+	}
+	// length is the only function accepting a whole array field
+	if name != funcLength {
+		if err := checkDirectComparisonBinaryField(column); err != nil {
+			return err
+		}
+	}
+	call.column = column
+
+	expr, resultType, err := fn.bind(call)
+	if err != nil {
+		return err
+	}
+	if expr != nil {
+		return expr
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_CallExpr{
+				CallExpr: &planpb.CallExpr{
+					FunctionName: name,
+					FunctionParameters: append([]*planpb.Expr{{Expr: &planpb.Expr_ColumnExpr{ColumnExpr: &planpb.ColumnExpr{Info: column}}}},
+						valuesToExprs(call.args)...),
+				},
+			},
+		},
+		dataType:      resultType,
+		nodeDependent: true,
+		call:          call,
+	}
+}
+
+func valuesToExprs(values []*planpb.GenericValue) []*planpb.Expr {
+	exprs := make([]*planpb.Expr, 0, len(values))
+	for _, value := range values {
+		exprs = append(exprs, &planpb.Expr{Expr: &planpb.Expr_ValueExpr{ValueExpr: &planpb.ValueExpr{Value: value}}})
+	}
+	return exprs
+}
+
+// lowerScalarFunctionCompare lowers `f(field) op constant` or `constant op f(field)`.
+func lowerScalarFunctionCompare(op planpb.OpType, left, right *ExprWithType) (*ExprWithType, error) {
+	call, other := left.call, right
+	if call == nil {
+		var err error
+		if op, err = reverseOrder(op); err != nil {
+			return nil, err
+		}
+		call, other = right.call, left
+	}
+	valueExpr := other.expr.GetValueExpr()
+	if valueExpr == nil || isTemplateExpr(valueExpr) {
+		return nil, fmt.Errorf("function %s on field can only be compared with a constant", call.name)
+	}
+
+	var expr *planpb.Expr
+	var err error
+	switch call.name {
+	case funcAbs:
+		expr, err = lowerAbsCompare(op, call.column, valueExpr.GetValue())
+	case funcFloor, funcCeil, funcCast:
+		expr, err = lowerStepCompare(op, call, valueExpr.GetValue())
+	case funcLength:
+		expr, err = lowerLengthCompare(op, call.column, valueExpr.GetValue())
+	case funcSubstr:
+		expr, err = lowerSubstrCompare(op, call, valueExpr.GetValue())
+	case funcLower, funcUpper:
+		expr, err = lowerCaseCompare(op, call, valueExpr.GetValue())
+	default:
+		err = fmt.Errorf("comparison on function %s is not supported", call.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ExprWithType{
+		expr:     expr,
+		dataType: schemapb.DataType_Bool,
+	}, nil
+}
+
+func getNumber(value *planpb.GenericValue) (float64, bool) {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return float64(v.Int64Val), true
+	case *planpb.GenericValue_FloatVal:
+		return v.FloatVal, true
+	default:
+		return 0, false
+	}
+}
+
+func isIntegral(f float64) bool {
+	return f == math.Trunc(f)
+}
+
+// numberForColumn converts the number into the value type of the column.
+func numberForColumn(column *planpb.ColumnInfo, f float64) *planpb.GenericValue {
+	if typeutil.IsIntegerType(column.GetDataType()) || (typeutil.IsJSONType(column.GetDataType()) && isIntegral(f)) {
+		return NewInt(int64(f))
+	}
+	return NewFloat(f)
+}
+
+func isNumericColumn(column *planpb.ColumnInfo) bool {
+	return typeutil.IsArithmetic(column.GetDataType()) || typeutil.IsJSONType(column.GetDataType())
+}
+
+func unaryRangeExpr(column *planpb.ColumnInfo, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: column,
+				Op:         op,
+				Value:      value,
+			},
+		},
+	}
+}
+
+func binaryRangeExpr(column *planpb.ColumnInfo, lower *planpb.GenericValue, lowerInclusive bool, upper *planpb.GenericValue, upperInclusive bool) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryRangeExpr{
+			BinaryRangeExpr: &planpb.BinaryRangeExpr{
+				ColumnInfo:     column,
+				LowerInclusive: lowerInclusive,
+				UpperInclusive: upperInclusive,
+				LowerValue:     lower,
+				UpperValue:     upper,
+			},
+		},
+	}
+}
+
+func notExpr(child *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op:    planpb.UnaryExpr_Not,
+				Child: child,
+			},
+		},
+	}
+}
+
+func orExpr(left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    planpb.BinaryExpr_LogicalOr,
+				Left:  left,
+				Right: right,
+			},
+		},
+	}
+}
+
+// notNullExpr matches every row that has a value for the column.
+func notNullExpr(column *planpb.ColumnInfo) *planpb.Expr {
+	if typeutil.IsJSONType(column.GetDataType()) && len(column.GetNestedPath()) > 0 {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_ExistsExpr{
+				ExistsExpr: &planpb.ExistsExpr{Info: column},
+			},
+		}
+	}
+	if column.GetNullable() {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{ColumnInfo: column, Op: planpb.NullExpr_IsNotNull},
+			},
+		}
+	}
+	return alwaysTrueExpr()
+}
+
+func alwaysFalseExpr() *planpb.Expr {
+	return notExpr(alwaysTrueExpr())
+}
+
+// normalizeIntegralCompare rewrites `x op c` into an equivalent comparison with an integral constant,
+// where x only takes integral values. The returned op is one of GreaterEqual, LessEqual, Equal and NotEqual,
+// ok is false if `x == c` can never be true.
+func normalizeIntegralCompare(op planpb.OpType, c float64) (planpb.OpType, float64, bool) {
+	switch op {
+	case planpb.OpType_GreaterThan:
+		return planpb.OpType_GreaterEqual, math.Floor(c) + 1, true
+	case planpb.OpType_GreaterEqual:
+		return planpb.OpType_GreaterEqual, math.Ceil(c), true
+	case planpb.OpType_LessThan:
+		return planpb.OpType_LessEqual, math.Ceil(c) - 1, true
+	case planpb.OpType_LessEqual:
+		return planpb.OpType_LessEqual, math.Floor(c), true
+	default:
+		return op, c, isIntegral(c)
+	}
+}
+
+/* cast */
+
+func bindCast(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	column, target := call.column, call.castType
+	source := column.GetDataType()
+	switch {
+	case typeutil.IsJSONType(source):
+		// values in JSON are treated as the target type, integer casts truncate numbers
+		if typeutil.IsIntegerType(target) {
+			return nil, target, nil
+		}
+		return toColumnExpr(column), schemapb.DataType_JSON, nil
+	case typeutil.IsIntegerType(target) && typeutil.IsArithmetic(source):
+		if typeutil.IsIntegerType(source) {
+			return toColumnExpr(column), source, nil
+		}
+		return nil, target, nil
+	case typeutil.IsFloatingType(target) && typeutil.IsArithmetic(source):
+		if typeutil.IsIntegerType(source) {
+			// compare integers with floating constants
+			return nil, target, nil
+		}
+		return toColumnExpr(column), source, nil
+	case typeutil.IsStringType(target) && typeutil.IsStringType(source),
+		typeutil.IsBoolType(target) && typeutil.IsBoolType(source):
+		return toColumnExpr(column), source, nil
+	default:
+		return nil, schemapb.DataType_None, fmt.Errorf("cannot cast field of type %s to %s", source, target)
+	}
+}
+
+func castConstant(value *planpb.GenericValue, target schemapb.DataType) (*planpb.GenericValue, error) {
+	switch {
+	case typeutil.IsIntegerType(target):
+		var n int64
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			n = v.Int64Val
+		case *planpb.GenericValue_FloatVal:
+			if math.IsNaN(v.FloatVal) || math.IsInf(v.FloatVal, 0) {
+				return nil, fmt.Errorf("cannot cast %v to %s", v.FloatVal, target)
+			}
+			n = int64(math.Trunc(v.FloatVal))
+		case *planpb.GenericValue_BoolVal:
+			if v.BoolVal {
+				n = 1
+			}
+		case *planpb.GenericValue_StringVal:
+			var err error
+			if n, err = strconv.ParseInt(strings.TrimSpace(v.StringVal), 10, 64); err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, target)
+			}
+		default:
+			return nil, fmt.Errorf("cannot cast %s to %s", value, target)
+		}
+		if err := checkIntegerRange(n, target); err != nil {
+			return nil, err
+		}
+		return NewInt(n), nil
+	case typeutil.IsFloatingType(target):
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return NewFloat(float64(v.Int64Val)), nil
+		case *planpb.GenericValue_FloatVal:
+			return NewFloat(v.FloatVal), nil
+		case *planpb.GenericValue_StringVal:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v.StringVal), 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, target)
+			}
+			return NewFloat(f), nil
+		}
+	case typeutil.IsStringType(target):
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return NewString(strconv.FormatInt(v.Int64Val, 10)), nil
+		case *planpb.GenericValue_FloatVal:
+			return NewString(strconv.FormatFloat(v.FloatVal, 'g', -1, 64)), nil
+		case *planpb.GenericValue_BoolVal:
+			return NewString(strconv.FormatBool(v.BoolVal)), nil
+		case *planpb.GenericValue_StringVal:
+			return value, nil
+		}
+	case typeutil.IsBoolType(target):
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_BoolVal:
+			return value, nil
+		case *planpb.GenericValue_Int64Val:
+			return NewBool(v.Int64Val != 0), nil
+		case *planpb.GenericValue_StringVal:
+			b, err := strconv.ParseBool(strings.TrimSpace(v.StringVal))
+			if err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, target)
+			}
+			return NewBool(b), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot cast %s to %s", value, target)
+}
+
+func checkIntegerRange(n int64, dataType schemapb.DataType) error {
+	var bits uint
+	switch dataType {
+	case schemapb.DataType_Int8:
+		bits = 8
+	case schemapb.DataType_Int16:
+		bits = 16
+	case schemapb.DataType_Int32:
+		bits = 32
+	default:
+		return nil
+	}
+	limit := int64(1) << (bits - 1)
+	if n < -limit || n >= limit {
+		return fmt.Errorf("value %d overflows %s", n, dataType)
+	}
+	return nil
+}
+
+/* floor, ceil, integer cast */
+
+// interval is the set of field values mapped to the same integer by a step function.
+type interval struct {
+	lower, upper                   float64
+	lowerInclusive, upperInclusive bool
+}
+
+func floorPreimage(n float64) interval {
+	return interval{lower: n, upper: n + 1, lowerInclusive: true}
+}
+
+func ceilPreimage(n float64) interval {
+	return interval{lower: n - 1, upper: n, upperInclusive: true}
+}
+
+func truncPreimage(n float64) interval {
+	switch {
+	case n > 0:
+		return floorPreimage(n)
+	case n < 0:
+		return ceilPreimage(n)
+	default:
+		return interval{lower: -1, upper: 1}
+	}
+}
+
+func identityPreimage(n float64) interval {
+	return interval{lower: n, upper: n, lowerInclusive: true, upperInclusive: true}
+}
+
+func bindNumeric(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	if !isNumericColumn(call.column) {
+		return nil, schemapb.DataType_None, fmt.Errorf("function %s is only supported on numeric or json fields, got: %s",
+			call.name, call.column.GetDataType())
+	}
+	if typeutil.IsJSONType(call.column.GetDataType()) {
+		return nil, schemapb.DataType_Double, nil
+	}
+	return nil, call.column.GetDataType(), nil
+}
+
+// lowerStepCompare lowers comparisons on integer valued non-decreasing functions, which map each
+// integer n from the interval `preimage(n)` of field values.
+func lowerStepCompare(op planpb.OpType, call *scalarFunctionCall, value *planpb.GenericValue) (*planpb.Expr, error) {
+	c, ok := getNumber(value)
+	if !ok {
+		return nil, fmt.Errorf("function %s on field can only be compared with a number, got: %s", call.name, value)
+	}
+	column := call.column
+	isIntegerColumn := typeutil.IsIntegerType(column.GetDataType())
+
+	var preimage func(float64) interval
+	switch {
+	case isIntegerColumn:
+		preimage = identityPreimage
+	case call.name == funcFloor:
+		preimage = floorPreimage
+	case call.name == funcCeil:
+		preimage = ceilPreimage
+	default:
+		preimage = truncPreimage
+	}
+
+	op, n, ok := normalizeIntegralCompare(op, c)
+	switch op {
+	case planpb.OpType_GreaterEqual:
+		iv := preimage(n)
+		if iv.lowerInclusive {
+			return unaryRangeExpr(column, planpb.OpType_GreaterEqual, numberForColumn(column, iv.lower)), nil
+		}
+		return unaryRangeExpr(column, planpb.OpType_GreaterThan, numberForColumn(column, iv.lower)), nil
+	case planpb.OpType_LessEqual:
+		iv := preimage(n)
+		if iv.upperInclusive {
+			return unaryRangeExpr(column, planpb.OpType_LessEqual, numberForColumn(column, iv.upper)), nil
+		}
+		return unaryRangeExpr(column, planpb.OpType_LessThan, numberForColumn(column, iv.upper)), nil
+	case planpb.OpType_Equal, planpb.OpType_NotEqual:
+		var expr *planpb.Expr
+		if !ok {
+			expr = alwaysFalseExpr()
+		} else if iv := preimage(n); iv.lower == iv.upper {
+			expr = unaryRangeExpr(column, planpb.OpType_Equal, numberForColumn(column, iv.lower))
+		} else {
+			expr = binaryRangeExpr(column, numberForColumn(column, iv.lower), iv.lowerInclusive,
+				numberForColumn(column, iv.upper), iv.upperInclusive)
+		}
+		if op == planpb.OpType_NotEqual {
+			return notExpr(expr), nil
+		}
+		return expr, nil
+	default:
+		return nil, fmt.Errorf("unsupported comparison on function %s: %s", call.name, op)
+	}
+}
+
+func evalRound(round func(float64) float64) func(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	return func(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+		switch v := args[0].GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return args[0], nil
+		case *planpb.GenericValue_FloatVal:
+			return NewFloat(round(v.FloatVal)), nil
+		default:
+			return nil, fmt.Errorf("expect a number, got: %s", args[0])
+		}
+	}
+}
+
+/* abs */
+
+func evalAbs(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	switch v := args[0].GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		if v.Int64Val == math.MinInt64 {
+			return nil, errors.New("integer overflow")
+		}
+		if v.Int64Val < 0 {
+			return NewInt(-v.Int64Val), nil
+		}
+		return args[0], nil
+	case *planpb.GenericValue_FloatVal:
+		return NewFloat(math.Abs(v.FloatVal)), nil
+	default:
+		return nil, fmt.Errorf("expect a number, got: %s", args[0])
+	}
+}
+
+func lowerAbsCompare(op planpb.OpType, column *planpb.ColumnInfo, value *planpb.GenericValue) (*planpb.Expr, error) {
+	c, ok := getNumber(value)
+	if !ok {
+		return nil, fmt.Errorf("function abs on field can only be compared with a number, got: %s", value)
+	}
+	possible := true
+	if typeutil.IsIntegerType(column.GetDataType()) {
+		op, c, possible = normalizeIntegralCompare(op, c)
+	}
+	pos, neg := numberForColumn(column, c), numberForColumn(column, -c)
+
+	switch op {
+	case planpb.OpType_GreaterThan:
+		if c < 0 {
+			return notNullExpr(column), nil
+		}
+		return orExpr(unaryRangeExpr(column, planpb.OpType_GreaterThan, pos), unaryRangeExpr(column, planpb.OpType_LessThan, neg)), nil
+	case planpb.OpType_GreaterEqual:
+		if c <= 0 {
+			return notNullExpr(column), nil
+		}
+		return orExpr(unaryRangeExpr(column, planpb.OpType_GreaterEqual, pos), unaryRangeExpr(column, planpb.OpType_LessEqual, neg)), nil
+	case planpb.OpType_LessThan:
+		if c <= 0 {
+			return alwaysFalseExpr(), nil
+		}
+		return binaryRangeExpr(column, neg, false, pos, false), nil
+	case planpb.OpType_LessEqual:
+		if c < 0 {
+			return alwaysFalseExpr(), nil
+		}
+		return binaryRangeExpr(column, neg, true, pos, true), nil
+	case planpb.OpType_Equal, planpb.OpType_NotEqual:
+		var expr *planpb.Expr
+		switch {
+		case !possible || c < 0:
+			expr = alwaysFalseExpr()
+		case c == 0:
+			expr = unaryRangeExpr(column, planpb.OpType_Equal, pos)
+		default:
+			expr = &planpb.Expr{
+				Expr: &planpb.Expr_TermExpr{
+					TermExpr: &planpb.TermExpr{
+						ColumnInfo: column,
+						Values:     []*planpb.GenericValue{neg, pos},
+					},
+				},
+			}
+		}
+		if op == planpb.OpType_NotEqual {
+			return notExpr(expr), nil
+		}
+		return expr, nil
+	default:
+		return nil, fmt.Errorf("unsupported comparison on function abs: %s", op)
+	}
+}
+
+/* lower, upper */
+
+func evalCase(convert func(string) string) func(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	return func(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+		if !IsString(args[0]) {
+			return nil, fmt.Errorf("expect a string, got: %s", args[0])
+		}
+		return NewString(convert(args[0].GetStringVal())), nil
+	}
+}
+
+func bindCase(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	if !typeutil.IsStringType(call.column.GetDataType()) {
+		return nil, schemapb.DataType_None, fmt.Errorf("function %s is only supported on string fields, got: %s",
+			call.name, call.column.GetDataType())
+	}
+	return nil, schemapb.DataType_VarChar, nil
+}
+
+// lowerCaseCompare lowers `lower(s) == c` into a regex match of the whole string, which accepts
+// any character converted to the one in c, it never matches if c itself isn't in lower case.
+func lowerCaseCompare(op planpb.OpType, call *scalarFunctionCall, value *planpb.GenericValue) (*planpb.Expr, error) {
+	if op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+		return nil, fmt.Errorf("function %s on field only supports == and != comparisons, got: %s", call.name, op)
+	}
+	if !IsString(value) {
+		return nil, fmt.Errorf("function %s on field can only be compared with a string, got: %s", call.name, value)
+	}
+	str := value.GetStringVal()
+	convert := strings.ToLower
+	if call.name == funcUpper {
+		convert = strings.ToUpper
+	}
+
+	var expr *planpb.Expr
+	if convert(str) != str {
+		expr = alwaysFalseExpr()
+	} else {
+		var buf strings.Builder
+		for _, r := range str {
+			variants := lo.Filter(caseFoldOrbit(r), func(v rune, _ int) bool {
+				return convert(string(v)) == string(r)
+			})
+			writeRegexRuneVariants(&buf, r, variants)
+		}
+		expr = unaryRangeExpr(call.column, planpb.OpType_RegexMatch, NewString(buf.String()))
+	}
+	if op == planpb.OpType_NotEqual {
+		return notExpr(expr), nil
+	}
+	return expr, nil
+}
+
+/* length */
+
+func evalLength(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	switch v := args[0].GetVal().(type) {
+	case *planpb.GenericValue_StringVal:
+		return NewInt(int64(utf8.RuneCountInString(v.StringVal))), nil
+	case *planpb.GenericValue_ArrayVal:
+		return NewInt(int64(len(v.ArrayVal.GetArray()))), nil
+	default:
+		return nil, fmt.Errorf("expect a string or an array, got: %s", args[0])
+	}
+}
+
+func bindLength(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	column := call.column
+	switch {
+	case typeutil.IsStringType(column.GetDataType()):
+		return nil, schemapb.DataType_Int64, nil
+	case typeutil.IsArrayType(column.GetDataType()) || typeutil.IsJSONType(column.GetDataType()):
+		// same as array_length
+		return &ExprWithType{
+			expr: &planpb.Expr{
+				Expr: &planpb.Expr_BinaryArithExpr{
+					BinaryArithExpr: &planpb.BinaryArithExpr{
+						Left: &planpb.Expr{
+							Expr: &planpb.Expr_ColumnExpr{
+								ColumnExpr: &planpb.ColumnExpr{Info: column},
+							},
+						},
+						Op: planpb.ArithOpType_ArrayLength,
+					},
+				},
+			},
+			dataType:      schemapb.DataType_Int64,
+			nodeDependent: true,
+		}, schemapb.DataType_Int64, nil
+	default:
+		return nil, schemapb.DataType_None, fmt.Errorf("function length is only supported on string, array or json fields, got: %s",
+			column.GetDataType())
+	}
+}
+
+// lengthAtLeastExpr matches strings of at least n characters.
+func lengthAtLeastExpr(column *planpb.ColumnInfo, n int64) *planpb.Expr {
+	if n <= 0 {
+		return notNullExpr(column)
+	}
+	if n > maxLoweredStringLength {
+		return alwaysFalseExpr()
+	}
+	return unaryRangeExpr(column, planpb.OpType_Match, NewString(strings.Repeat("_", int(n))+"%"))
+}
+
+func lowerLengthCompare(op planpb.OpType, column *planpb.ColumnInfo, value *planpb.GenericValue) (*planpb.Expr, error) {
+	c, ok := getNumber(value)
+	if !ok {
+		return nil, fmt.Errorf("function length on field can only be compared with a number, got: %s", value)
+	}
+	op, c, possible := normalizeIntegralCompare(op, c)
+	n := int64(c)
+	switch op {
+	case planpb.OpType_GreaterEqual:
+		return lengthAtLeastExpr(column, n), nil
+	case planpb.OpType_LessEqual:
+		if n < 0 {
+			return alwaysFalseExpr(), nil
+		}
+		return notExpr(lengthAtLeastExpr(column, n+1)), nil
+	case planpb.OpType_Equal, planpb.OpType_NotEqual:
+		var expr *planpb.Expr
+		switch {
+		case !possible || n < 0 || n > maxLoweredStringLength:
+			expr = alwaysFalseExpr()
+		case n == 0:
+			expr = unaryRangeExpr(column, planpb.OpType_Equal, NewString(""))
+		default:
+			expr = unaryRangeExpr(column, planpb.OpType_Match, NewString(strings.Repeat("_", int(n))))
+		}
+		if op == planpb.OpType_NotEqual {
+			return notExpr(expr), nil
+		}
+		return expr, nil
+	default:
+		return nil, fmt.Errorf("unsupported comparison on function length: %s", op)
+	}
+}
+
+/* substr */
+
+// substrRange checks the arguments of substr and returns the 1-based start position and the length,
+// length is -1 if not specified.
+func substrRange(args []*planpb.GenericValue) (int64, int64, error) {
+	if !IsInteger(args[0]) || args[0].GetInt64Val() < 1 {
+		return 0, 0, fmt.Errorf("start position of substr must be a positive integer, got: %s", args[0])
+	}
+	length := int64(-1)
+	if len(args) > 1 {
+		if !IsInteger(args[1]) || args[1].GetInt64Val() < 0 {
+			return 0, 0, fmt.Errorf("length of substr must be a non-negative integer, got: %s", args[1])
+		}
+		length = args[1].GetInt64Val()
+	}
+	return args[0].GetInt64Val(), length, nil
+}
+
+func evalSubstr(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	if !IsString(args[0]) {
+		return nil, fmt.Errorf("expect a string, got: %s", args[0])
+	}
+	start, length, err := substrRange(args[1:])
+	if err != nil {
+		return nil, err
+	}
+	runes := []rune(args[0].GetStringVal())
+	begin := start - 1
+	if begin >= int64(len(runes)) {
+		return NewString(""), nil
+	}
+	end := int64(len(runes))
+	if length >= 0 && begin+length < end {
+		end = begin + length
+	}
+	return NewString(string(runes[begin:end])), nil
+}
+
+func bindSubstr(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	if !typeutil.IsStringType(call.column.GetDataType()) {
+		return nil, schemapb.DataType_None, fmt.Errorf("function substr is only supported on string fields, got: %s",
+			call.column.GetDataType())
+	}
+	if _, _, err := substrRange(call.args); err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	return nil, schemapb.DataType_VarChar, nil
+}
+
+func escapeLikePattern(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if _, ok := wildcards[c]; ok || c == escapeCharacter {
+			buf.WriteByte(escapeCharacter)
+		}
+		buf.WriteByte(c)
+	}
+	return buf.String()
+}
+
+func lowerSubstrCompare(op planpb.OpType, call *scalarFunctionCall, value *planpb.GenericValue) (*planpb.Expr, error) {
+	if op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+		return nil, fmt.Errorf("function substr on field only supports == and != comparisons, got: %s", op)
+	}
+	if !IsString(value) {
+		return nil, fmt.Errorf("function substr on field can only be compared with a string, got: %s", value)
+	}
+	start, length, _ := substrRange(call.args)
+	if start > maxLoweredStringLength {
+		return nil, fmt.Errorf("start position of substr exceeds %d", maxLoweredStringLength)
+	}
+	column, str := call.column, value.GetStringVal()
+	strLength := int64(utf8.RuneCountInString(str))
+	skip := strings.Repeat("_", int(start-1))
+
+	var expr *planpb.Expr
+	switch {
+	case length == 0:
+		if str == "" {
+			expr = notNullExpr(column)
+		} else {
+			expr = alwaysFalseExpr()
+		}
+	case str == "":
+		// the string ends before the start position
+		expr = notExpr(lengthAtLeastExpr(column, start))
+	case length > 0 && strLength > length:
+		expr = alwaysFalseExpr()
+	default:
+		pattern := skip + escapeLikePattern(str)
+		if strLength == length {
+			pattern += "%"
+		}
+		matchOp, operand, err := translatePatternMatch(pattern)
+		if err != nil {
+			return nil, err
+		}
+		expr = unaryRangeExpr(column, matchOp, NewString(operand))
+	}
+	if op == planpb.OpType_NotEqual {
+		return notExpr(expr), nil
+	}
+	return expr, nil
+}
+
+/* starts_with */
+
+func evalStartsWith(args []*planpb.GenericValue) (*planpb.GenericValue, error) {
+	if !IsString(args[0]) || !IsString(args[1]) {
+		return nil, fmt.Errorf("expect strings, got: %s, %s", args[0], args[1])
+	}
+	return NewBool(strings.HasPrefix(args[0].GetStringVal(), args[1].GetStringVal())), nil
+}
+
+func bindStartsWith(call *scalarFunctionCall) (*ExprWithType, schemapb.DataType, error) {
+	column := call.column
+	if !typeutil.IsStringType(column.GetDataType()) && !typeutil.IsJSONType(column.GetDataType()) &&
+		!(typeutil.IsArrayType(column.GetDataType()) && typeutil.IsStringType(column.GetElementType())) {
+		return nil, schemapb.DataType_None, fmt.Errorf("function starts_with is only supported on string or json fields, got: %s",
+			column.GetDataType())
+	}
+	if !IsString(call.args[0]) {
+		return nil, schemapb.DataType_None, fmt.Errorf("prefix of starts_with must be a string, got: %s", call.args[0])
+	}
+	return &ExprWithType{
+		expr:     unaryRangeExpr(column, planpb.OpType_PrefixMatch, call.args[0]),
+		dataType: schemapb.DataType_Bool,
+	}, schemapb.DataType_Bool, nil
+}
@@ -0,0 +1,206 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+)
+
+func TestScalarFunction_Constant(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	testcases := []struct {
+		expr     string
+		expected *planpb.Expr
+	}{
+		{`Int64Field == cast(3.7 as int64)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(3))},
+		{`Int64Field == cast("42" as int64)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(42))},
+		{`DoubleField > cast(2 as double)`, unaryRangeExpr(nil, planpb.OpType_GreaterThan, NewFloat(2))},
+		{`VarCharField == cast(12 as varchar)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("12"))},
+		{`VarCharField == lower("ABC")`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("abc"))},
+		{`VarCharField == upper("abc")`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("ABC"))},
+		{`Int64Field == length("abcd")`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(4))},
+		{`Int64Field == length("北京")`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(2))},
+		{`VarCharField == substr("北京市", 2, 1)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("京"))},
+		{`Int64Field == length([1, 2, 3])`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(3))},
+		{`VarCharField == substr("milvus", 2, 3)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("ilv"))},
+		{`VarCharField == substr("milvus", 4)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString("vus"))},
+		{`VarCharField == substr("milvus", 10)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewString(""))},
+		{`Int64Field == abs(-5)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewInt(5))},
+		{`DoubleField == floor(2.5)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewFloat(2))},
+		{`DoubleField == ceil(2.5)`, unaryRangeExpr(nil, planpb.OpType_Equal, NewFloat(3))},
+		{`BoolField == starts_with("milvus", "mil")`, unaryRangeExpr(nil, planpb.OpType_Equal, NewBool(true))},
+	}
+	for _, c := range testcases {
+		expr, err := ParseExpr(helper, c.expr, nil)
+		require.NoError(t, err, c.expr)
+		assert.Equal(t, c.expected.GetUnaryRangeExpr().GetOp(), expr.GetUnaryRangeExpr().GetOp(), c.expr)
+		assert.Equal(t, c.expected.GetUnaryRangeExpr().GetValue().String(), expr.GetUnaryRangeExpr().GetValue().String(), c.expr)
+	}
+}
+
+func TestScalarFunction_Lowering(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	unary := func(op planpb.OpType, value *planpb.GenericValue) func(t *testing.T, expr *planpb.Expr) {
+		return func(t *testing.T, expr *planpb.Expr) {
+			require.NotNil(t, expr.GetUnaryRangeExpr())
+			assert.Equal(t, op, expr.GetUnaryRangeExpr().GetOp())
+			assert.Equal(t, value.String(), expr.GetUnaryRangeExpr().GetValue().String())
+		}
+	}
+	binary := func(lower *planpb.GenericValue, lowerInclusive bool, upper *planpb.GenericValue, upperInclusive bool) func(t *testing.T, expr *planpb.Expr) {
+		return func(t *testing.T, expr *planpb.Expr) {
+			rangeExpr := expr.GetBinaryRangeExpr()
+			require.NotNil(t, rangeExpr)
+			assert.Equal(t, lower.String(), rangeExpr.GetLowerValue().String())
+			assert.Equal(t, upper.String(), rangeExpr.GetUpperValue().String())
+			assert.Equal(t, lowerInclusive, rangeExpr.GetLowerInclusive())
+			assert.Equal(t, upperInclusive, rangeExpr.GetUpperInclusive())
+		}
+	}
+	not := func(check func(t *testing.T, expr *planpb.Expr)) func(t *testing.T, expr *planpb.Expr) {
+		return func(t *testing.T, expr *planpb.Expr) {
+			require.Equal(t, planpb.UnaryExpr_Not, expr.GetUnaryExpr().GetOp())
+			check(t, expr.GetUnaryExpr().GetChild())
+		}
+	}
+	alwaysFalse := not(func(t *testing.T, expr *planpb.Expr) { assert.NotNil(t, expr.GetAlwaysTrueExpr()) })
+	alwaysTrue := func(t *testing.T, expr *planpb.Expr) { assert.NotNil(t, expr.GetAlwaysTrueExpr()) }
+	or := func(left, right func(t *testing.T, expr *planpb.Expr)) func(t *testing.T, expr *planpb.Expr) {
+		return func(t *testing.T, expr *planpb.Expr) {
+			require.Equal(t, planpb.BinaryExpr_LogicalOr, expr.GetBinaryExpr().GetOp())
+			left(t, expr.GetBinaryExpr().GetLeft())
+			right(t, expr.GetBinaryExpr().GetRight())
+		}
+	}
+
+	testcases := []struct {
+		expr  string
+		check func(t *testing.T, expr *planpb.Expr)
+	}{
+		// floor/ceil
+		{`floor(DoubleField) > 2.5`, unary(planpb.OpType_GreaterEqual, NewFloat(3))},
+		{`floor(DoubleField) >= 2.5`, unary(planpb.OpType_GreaterEqual, NewFloat(3))},
+		{`floor(DoubleField) < 3`, unary(planpb.OpType_LessThan, NewFloat(3))},
+		{`floor(DoubleField) <= 3`, unary(planpb.OpType_LessThan, NewFloat(4))},
+		{`floor(DoubleField) == 3`, binary(NewFloat(3), true, NewFloat(4), false)},
+		{`floor(DoubleField) == 3.5`, alwaysFalse},
+		{`floor(DoubleField) != 3`, not(binary(NewFloat(3), true, NewFloat(4), false))},
+		{`3 < floor(DoubleField)`, unary(planpb.OpType_GreaterEqual, NewFloat(4))},
+		{`ceil(FloatField) >= 3`, unary(planpb.OpType_GreaterThan, NewFloat(2))},
+		{`ceil(FloatField) <= 3`, unary(planpb.OpType_LessEqual, NewFloat(3))},
+		{`ceil(FloatField) == -1`, binary(NewFloat(-2), false, NewFloat(-1), true)},
+		{`floor(Int64Field) > 2.5`, unary(planpb.OpType_GreaterEqual, NewInt(3))},
+		{`floor(JSONField["a"]) == 1`, binary(NewInt(1), true, NewInt(2), false)},
+		// cast
+		{`cast(DoubleField as int64) == 0`, binary(NewFloat(-1), false, NewFloat(1), false)},
+		{`cast(DoubleField as int64) == 2`, binary(NewFloat(2), true, NewFloat(3), false)},
+		{`cast(DoubleField as int64) == -2`, binary(NewFloat(-3), false, NewFloat(-2), true)},
+		{`cast(DoubleField as int32) >= 2`, unary(planpb.OpType_GreaterEqual, NewFloat(2))},
+		{`cast(DoubleField as int32) >= -2`, unary(planpb.OpType_GreaterThan, NewFloat(-3))},
+		{`cast(Int64Field as double) > 1.5`, unary(planpb.OpType_GreaterEqual, NewInt(2))},
+		{`cast(Int64Field as double) == 1.5`, alwaysFalse},
+		{`cast(Int32Field as int64) > 1`, unary(planpb.OpType_GreaterThan, NewInt(1))},
+		{`cast(FloatField as double) > 1.5`, unary(planpb.OpType_GreaterThan, NewFloat(1.5))},
+		{`cast(VarCharField as varchar) == "a"`, unary(planpb.OpType_Equal, NewString("a"))},
+		{`cast(A as double) > 1.5`, unary(planpb.OpType_GreaterThan, NewFloat(1.5))},
+		// abs
+		{`abs(Int64Field) < 3`, binary(NewInt(-2), true, NewInt(2), true)},
+		{`abs(DoubleField) < 3`, binary(NewFloat(-3), false, NewFloat(3), false)},
+		{`abs(Int64Field) <= 2.5`, binary(NewInt(-2), true, NewInt(2), true)},
+		{`abs(DoubleField) > 2`, or(unary(planpb.OpType_GreaterThan, NewFloat(2)), unary(planpb.OpType_LessThan, NewFloat(-2)))},
+		{`abs(DoubleField) > -1`, alwaysTrue},
+		{`abs(DoubleField) < 0`, alwaysFalse},
+		{`abs(DoubleField) == 0`, unary(planpb.OpType_Equal, NewFloat(0))},
+		{`abs(Int64Field) == 2`, func(t *testing.T, expr *planpb.Expr) {
+			assert.Equal(t, []int64{-2, 2}, []int64{
+				expr.GetTermExpr().GetValues()[0].GetInt64Val(),
+				expr.GetTermExpr().GetValues()[1].GetInt64Val(),
+			})
+		}},
+		// length
+		{`length(VarCharField) == 3`, unary(planpb.OpType_Match, NewString("___"))},
+		{`length(VarCharField) == 0`, unary(planpb.OpType_Equal, NewString(""))},
+		{`length(VarCharField) >= 2`, unary(planpb.OpType_Match, NewString("__%"))},
+		{`length(VarCharField) < 2`, not(unary(planpb.OpType_Match, NewString("__%")))},
+		{`length(VarCharField) > 1.5`, unary(planpb.OpType_Match, NewString("__%"))},
+		{`length(VarCharField) == 1.5`, alwaysFalse},
+		{`length(ArrayField) == 3`, func(t *testing.T, expr *planpb.Expr) {
+			assert.Equal(t, planpb.ArithOpType_ArrayLength, expr.GetBinaryArithOpEvalRangeExpr().GetArithOp())
+		}},
+		// substr
+		{`substr(VarCharField, 1, 3) == "abc"`, unary(planpb.OpType_PrefixMatch, NewString("abc"))},
+		{`substr(VarCharField, 1, 3) == "ab"`, unary(planpb.OpType_Equal, NewString("ab"))},
+		{`substr(VarCharField, 1, 3) == "abcd"`, alwaysFalse},
+		{`substr(VarCharField, 3, 2) == "a%"`, unary(planpb.OpType_Match, NewString(`__a\%%`))},
+		{`substr(VarCharField, 2) == "bc"`, unary(planpb.OpType_Match, NewString("_bc"))},
+		{`substr(VarCharField, 3) == ""`, not(unary(planpb.OpType_Match, NewString("___%")))},
+		{`substr(VarCharField, 2, 2) == "京市"`, unary(planpb.OpType_Match, NewString("_京市%"))},
+		{`substr(VarCharField, 1, 3) != "abc"`, not(unary(planpb.OpType_PrefixMatch, NewString("abc")))},
+		// lower, upper
+		{`lower(VarCharField) == "a.b"`, unary(planpb.OpType_RegexMatch, NewString(`[aA]\.[bB]`))},
+		{`upper(VarCharField) != "ABC"`, not(unary(planpb.OpType_RegexMatch, NewString(`[Aa][Bb][Cc]`)))},
+		{`lower(VarCharField) == "Abc"`, alwaysFalse},
+		{`lower(VarCharField) == "k1"`, unary(planpb.OpType_RegexMatch, NewString("(?:k|\u212a|K)1"))},
+		{`"abc" == lower(VarCharField)`, unary(planpb.OpType_RegexMatch, NewString(`[aA][bB][cC]`))},
+		// starts_with
+		{`starts_with(VarCharField, "abc")`, unary(planpb.OpType_PrefixMatch, NewString("abc"))},
+		{`starts_with(JSONField["a"], "abc")`, unary(planpb.OpType_PrefixMatch, NewString("abc"))},
+	}
+	for _, c := range testcases {
+		t.Run(c.expr, func(t *testing.T) {
+			expr, err := ParseExpr(helper, c.expr, nil)
+			require.NoError(t, err)
+			c.check(t, expr)
+		})
+	}
+}
+
+func TestScalarFunction_Invalid(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	exprs := []string{
+		// argument count
+		`abs()`,
+		`abs(Int64Field, 1) > 1`,
+		`substr(VarCharField) == "a"`,
+		`cast(Int64Field) > 1`,
+		`cast(Int64Field, int64) > 1`,
+		// unsupported cast
+		`cast(Int64Field as vector) > 1`,
+		`cast(VarCharField as int64) > 1`,
+		`cast("abc" as int64) > 1`,
+		`Int8Field == cast(1000 as int8)`,
+		// not a boolean expression
+		`abs(Int64Field)`,
+		// argument types
+		`abs(VarCharField) > 1`,
+		`abs("abc") > 1`,
+		`substr(VarCharField, 0) == "a"`,
+		`substr(VarCharField, 1, -1) == "a"`,
+		`substr(VarCharField, Int64Field) == "a"`,
+		`length(Int64Field) > 1`,
+		`starts_with(Int64Field, "a")`,
+		`starts_with(VarCharField, 1)`,
+		`lower(Int64Field) == "abc"`,
+		`lower(VarCharField) > "abc"`,
+		`upper(VarCharField) == 1`,
+		`cast(Int64Field as int64 as int64) > 1`,
+		// comparisons
+		`abs(Int64Field) > Int32Field`,
+		`abs(Int64Field) > "a"`,
+		`substr(VarCharField, 1) > "a"`,
+		`substr(VarCharField, 1) == 1`,
+		`length(VarCharField) == "a"`,
+		`abs({value}) > 1`,
+		`abs(Int64Field) > {value}`,
+		`abs(Int64Field + 1) > 1`,
+	}
+	for _, expr := range exprs {
+		assertInvalidExpr(t, helper, expr)
+	}
+}
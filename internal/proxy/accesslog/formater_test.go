@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	"github.com/milvus-io/milvus/pkg/v2/tracer"
 	"github.com/milvus-io/milvus/pkg/v2/util"
@@ -181,6 +182,27 @@ func (s *LogFormatterSuite) TestFormatMethodResult() {
 	}
 }
 
+func (s *LogFormatterSuite) TestFormatJSON() {
+	formatter := NewJSONFormatter()
+
+	for id, req := range s.reqs {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+		i.SetResult(s.resps[id], s.errs[id])
+		fs := formatter.Format(i)
+		s.True(strings.HasSuffix(fs, "\n"))
+
+		fields := make(map[string]any)
+		s.NoError(json.Unmarshal([]byte(fs), &fields))
+		s.Equal(len(info.MetricFuncMap), len(fields))
+		s.IsType(float64(0), fields["error_code"])
+		s.IsType(float64(0), fields["time_cost"])
+		s.Equal(i.MethodName(), fields["method_name"])
+		s.Equal(i.CollectionName(), fields["collection_name"])
+		s.Equal(i.Expression(), fields["method_expr"])
+		s.Equal(i.ConsistencyLevel(), fields["consistency_level"])
+	}
+}
+
 func (s *LogFormatterSuite) TestParseConfigKeyFailed() {
 	configKey := ".testf.invalidSub"
 	_, _, err := parseConfigKey(configKey)
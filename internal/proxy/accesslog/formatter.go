@@ -31,16 +31,21 @@ const (
 
 var BaseFormatterKey = "base"
 
+// LogFormatter formats the access info into a log line.
+type LogFormatter interface {
+	Format(i info.AccessInfo) string
+}
+
 // Formaater manager not concurrent safe
 // make sure init with Add and SetMethod before use Get
 type FormatterManger struct {
-	formatters map[string]*Formatter
+	formatters map[string]LogFormatter
 	methodMap  map[string]string
 }
 
 func NewFormatterManger() *FormatterManger {
 	return &FormatterManger{
-		formatters: make(map[string]*Formatter),
+		formatters: make(map[string]LogFormatter),
 		methodMap:  make(map[string]string),
 	}
 }
@@ -49,13 +54,17 @@ func (m *FormatterManger) Add(name, fmt string) {
 	m.formatters[name] = NewFormatter(fmt)
 }
 
+func (m *FormatterManger) AddFormatter(name string, formatter LogFormatter) {
+	m.formatters[name] = formatter
+}
+
 func (m *FormatterManger) SetMethod(name string, methods ...string) {
 	for _, method := range methods {
 		m.methodMap[method] = name
 	}
 }
 
-func (m *FormatterManger) GetByMethod(method string) (*Formatter, bool) {
+func (m *FormatterManger) GetByMethod(method string) (LogFormatter, bool) {
 	formatterName, ok := m.methodMap[method]
 	if !ok {
 		formatterName = BaseFormatterKey
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	configEvent "github.com/milvus-io/milvus/pkg/v2/config"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -40,6 +41,7 @@ type AccessLogger struct {
 	enable     atomic.Bool
 	writer     io.Writer
 	formatters *FormatterManger
+	exporter   *OtlpExporter
 	mu         sync.RWMutex
 }
 
@@ -59,6 +61,14 @@ func (l *AccessLogger) init(params *paramtable.ComponentParam) error {
 		return err
 	}
 	l.writer = writer
+
+	if params.ProxyCfg.AccessLog.OtlpEnable.GetAsBool() {
+		exporter, err := NewOtlpExporter(params)
+		if err != nil {
+			return err
+		}
+		l.exporter = exporter
+	}
 	return nil
 }
 
@@ -97,6 +107,10 @@ func (l *AccessLogger) SetEnable(enable bool) error {
 		if write, ok := l.writer.(*RotateWriter); ok {
 			write.Close()
 		}
+		if l.exporter != nil {
+			l.exporter.Close()
+			l.exporter = nil
+		}
 	}
 
 	l.enable.Store(enable)
@@ -121,6 +135,9 @@ func (l *AccessLogger) Write(info info.AccessInfo) bool {
 		log.Warn("write access log failed", zap.Error(err))
 		return false
 	}
+	if l.exporter != nil && !l.exporter.Export(info) {
+		log.RatedWarn(60, "export access log to otlp failed, the queue is full")
+	}
 	return true
 }
 
@@ -149,6 +166,16 @@ func InitAccessLogger(params *paramtable.ComponentParam) {
 
 func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error) {
 	formatterManger := NewFormatterManger()
+	format := logCfg.Format.GetValue()
+	if format == JSONFormat {
+		// all methods share the base formatter, which writes all the fields
+		formatterManger.AddFormatter(BaseFormatterKey, NewJSONFormatter())
+		return formatterManger, nil
+	}
+	if format != TextFormat {
+		return nil, merr.WrapErrParameterInvalid("text or json", format, "invalid access log format")
+	}
+
 	formatMap := make(map[string]string)   // fommatter name -> formatter format
 	methodMap := make(map[string][]string) // fommatter name -> formatter owner method
 	for key, value := range logCfg.Formatter.GetValue() {
@@ -172,6 +172,22 @@ func TestAccessLogger_Basic(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestAccessLogger_Format(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+
+	Params.Save(Params.ProxyCfg.AccessLog.Format.Key, JSONFormat)
+	formatters, err := initFormatter(&Params.ProxyCfg.AccessLog)
+	assert.NoError(t, err)
+	formatter, ok := formatters.GetByMethod("Search")
+	assert.True(t, ok)
+	assert.IsType(t, &JSONFormatter{}, formatter)
+
+	Params.Save(Params.ProxyCfg.AccessLog.Format.Key, "invalid")
+	_, err = initFormatter(&Params.ProxyCfg.AccessLog)
+	assert.Error(t, err)
+}
+
 func TestAccessLogger_WriteFailed(t *testing.T) {
 	once = sync.Once{}
 	var Params paramtable.ComponentParam
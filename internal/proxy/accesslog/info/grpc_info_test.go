@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
@@ -281,6 +282,37 @@ func (s *GrpcAccessInfoSuite) TestQueryParams() {
 	s.Equal(kvsToString(params), Get(s.info, "$query_params")[0])
 }
 
+func (s *GrpcAccessInfoSuite) TestGetAll() {
+	s.info.req = &milvuspb.SearchRequest{Nq: 10}
+	fields := GetAll(s.info)
+	s.Equal(len(MetricFuncMap), len(fields))
+	s.Equal(int64(10), fields["nq"])
+	s.Equal(int64(0), fields["error_code"])
+	s.Equal(Unknown, fields["time_cost"])
+	s.Equal("test", fields["method_name"])
+
+	s.info.start = time.Now()
+	s.info.SetResult(&milvuspb.SearchResults{Status: merr.Status(merr.ErrCollectionNotFound)}, nil)
+	fields = GetAll(s.info)
+	s.Equal(int64(merr.Code(merr.ErrCollectionNotFound)), fields["error_code"])
+	s.Equal(s.info.end.Sub(s.info.start).Microseconds(), fields["time_cost"])
+	s.IsType(int64(0), fields["response_size"])
+
+	s.info.req = &milvuspb.HybridSearchRequest{Requests: []*milvuspb.SearchRequest{{Nq: 10}, {Nq: 10}}}
+	s.Equal("[\"10\", \"10\"]", GetAll(s.info)["nq"])
+}
+
+func (s *GrpcAccessInfoSuite) TestGetTime() {
+	now := time.Now()
+	s.WithinDuration(now, GetTime(s.info), time.Second)
+
+	s.info.start = now.Add(-time.Hour)
+	s.WithinDuration(s.info.start, GetTime(s.info), time.Millisecond)
+
+	s.info.end = now.Add(-time.Minute)
+	s.WithinDuration(s.info.end, GetTime(s.info), time.Millisecond)
+}
+
 func TestGrpcAccssInfo(t *testing.T) {
 	suite.Run(t, new(GrpcAccessInfoSuite))
 }
@@ -16,6 +16,12 @@
 
 package info
 
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
 const (
 	Unknown            = "Unknown"
 	timeFormat         = "2006/01/02 15:04:05.000 -07:00"
@@ -93,6 +99,43 @@ func Get(i AccessInfo, keys ...string) []any {
 	return result
 }
 
+// GetAll returns all the supported metrics of the access info,
+// keyed by the metric name without the "$" prefix.
+// Numeric metrics are returned as int64 if they are known, time_cost in microseconds,
+// other metrics are returned as string.
+func GetAll(i AccessInfo) map[string]any {
+	result := make(map[string]any, len(MetricFuncMap))
+	for key, getFunc := range MetricFuncMap {
+		result[strings.TrimPrefix(key, "$")] = typedValue(key, getFunc(i))
+	}
+	return result
+}
+
+func typedValue(key string, value string) any {
+	switch key {
+	case "$time_cost":
+		if cost, err := time.ParseDuration(value); err == nil {
+			return cost.Microseconds()
+		}
+	case "$nq", "$response_size", "$error_code":
+		if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return number
+		}
+	}
+	return value
+}
+
+// GetTime returns the time when the request finished,
+// or the time when the request was received if it's unfinished.
+func GetTime(i AccessInfo) time.Time {
+	for _, value := range []string{i.TimeEnd(), i.TimeStart()} {
+		if t, err := time.Parse(timeFormat, value); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
+
 func getMethodName(i AccessInfo) string {
 	return i.MethodName()
 }
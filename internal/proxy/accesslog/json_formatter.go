@@ -0,0 +1,49 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+)
+
+const (
+	// TextFormat formats access logs with the configured formatters.
+	TextFormat = "text"
+	// JSONFormat formats access logs as JSON Lines, with all the metrics of each request.
+	JSONFormat = "json"
+)
+
+// JSONFormatter formats the access info as one JSON object per line,
+// keyed by the metric names without the "$" prefix, e.g. "method_name".
+// Numeric metrics such as nq and time_cost are written as JSON numbers.
+type JSONFormatter struct{}
+
+func NewJSONFormatter() *JSONFormatter {
+	return &JSONFormatter{}
+}
+
+func (f *JSONFormatter) Format(i info.AccessInfo) string {
+	bs, err := json.Marshal(info.GetAll(i))
+	if err != nil {
+		// never happen for strings and numbers, keep the line parsable anyway
+		return fmt.Sprintf("{\"error_msg\":%q}\n", err.Error())
+	}
+	return string(bs) + "\n"
+}
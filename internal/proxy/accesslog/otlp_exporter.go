@@ -0,0 +1,307 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/tracer"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	otlpScopeName     = "milvus.accesslog"
+	otlpQueueSize     = 4096
+	otlpBatchSize     = 512
+	otlpFlushInterval = time.Second
+	otlpExportTimeout = 10 * time.Second
+)
+
+type otlpLogClient interface {
+	Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	Close() error
+}
+
+// OtlpExporter exports access logs as OTLP log records in background,
+// records are dropped if the queue is full, so that requests are never blocked by the exporter.
+type OtlpExporter struct {
+	client   otlpLogClient
+	resource *resourcepb.Resource
+
+	queue     chan *logspb.LogRecord
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+}
+
+// NewOtlpExporter creates the exporter with the otlp endpoint, method, secure and headers of trace config.
+func NewOtlpExporter(params *paramtable.ComponentParam) (*OtlpExporter, error) {
+	client, err := newOtlpLogClient(params)
+	if err != nil {
+		return nil, err
+	}
+	return newOtlpExporterWithClient(client), nil
+}
+
+func newOtlpExporterWithClient(client otlpLogClient) *OtlpExporter {
+	e := &OtlpExporter{
+		client: client,
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				newStringAttr("service.name", paramtable.GetRole()),
+				{Key: "NodeID", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: paramtable.GetNodeID()}}},
+			},
+		},
+		queue:   make(chan *logspb.LogRecord, otlpQueueSize),
+		closeCh: make(chan struct{}),
+	}
+	e.closeWg.Add(1)
+	go e.loop()
+	return e
+}
+
+// Export enqueues the access info, returns false if the queue is full.
+func (e *OtlpExporter) Export(i info.AccessInfo) bool {
+	select {
+	case e.queue <- newLogRecord(i):
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *OtlpExporter) Close() {
+	e.closeOnce.Do(func() {
+		close(e.closeCh)
+		e.closeWg.Wait()
+		if err := e.client.Close(); err != nil {
+			log.Warn("close access log otlp client failed", zap.Error(err))
+		}
+	})
+}
+
+func (e *OtlpExporter) loop() {
+	defer e.closeWg.Done()
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*logspb.LogRecord, 0, otlpBatchSize)
+	for {
+		select {
+		case record := <-e.queue:
+			batch = append(batch, record)
+			if len(batch) >= otlpBatchSize {
+				e.flush(batch)
+				batch = make([]*logspb.LogRecord, 0, otlpBatchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				e.flush(batch)
+				batch = make([]*logspb.LogRecord, 0, otlpBatchSize)
+			}
+		case <-e.closeCh:
+			// drain the queue before exit
+			for {
+				select {
+				case record := <-e.queue:
+					batch = append(batch, record)
+				default:
+					if len(batch) > 0 {
+						e.flush(batch)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *OtlpExporter) flush(records []*logspb.LogRecord) {
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: e.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: records,
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	if err := e.client.Export(ctx, req); err != nil {
+		log.Warn("export access logs to otlp failed", zap.Int("num", len(records)), zap.Error(err))
+	}
+}
+
+func newStringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func newAttr(key string, value any) *commonpb.KeyValue {
+	switch v := value.(type) {
+	case int64:
+		return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}}
+	default:
+		return newStringAttr(key, fmt.Sprint(v))
+	}
+}
+
+// newLogRecord converts the access info into a log record,
+// with all the metrics as attributes and the method name as body,
+// the time of the record is when the request finished rather than when it's exported.
+func newLogRecord(i info.AccessInfo) *logspb.LogRecord {
+	fields := info.GetAll(i)
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, newAttr(key, fields[key]))
+	}
+
+	record := &logspb.LogRecord{
+		TimeUnixNano:         uint64(info.GetTime(i).UnixNano()),
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: i.MethodName()}},
+		Attributes:           attrs,
+	}
+	if i.MethodStatus() != "Successful" {
+		record.SeverityNumber = logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+		record.SeverityText = "ERROR"
+	}
+	// trace id may be the client request id, only set it if it's a valid otel trace id
+	if traceID, err := hex.DecodeString(i.TraceID()); err == nil && len(traceID) == 16 {
+		record.TraceId = traceID
+	}
+	return record
+}
+
+func newOtlpLogClient(params *paramtable.ComponentParam) (otlpLogClient, error) {
+	cfg := &params.TraceCfg
+	endpoint := cfg.OtlpEndpoint.GetValue()
+	if endpoint == "" {
+		return nil, errors.New("trace.otlp.endpoint is required to export access logs")
+	}
+	secure := cfg.OtlpSecure.GetAsBool()
+	headers := tracer.ParseHeaders(cfg.OtlpHeaders.GetValue())
+
+	switch cfg.OtlpMethod.GetValue() {
+	case "", "grpc":
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{})
+		}
+		conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, err
+		}
+		return &otlpGrpcClient{conn: conn, client: collogspb.NewLogsServiceClient(conn), headers: headers}, nil
+	case "http":
+		scheme := "http"
+		if secure {
+			scheme = "https"
+		}
+		return &otlpHTTPClient{
+			client:  &http.Client{Timeout: otlpExportTimeout},
+			url:     fmt.Sprintf("%s://%s/v1/logs", scheme, endpoint),
+			headers: headers,
+		}, nil
+	default:
+		return nil, errors.Newf("otlp method not supported: %s", cfg.OtlpMethod.GetValue())
+	}
+}
+
+type otlpGrpcClient struct {
+	conn    *grpc.ClientConn
+	client  collogspb.LogsServiceClient
+	headers map[string]string
+}
+
+func (c *otlpGrpcClient) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	if len(c.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(c.headers))
+	}
+	_, err := c.client.Export(ctx, req)
+	return err
+}
+
+func (c *otlpGrpcClient) Close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPClient struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (c *otlpHTTPClient) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range c.headers {
+		httpReq.Header.Set(k, v)
+	}
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return errors.Newf("otlp http export failed, status: %s", resp.Status)
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
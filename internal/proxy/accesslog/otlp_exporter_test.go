@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestOtlpExporter_HTTP(t *testing.T) {
+	received := make(chan *collogspb.ExportLogsServiceRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/logs", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		assert.Equal(t, "token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		req := &collogspb.ExportLogsServiceRequest{}
+		assert.NoError(t, proto.Unmarshal(body, req))
+		received <- req
+	}))
+	defer server.Close()
+
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	params.Save(params.TraceCfg.OtlpEndpoint.Key, strings.TrimPrefix(server.URL, "http://"))
+	params.Save(params.TraceCfg.OtlpMethod.Key, "http")
+	params.Save(params.TraceCfg.OtlpSecure.Key, "false")
+	params.Save(params.TraceCfg.OtlpHeaders.Key, `{"Authorization": "token"}`)
+
+	exporter, err := NewOtlpExporter(&params)
+	require.NoError(t, err)
+
+	req := &milvuspb.SearchRequest{CollectionName: "test-collection", Dsl: "id > 0", Nq: 10}
+	i := info.NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, req)
+	i.SetResult(&milvuspb.SearchResults{Status: merr.Status(merr.ErrCollectionNotFound)}, nil)
+	assert.True(t, exporter.Export(i))
+	// close flushes the pending records
+	exporter.Close()
+
+	exported := <-received
+	records := exported.GetResourceLogs()[0].GetScopeLogs()[0].GetLogRecords()
+	require.Len(t, records, 1)
+	assert.Equal(t, "Search", records[0].GetBody().GetStringValue())
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, records[0].GetSeverityNumber())
+
+	// the record is stamped with the time when the request finished
+	assert.Equal(t, info.GetTime(i).UnixNano(), int64(records[0].GetTimeUnixNano()))
+	assert.GreaterOrEqual(t, records[0].GetObservedTimeUnixNano(), records[0].GetTimeUnixNano())
+
+	attrs := make(map[string]*commonpb.AnyValue)
+	for _, kv := range records[0].GetAttributes() {
+		attrs[kv.GetKey()] = kv.GetValue()
+	}
+	assert.Equal(t, len(info.MetricFuncMap), len(attrs))
+	assert.Equal(t, "test-collection", attrs["collection_name"].GetStringValue())
+	assert.Equal(t, "id > 0", attrs["method_expr"].GetStringValue())
+	assert.Equal(t, int64(10), attrs["nq"].GetIntValue())
+	assert.Equal(t, int64(merr.Code(merr.ErrCollectionNotFound)), attrs["error_code"].GetIntValue())
+	_, ok := attrs["time_cost"].GetValue().(*commonpb.AnyValue_IntValue)
+	assert.True(t, ok)
+}
+
+func TestOtlpExporter_InvalidConfig(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+
+	params.Save(params.TraceCfg.OtlpEndpoint.Key, "")
+	_, err := NewOtlpExporter(&params)
+	assert.Error(t, err)
+
+	params.Save(params.TraceCfg.OtlpEndpoint.Key, "localhost:4317")
+	params.Save(params.TraceCfg.OtlpMethod.Key, "invalid")
+	_, err = NewOtlpExporter(&params)
+	assert.Error(t, err)
+}
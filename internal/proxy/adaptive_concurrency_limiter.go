@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	// latencyAvgWeight is the weight of the new sample in the moving average of latency.
+	latencyAvgWeight = 0.1
+	// overloadedLatencyAvgWeight is the weight of the overloaded sample in the moving average of latency,
+	// it's much smaller to keep the baseline from following the latency of overload,
+	// but still let the baseline catch up if the workload is changed.
+	overloadedLatencyAvgWeight = 0.01
+
+	// clearIdleLimiterInterval is the interval to clear the idle limiters.
+	clearIdleLimiterInterval = 1 * time.Minute
+	// limiterIdleTimeout is the duration that a limiter without any request is regarded as idle,
+	// e.g. the channel is released or moved out of the proxy's view.
+	limiterIdleTimeout = 10 * time.Minute
+)
+
+// costMetricsGetter is implemented by the balancer which collects the cost metrics of query nodes.
+type costMetricsGetter interface {
+	GetCostMetrics(node int64) *internalpb.CostAggregation
+}
+
+// concurrencySample is the result of a finished shard request.
+type concurrencySample struct {
+	latency time.Duration
+	// queueNQ is the total nq queued in the query node which served the request.
+	queueNQ int64
+	// dropped is true if the request is timeout or rejected by the query node for overload.
+	dropped bool
+}
+
+// adaptiveConcurrencyLimiter limits the in-flight requests of a shard,
+// the limit is adjusted by AIMD (additive increase, multiplicative decrease):
+// it's increased by one when a request finishes in time while at least half of the limit is used,
+// and it's multiplied by the backoff ratio when the shard is overloaded,
+// which means the request is dropped, the latency is much higher than the moving average,
+// or the query node queues too much nq.
+type adaptiveConcurrencyLimiter struct {
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+	// avgLatency is the moving average of the latency in milliseconds, 0 if there's no sample yet.
+	avgLatency float64
+	// lastActive is the last time that the limiter is acquired or released.
+	lastActive time.Time
+}
+
+func newAdaptiveConcurrencyLimiter(initialLimit float64) *adaptiveConcurrencyLimiter {
+	return &adaptiveConcurrencyLimiter{limit: initialLimit, lastActive: time.Now()}
+}
+
+// TryAcquire acquires a slot of the limiter, returns false if the limit is reached.
+func (l *adaptiveConcurrencyLimiter) TryAcquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastActive = time.Now()
+	if float64(l.inflight) >= math.Floor(l.limit) {
+		return false
+	}
+	l.inflight++
+	return true
+}
+
+// Release releases the slot acquired by TryAcquire, and adjusts the limit by the sample if it's not nil.
+func (l *adaptiveConcurrencyLimiter) Release(sample *concurrencySample) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	inflight := l.inflight
+	l.inflight--
+	l.lastActive = time.Now()
+	if sample == nil {
+		return
+	}
+
+	params := Params.ProxyCfg
+	latency := float64(sample.latency) / float64(time.Millisecond)
+	overloaded := sample.dropped
+	if threshold := params.AdaptiveConcurrencyQueueNQThreshold.GetAsInt64(); threshold > 0 && sample.queueNQ > threshold {
+		overloaded = true
+	}
+	if l.avgLatency > 0 && latency > l.avgLatency*params.AdaptiveConcurrencyLatencyTolerance.GetAsFloat() {
+		overloaded = true
+	}
+
+	if !sample.dropped {
+		switch {
+		case l.avgLatency == 0:
+			l.avgLatency = latency
+		case overloaded:
+			l.avgLatency = l.avgLatency*(1-overloadedLatencyAvgWeight) + latency*overloadedLatencyAvgWeight
+		default:
+			l.avgLatency = l.avgLatency*(1-latencyAvgWeight) + latency*latencyAvgWeight
+		}
+	}
+
+	if overloaded {
+		l.limit *= params.AdaptiveConcurrencyBackoffRatio.GetAsFloat()
+	} else if float64(inflight)*2 >= l.limit {
+		l.limit++
+	}
+	l.limit = math.Max(l.limit, params.AdaptiveConcurrencyMinLimit.GetAsFloat())
+	l.limit = math.Min(l.limit, params.AdaptiveConcurrencyMaxLimit.GetAsFloat())
+}
+
+// Limit returns the current concurrency limit.
+func (l *adaptiveConcurrencyLimiter) Limit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// IdleSince returns true if there's no in-flight request and the limiter is not used since t.
+func (l *adaptiveConcurrencyLimiter) IdleSince(t time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight == 0 && l.lastActive.Before(t)
+}
+
+// shardConcurrencyLimiter manages the adaptive concurrency limiters of shards, keyed by channel name.
+type shardConcurrencyLimiter struct {
+	limiters *typeutil.ConcurrentMap[string, *adaptiveConcurrencyLimiter]
+
+	mu            sync.Mutex
+	lastClearTime time.Time
+}
+
+func newShardConcurrencyLimiter() *shardConcurrencyLimiter {
+	return &shardConcurrencyLimiter{
+		limiters:      typeutil.NewConcurrentMap[string, *adaptiveConcurrencyLimiter](),
+		lastClearTime: time.Now(),
+	}
+}
+
+// Acquire acquires a slot of the channel, returns a retryable rate limit error if the channel is overloaded.
+// The returned limiter is nil if the adaptive concurrency limit is disabled.
+func (s *shardConcurrencyLimiter) Acquire(channel string) (*adaptiveConcurrencyLimiter, error) {
+	if !Params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.GetAsBool() {
+		return nil, nil
+	}
+	s.clearIdleLimiters(time.Now())
+	limiter, ok := s.limiters.Get(channel)
+	if !ok {
+		limiter, _ = s.limiters.GetOrInsert(channel,
+			newAdaptiveConcurrencyLimiter(Params.ProxyCfg.AdaptiveConcurrencyInitialLimit.GetAsFloat()))
+	}
+	if !limiter.TryAcquire() {
+		return nil, merr.WrapErrServiceRateLimit(limiter.Limit(),
+			fmt.Sprintf("too many concurrent requests on channel %s, please retry later", channel))
+	}
+	return limiter, nil
+}
+
+// Release releases the slot acquired by Acquire with the result of the request.
+func (s *shardConcurrencyLimiter) Release(limiter *adaptiveConcurrencyLimiter, latency time.Duration, queueNQ int64, err error) {
+	if limiter == nil {
+		return
+	}
+	var sample *concurrencySample
+	switch {
+	case err == nil:
+		sample = &concurrencySample{latency: latency, queueNQ: queueNQ}
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, merr.ErrServiceRateLimit),
+		errors.Is(err, merr.ErrServiceQuotaExceeded):
+		sample = &concurrencySample{latency: latency, queueNQ: queueNQ, dropped: true}
+	default:
+		// the other failures tell nothing about the load of the shard.
+	}
+	limiter.Release(sample)
+}
+
+// clearIdleLimiters removes the limiters which are idle for limiterIdleTimeout,
+// so the limiters of the released channels are not kept forever.
+func (s *shardConcurrencyLimiter) clearIdleLimiters(now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastClearTime) < clearIdleLimiterInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastClearTime = now
+	s.mu.Unlock()
+
+	s.limiters.Range(func(channel string, limiter *adaptiveConcurrencyLimiter) bool {
+		if limiter.IdleSince(now.Add(-limiterIdleTimeout)) {
+			s.limiters.Remove(channel)
+		}
+		return true
+	})
+}
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestAdaptiveConcurrencyLimiter(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyMinLimit.Key, "2")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyMinLimit.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyMaxLimit.Key, "5")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyMaxLimit.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyBackoffRatio.Key, "0.5")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyBackoffRatio.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyQueueNQThreshold.Key, "100")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyQueueNQThreshold.Key)
+
+	l := newAdaptiveConcurrencyLimiter(4)
+	for i := 0; i < 4; i++ {
+		assert.True(t, l.TryAcquire())
+	}
+	assert.False(t, l.TryAcquire())
+
+	// the limit is increased when the requests finish in time.
+	l.Release(&concurrencySample{latency: 10 * time.Millisecond})
+	assert.Equal(t, float64(5), l.Limit())
+	// the limit is capped by the max limit.
+	l.Release(&concurrencySample{latency: 10 * time.Millisecond})
+	assert.Equal(t, float64(5), l.Limit())
+
+	// the limit is not increased if less than half of the limit is used.
+	l.Release(nil)
+	l.Release(nil)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyMaxLimit.Key, "10")
+	assert.True(t, l.TryAcquire())
+	l.Release(&concurrencySample{latency: 10 * time.Millisecond})
+	assert.Equal(t, float64(5), l.Limit())
+
+	// the limit is decreased if the latency is much higher than the average.
+	assert.True(t, l.TryAcquire())
+	l.Release(&concurrencySample{latency: 100 * time.Millisecond})
+	assert.Equal(t, 2.5, l.Limit())
+	// the limit is decreased if the query node queues too much nq, and capped by the min limit.
+	assert.True(t, l.TryAcquire())
+	l.Release(&concurrencySample{latency: 10 * time.Millisecond, queueNQ: 101})
+	assert.Equal(t, float64(2), l.Limit())
+	// the limit is decreased if the request is dropped.
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyMinLimit.Key, "1")
+	assert.True(t, l.TryAcquire())
+	l.Release(&concurrencySample{latency: time.Second, dropped: true})
+	assert.Equal(t, float64(1), l.Limit())
+	assert.True(t, l.TryAcquire())
+	assert.False(t, l.TryAcquire())
+}
+
+func TestShardConcurrencyLimiter(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	s := newShardConcurrencyLimiter()
+	// disabled by default
+	limiter, err := s.Acquire("ch1")
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+	s.Release(limiter, time.Second, 0, nil)
+
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyInitialLimit.Key, "1")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyInitialLimit.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyMinLimit.Key, "1")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyMinLimit.Key)
+
+	limiter, err = s.Acquire("ch1")
+	assert.NoError(t, err)
+	assert.NotNil(t, limiter)
+	// the rejected request should get a retryable rate limit error.
+	_, err = s.Acquire("ch1")
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, merr.Status(err).GetErrorCode())
+	// the channels are limited separately.
+	limiter2, err := s.Acquire("ch2")
+	assert.NoError(t, err)
+
+	// the failure unrelated to load should not change the limit.
+	s.Release(limiter, time.Second, 0, errors.New("mock error"))
+	assert.Equal(t, float64(1), limiter.Limit())
+	limiter, err = s.Acquire("ch1")
+	assert.NoError(t, err)
+	s.Release(limiter, time.Second, 0, nil)
+	assert.Equal(t, float64(2), limiter.Limit())
+
+	// the timeout should decrease the limit.
+	s.Release(limiter2, time.Second, 0, nil)
+	assert.Equal(t, float64(2), limiter2.Limit())
+	limiter2, err = s.Acquire("ch2")
+	assert.NoError(t, err)
+	s.Release(limiter2, time.Second, 0, errors.Wrap(context.DeadlineExceeded, "mock"))
+	assert.InDelta(t, 1.8, limiter2.Limit(), 1e-9)
+}
+
+func TestShardConcurrencyLimiter_ClearIdleLimiters(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key)
+
+	s := newShardConcurrencyLimiter()
+	limiter1, err := s.Acquire("ch1")
+	assert.NoError(t, err)
+	s.Release(limiter1, time.Millisecond, 0, nil)
+	limiter2, err := s.Acquire("ch2")
+	assert.NoError(t, err)
+
+	// the limiters are not cleared until the clear interval.
+	now := time.Now().Add(limiterIdleTimeout + time.Second)
+	s.lastClearTime = now
+	s.clearIdleLimiters(now)
+	assert.Equal(t, 2, s.limiters.Len())
+
+	// the idle limiter is cleared, the one with in-flight request is kept.
+	now = now.Add(clearIdleLimiterInterval)
+	s.clearIdleLimiters(now)
+	assert.Equal(t, []string{"ch2"}, s.limiters.Keys())
+
+	s.Release(limiter2, time.Millisecond, 0, nil)
+	assert.False(t, limiter2.IdleSince(time.Now().Add(-time.Minute)))
+	assert.True(t, limiter2.IdleSince(time.Now().Add(time.Minute)))
+}
+
+func TestLookAsideBalancer_GetCostMetrics(t *testing.T) {
+	paramtable.Init()
+	b := NewLookAsideBalancer(nil)
+	assert.Nil(t, b.GetCostMetrics(1))
+	b.UpdateCostMetrics(1, &internalpb.CostAggregation{TotalNQ: 10})
+	assert.Equal(t, int64(10), b.GetCostMetrics(1).GetTotalNQ())
+}
@@ -19,8 +19,10 @@ package proxy
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/ctokenizer"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -4967,16 +4970,33 @@ func (node *Proxy) checkHealthy() bool {
 	return code == commonpb.StateCode_Healthy
 }
 
-func convertToV2ImportRequest(req *milvuspb.ImportRequest) *internalpb.ImportRequest {
+func convertToV2ImportRequest(req *milvuspb.ImportRequest) (*internalpb.ImportRequest, error) {
+	files := []*internalpb.ImportFile{{
+		Paths: req.GetFiles(),
+	}}
+	options := req.GetOptions()
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(importutilv2.FileGroups, options); err == nil {
+		var groups [][]string
+		if err = json.Unmarshal([]byte(value), &groups); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid %s option: %s", importutilv2.FileGroups, err.Error())
+		}
+		if !slices.Equal(lo.Flatten(groups), req.GetFiles()) {
+			return nil, merr.WrapErrParameterInvalidMsg("the files in %s option mismatch the files of the request", importutilv2.FileGroups)
+		}
+		files = lo.Map(groups, func(paths []string, _ int) *internalpb.ImportFile {
+			return &internalpb.ImportFile{Paths: paths}
+		})
+		options = lo.Filter(options, func(kv *commonpb.KeyValuePair, _ int) bool {
+			return kv.GetKey() != importutilv2.FileGroups
+		})
+	}
 	return &internalpb.ImportRequest{
 		DbName:         req.GetDbName(),
 		CollectionName: req.GetCollectionName(),
 		PartitionName:  req.GetPartitionName(),
-		Files: []*internalpb.ImportFile{{
-			Paths: req.GetFiles(),
-		}},
-		Options: req.GetOptions(),
-	}
+		Files:          files,
+		Options:        options,
+	}, nil
 }
 
 func convertToV1ImportResponse(rsp *internalpb.ImportResponse) *milvuspb.ImportResponse {
@@ -5003,10 +5023,12 @@ func convertToV2GetImportRequest(req *milvuspb.GetImportStateRequest) *internalp
 	}
 }
 
-func convertToV1GetImportResponse(rsp *internalpb.GetImportProgressResponse) *milvuspb.GetImportStateResponse {
+func convertToV1GetImportResponse(jobID int64, rsp *internalpb.GetImportProgressResponse) *milvuspb.GetImportStateResponse {
 	const (
 		failedReason    = "failed_reason"
 		progressPercent = "progress_percent"
+		collectionName  = "collection_name"
+		fileProgresses  = "file_progresses"
 	)
 	if rsp.GetStatus().GetCode() != 0 {
 		return &milvuspb.GetImportStateResponse{
@@ -5035,6 +5057,22 @@ func convertToV1GetImportResponse(rsp *internalpb.GetImportProgressResponse) *mi
 		Key:   progressPercent,
 		Value: strconv.FormatInt(rsp.GetProgress(), 10),
 	})
+	if rsp.GetCollectionName() != "" {
+		infos = append(infos, &commonpb.KeyValuePair{
+			Key:   collectionName,
+			Value: rsp.GetCollectionName(),
+		})
+	}
+	if len(rsp.GetTaskProgresses()) > 0 {
+		// the progress and failed reason of each file
+		bs, err := json.Marshal(rsp.GetTaskProgresses())
+		if err == nil {
+			infos = append(infos, &commonpb.KeyValuePair{
+				Key:   fileProgresses,
+				Value: string(bs),
+			})
+		}
+	}
 	var createTs int64
 	createTime, err := time.Parse("2006-01-02T15:04:05Z07:00", rsp.GetStartTime())
 	if err == nil {
@@ -5046,7 +5084,7 @@ func convertToV1GetImportResponse(rsp *internalpb.GetImportProgressResponse) *mi
 		RowCount:     rsp.GetImportedRows(),
 		IdList:       nil,
 		Infos:        infos,
-		Id:           0,
+		Id:           jobID,
 		CollectionId: 0,
 		SegmentIds:   nil,
 		CreateTs:     createTs,
@@ -5068,11 +5106,20 @@ func convertToV1ListImportResponse(rsp *internalpb.ListImportsResponse) *milvusp
 	}
 	responses := make([]*milvuspb.GetImportStateResponse, 0, len(rsp.GetStates()))
 	for i := 0; i < len(rsp.GetStates()); i++ {
-		responses = append(responses, convertToV1GetImportResponse(&internalpb.GetImportProgressResponse{
-			Status:   rsp.GetStatus(),
-			State:    rsp.GetStates()[i],
-			Reason:   rsp.GetReasons()[i],
-			Progress: rsp.GetProgresses()[i],
+		var jobID int64
+		if i < len(rsp.GetJobIDs()) {
+			jobID, _ = strconv.ParseInt(rsp.GetJobIDs()[i], 10, 64)
+		}
+		var collectionName string
+		if i < len(rsp.GetCollectionNames()) {
+			collectionName = rsp.GetCollectionNames()[i]
+		}
+		responses = append(responses, convertToV1GetImportResponse(jobID, &internalpb.GetImportProgressResponse{
+			Status:         rsp.GetStatus(),
+			State:          rsp.GetStates()[i],
+			Reason:         rsp.GetReasons()[i],
+			Progress:       rsp.GetProgresses()[i],
+			CollectionName: collectionName,
 		}))
 	}
 	return &milvuspb.ListImportTasksResponse{
@@ -5083,7 +5130,13 @@ func convertToV1ListImportResponse(rsp *internalpb.ListImportsResponse) *milvusp
 
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
-	rsp, err := node.ImportV2(ctx, convertToV2ImportRequest(req))
+	v2Req, err := convertToV2ImportRequest(req)
+	if err != nil {
+		return &milvuspb.ImportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	rsp, err := node.ImportV2(ctx, v2Req)
 	if err != nil {
 		return &milvuspb.ImportResponse{
 			Status: merr.Status(err),
@@ -5100,7 +5153,7 @@ func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportSt
 			Status: merr.Status(err),
 		}, nil
 	}
-	return convertToV1GetImportResponse(rsp), err
+	return convertToV1GetImportResponse(req.GetTask(), rsp), err
 }
 
 // ListImportTasks get id array of all import tasks from rootcoord
@@ -5841,6 +5894,10 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 		resp = merr.Status(err)
 		return resp, nil
 	}
+	if err := node.simpleLimiter.SetUserRates(request.GetUserLimiters()); err != nil {
+		resp = merr.Status(err)
+		return resp, nil
+	}
 
 	return resp, nil
 }
@@ -5939,6 +5996,114 @@ func (node *Proxy) RenameCollection(ctx context.Context, req *milvuspb.RenameCol
 	return resp, nil
 }
 
+// CloneCollection creates a new collection with the schema and the flushed data of the source collection,
+// the data files are shared with the source collection without copying.
+func (node *Proxy) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CloneCollection")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.String("newDbName", req.GetNewDbName()),
+		zap.String("newCollectionName", req.GetNewCollectionName()),
+	)
+	method := "CloneCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	if err := validateCollectionName(req.GetNewCollectionName()); err != nil {
+		log.Warn("validate new collection name fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	// the request carries no privilege ext obj for the privilege interceptor, cloning needs the privilege
+	// to read the source collection, and the privilege to create collection in the database of the clone.
+	newDBName := req.GetNewDbName()
+	if newDBName == "" {
+		newDBName = req.GetDbName()
+	}
+	err := checkPrivileges(ctx, req.GetDbName(), &milvuspb.QueryRequest{DbName: req.GetDbName(), CollectionName: req.GetCollectionName()})
+	if err == nil {
+		err = checkPrivileges(ctx, newDBName, &milvuspb.CreateCollectionRequest{DbName: newDBName})
+	}
+	if err != nil {
+		log.Warn("check privileges fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.mixCoord.CloneCollection(ctx, req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// TruncateCollection drops all data of the collection, the schema, indexes and aliases are kept.
+// It requires the DropCollection privilege of the database. The request is served by the proxy service
+// and the RESTful API only, MilvusService of the published milvus-proto has no TruncateCollection yet,
+// so the SDKs cannot truncate a collection until the RPC is added there,
+// see docs/design_docs/20261018-truncate_collection.md.
+func (node *Proxy) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-TruncateCollection")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "TruncateCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		log.Warn("validate collection name fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	if err := checkPrivileges(ctx, req.GetDbName(), &milvuspb.DropCollectionRequest{DbName: req.GetDbName()}); err != nil {
+		log.Warn("check privileges fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.mixCoord.TruncateCollection(ctx, req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
 func (node *Proxy) CreateResourceGroup(ctx context.Context, request *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -6758,6 +6923,234 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 	return resp, nil
 }
 
+// CreateSnapshot flushes the collection and creates a snapshot of all the data of the collection at the flush ts.
+func (node *Proxy) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.String("snapshotName", req.GetSnapshotName()),
+	)
+	method := "CreateSnapshot"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	// a snapshot keeps the data of the collection to restore, which needs the privileges to create collection
+	// and to read the collection, the request carries no privilege ext obj for the privilege interceptor.
+	err := checkPrivileges(ctx, req.GetDbName(),
+		&milvuspb.CreateCollectionRequest{DbName: req.GetDbName()},
+		&milvuspb.QueryRequest{DbName: req.GetDbName(), CollectionName: req.GetCollectionName()})
+	var collectionID UniqueID
+	if err == nil {
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	}
+	// flush the collection first, so that the data before the snapshot, especially the deletes
+	// still in the growing L0 segments, is persisted and included in the snapshot.
+	var flushResp *milvuspb.FlushResponse
+	if err == nil {
+		flushResp, err = node.Flush(ctx, &milvuspb.FlushRequest{
+			DbName:          req.GetDbName(),
+			CollectionNames: []string{req.GetCollectionName()},
+		})
+		err = merr.CheckRPCCall(flushResp, err)
+	}
+	if err == nil {
+		var status *commonpb.Status
+		status, err = node.mixCoord.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+			Name:         req.GetSnapshotName(),
+			CollectionID: collectionID,
+			FlushTs:      flushResp.GetCollFlushTs()[req.GetCollectionName()],
+		})
+		err = merr.CheckRPCCall(status, err)
+	}
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ListSnapshots lists the snapshots of the collection, or of all collections in the database if collection name is empty.
+func (node *Proxy) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListSnapshotsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "ListSnapshots"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	var (
+		err          error
+		collectionID UniqueID
+		resp         *datapb.ListSnapshotsResponse
+	)
+	if req.GetCollectionName() != "" {
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	}
+	if err == nil {
+		resp, err = node.mixCoord.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+			CollectionID: collectionID,
+		})
+		err = merr.CheckRPCCall(resp, err)
+	}
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.ListSnapshotsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	dbName := req.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	snapshots := make([]*internalpb.SnapshotSummary, 0, len(resp.GetSnapshots()))
+	for _, snapshot := range resp.GetSnapshots() {
+		if snapshot.GetDbName() != dbName {
+			continue
+		}
+		snapshots = append(snapshots, &internalpb.SnapshotSummary{
+			SnapshotName:   snapshot.GetName(),
+			SnapshotID:     snapshot.GetSnapshotID(),
+			DbName:         snapshot.GetDbName(),
+			CollectionName: snapshot.GetCollectionName(),
+			Ts:             snapshot.GetTs(),
+			NumSegments:    int64(len(snapshot.GetSegmentIDs())),
+			NumRows:        snapshot.GetNumRows(),
+		})
+	}
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: snapshots,
+	}, nil
+}
+
+// DropSnapshot drops the snapshot, the data pinned by it will be recycled if it's not used anymore.
+func (node *Proxy) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(zap.String("snapshotName", req.GetSnapshotName()))
+	method := "DropSnapshot"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, "", "").Inc()
+
+	// dropping a snapshot needs the privilege to drop collection in the database of the snapshot.
+	snapshot, err := node.getSnapshot(ctx, req.GetSnapshotName())
+	if err == nil {
+		err = checkPrivileges(ctx, snapshot.GetDbName(), &milvuspb.DropCollectionRequest{DbName: snapshot.GetDbName()})
+	}
+	if err == nil {
+		var status *commonpb.Status
+		status, err = node.mixCoord.DropSnapshot(ctx, &datapb.DropSnapshotRequest{
+			Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+			Name: req.GetSnapshotName(),
+		})
+		err = merr.CheckRPCCall(status, err)
+	}
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, "", "").Inc()
+		return merr.Status(err), nil
+	}
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, "", "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// RestoreSnapshot creates a new collection with the schema of the snapshot, and restores the data of the snapshot into it
+// without copying, the collection is created and restored by rootcoord in one task.
+func (node *Proxy) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("snapshotName", req.GetSnapshotName()),
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "RestoreSnapshot"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if err := node.restoreSnapshot(ctx, req); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+func (node *Proxy) restoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) error {
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return err
+	}
+	// restoring a snapshot needs the privilege to read the collection of the snapshot,
+	// and the privilege to create collection in the database to restore into.
+	snapshot, err := node.getSnapshot(ctx, req.GetSnapshotName())
+	if err != nil {
+		return err
+	}
+	if err := checkPrivileges(ctx, snapshot.GetDbName(),
+		&milvuspb.QueryRequest{DbName: snapshot.GetDbName(), CollectionName: snapshot.GetCollectionName()}); err != nil {
+		return err
+	}
+	if err := checkPrivileges(ctx, req.GetDbName(), &milvuspb.CreateCollectionRequest{DbName: req.GetDbName()}); err != nil {
+		return err
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.mixCoord.RestoreSnapshot(ctx, req)
+	return merr.CheckRPCCall(status, err)
+}
+
+// getSnapshot returns the snapshot by name.
+func (node *Proxy) getSnapshot(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+	resp, err := node.mixCoord.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		Name: name,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	if len(resp.GetSnapshots()) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("snapshot %s not found", name)
+	}
+	return resp.GetSnapshots()[0], nil
+}
+
 // DeregisterSubLabel must add the sub-labels here if using other labels for the sub-labels
 func DeregisterSubLabel(subLabel string) {
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLQuery.String(), subLabel)
@@ -45,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -57,6 +58,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
@@ -1826,6 +1828,69 @@ func TestProxy_ImportV2(t *testing.T) {
 	})
 }
 
+func TestProxy_ConvertV1Import(t *testing.T) {
+	// all the files are one group as before
+	req, err := convertToV2ImportRequest(&milvuspb.ImportRequest{
+		Files:   []string{"backup/insert_log/1", "backup/delta_log/1"},
+		Options: []*commonpb.KeyValuePair{{Key: importutilv2.BackupFlag, Value: "true"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(req.GetFiles()))
+	assert.Equal(t, []string{"backup/insert_log/1", "backup/delta_log/1"}, req.GetFiles()[0].GetPaths())
+	assert.Equal(t, 1, len(req.GetOptions()))
+
+	// the groups are specified explicitly
+	req, err = convertToV2ImportRequest(&milvuspb.ImportRequest{
+		Files: []string{"a/1.json", "b/id.npy", "b/vector.npy"},
+		Options: []*commonpb.KeyValuePair{
+			{Key: importutilv2.FileGroups, Value: `[["a/1.json"], ["b/id.npy", "b/vector.npy"]]`},
+			{Key: importutilv2.Timeout, Value: "300s"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(req.GetFiles()))
+	assert.Equal(t, []string{"a/1.json"}, req.GetFiles()[0].GetPaths())
+	assert.Equal(t, []string{"b/id.npy", "b/vector.npy"}, req.GetFiles()[1].GetPaths())
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: importutilv2.Timeout, Value: "300s"}}, req.GetOptions())
+
+	for _, groups := range []string{`invalid`, `[["a/1.json"]]`, `[["b/id.npy", "b/vector.npy"], ["a/1.json"]]`} {
+		_, err = convertToV2ImportRequest(&milvuspb.ImportRequest{
+			Files:   []string{"a/1.json", "b/id.npy", "b/vector.npy"},
+			Options: []*commonpb.KeyValuePair{{Key: importutilv2.FileGroups, Value: groups}},
+		})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, groups)
+	}
+
+	resp := convertToV1GetImportResponse(100, &internalpb.GetImportProgressResponse{
+		Status:         merr.Success(),
+		State:          internalpb.ImportJobState_Failed,
+		Reason:         "mock reason",
+		CollectionName: "coll",
+		TaskProgresses: []*internalpb.ImportTaskProgress{
+			{FileName: "a/1.json", Reason: "mock reason", State: "Failed"},
+		},
+	})
+	assert.Equal(t, int64(100), resp.GetId())
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	progresses, err := funcutil.GetAttrByKeyFromRepeatedKV("file_progresses", resp.GetInfos())
+	assert.NoError(t, err)
+	assert.Contains(t, progresses, "mock reason")
+	collectionName, err := funcutil.GetAttrByKeyFromRepeatedKV("collection_name", resp.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "coll", collectionName)
+
+	listResp := convertToV1ListImportResponse(&internalpb.ListImportsResponse{
+		Status:          merr.Success(),
+		JobIDs:          []string{"1", "2"},
+		States:          []internalpb.ImportJobState{internalpb.ImportJobState_Completed, internalpb.ImportJobState_Importing},
+		Reasons:         []string{"", ""},
+		Progresses:      []int64{100, 50},
+		CollectionNames: []string{"coll", "coll"},
+	})
+	assert.Equal(t, 2, len(listResp.GetTasks()))
+	assert.Equal(t, int64(2), listResp.GetTasks()[1].GetId())
+}
+
 func TestGetCollectionRateSubLabel(t *testing.T) {
 	d := "db1"
 	collectionName := "test1"
@@ -2421,3 +2486,57 @@ func Test_GetSegmentsInfo(t *testing.T) {
 		assert.ElementsMatch(t, []int64{4, 8}, resp.GetSegmentInfos()[0].GetInsertLogs()[3].GetLogIDs())
 	})
 }
+
+func TestProxy_CloneCollection(t *testing.T) {
+	paramtable.Init()
+	node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	mixc := mocks.NewMockMixCoordClient(t)
+	node.mixCoord = mixc
+	req := &internalpb.CloneCollectionRequest{
+		DbName:            "default",
+		CollectionName:    "source",
+		NewDbName:         "db2",
+		NewCollectionName: "target",
+	}
+
+	t.Run("permission denied", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		// the request is denied before sent to rootcoord since no user is authenticated.
+		resp, err := node.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		mixc.EXPECT().CloneCollection(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		resp, err := node.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+}
+
+func TestProxy_TruncateCollection(t *testing.T) {
+	paramtable.Init()
+	node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	mixc := mocks.NewMockMixCoordClient(t)
+	node.mixCoord = mixc
+	req := &internalpb.TruncateCollectionRequest{DbName: "default", CollectionName: "coll"}
+
+	t.Run("permission denied", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		resp, err := node.TruncateCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		mixc.EXPECT().TruncateCollection(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		resp, err := node.TruncateCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+}
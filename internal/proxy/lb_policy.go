@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -68,10 +69,11 @@ const (
 )
 
 type LBPolicyImpl struct {
-	getBalancer    func() LBBalancer
-	clientMgr      shardClientMgr
-	balancerMap    map[string]LBBalancer
-	retryOnReplica int
+	getBalancer        func() LBBalancer
+	clientMgr          shardClientMgr
+	balancerMap        map[string]LBBalancer
+	retryOnReplica     int
+	concurrencyLimiter *shardConcurrencyLimiter
 }
 
 func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
@@ -90,10 +92,11 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	retryOnReplica := Params.ProxyCfg.RetryTimesOnReplica.GetAsInt()
 
 	return &LBPolicyImpl{
-		getBalancer:    getBalancer,
-		clientMgr:      clientMgr,
-		balancerMap:    balancerMap,
-		retryOnReplica: retryOnReplica,
+		getBalancer:        getBalancer,
+		clientMgr:          clientMgr,
+		balancerMap:        balancerMap,
+		retryOnReplica:     retryOnReplica,
+		concurrencyLimiter: newShardConcurrencyLimiter(),
 	}
 }
 
@@ -261,7 +264,16 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 			return true, lastErr
 		}
 
+		// each attempt is limited and sampled separately, so the retry on other replicas
+		// does not count into the latency of the node which serves the request.
+		limiter, err := lb.concurrencyLimiter.Acquire(workload.channel)
+		if err != nil {
+			log.RatedWarn(10, "shard request is rejected by adaptive concurrency limiter", zap.Error(err))
+			return false, err
+		}
+		start := time.Now()
 		err = workload.exec(ctx, targetNode.nodeID, client, workload.channel)
+		lb.concurrencyLimiter.Release(limiter, time.Since(start), lb.getQueueNQ(targetNode.nodeID), err)
 		if err != nil {
 			log.Warn("search/query channel failed",
 				zap.Int64("nodeID", targetNode.nodeID),
@@ -345,6 +357,15 @@ func (lb *LBPolicyImpl) ExecuteOneChannel(ctx context.Context, workload Collecti
 	return fmt.Errorf("no acitvate sheard leader exist for collection: %s", workload.collectionName)
 }
 
+// getQueueNQ returns the total nq queued in the query node collected by the balancer, 0 if unknown.
+func (lb *LBPolicyImpl) getQueueNQ(node int64) int64 {
+	getter, ok := lb.getBalancer().(costMetricsGetter)
+	if !ok {
+		return 0
+	}
+	return getter.GetCostMetrics(node).GetTotalNQ()
+}
+
 func (lb *LBPolicyImpl) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
 	lb.getBalancer().UpdateCostMetrics(node, cost)
 }
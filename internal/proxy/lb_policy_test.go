@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
@@ -376,6 +377,62 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 	s.True(merr.IsCanceledOrTimeout(err))
 }
 
+func (s *LBPolicySuite) TestExecuteWithConcurrencyLimit() {
+	ctx := context.Background()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyLimitEnabled.Key)
+	params.Save(params.ProxyCfg.AdaptiveConcurrencyInitialLimit.Key, "1")
+	defer params.Reset(params.ProxyCfg.AdaptiveConcurrencyInitialLimit.Key)
+	s.lbPolicy.concurrencyLimiter = newShardConcurrencyLimiter()
+
+	workload := ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			return nil
+		},
+	}
+
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().RegisterNodeInfo(mock.Anything)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, availableNodes []int64, nq int64) (int64, error) {
+		return availableNodes[0], nil
+	})
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+
+	// the request exceeding the concurrency limit of the channel should be rejected without retry.
+	limiter, err := s.lbPolicy.concurrencyLimiter.Acquire(s.channels[0])
+	s.NoError(err)
+	err = s.lbPolicy.ExecuteWithRetry(ctx, workload)
+	s.ErrorIs(err, merr.ErrServiceRateLimit)
+
+	s.lbPolicy.concurrencyLimiter.Release(limiter, time.Millisecond, 0, nil)
+	err = s.lbPolicy.ExecuteWithRetry(ctx, workload)
+	s.NoError(err)
+
+	// each attempt is sampled separately, the latency of the failed attempt is not counted.
+	s.lbPolicy.concurrencyLimiter = newShardConcurrencyLimiter()
+	attempts := 0
+	workload.exec = func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+		attempts++
+		if attempts == 1 {
+			time.Sleep(100 * time.Millisecond)
+			return errors.New("mock error")
+		}
+		return nil
+	}
+	err = s.lbPolicy.ExecuteWithRetry(ctx, workload)
+	s.NoError(err)
+	s.Equal(2, attempts)
+	limiter, ok := s.lbPolicy.concurrencyLimiter.limiters.Get(s.channels[0])
+	s.True(ok)
+	s.Less(limiter.avgLatency, float64(100))
+}
+
 func (s *LBPolicySuite) TestExecuteOneChannel() {
 	ctx := context.Background()
 	mockErr := errors.New("mock error")
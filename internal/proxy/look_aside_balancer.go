@@ -190,6 +190,15 @@ func (b *LookAsideBalancer) UpdateCostMetrics(node int64, cost *internalpb.CostA
 	}
 }
 
+// GetCostMetrics returns the latest cost metrics of the query node, nil if there's none.
+func (b *LookAsideBalancer) GetCostMetrics(node int64) *internalpb.CostAggregation {
+	metrics, ok := b.metricsMap.Get(node)
+	if !ok {
+		return nil
+	}
+	return metrics.cost.Load()
+}
+
 // calculateScore compute the query node's workload score
 // https://www.usenix.org/conference/nsdi15/technical-sessions/presentation/suresh
 func (b *LookAsideBalancer) calculateScore(node int64, cost *internalpb.CostAggregation, executingNQ int64) int64 {
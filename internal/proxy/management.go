@@ -46,6 +46,14 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteGcResume,
 			HandlerFunc: proxy.ResumeDatacoordGC,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGcMode,
+			HandlerFunc: proxy.SetDatacoordGCMode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGcReport,
+			HandlerFunc: proxy.GetDatacoordGCReport,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteListQueryNode,
 			HandlerFunc: proxy.ListQueryNode,
@@ -132,6 +140,58 @@ func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) SetDatacoordGCMode(w http.ResponseWriter, req *http.Request) {
+	mode := req.URL.Query().Get("mode")
+
+	resp, err := node.mixCoord.GcControl(req.Context(), &datapb.GcControlRequest{
+		Base:    commonpbutil.NewMsgBase(),
+		Command: datapb.GcCommand_SetMode,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "mode", Value: mode},
+		},
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set garbage collection mode, %s"}`, err.Error())))
+		return
+	}
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set garbage collection mode, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) GetDatacoordGCReport(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.mixCoord.GetGcReport(req.Context(), &datapb.GetGcReportRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get garbage collection report, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get garbage collection report, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get garbage collection report, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) ListQueryNode(w http.ResponseWriter, req *http.Request) {
 	resp, err := node.mixCoord.ListQueryNode(req.Context(), &querypb.ListQueryNodeRequest{
 		Base: commonpbutil.NewMsgBase(),
@@ -163,6 +163,81 @@ func (s *ProxyManagementSuite) TestResumeDatacoordGC() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestSetDatacoordGCMode() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.mixcoord.EXPECT().GcControl(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GcControlRequest, options ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal(datapb.GcCommand_SetMode, req.GetCommand())
+			s.Equal("dryRun", req.GetParams()[0].GetValue())
+			return &commonpb.Status{}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcMode+"?mode=dryRun", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.SetDatacoordGCMode(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.mixcoord.EXPECT().GcControl(mock.Anything, mock.Anything).Return(&commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "mocked",
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcMode+"?mode=unknown", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.SetDatacoordGCMode(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDatacoordGCReport() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.mixcoord.EXPECT().GetGcReport(mock.Anything, mock.Anything).Return(&datapb.GetGcReportResponse{
+			Status: merr.Success(),
+			Mode:   "dryRun",
+			Categories: []*datapb.GcReportCategory{
+				{Category: "binlog", ObjectNum: 1, SamplePaths: []string{"files/insert_log/1/2/3/4/5"}},
+			},
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcReport, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordGCReport(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), "dryRun")
+		s.Contains(recorder.Body.String(), "files/insert_log/1/2/3/4/5")
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.mixcoord.EXPECT().GetGcReport(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcReport, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordGCReport(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestListQueryNode() {
 	s.Run("normal", func() {
 		s.SetupTest()
@@ -852,9 +852,15 @@ func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionNa
 	defer m.mu.Unlock()
 	_, dbOk := m.collInfo[database]
 	if dbOk {
+		if info, ok := m.collInfo[database][collectionName]; ok {
+			globalSearchResultCache.RemoveCollection(info.collID)
+		}
 		delete(m.collInfo[database], collectionName)
 	}
 	if database == "" {
+		if info, ok := m.collInfo[defaultDB][collectionName]; ok {
+			globalSearchResultCache.RemoveCollection(info.collID)
+		}
 		delete(m.collInfo[defaultDB], collectionName)
 	}
 	log.Ctx(ctx).Debug("remove collection", zap.String("db", database), zap.String("collection", collectionName), zap.Bool("dbok", dbOk))
@@ -864,6 +870,7 @@ func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID Uniq
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	globalSearchResultCache.RemoveCollection(collectionID)
 	curVersion := m.collectionCacheVersion[collectionID]
 	var collNames []string
 	for database, db := range m.collInfo {
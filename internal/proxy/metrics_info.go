@@ -76,10 +76,15 @@ func getQuotaMetrics(node *Proxy) (*metricsinfo.ProxyQuotaMetrics, error) {
 	if err != nil {
 		return nil, err
 	}
+	var userMetrics []metricsinfo.UserQuotaMetrics
+	if node.simpleLimiter != nil {
+		userMetrics = node.simpleLimiter.GetUserQuotaMetrics()
+	}
 	return &metricsinfo.ProxyQuotaMetrics{
 		Hms:          metricsinfo.HardwareMetrics{},
 		Rms:          rms,
 		QueueMetrics: node.sched.getMetrics(),
+		UserMetrics:  userMetrics,
 	}, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -21,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -218,6 +220,32 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		fmt.Sprintf("%s: permission deny to %s in the `%s` database", objectPrivilege, username, dbName))
 }
 
+// checkPrivileges checks the privileges of the current user to call the requests in the database,
+// the database in the context is used if dbName is empty.
+// It's used by the apis whose requests don't carry the privilege ext obj, which are passed by PrivilegeInterceptor,
+// the requests should be the milvus requests which need the same privileges.
+func checkPrivileges(ctx context.Context, dbName string, reqs ...any) error {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	if dbName != "" {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		md.Set(strings.ToLower(util.HeaderDBName), dbName)
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	for _, req := range reqs {
+		if _, err := PrivilegeInterceptor(ctx, req); err != nil {
+			return merr.WrapErrPrivilegeNotPermitted("%s", status.Convert(err).Message())
+		}
+	}
+	return nil
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
@@ -263,6 +263,44 @@ func TestResourceGroupPrivilege(t *testing.T) {
 	})
 }
 
+func TestCheckPrivileges(t *testing.T) {
+	paramtable.Init()
+	ctx := GetContext(context.Background(), "snapshot_user:123456")
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+	assert.NoError(t, checkPrivileges(ctx, "db2", &milvuspb.CreateCollectionRequest{}))
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+	client := &MockMixCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("snapshot_role", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeCreateCollection.String(), "default"),
+				funcutil.PolicyForPrivilege("snapshot_role", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("snapshot_user", "snapshot_role"),
+			},
+		}, nil
+	}
+	InitMetaCache(ctx, client, newShardClientMgr())
+
+	assert.NoError(t, checkPrivileges(ctx, "", &milvuspb.CreateCollectionRequest{},
+		&milvuspb.QueryRequest{CollectionName: "col1"}))
+	assert.NoError(t, checkPrivileges(ctx, util.DefaultDBName, &milvuspb.CreateCollectionRequest{}))
+
+	// all the privileges are required.
+	err := checkPrivileges(ctx, "", &milvuspb.CreateCollectionRequest{}, &milvuspb.QueryRequest{CollectionName: "col2"})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+	err = checkPrivileges(ctx, "", &milvuspb.DropCollectionRequest{})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+	// the privileges are checked in the specified database.
+	err = checkPrivileges(ctx, "db2", &milvuspb.CreateCollectionRequest{})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+}
+
 func TestPrivilegeGroup(t *testing.T) {
 	ctx := context.Background()
 
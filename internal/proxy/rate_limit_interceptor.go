@@ -55,7 +55,7 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 				}
 			}
 		}
-		err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+		err = CheckRateLimit(ctx, limiter, dbID, collectionIDToPartIDs, rt, n)
 		nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 		metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 		if err != nil {
@@ -72,6 +72,30 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	}
 }
 
+// userLimiter is the limiter supporting the user level limits.
+type userLimiter interface {
+	CheckUser(user string, rt internalpb.RateType, n int) error
+	CancelUser(user string, rt internalpb.RateType, n int)
+}
+
+// CheckRateLimit checks if the request would be limited or denied,
+// the user level limits of the current user are checked before the other levels if supported by the limiter.
+func CheckRateLimit(ctx context.Context, limiter types.Limiter, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
+	ul, ok := limiter.(userLimiter)
+	if !ok {
+		return limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+	}
+	user := GetCurUserFromContextOrDefault(ctx)
+	if err := ul.CheckUser(user, rt, n); err != nil {
+		return err
+	}
+	if err := limiter.Check(dbID, collectionIDToPartIDs, rt, n); err != nil {
+		ul.CancelUser(user, rt, n)
+		return err
+	}
+	return nil
+}
+
 type reqPartName interface {
 	requestutil.DBNameGetter
 	requestutil.CollectionNameGetter
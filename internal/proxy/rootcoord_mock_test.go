@@ -1360,6 +1360,48 @@ func (coord *MixCoordMock) GcControl(ctx context.Context, in *datapb.GcControlRe
 	return merr.Success(), nil
 }
 
+func (coord *MixCoordMock) GetGcReport(ctx context.Context, in *datapb.GetGcReportRequest, opts ...grpc.CallOption) (*datapb.GetGcReportResponse, error) {
+	return &datapb.GetGcReportResponse{Status: merr.Success()}, nil
+}
+
+func (coord *MixCoordMock) CreateSnapshot(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) ListSnapshots(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	return &datapb.ListSnapshotsResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) DropSnapshot(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) RestoreSnapshotSegments(ctx context.Context, in *datapb.RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) CloneSegments(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) TruncateSegments(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 // importV2
 func (coord *MixCoordMock) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	return &internalpb.ImportResponse{
@@ -0,0 +1,266 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const searchResultCacheName = "SearchResult"
+
+// globalSearchResultCache is the proxy side search result cache, it's nil if the proxy is not initialized.
+var globalSearchResultCache *searchResultCache
+
+// initSearchResultCache initializes globalSearchResultCache.
+func initSearchResultCache() {
+	params := paramtable.Get()
+	globalSearchResultCache = newSearchResultCache(
+		params.ProxyCfg.SearchResultCacheCapacity.GetAsInt(),
+		params.ProxyCfg.SearchResultCacheTTL.GetAsDurationByParse(),
+	)
+}
+
+// searchResultCacheEntry is a cached search result.
+type searchResultCacheEntry struct {
+	collectionID int64
+	version      uint64 // version is the invalidation version of the collection when the result is cached.
+	ts           uint64 // ts is the timestamp that all data before it is visible to the search.
+	result       *milvuspb.SearchResults
+}
+
+// collectionCacheState is the invalidation state of a collection in search result cache.
+// The state is created when a cacheable search of the collection starts, and dropped when the collection is
+// released, dropped or altered, so only the opt-in collections in use are tracked.
+type collectionCacheState struct {
+	version      uint64 // version is unique among the states, so a recreated state never validates an old result.
+	dmlWatermark uint64 // dmlWatermark is the max timestamp of the dml applied to the collection by this proxy.
+}
+
+// searchResultCache caches the reduced search results of the opt-in collections.
+// A cached result is only returned if
+//  1. the collection meta is not changed since the result is cached,
+//  2. no dml is applied by this proxy to the collection after the data snapshot of the result,
+//  3. the data snapshot of the result is fresh enough for the guarantee timestamp of the request.
+type searchResultCache struct {
+	mu          sync.Mutex
+	collections map[int64]*collectionCacheState
+	nextVersion uint64
+	entries     *expirable.LRU[string, *searchResultCacheEntry]
+}
+
+// newSearchResultCache creates a new search result cache.
+func newSearchResultCache(capacity int, ttl time.Duration) *searchResultCache {
+	return &searchResultCache{
+		collections: make(map[int64]*collectionCacheState),
+		entries:     expirable.NewLRU[string, *searchResultCacheEntry](capacity, nil, ttl),
+	}
+}
+
+// Get returns the cached search result if there's a valid one for the key.
+// It's called when a cacheable search starts, the collection is tracked since then,
+// so the dml applied during the search can reject the result put later.
+func (c *searchResultCache) Get(key string, collectionID int64, guaranteeTs uint64) (*milvuspb.SearchResults, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	c.getOrCreateState(collectionID)
+	c.mu.Unlock()
+
+	entry, ok := c.entries.Get(key)
+	if ok && !c.isValid(entry, guaranteeTs) {
+		c.entries.Remove(key)
+		ok = false
+	}
+	if !ok {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), searchResultCacheName, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	metrics.ProxyCacheStatsCounter.WithLabelValues(paramtable.GetStringNodeID(), searchResultCacheName, metrics.CacheHitLabel).Inc()
+	return proto.Clone(entry.result).(*milvuspb.SearchResults), true
+}
+
+// Put caches the search result whose data snapshot is at ts.
+// The result is dropped if the collection is removed from the cache after the search started.
+func (c *searchResultCache) Put(key string, collectionID int64, ts uint64, result *milvuspb.SearchResults) {
+	if c == nil || proto.Size(result) > paramtable.Get().ProxyCfg.SearchResultCacheMaxResultSize.GetAsInt() {
+		return
+	}
+	c.mu.Lock()
+	state, ok := c.collections[collectionID]
+	if !ok || ts < state.dmlWatermark {
+		// the result is already stale.
+		c.mu.Unlock()
+		return
+	}
+	entry := &searchResultCacheEntry{
+		collectionID: collectionID,
+		version:      state.version,
+		ts:           ts,
+		result:       proto.Clone(result).(*milvuspb.SearchResults),
+	}
+	c.mu.Unlock()
+	c.entries.Add(key, entry)
+}
+
+// ObserveDML invalidates the cached results of the collection whose data snapshot is before the dml timestamp.
+func (c *searchResultCache) ObserveDML(collectionID int64, ts uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the collection is not tracked if the cache is off or the collection is not opted in,
+	// there's neither cached result nor in-flight cacheable search to invalidate.
+	state, ok := c.collections[collectionID]
+	if !ok {
+		return
+	}
+	if ts > state.dmlWatermark {
+		state.dmlWatermark = ts
+	}
+}
+
+// RemoveCollection invalidates all cached results of the collection and stops tracking it.
+func (c *searchResultCache) RemoveCollection(collectionID int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	delete(c.collections, collectionID)
+	c.mu.Unlock()
+
+	prefix := fmt.Sprintf("%d/", collectionID)
+	for _, key := range c.entries.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			c.entries.Remove(key)
+		}
+	}
+}
+
+// isValid checks if the cached entry can be used for the request with the guarantee timestamp.
+func (c *searchResultCache) isValid(entry *searchResultCacheEntry, guaranteeTs uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state, ok := c.collections[entry.collectionID]
+	if !ok {
+		return false
+	}
+	return entry.version == state.version && entry.ts >= state.dmlWatermark && entry.ts >= guaranteeTs
+}
+
+func (c *searchResultCache) getOrCreateState(collectionID int64) *collectionCacheState {
+	state, ok := c.collections[collectionID]
+	if !ok {
+		c.nextVersion++
+		state = &collectionCacheState{version: c.nextVersion}
+		c.collections[collectionID] = state
+	}
+	return state
+}
+
+// isSearchResultCacheable checks if the result of the search task can be cached.
+func (t *searchTask) isSearchResultCacheable() bool {
+	if globalSearchResultCache == nil || !paramtable.Get().ProxyCfg.SearchResultCacheEnabled.GetAsBool() {
+		return false
+	}
+	// the iterator and hybrid search is not cached.
+	if t.isIterator || t.SearchRequest.GetIsAdvanced() {
+		return false
+	}
+	return common.IsSearchResultCacheEnabled(t.schema.GetProperties()...)
+}
+
+// searchResultCacheKey generates the cache key of the search task,
+// which is composed of collection, schema version, normalized plan, query vectors, output fields, consistency level,
+// and the guarantee timestamp requested by user.
+func (t *searchTask) searchResultCacheKey(schemaUpdateTs uint64) string {
+	h := sha256.New()
+	writeUint64 := func(v uint64) {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	writeBytes := func(b []byte) {
+		writeUint64(uint64(len(b)))
+		h.Write(b)
+	}
+
+	writeUint64(schemaUpdateTs)
+	partitionIDs := append([]int64{}, t.SearchRequest.GetPartitionIDs()...)
+	sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+	writeUint64(uint64(len(partitionIDs)))
+	for _, id := range partitionIDs {
+		writeUint64(uint64(id))
+	}
+	writeBytes(t.SearchRequest.GetSerializedExprPlan())
+	writeBytes(t.SearchRequest.GetPlaceholderGroup())
+	writeUint64(uint64(t.SearchRequest.GetNq()))
+	writeUint64(uint64(t.SearchRequest.GetTopk()))
+	writeUint64(uint64(t.SearchRequest.GetOffset()))
+	writeUint64(uint64(t.SearchRequest.GetConsistencyLevel()))
+	writeUint64(t.request.GetGuaranteeTimestamp())
+	writeBytes([]byte(strings.Join(t.userOutputFields, ",")))
+	writeBytes([]byte(strings.Join(t.userDynamicFields, ",")))
+	if t.userRequestedPkFieldExplicitly {
+		writeUint64(1)
+	} else {
+		writeUint64(0)
+	}
+	if t.SearchRequest.GetIgnoreGrowing() {
+		writeUint64(1)
+	} else {
+		writeUint64(0)
+	}
+	// the search params that are not part of the plan, such as round_decimal.
+	params := make([]string, 0, len(t.request.GetSearchParams()))
+	for _, kv := range t.request.GetSearchParams() {
+		params = append(params, kv.GetKey()+"="+kv.GetValue())
+	}
+	sort.Strings(params)
+	writeBytes([]byte(strings.Join(params, "\n")))
+	return fmt.Sprintf("%d/%s", t.SearchRequest.GetCollectionID(), hex.EncodeToString(h.Sum(nil)))
+}
+
+// searchResultSnapshotTs returns the timestamp that all data before it is visible to the finished search.
+func (t *searchTask) searchResultSnapshotTs() uint64 {
+	var ts uint64
+	for _, channelTs := range t.queryChannelsTs {
+		if ts == 0 || channelTs < ts {
+			ts = channelTs
+		}
+	}
+	if ts == 0 {
+		ts = t.SearchRequest.GetGuaranteeTimestamp()
+	}
+	return ts
+}
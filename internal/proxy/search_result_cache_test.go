@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func newTestSearchResults(ids ...int64) *milvuspb.SearchResults {
+	return &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       int64(len(ids)),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+			},
+		},
+	}
+}
+
+func TestSearchResultCache(t *testing.T) {
+	paramtable.Init()
+
+	var nilCache *searchResultCache
+	_, ok := nilCache.Get("1/a", 1, 0)
+	assert.False(t, ok)
+	nilCache.Put("1/a", 1, 100, newTestSearchResults(1))
+	nilCache.ObserveDML(1, 100)
+	nilCache.RemoveCollection(1)
+
+	c := newSearchResultCache(16, time.Minute)
+	// the collection is not tracked before any search of it starts.
+	c.ObserveDML(1, 100)
+	assert.Empty(t, c.collections)
+	c.Put("1/a", 1, 100, newTestSearchResults(1))
+	assert.Zero(t, c.entries.Len())
+
+	_, ok = c.Get("1/a", 1, 0)
+	assert.False(t, ok)
+	_, ok = c.Get("2/a", 2, 0)
+	assert.False(t, ok)
+	assert.Len(t, c.collections, 2)
+
+	result := newTestSearchResults(1, 2)
+	c.Put("1/a", 1, 100, result)
+	c.Put("2/a", 2, 100, newTestSearchResults(3))
+
+	// the cached result should be a copy.
+	got, ok := c.Get("1/a", 1, 100)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, 2}, got.GetResults().GetIds().GetIntId().GetData())
+	got.Results.Ids.GetIntId().Data[0] = 10
+	got, ok = c.Get("1/a", 1, 50)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, 2}, got.GetResults().GetIds().GetIntId().GetData())
+
+	// the result is not fresh enough for the guarantee timestamp.
+	_, ok = c.Get("1/a", 1, 101)
+	assert.False(t, ok)
+
+	// the dml before the snapshot of result should not invalidate the result.
+	c.Put("1/a", 1, 100, result)
+	c.ObserveDML(1, 99)
+	_, ok = c.Get("1/a", 1, 0)
+	assert.True(t, ok)
+
+	// the dml after the snapshot of result should invalidate the result.
+	c.ObserveDML(1, 101)
+	_, ok = c.Get("1/a", 1, 0)
+	assert.False(t, ok)
+	// the stale result of in-flight search should not be cached.
+	c.Put("1/a", 1, 100, result)
+	_, ok = c.Get("1/a", 1, 0)
+	assert.False(t, ok)
+	c.Put("1/a", 1, 102, result)
+	_, ok = c.Get("1/a", 1, 0)
+	assert.True(t, ok)
+
+	// remove collection should only invalidate the results of the collection, and drop its state.
+	c.RemoveCollection(1)
+	assert.NotContains(t, c.collections, int64(1))
+	_, ok = c.Get("2/a", 2, 0)
+	assert.True(t, ok)
+	// the result of the search started before the removal should not be cached.
+	c.Put("1/a", 1, 102, result)
+	assert.False(t, c.entries.Contains("1/a"))
+	// the result cached before the removal should not be validated by the recreated state.
+	c.entries.Add("1/a", &searchResultCacheEntry{collectionID: 1, ts: 102, result: result})
+	_, ok = c.Get("1/a", 1, 0)
+	assert.False(t, ok)
+
+	// too large result should not be cached.
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.SearchResultCacheMaxResultSize.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.SearchResultCacheMaxResultSize.Key)
+	_, ok = c.Get("3/a", 3, 0)
+	assert.False(t, ok)
+	c.Put("3/a", 3, 100, result)
+	_, ok = c.Get("3/a", 3, 0)
+	assert.False(t, ok)
+
+	// the expired result should not be returned.
+	c = newSearchResultCache(16, 10*time.Millisecond)
+	_, ok = c.Get("4/a", 4, 0)
+	assert.False(t, ok)
+	c.Put("4/a", 4, 100, newTestSearchResults(1))
+	time.Sleep(20 * time.Millisecond)
+	_, ok = c.Get("4/a", 4, 0)
+	assert.False(t, ok)
+}
+
+func TestSearchTask_SearchResultCacheKey(t *testing.T) {
+	paramtable.Init()
+
+	newTask := func() *searchTask {
+		return &searchTask{
+			ctx: context.Background(),
+			SearchRequest: &internalpb.SearchRequest{
+				CollectionID:       1,
+				PartitionIDs:       []int64{2, 1},
+				SerializedExprPlan: []byte("plan"),
+				PlaceholderGroup:   []byte("vectors"),
+				Nq:                 1,
+				Topk:               10,
+				ConsistencyLevel:   commonpb.ConsistencyLevel_Bounded,
+			},
+			request: &milvuspb.SearchRequest{
+				SearchParams: []*commonpb.KeyValuePair{
+					{Key: "round_decimal", Value: "-1"},
+					{Key: "topk", Value: "10"},
+				},
+			},
+			userOutputFields: []string{"a"},
+			schema: newSchemaInfo(&schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				},
+			}),
+		}
+	}
+
+	key := newTask().searchResultCacheKey(1)
+	assert.Contains(t, key, "1/")
+
+	// partition ids and search params order should not change the key.
+	task := newTask()
+	task.SearchRequest.PartitionIDs = []int64{1, 2}
+	task.request.SearchParams = []*commonpb.KeyValuePair{task.request.SearchParams[1], task.request.SearchParams[0]}
+	assert.Equal(t, key, task.searchResultCacheKey(1))
+
+	assert.NotEqual(t, key, newTask().searchResultCacheKey(2))
+	for _, modify := range []func(task *searchTask){
+		func(task *searchTask) { task.SearchRequest.PlaceholderGroup = []byte("vectors2") },
+		func(task *searchTask) { task.SearchRequest.SerializedExprPlan = []byte("plan2") },
+		func(task *searchTask) { task.SearchRequest.ConsistencyLevel = commonpb.ConsistencyLevel_Eventually },
+		func(task *searchTask) { task.request.GuaranteeTimestamp = 100 },
+		func(task *searchTask) { task.userOutputFields = []string{"b"} },
+		func(task *searchTask) { task.SearchRequest.Offset = 1 },
+	} {
+		task := newTask()
+		modify(task)
+		assert.NotEqual(t, key, task.searchResultCacheKey(1))
+	}
+
+	// cacheable check.
+	globalSearchResultCache = nil
+	task = newTask()
+	assert.False(t, task.isSearchResultCacheable())
+
+	initSearchResultCache()
+	defer func() {
+		globalSearchResultCache = nil
+	}()
+	assert.False(t, task.isSearchResultCacheable())
+
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.SearchResultCacheEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.SearchResultCacheEnabled.Key)
+	assert.False(t, task.isSearchResultCacheable())
+
+	task.schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionSearchResultCacheKey, Value: "true"}}
+	assert.True(t, task.isSearchResultCacheable())
+	task.isIterator = true
+	assert.False(t, task.isSearchResultCacheable())
+
+	// the snapshot ts is the min mvcc ts of all channels.
+	task = newTask()
+	task.SearchRequest.GuaranteeTimestamp = 10
+	assert.Equal(t, uint64(10), task.searchResultSnapshotTs())
+	task.queryChannelsTs = map[string]uint64{"ch1": 100, "ch2": 50}
+	assert.Equal(t, uint64(50), task.searchResultSnapshotTs())
+}
+
+func TestSearchTask_SearchResultCacheHit(t *testing.T) {
+	paramtable.Init()
+
+	cached := newTestSearchResults(1, 2)
+	task := &searchTask{
+		ctx:           context.Background(),
+		SearchRequest: &internalpb.SearchRequest{Nq: 1},
+		cachedResult:  cached,
+	}
+	// the search should not be executed if the result is hit in cache.
+	assert.NoError(t, task.Execute(context.Background()))
+	assert.NoError(t, task.PostExecute(context.Background()))
+	assert.Equal(t, cached, task.result)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
@@ -145,6 +146,99 @@ func (m *SimpleLimiter) Check(dbID int64, collectionIDToPartIDs map[int64][]int6
 	return ret
 }
 
+// CheckUser checks if request of the user would be limited or denied by the user level limits.
+// The daily quotas are checked against the usage served by the proxy,
+// the usage of the whole cluster is enforced by the quota states from QuotaCenter.
+func (m *SimpleLimiter) CheckUser(user string, rt internalpb.RateType, n int) error {
+	if !isUserLevelLimitEnabled() || user == "" || n <= 0 || !isUserLevelLimitRequest(rt) {
+		return nil
+	}
+
+	m.quotaStatesMu.RLock()
+	defer m.quotaStatesMu.RUnlock()
+
+	userRateLimiters := m.rateLimiter.GetOrCreateUserLimiters(user, func() *rlinternal.RateLimiterNode {
+		return newUserLimiter(user)
+	})
+	if err := userRateLimiters.Check(rt, n); err != nil {
+		return err
+	}
+	if err := userRateLimiters.CheckDailyQuota(rlinternal.DailyRequests, 1); err != nil {
+		userRateLimiters.Cancel(rt, n)
+		return err
+	}
+	if isDMLRequest(rt) {
+		if err := userRateLimiters.CheckDailyQuota(rlinternal.DailyBytes, float64(n)); err != nil {
+			userRateLimiters.Cancel(rt, n)
+			userRateLimiters.CancelDailyQuota(rlinternal.DailyRequests, 1)
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelUser gives back the user level limits consumed by CheckUser,
+// it's used when the request is rejected by the other levels.
+func (m *SimpleLimiter) CancelUser(user string, rt internalpb.RateType, n int) {
+	if !isUserLevelLimitEnabled() || user == "" || n <= 0 || !isUserLevelLimitRequest(rt) {
+		return
+	}
+
+	m.quotaStatesMu.RLock()
+	defer m.quotaStatesMu.RUnlock()
+
+	userRateLimiters := m.rateLimiter.GetUserLimiters(user)
+	if userRateLimiters == nil {
+		return
+	}
+	userRateLimiters.Cancel(rt, n)
+	userRateLimiters.CancelDailyQuota(rlinternal.DailyRequests, 1)
+	if isDMLRequest(rt) {
+		userRateLimiters.CancelDailyQuota(rlinternal.DailyBytes, float64(n))
+	}
+}
+
+// GetUserQuotaMetrics returns the daily quota usage of the users served by the proxy.
+func (m *SimpleLimiter) GetUserQuotaMetrics() []metricsinfo.UserQuotaMetrics {
+	now := time.Now()
+	userMetrics := make([]metricsinfo.UserQuotaMetrics, 0)
+	m.rateLimiter.GetUsers().Range(func(user string, userRateLimiters *rlinternal.RateLimiterNode) bool {
+		metric := metricsinfo.UserQuotaMetrics{User: user}
+		if dailyQuota, ok := userRateLimiters.GetDailyQuotas().Get(rlinternal.DailyRequests); ok {
+			metric.DailyRequests = dailyQuota.Used(now)
+			metric.MaxDailyRequests = dailyQuota.Limit()
+		}
+		if dailyQuota, ok := userRateLimiters.GetDailyQuotas().Get(rlinternal.DailyBytes); ok {
+			metric.DailyBytes = dailyQuota.Used(now)
+			metric.MaxDailyBytes = dailyQuota.Limit()
+		}
+		userMetrics = append(userMetrics, metric)
+		return true
+	})
+	return userMetrics
+}
+
+func isUserLevelLimitEnabled() bool {
+	return Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() && Params.QuotaConfig.UserLimitEnabled.GetAsBool()
+}
+
+// isUserLevelLimitRequest checks if the request is limited by the user level limits, only dml and dql are limited.
+func isUserLevelLimitRequest(rt internalpb.RateType) bool {
+	return isDMLRequest(rt) || rt == internalpb.RateType_DQLSearch || rt == internalpb.RateType_DQLQuery
+}
+
+func isDMLRequest(rt internalpb.RateType) bool {
+	switch rt {
+	case internalpb.RateType_DMLInsert,
+		internalpb.RateType_DMLUpsert,
+		internalpb.RateType_DMLDelete,
+		internalpb.RateType_DMLBulkLoad:
+		return true
+	default:
+		return false
+	}
+}
+
 func isNotCollectionLevelLimitRequest(rt internalpb.RateType) bool {
 	// Most ddl is global level, only DDLFlush will be applied at collection
 	switch rt {
@@ -219,27 +313,88 @@ func (m *SimpleLimiter) SetRates(rootLimiter *proxypb.LimiterNode) error {
 	return nil
 }
 
+// SetUserRates sets the user level limits split by QuotaCenter, the limiters of the users
+// which are not active in the cluster any more, e.g. dropped or idle in the day, are removed.
+func (m *SimpleLimiter) SetUserRates(userLimiters map[string]*proxypb.Limiter) error {
+	m.quotaStatesMu.Lock()
+	defer m.quotaStatesMu.Unlock()
+
+	// Reset the limiter rates due to potential changes in configurations.
+	m.rateLimiter.GetUsers().Range(func(user string, userLimiter *rlinternal.RateLimiterNode) bool {
+		initUserLimiter(user, userLimiter)
+		return true
+	})
+
+	for user, reqUserLimiter := range userLimiters {
+		userRateLimiters := m.rateLimiter.GetOrCreateUserLimiters(user, func() *rlinternal.RateLimiterNode {
+			return newUserLimiter(user)
+		})
+		if err := m.updateLimiterNode(reqUserLimiter, userRateLimiters, fmt.Sprintf("user.%s", user)); err != nil {
+			log.Warn("update user rate limiters failed", zap.String("user", user), zap.Error(err))
+			return err
+		}
+	}
+
+	m.rateLimiter.ClearInvalidUserLimiters(userLimiters)
+	return nil
+}
+
 func initLimiter(source string, rln *rlinternal.RateLimiterNode, rateLimiterConfigs map[internalpb.RateType]*paramtable.ParamItem) {
 	for rt, p := range rateLimiterConfigs {
-		newLimit := ratelimitutil.Limit(p.GetAsFloat())
-		burst := p.GetAsFloat() // use rate as burst, because SimpleLimiter is with punishment mechanism, burst is insignificant.
-		old, ok := rln.GetLimiters().Get(rt)
-		updated := false
-		if ok {
-			if old.Limit() != newLimit {
-				old.SetLimit(newLimit)
-				updated = true
-			}
-		} else {
-			rln.GetLimiters().Insert(rt, ratelimitutil.NewLimiter(newLimit, burst))
+		setLimiter(source, rln, rt, p.GetAsFloat())
+	}
+}
+
+func setLimiter(source string, rln *rlinternal.RateLimiterNode, rt internalpb.RateType, rate float64) {
+	newLimit := ratelimitutil.Limit(rate)
+	burst := rate // use rate as burst, because SimpleLimiter is with punishment mechanism, burst is insignificant.
+	old, ok := rln.GetLimiters().Get(rt)
+	updated := false
+	if ok {
+		if old.Limit() != newLimit {
+			old.SetLimit(newLimit)
 			updated = true
 		}
-		if updated {
-			log.Ctx(context.TODO()).Debug("RateLimiter register for rateType",
-				zap.String("source", source),
-				zap.String("rateType", internalpb.RateType_name[(int32(rt))]),
-				zap.String("rateLimit", newLimit.String()),
-				zap.String("burst", fmt.Sprintf("%v", burst)))
+	} else {
+		rln.GetLimiters().Insert(rt, ratelimitutil.NewLimiter(newLimit, burst))
+		updated = true
+	}
+	if updated {
+		log.Ctx(context.TODO()).Debug("RateLimiter register for rateType",
+			zap.String("source", source),
+			zap.String("rateType", internalpb.RateType_name[(int32(rt))]),
+			zap.String("rateLimit", newLimit.String()),
+			zap.String("burst", fmt.Sprintf("%v", burst)))
+	}
+}
+
+// initUserLimiter sets the limiters and daily quotas of the user by the user level limits of the user and its roles.
+func initUserLimiter(user string, rln *rlinternal.RateLimiterNode) {
+	var roles []string
+	if globalMetaCache != nil {
+		roles = globalMetaCache.GetUserRole(user)
+	}
+	limits := quota.GetUserLimits(user, roles)
+	source := fmt.Sprintf("user-%s", user)
+	for _, rt := range []internalpb.RateType{
+		internalpb.RateType_DMLInsert,
+		internalpb.RateType_DMLUpsert,
+		internalpb.RateType_DMLDelete,
+		internalpb.RateType_DMLBulkLoad,
+	} {
+		setLimiter(source, rln, rt, limits.DMLRate)
+	}
+	setLimiter(source, rln, internalpb.RateType_DQLSearch, limits.DQLRate)
+	setLimiter(source, rln, internalpb.RateType_DQLQuery, limits.DQLRate)
+
+	for qt, limit := range map[rlinternal.DailyQuotaType]float64{
+		rlinternal.DailyRequests: limits.DailyRequests,
+		rlinternal.DailyBytes:    limits.DailyBytes,
+	} {
+		if dailyQuota, ok := rln.GetDailyQuotas().Get(qt); ok {
+			dailyQuota.SetLimit(limit)
+		} else {
+			rln.GetDailyQuotas().Insert(qt, rlinternal.NewDailyQuota(limit))
 		}
 	}
 }
@@ -275,6 +430,12 @@ func newPartitionLimiters() *rlinternal.RateLimiterNode {
 	return partRateLimiters
 }
 
+func newUserLimiter(user string) *rlinternal.RateLimiterNode {
+	userRateLimiters := rlinternal.NewRateLimiterNode(internalpb.RateScope_User)
+	initUserLimiter(user, userRateLimiters)
+	return userRateLimiters
+}
+
 func (m *SimpleLimiter) updateLimiterNode(req *proxypb.Limiter, node *rlinternal.RateLimiterNode, sourceID string) error {
 	curLimiters := node.GetLimiters()
 	for _, rate := range req.GetRates() {
@@ -17,6 +17,7 @@
 package proxy
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"testing"
@@ -402,3 +403,162 @@ func TestRateLimiter(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestSimpleLimiter_CheckUser(t *testing.T) {
+	paramtable.Init()
+	bakCache := globalMetaCache
+	globalMetaCache = nil
+	defer func() {
+		globalMetaCache = bakCache
+	}()
+
+	params := paramtable.Get()
+	params.Save(params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	simpleLimiter := NewSimpleLimiter(0, 0)
+	// the user level limit is disabled by default.
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLSearch, math.MaxInt))
+	assert.Nil(t, simpleLimiter.rateLimiter.GetUserLimiters("alice"))
+
+	params.Save(params.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.UserLimitEnabled.Key)
+	params.Save(params.QuotaConfig.UserMaxDailyRequests.Key, "2")
+	defer params.Reset(params.QuotaConfig.UserMaxDailyRequests.Key)
+	params.Save(params.QuotaConfig.UserLimitsPerUser.Key, `{"bob": {"dailyRequests": -1, "dailyBytes": 1}}`)
+	defer params.Reset(params.QuotaConfig.UserLimitsPerUser.Key)
+
+	// ddl and anonymous requests are not limited by the user level.
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DDLCollection, 1))
+	assert.NoError(t, simpleLimiter.CheckUser("", internalpb.RateType_DQLSearch, 1))
+	assert.Nil(t, simpleLimiter.rateLimiter.GetUserLimiters("alice"))
+
+	// the daily requests quota of alice is exhausted.
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLSearch, 1))
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DMLInsert, 100))
+	err := simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1)
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	simpleLimiter.CancelUser("alice", internalpb.RateType_DMLInsert, 100)
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1))
+
+	// the daily bytes quota of bob is exhausted, the consumed request quota should be given back.
+	assert.NoError(t, simpleLimiter.CheckUser("bob", internalpb.RateType_DQLSearch, 10))
+	assert.NoError(t, simpleLimiter.CheckUser("bob", internalpb.RateType_DMLInsert, 1024*1024))
+	err = simpleLimiter.CheckUser("bob", internalpb.RateType_DMLDelete, 1)
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+
+	userMetrics := simpleLimiter.GetUserQuotaMetrics()
+	assert.Len(t, userMetrics, 2)
+	for _, m := range userMetrics {
+		switch m.User {
+		case "alice":
+			assert.Equal(t, float64(2), m.DailyRequests)
+			assert.Equal(t, float64(2), m.MaxDailyRequests)
+		case "bob":
+			assert.Equal(t, float64(2), m.DailyRequests)
+			assert.Equal(t, math.MaxFloat64, m.MaxDailyRequests)
+			assert.Equal(t, float64(1024*1024), m.DailyBytes)
+			assert.Equal(t, float64(1024*1024), m.MaxDailyBytes)
+		default:
+			t.Fatalf("unexpected user %s", m.User)
+		}
+	}
+
+	// the rates of user limiters are refreshed by set user rates.
+	params.Save(params.QuotaConfig.UserDQLMaxRate.Key, "0")
+	defer params.Reset(params.QuotaConfig.UserDQLMaxRate.Key)
+	assert.NoError(t, simpleLimiter.SetUserRates(map[string]*proxypb.Limiter{"bob": {}}))
+	err = simpleLimiter.CheckUser("bob", internalpb.RateType_DQLSearch, 1)
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+}
+
+func TestSimpleLimiter_SetUserRates(t *testing.T) {
+	paramtable.Init()
+	bakCache := globalMetaCache
+	globalMetaCache = nil
+	defer func() {
+		globalMetaCache = bakCache
+	}()
+
+	params := paramtable.Get()
+	params.Save(params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	params.Save(params.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.UserLimitEnabled.Key)
+	params.Save(params.QuotaConfig.UserDQLMaxRate.Key, "100")
+	defer params.Reset(params.QuotaConfig.UserDQLMaxRate.Key)
+
+	simpleLimiter := NewSimpleLimiter(0, 0)
+	assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLSearch, 1))
+	assert.NoError(t, simpleLimiter.CheckUser("bob", internalpb.RateType_DQLSearch, 1))
+
+	// the user limits split by QuotaCenter are applied, and the quota states are set.
+	err := simpleLimiter.SetUserRates(map[string]*proxypb.Limiter{
+		"alice": {
+			Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 50}},
+		},
+		"carol": {
+			Rates:  []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 0}},
+			States: []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite},
+			Codes:  []commonpb.ErrorCode{commonpb.ErrorCode_RateLimit},
+		},
+	})
+	assert.NoError(t, err)
+	limiter, ok := simpleLimiter.rateLimiter.GetUserLimiters("alice").GetLimiters().Get(internalpb.RateType_DQLSearch)
+	assert.True(t, ok)
+	assert.Equal(t, ratelimitutil.Limit(50), limiter.Limit())
+	limiter, ok = simpleLimiter.rateLimiter.GetUserLimiters("bob").GetLimiters().Get(internalpb.RateType_DQLSearch)
+	assert.True(t, ok)
+	assert.Equal(t, ratelimitutil.Limit(100), limiter.Limit())
+	err = simpleLimiter.CheckUser("carol", internalpb.RateType_DMLInsert, 1)
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	assert.Contains(t, err.Error(), "daily quota of the user exceeded")
+
+	// the limiter of user absent from QuotaCenter twice in a row is removed.
+	err = simpleLimiter.SetUserRates(map[string]*proxypb.Limiter{"alice": {}})
+	assert.NoError(t, err)
+	assert.Nil(t, simpleLimiter.rateLimiter.GetUserLimiters("bob"))
+	assert.NotNil(t, simpleLimiter.rateLimiter.GetUserLimiters("carol"))
+	err = simpleLimiter.SetUserRates(map[string]*proxypb.Limiter{"alice": {}})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"alice"}, simpleLimiter.rateLimiter.GetUsers().Keys())
+
+	// unregistered rate type is rejected.
+	err = simpleLimiter.SetUserRates(map[string]*proxypb.Limiter{
+		"alice": {Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DDLCollection, R: 1}}},
+	})
+	assert.Error(t, err)
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	paramtable.Init()
+	bakCache := globalMetaCache
+	globalMetaCache = nil
+	defer func() {
+		globalMetaCache = bakCache
+	}()
+
+	params := paramtable.Get()
+	params.Save(params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	params.Save(params.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.UserLimitEnabled.Key)
+	params.Save(params.QuotaConfig.UserMaxDailyRequests.Key, "1")
+	defer params.Reset(params.QuotaConfig.UserMaxDailyRequests.Key)
+
+	ctx := GetContext(context.Background(), "alice:123456")
+	simpleLimiter := NewSimpleLimiter(0, 0)
+	simpleLimiter.rateLimiter.GetRootLimiters().GetLimiters().Insert(internalpb.RateType_DQLSearch, ratelimitutil.NewLimiter(0, 0))
+
+	// the user quota should be given back if the request is rejected by the cluster level.
+	err := CheckRateLimit(ctx, simpleLimiter, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 1)
+	assert.Error(t, err)
+	userMetrics := simpleLimiter.GetUserQuotaMetrics()
+	assert.Len(t, userMetrics, 1)
+	assert.Equal(t, float64(0), userMetrics[0].DailyRequests)
+
+	simpleLimiter.rateLimiter.GetRootLimiters().GetLimiters().Insert(internalpb.RateType_DQLSearch, ratelimitutil.NewLimiter(ratelimitutil.Inf, 0))
+	assert.NoError(t, CheckRateLimit(ctx, simpleLimiter, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 1))
+	err = CheckRateLimit(ctx, simpleLimiter, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 1)
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+}
@@ -30,7 +30,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage/compress"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
@@ -395,6 +397,14 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := compress.ValidateConfig(t.GetProperties()...); err != nil {
+		return err
+	}
+
+	if err := maintenance.ValidateProperties(t.GetProperties()...); err != nil {
+		return err
+	}
+
 	// validate clustering key
 	if err := t.validateClusteringKey(ctx); err != nil {
 		return err
@@ -1069,6 +1079,15 @@ func hasLazyLoadProp(props ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+func hasBinlogCompressionProp(props ...*commonpb.KeyValuePair) bool {
+	for _, p := range props {
+		if p.GetKey() == common.CollectionBinlogCompressionKey || p.GetKey() == common.CollectionBinlogCompressionLevelKey {
+			return true
+		}
+	}
+	return false
+}
+
 func hasPropInDeletekeys(keys []string) string {
 	for _, key := range keys {
 		if key == common.MmapEnabledKey || key == common.LazyLoadEnableKey {
@@ -1126,6 +1145,32 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 				return merr.WrapErrCollectionLoaded(t.CollectionName, "can not alter mmap properties if collection loaded")
 			}
 		}
+		if hasBinlogCompressionProp(t.Properties...) {
+			collSchema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.CollectionName)
+			if err != nil {
+				return err
+			}
+			// the level may be altered alone, validate it along with the codec already set
+			props := make([]*commonpb.KeyValuePair, 0, len(collSchema.GetProperties())+len(t.Properties))
+			props = append(props, collSchema.GetProperties()...)
+			props = append(props, t.Properties...)
+			if err := compress.ValidateConfig(props...); err != nil {
+				return err
+			}
+		}
+		if maintenance.HasProperties(t.Properties...) {
+			collSchema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.CollectionName)
+			if err != nil {
+				return err
+			}
+			// the window may be altered partially, validate it along with the properties already set
+			props := make([]*commonpb.KeyValuePair, 0, len(collSchema.GetProperties())+len(t.Properties))
+			props = append(props, collSchema.GetProperties()...)
+			props = append(props, t.Properties...)
+			if err := maintenance.ValidateProperties(props...); err != nil {
+				return err
+			}
+		}
 	} else if len(t.GetDeleteKeys()) > 0 {
 		key := hasPropInDeletekeys(t.DeleteKeys)
 		if key != "" {
@@ -9,6 +9,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
@@ -71,7 +72,10 @@ func (cdt *createDatabaseTask) OnEnqueue() error {
 }
 
 func (cdt *createDatabaseTask) PreExecute(ctx context.Context) error {
-	return ValidateDatabaseName(cdt.GetDbName())
+	if err := ValidateDatabaseName(cdt.GetDbName()); err != nil {
+		return err
+	}
+	return maintenance.ValidateProperties(cdt.GetProperties()...)
 }
 
 func (cdt *createDatabaseTask) Execute(ctx context.Context) error {
@@ -276,6 +280,19 @@ func (t *alterDatabaseTask) OnEnqueue() error {
 }
 
 func (t *alterDatabaseTask) PreExecute(ctx context.Context) error {
+	if maintenance.HasProperties(t.Properties...) {
+		cacheInfo, err := globalMetaCache.GetDatabaseInfo(ctx, t.DbName)
+		if err != nil {
+			return err
+		}
+		// the window may be altered partially, validate it along with the properties already set
+		props := make([]*commonpb.KeyValuePair, 0, len(cacheInfo.properties)+len(t.Properties))
+		props = append(props, cacheInfo.properties...)
+		props = append(props, t.Properties...)
+		if err := maintenance.ValidateProperties(props...); err != nil {
+			return err
+		}
+	}
 	_, ok := common.GetReplicateID(t.Properties)
 	if ok {
 		return merr.WrapErrParameterInvalidMsg("can't set the replicate id property in alter database request")
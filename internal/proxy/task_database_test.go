@@ -66,6 +66,13 @@ func TestCreateDatabaseTask(t *testing.T) {
 		err := task.PreExecute(ctx)
 		assert.Error(t, err)
 	})
+
+	t.Run("invalid maintenance window", func(t *testing.T) {
+		task.DbName = "db"
+		task.Properties = []*commonpb.KeyValuePair{{Key: common.MaintenanceWindowCronKey, Value: "0 1 * * *"}}
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+	})
 }
 
 func TestDropDatabaseTask(t *testing.T) {
@@ -202,6 +209,30 @@ func TestAlterDatabase(t *testing.T) {
 	assert.Nil(t, err1)
 }
 
+func TestAlterDatabaseTaskForMaintenanceWindow(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := NewMockCache(t)
+	globalMetaCache = mockCache
+	mockCache.EXPECT().GetDatabaseInfo(mock.Anything, "test_alter_database").Return(&databaseInfo{
+		properties: []*commonpb.KeyValuePair{{Key: common.MaintenanceWindowCronKey, Value: "0 1 * * *"}},
+	}, nil)
+
+	newTask := func(props ...*commonpb.KeyValuePair) *alterDatabaseTask {
+		return &alterDatabaseTask{
+			AlterDatabaseRequest: &milvuspb.AlterDatabaseRequest{
+				Base:       &commonpb.MsgBase{},
+				DbName:     "test_alter_database",
+				Properties: props,
+			},
+		}
+	}
+	// the duration is validated along with the cron already set
+	assert.NoError(t, newTask(&commonpb.KeyValuePair{Key: common.MaintenanceWindowDurationKey, Value: "2h"}).PreExecute(context.Background()))
+	assert.Error(t, newTask(&commonpb.KeyValuePair{Key: common.MaintenanceWindowDurationKey, Value: "abc"}).PreExecute(context.Background()))
+	assert.Error(t, newTask(&commonpb.KeyValuePair{Key: common.MaintenanceWindowOperationsKey, Value: "backup"}).PreExecute(context.Background()))
+}
+
 func TestAlterDatabaseTaskForReplicateProperty(t *testing.T) {
 	rc := mocks.NewMockMixCoordClient(t)
 	cache := globalMetaCache
@@ -188,6 +188,7 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 }
 
 func (dt *deleteTask) PostExecute(ctx context.Context) error {
+	globalSearchResultCache.ObserveDML(dt.collectionID, dt.EndTs())
 	metrics.ProxyDeleteVectors.WithLabelValues(
 		paramtable.GetStringNodeID(),
 		dt.req.GetDbName(),
@@ -352,5 +352,6 @@ func (it *insertTask) Execute(ctx context.Context) error {
 }
 
 func (it *insertTask) PostExecute(ctx context.Context) error {
+	globalSearchResultCache.ObserveDML(it.insertMsg.GetCollectionID(), it.EndTs())
 	return nil
 }
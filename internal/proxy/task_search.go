@@ -97,6 +97,11 @@ type searchTask struct {
 	// if the user explicitly set pk field in output fields, we add it back to the result.
 	userRequestedPkFieldExplicitly bool
 
+	// resultCacheKey is the key of search result cache, empty if the search result is not cacheable.
+	resultCacheKey string
+	// cachedResult is the search result hit in the search result cache.
+	cachedResult *milvuspb.SearchResults
+
 	// To facilitate writing unit tests
 	requeryFunc func(t *searchTask, span trace.Span, ids *schemapb.IDs, outputFields []string) (*milvuspb.QueryResults, error)
 }
@@ -282,6 +287,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if t.isSearchResultCacheable() {
+		t.resultCacheKey = t.searchResultCacheKey(collectionInfo.updateTimestamp)
+		t.cachedResult, _ = globalSearchResultCache.Get(t.resultCacheKey, t.GetCollectionID(), guaranteeTs)
+	}
+
 	log.Debug("search PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Bool("use_default_consistency", useDefaultConsistency),
@@ -901,6 +911,11 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
 
+	if t.cachedResult != nil {
+		log.Debug("search result cache hit, skip execute", zap.Int64("collection", t.GetCollectionID()))
+		return nil
+	}
+
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.SearchRequest.CollectionID,
@@ -991,6 +1006,11 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	}()
 	log := log.Ctx(ctx).With(zap.Int64("nq", t.SearchRequest.GetNq()))
 
+	if t.cachedResult != nil {
+		t.result = t.cachedResult
+		return nil
+	}
+
 	toReduceResults, err := t.collectSearchResults(ctx)
 	if err != nil {
 		log.Warn("failed to collect search results", zap.Error(err))
@@ -1073,6 +1093,11 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
+	// the result that may trigger a retry or is not a normal search result should not be cached.
+	if t.resultCacheKey != "" && !t.resultSizeInsufficient && !t.isTopkReduce && !t.isRecallEvaluation {
+		globalSearchResultCache.Put(t.resultCacheKey, t.GetCollectionID(), t.searchResultSnapshotTs(), t.result)
+	}
+
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()))
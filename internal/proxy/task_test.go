@@ -1317,6 +1317,38 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("binlog compression", func(t *testing.T) {
+		newTask := func(props ...*commonpb.KeyValuePair) *createCollectionTask {
+			return &createCollectionTask{
+				Condition: NewTaskCondition(ctx),
+				CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+					DbName:         dbName,
+					CollectionName: collectionName,
+					Schema:         marshaledSchema,
+					ShardsNum:      shardsNum,
+					Properties:     props,
+				},
+				ctx:      ctx,
+				mixCoord: mix,
+			}
+		}
+
+		task2 := newTask(
+			&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKey, Value: "lz4"},
+			&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionLevelKey, Value: "9"},
+		)
+		assert.NoError(t, task2.OnEnqueue())
+		assert.NoError(t, task2.PreExecute(ctx))
+
+		task2 = newTask(&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKey, Value: "lzo"})
+		assert.NoError(t, task2.OnEnqueue())
+		assert.ErrorIs(t, task2.PreExecute(ctx), merr.ErrParameterInvalid)
+
+		task2 = newTask(&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionLevelKey, Value: "30"})
+		assert.NoError(t, task2.OnEnqueue())
+		assert.ErrorIs(t, task2.PreExecute(ctx), merr.ErrParameterInvalid)
+	})
+
 	t.Run("collection with embedding function ", func(t *testing.T) {
 		paramtable.Init()
 		paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
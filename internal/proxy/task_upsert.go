@@ -619,5 +619,6 @@ func (it *upsertTask) Execute(ctx context.Context) (err error) {
 }
 
 func (it *upsertTask) PostExecute(ctx context.Context) error {
+	globalSearchResultCache.ObserveDML(it.collectionID, it.EndTs())
 	return nil
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
@@ -46,6 +47,9 @@ type BalanceChecker struct {
 	scheduler       task.Scheduler
 	targetMgr       meta.TargetManagerInterface
 	getBalancerFunc GetBalancerFunc
+	// the normal balance is gated by the maintenance window, the stopping balance is not,
+	// otherwise the stopping nodes could not be offline until the window opens.
+	maintenanceChecker *maintenance.Checker
 
 	normalBalanceCollectionsCurrentRound   typeutil.UniqueSet
 	stoppingBalanceCollectionsCurrentRound typeutil.UniqueSet
@@ -59,6 +63,7 @@ func NewBalanceChecker(meta *meta.Meta,
 	nodeMgr *session.NodeManager,
 	scheduler task.Scheduler,
 	getBalancerFunc GetBalancerFunc,
+	maintenanceChecker *maintenance.Checker,
 ) *BalanceChecker {
 	return &BalanceChecker{
 		checkerActivation:                      newCheckerActivation(),
@@ -69,6 +74,7 @@ func NewBalanceChecker(meta *meta.Meta,
 		stoppingBalanceCollectionsCurrentRound: typeutil.NewUniqueSet(),
 		scheduler:                              scheduler,
 		getBalancerFunc:                        getBalancerFunc,
+		maintenanceChecker:                     maintenanceChecker,
 	}
 }
 
@@ -155,6 +161,14 @@ func (b *BalanceChecker) getReplicaForNormalBalance(ctx context.Context) []int64
 		collection := b.meta.GetCollection(ctx, cid)
 		return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded
 	})
+	// the collections outside the maintenance window are not balanced
+	loadedCollections = lo.Filter(loadedCollections, func(cid int64, _ int) bool {
+		if b.maintenanceChecker.Allow(ctx, cid, maintenance.OperationBalance) {
+			return true
+		}
+		log.RatedDebug(60, "skip normal balance outside the maintenance window", zap.Int64("collectionID", cid))
+		return false
+	})
 
 	// Before performing balancing, check the CurrentTarget/LeaderView/Distribution for all collections.
 	// If any collection has unready info, skip the balance operation to avoid inconsistencies.
@@ -25,6 +25,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
@@ -33,9 +35,11 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/kv"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -81,7 +85,7 @@ func (suite *BalanceCheckerTestSuite) SetupTest() {
 	suite.targetMgr = meta.NewTargetManager(suite.broker, suite.meta)
 
 	suite.balancer = balance.NewMockBalancer(suite.T())
-	suite.checker = NewBalanceChecker(suite.meta, suite.targetMgr, suite.nodeMgr, suite.scheduler, func() balance.Balance { return suite.balancer })
+	suite.checker = NewBalanceChecker(suite.meta, suite.targetMgr, suite.nodeMgr, suite.scheduler, func() balance.Balance { return suite.balancer }, nil)
 }
 
 func (suite *BalanceCheckerTestSuite) TearDownTest() {
@@ -402,6 +406,66 @@ func (suite *BalanceCheckerTestSuite) TestTargetNotReady() {
 	suite.ElementsMatch(idsToBalance, replicasToBalance)
 }
 
+func (suite *BalanceCheckerTestSuite) TestMaintenanceWindow() {
+	ctx := context.Background()
+	nodeID1, nodeID2 := int64(1), int64(2)
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID2,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.checker.meta.ResourceManager.HandleNodeUp(ctx, nodeID1)
+	suite.checker.meta.ResourceManager.HandleNodeUp(ctx, nodeID2)
+
+	mockTarget := meta.NewMockTargetManager(suite.T())
+	suite.checker.targetMgr = mockTarget
+	mockTarget.EXPECT().IsCurrentTargetReady(mock.Anything, mock.Anything).Return(true)
+	mockTarget.EXPECT().GetCollectionRowCount(mock.Anything, mock.Anything, mock.Anything).Return(100).Maybe()
+
+	cid1, replicaID1, partitionID1 := 1, 1, 1
+	collection1 := utils.CreateTestCollection(int64(cid1), int32(replicaID1))
+	collection1.Status = querypb.LoadStatus_Loaded
+	replica1 := utils.CreateTestReplica(int64(replicaID1), int64(cid1), []int64{nodeID1, nodeID2})
+	partition1 := utils.CreateTestPartition(int64(cid1), int64(partitionID1))
+	suite.checker.meta.CollectionManager.PutCollection(ctx, collection1, partition1)
+	suite.checker.meta.ReplicaManager.Put(ctx, replica1)
+
+	cid2, replicaID2, partitionID2 := 2, 2, 2
+	collection2 := utils.CreateTestCollection(int64(cid2), int32(replicaID2))
+	collection2.Status = querypb.LoadStatus_Loaded
+	replica2 := utils.CreateTestReplica(int64(replicaID2), int64(cid2), []int64{nodeID1, nodeID2})
+	partition2 := utils.CreateTestPartition(int64(cid2), int64(partitionID2))
+	suite.checker.meta.CollectionManager.PutCollection(ctx, collection2, partition2)
+	suite.checker.meta.ReplicaManager.Put(ctx, replica2)
+
+	// the window of collection 1 on february 30th is never active, collection 2 has no window.
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(cid1)).Return(&milvuspb.DescribeCollectionResponse{
+		DbName: "default",
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.MaintenanceWindowCronKey, Value: "0 0 30 2 *"},
+			{Key: common.MaintenanceWindowDurationKey, Value: "1h"},
+			{Key: common.MaintenanceWindowOperationsKey, Value: "balance"},
+		},
+	}, nil)
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(cid2)).Return(&milvuspb.DescribeCollectionResponse{
+		DbName: "default",
+	}, nil)
+	suite.broker.EXPECT().DescribeDatabase(mock.Anything, "default").Return(&rootcoordpb.DescribeDatabaseResponse{}, nil)
+	suite.checker.maintenanceChecker = newMaintenanceChecker(suite.broker)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoBalance.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoBalance.Key)
+	replicasToBalance := suite.checker.getReplicaForNormalBalance(ctx)
+	suite.ElementsMatch([]int64{int64(replicaID2)}, replicasToBalance)
+	replicasToBalance = suite.checker.getReplicaForNormalBalance(ctx)
+	suite.Empty(replicasToBalance)
+}
+
 func (suite *BalanceCheckerTestSuite) TestAutoBalanceInterval() {
 	ctx := context.Background()
 	// set up nodes info
@@ -30,7 +30,10 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/internal/util/maintenance"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 )
 
 var errTypeNotFound = errors.New("checker type not found")
@@ -68,7 +71,7 @@ func NewCheckerController(
 	checkers := map[utils.CheckerType]Checker{
 		utils.ChannelChecker: NewChannelChecker(meta, dist, targetMgr, nodeMgr, getBalancerFunc),
 		utils.SegmentChecker: NewSegmentChecker(meta, dist, targetMgr, nodeMgr, getBalancerFunc),
-		utils.BalanceChecker: NewBalanceChecker(meta, targetMgr, nodeMgr, scheduler, getBalancerFunc),
+		utils.BalanceChecker: NewBalanceChecker(meta, targetMgr, nodeMgr, scheduler, getBalancerFunc, newMaintenanceChecker(broker)),
 		utils.IndexChecker:   NewIndexChecker(meta, dist, broker, nodeMgr, targetMgr),
 		// todo temporary work around must fix
 		// utils.LeaderChecker:  NewLeaderChecker(meta, dist, targetMgr, nodeMgr, true),
@@ -92,6 +95,28 @@ func NewCheckerController(
 	}
 }
 
+// newMaintenanceChecker resolves the maintenance window from the collection properties,
+// or from the database properties if the collection doesn't configure it.
+func newMaintenanceChecker(broker meta.Broker) *maintenance.Checker {
+	return maintenance.NewChecker(
+		func(ctx context.Context, collectionID int64) (string, map[string]string, error) {
+			resp, err := broker.DescribeCollection(ctx, collectionID)
+			if err != nil {
+				return "", nil, err
+			}
+			return resp.GetDbName(), funcutil.KeyValuePair2Map(resp.GetProperties()), nil
+		},
+		func(ctx context.Context, dbName string) (map[string]string, error) {
+			resp, err := broker.DescribeDatabase(ctx, dbName)
+			if err != nil {
+				return nil, err
+			}
+			return funcutil.KeyValuePair2Map(resp.GetProperties()), nil
+		},
+		metrics.QueryCoordMaintenanceWindowActive,
+	)
+}
+
 func (controller *CheckerController) Start() {
 	ctx, cancel := context.WithCancel(context.Background())
 	controller.cancel = cancel
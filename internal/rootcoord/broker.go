@@ -46,6 +46,25 @@ type watchInfo struct {
 	dbProperties   []*commonpb.KeyValuePair
 }
 
+type cloneInfo struct {
+	ts                 Timestamp
+	sourceCollectionID UniqueID
+	sourceVChannels    []string
+	collectionID       UniqueID
+	vChannels          []string
+	schema             *schemapb.CollectionSchema
+	partitionMapping   map[UniqueID]UniqueID
+}
+
+type restoreInfo struct {
+	ts               Timestamp
+	snapshotName     string
+	collectionID     UniqueID
+	vChannels        []string
+	schema           *schemapb.CollectionSchema
+	partitionMapping map[UniqueID]UniqueID
+}
+
 // Broker communicates with other components.
 type Broker interface {
 	ReleaseCollection(ctx context.Context, collectionID UniqueID) error
@@ -57,6 +76,11 @@ type Broker interface {
 	UnwatchChannels(ctx context.Context, info *watchInfo) error
 	GetSegmentStates(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
 	GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool
+	CloneSegments(ctx context.Context, info *cloneInfo) error
+	GetSnapshot(ctx context.Context, name string) (*datapb.SnapshotInfo, error)
+	RestoreSnapshotSegments(ctx context.Context, info *restoreInfo) error
+	TruncateSegments(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RefreshCollection(ctx context.Context, collectionID UniqueID) error
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	// notify observer to clean their meta cache
@@ -180,6 +204,107 @@ func (b *ServerBroker) WatchChannels(ctx context.Context, info *watchInfo) error
 	return nil
 }
 
+func (b *ServerBroker) CloneSegments(ctx context.Context, info *cloneInfo) error {
+	log := log.Ctx(ctx).With(zap.Uint64("ts", info.ts), zap.Int64("sourceCollection", info.sourceCollectionID),
+		zap.Int64("collection", info.collectionID))
+	log.Info("cloning segments")
+
+	resp, err := b.s.mixCoord.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+		Base:               commonpbutil.NewMsgBase(commonpbutil.WithTimeStamp(info.ts)),
+		SourceCollectionID: info.sourceCollectionID,
+		SourceVchannels:    info.sourceVChannels,
+		CollectionID:       info.collectionID,
+		Vchannels:          info.vChannels,
+		Schema:             info.schema,
+		PartitionMapping:   info.partitionMapping,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to clone segments", zap.Error(err))
+		return err
+	}
+
+	log.Info("done to clone segments")
+	return nil
+}
+
+func (b *ServerBroker) TruncateSegments(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collectionID), zap.Uint64("ts", ts))
+	log.Info("truncating segments")
+
+	resp, err := b.s.mixCoord.TruncateSegments(ctx, &datapb.TruncateSegmentsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithTimeStamp(ts)),
+		CollectionID: collectionID,
+		TruncateTs:   ts,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to truncate segments", zap.Error(err))
+		return err
+	}
+
+	log.Info("done to truncate segments")
+	return nil
+}
+
+// RefreshCollection makes querycoord pull the latest target of the collection, it's a no-op if the collection is not loaded.
+func (b *ServerBroker) RefreshCollection(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collectionID))
+	log.Info("refreshing collection")
+
+	resp, err := b.s.mixCoord.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_LoadCollection)),
+		CollectionID: collectionID,
+		Refresh:      true,
+	})
+	err = merr.CheckRPCCall(resp, err)
+	if errors.Is(err, merr.ErrCollectionNotLoaded) {
+		log.Info("skip refreshing the collection which is not loaded")
+		return nil
+	}
+	if err != nil {
+		log.Warn("failed to refresh collection", zap.Error(err))
+		return err
+	}
+
+	log.Info("done to refresh collection")
+	return nil
+}
+
+func (b *ServerBroker) GetSnapshot(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+	resp, err := b.s.mixCoord.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: name,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	if len(resp.GetSnapshots()) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("snapshot %s not found", name)
+	}
+	return resp.GetSnapshots()[0], nil
+}
+
+func (b *ServerBroker) RestoreSnapshotSegments(ctx context.Context, info *restoreInfo) error {
+	log := log.Ctx(ctx).With(zap.Uint64("ts", info.ts), zap.String("snapshot", info.snapshotName),
+		zap.Int64("collection", info.collectionID))
+	log.Info("restoring snapshot segments")
+
+	resp, err := b.s.mixCoord.RestoreSnapshotSegments(ctx, &datapb.RestoreSnapshotSegmentsRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithTimeStamp(info.ts)),
+		Name:             info.snapshotName,
+		CollectionID:     info.collectionID,
+		PartitionMapping: info.partitionMapping,
+		Vchannels:        info.vChannels,
+		Schema:           info.schema,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to restore snapshot segments", zap.Error(err))
+		return err
+	}
+
+	log.Info("done to restore snapshot segments")
+	return nil
+}
+
 func (b *ServerBroker) UnwatchChannels(ctx context.Context, info *watchInfo) error {
 	// TODO: release flowgraph on datanodes.
 	return nil
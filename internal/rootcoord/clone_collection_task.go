@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// cloneCollectionTask creates a new collection with the same schema as the source collection,
+// the flushed segments of the source collection are registered into the new collection by datacoord,
+// which share the binlogs and index files with the source segments without copying them.
+// The data which is not flushed when the clone happens is not cloned.
+type cloneCollectionTask struct {
+	createCollectionTask
+	CloneReq *internalpb.CloneCollectionRequest
+	source   *model.Collection
+}
+
+func (t *cloneCollectionTask) Prepare(ctx context.Context) error {
+	if t.CloneReq == nil {
+		return errors.New("empty requests")
+	}
+	if t.CloneReq.GetNewCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the name of the new collection is empty")
+	}
+	dbName := t.CloneReq.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	newDBName := t.CloneReq.GetNewDbName()
+	if newDBName == "" {
+		newDBName = dbName
+	}
+
+	source, err := t.core.meta.GetCollectionByName(ctx, dbName, t.CloneReq.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.source = source
+	if _, err := t.core.meta.GetCollectionByName(ctx, newDBName, t.CloneReq.GetNewCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return merr.WrapErrParameterInvalidMsg("collection %s already exists in database %s", t.CloneReq.GetNewCollectionName(), newDBName)
+	}
+
+	sourcePartitions := make([]*model.Partition, 0, len(source.Partitions))
+	for _, partition := range source.Partitions {
+		if partition.Available() {
+			sourcePartitions = append(sourcePartitions, partition)
+		}
+	}
+
+	t.Req = &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           newDBName,
+		CollectionName:   t.CloneReq.GetNewCollectionName(),
+		ShardsNum:        source.ShardsNum,
+		ConsistencyLevel: source.ConsistencyLevel,
+		Properties:       source.Properties,
+	}
+	// keep the field ids of the source collection, the shared binlogs are organized by field id.
+	t.schema = &schemapb.CollectionSchema{
+		Name:               t.CloneReq.GetNewCollectionName(),
+		Description:        source.Description,
+		AutoID:             source.AutoID,
+		Fields:             model.MarshalFieldModels(source.Fields),
+		Functions:          model.MarshalFunctionModels(source.Functions),
+		EnableDynamicField: source.EnableDynamicField,
+	}
+	if _, err := typeutil.GetPartitionKeyFieldSchema(t.schema); err == nil {
+		t.Req.NumPartitions = int64(len(sourcePartitions))
+	}
+
+	if err := t.prepareCopiedCollection(ctx, sourcePartitions); err != nil {
+		return err
+	}
+
+	partitionMapping := make(map[UniqueID]UniqueID, len(sourcePartitions))
+	for i, partition := range sourcePartitions {
+		partitionMapping[partition.PartitionID] = t.partIDs[i]
+	}
+	t.dataSteps = []nestedStep{
+		&cloneSegmentsStep{
+			baseStep: baseStep{core: t.core},
+			info: &cloneInfo{
+				ts:                 t.GetTs(),
+				sourceCollectionID: source.CollectionID,
+				sourceVChannels:    source.VirtualChannelNames,
+				collectionID:       t.collID,
+				vChannels:          t.channels.virtualChannels,
+				schema:             t.schema,
+				partitionMapping:   partitionMapping,
+			},
+		},
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) GetLockerKey() LockerKey {
+	return NewLockerKeyChain(
+		NewClusterLockerKey(true),
+	)
+}
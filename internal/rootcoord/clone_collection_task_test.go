@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func Test_cloneCollectionTask_Prepare(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNum.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNum.Key)
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNumPerDB.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNumPerDB.Key)
+
+	source := &model.Collection{
+		CollectionID: 100,
+		DBID:         util.DefaultDBID,
+		Name:         "source",
+		Fields: []*model.Field{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+		VirtualChannelNames: []string{"source-dml_0_100v0"},
+		ShardsNum:           1,
+		ConsistencyLevel:    commonpb.ConsistencyLevel_Bounded,
+		Partitions: []*model.Partition{
+			{PartitionID: 101, PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 102, PartitionName: "dropping", State: pb.PartitionState_PartitionDropping},
+		},
+		State: pb.CollectionState_CollectionCreated,
+	}
+
+	newTask := func(core *Core, req *internalpb.CloneCollectionRequest) *cloneCollectionTask {
+		return &cloneCollectionTask{
+			createCollectionTask: createCollectionTask{
+				baseTask: newBaseTask(context.Background(), core),
+			},
+			CloneReq: req,
+		}
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		core := newTestCore()
+		task := newTask(core, nil)
+		assert.Error(t, task.Prepare(context.Background()))
+
+		task = newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "source"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "source", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("source"))
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "source", NewCollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("target exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "source", mock.Anything).Return(source, nil)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "target", mock.Anything).Return(source, nil)
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "source", NewCollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("failed to get database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "source", mock.Anything).Return(source, nil)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db2", "target", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("target"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", mock.Anything).Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{
+			DbName:            util.DefaultDBName,
+			CollectionName:    "source",
+			NewDbName:         "db2",
+			NewCollectionName: "target",
+		})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "source", mock.Anything).Return(source, nil)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "target", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("target"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, util.DefaultDBName, mock.Anything).Return(model.NewDefaultDatabase(nil), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{util.DefaultDBID: {100}})
+		meta.EXPECT().GetGeneralCount(mock.Anything).Return(0)
+
+		ticker := newRocksMqTtSynchronizer()
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "source", NewCollectionName: "target"})
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+
+		assert.Equal(t, "target", task.schema.GetName())
+		assert.Len(t, task.schema.GetFields(), 4)
+		for i, field := range task.schema.GetFields() {
+			assert.Equal(t, source.Fields[i].FieldID, field.GetFieldID())
+		}
+		assert.Equal(t, []string{"_default"}, task.partitionNames)
+		assert.Len(t, task.partIDs, 1)
+		assert.Len(t, task.channels.virtualChannels, 1)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, task.Req.GetConsistencyLevel())
+
+		assert.Len(t, task.dataSteps, 1)
+		step := task.dataSteps[0].(*cloneSegmentsStep)
+		assert.Equal(t, int64(100), step.info.sourceCollectionID)
+		assert.Equal(t, task.collID, step.info.collectionID)
+		assert.Equal(t, source.VirtualChannelNames, step.info.sourceVChannels)
+		assert.Equal(t, map[UniqueID]UniqueID{101: task.partIDs[0]}, step.info.partitionMapping)
+	})
+}
+
+func Test_cloneSegmentsStep_Execute(t *testing.T) {
+	broker := newMockBroker()
+	broker.CloneSegmentsFunc = func(ctx context.Context, info *cloneInfo) error {
+		if info.collectionID == 0 {
+			return errors.New("mock")
+		}
+		return nil
+	}
+	core := newTestCore(withBroker(broker))
+
+	s := &cloneSegmentsStep{baseStep: baseStep{core: core}, info: &cloneInfo{collectionID: 0}}
+	_, err := s.Execute(context.Background())
+	assert.Error(t, err)
+
+	s = &cloneSegmentsStep{baseStep: baseStep{core: core}, info: &cloneInfo{collectionID: 200}}
+	_, err = s.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s.Desc())
+}
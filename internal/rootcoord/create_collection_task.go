@@ -61,6 +61,8 @@ type createCollectionTask struct {
 	dbID           UniqueID
 	partitionNames []string
 	dbProperties   []*commonpb.KeyValuePair
+	// dataSteps fill the data of the collection before it becomes available, such as cloning segments.
+	dataSteps []nestedStep
 }
 
 func (t *createCollectionTask) validate(ctx context.Context) error {
@@ -376,6 +378,68 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 	return t.assignChannels()
 }
 
+// prepareCopiedCollection prepares the collection which copies the schema and the partitions of an existing one,
+// such as the collection cloned from another or restored from a snapshot, t.Req and t.schema must be set before.
+// The field ids of the schema are kept, since the copied binlogs are organized by field id.
+func (t *createCollectionTask) prepareCopiedCollection(ctx context.Context, partitions []*model.Partition) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.dbID = db.ID
+	if dbReplicateID, _ := common.GetReplicateID(db.Properties); dbReplicateID != "" {
+		reqProperties := make([]*commonpb.KeyValuePair, 0, len(t.Req.Properties))
+		for _, prop := range t.Req.Properties {
+			if prop.Key == common.ReplicateIDKey {
+				continue
+			}
+			reqProperties = append(reqProperties, prop)
+		}
+		t.Req.Properties = reqProperties
+	}
+	t.dbProperties = db.Properties
+
+	if err := t.validate(ctx); err != nil {
+		return err
+	}
+
+	t.assignShardsNum()
+
+	if err := t.assignCollectionID(); err != nil {
+		return err
+	}
+
+	if err := t.assignCopiedPartitionIDs(ctx, partitions); err != nil {
+		return err
+	}
+
+	return t.assignChannels()
+}
+
+// assignCopiedPartitionIDs allocates the partitions of the collection by the partitions copied from.
+func (t *createCollectionTask) assignCopiedPartitionIDs(ctx context.Context, partitions []*model.Partition) error {
+	if len(partitions) == 0 {
+		return errors.New("no available partition to copy")
+	}
+	t.partitionNames = make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		t.partitionNames = append(t.partitionNames, partition.PartitionName)
+	}
+
+	t.partIDs = make([]UniqueID, len(t.partitionNames))
+	start, end, err := t.core.idAllocator.Alloc(uint32(len(t.partitionNames)))
+	if err != nil {
+		return err
+	}
+	for i := start; i < end; i++ {
+		t.partIDs[i-start] = i
+	}
+	log.Ctx(ctx).Info("assign copied partitions when create collection",
+		zap.String("collectionName", t.Req.GetCollectionName()),
+		zap.Strings("partitionNames", t.partitionNames))
+	return nil
+}
+
 func (t *createCollectionTask) genCreateCollectionMsg(ctx context.Context, ts uint64) *ms.MsgPack {
 	msgPack := ms.MsgPack{}
 	msg := &ms.CreateCollectionMsg{
@@ -563,7 +627,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 	}
 	collInfo.StartPositions = toKeyDataPairs(startPositions)
 
-	return executeCreateCollectionTaskSteps(ctx, t.core, &collInfo, t.Req.GetDbName(), t.dbProperties, ts)
+	return executeCreateCollectionTaskSteps(ctx, t.core, &collInfo, t.Req.GetDbName(), t.dbProperties, ts, t.dataSteps...)
 }
 
 func (t *createCollectionTask) GetLockerKey() LockerKey {
@@ -580,6 +644,7 @@ func executeCreateCollectionTaskSteps(ctx context.Context,
 	dbName string,
 	dbProperties []*commonpb.KeyValuePair,
 	ts Timestamp,
+	dataSteps ...nestedStep,
 ) error {
 	undoTask := newBaseUndoTask(core.stepExecutor)
 	collID := col.CollectionID
@@ -633,6 +698,9 @@ func executeCreateCollectionTaskSteps(ctx context.Context,
 			dbProperties: dbProperties,
 		},
 	}, &nullStep{})
+	for _, step := range dataSteps {
+		undoTask.AddStep(step, &nullStep{})
+	}
 	undoTask.AddStep(&changeCollectionStateStep{
 		baseStep:     baseStep{core: core},
 		collectionID: collID,
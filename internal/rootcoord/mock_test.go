@@ -896,7 +896,14 @@ type mockBroker struct {
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 
-	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	GCConfirmFunc     func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	CloneSegmentsFunc func(ctx context.Context, info *cloneInfo) error
+
+	GetSnapshotFunc             func(ctx context.Context, name string) (*datapb.SnapshotInfo, error)
+	RestoreSnapshotSegmentsFunc func(ctx context.Context, info *restoreInfo) error
+
+	TruncateSegmentsFunc  func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	RefreshCollectionFunc func(ctx context.Context, collectionID UniqueID) error
 }
 
 func newMockBroker() *mockBroker {
@@ -935,6 +942,26 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) CloneSegments(ctx context.Context, info *cloneInfo) error {
+	return b.CloneSegmentsFunc(ctx, info)
+}
+
+func (b mockBroker) GetSnapshot(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+	return b.GetSnapshotFunc(ctx, name)
+}
+
+func (b mockBroker) RestoreSnapshotSegments(ctx context.Context, info *restoreInfo) error {
+	return b.RestoreSnapshotSegmentsFunc(ctx, info)
+}
+
+func (b mockBroker) TruncateSegments(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	return b.TruncateSegmentsFunc(ctx, collectionID, ts)
+}
+
+func (b mockBroker) RefreshCollection(ctx context.Context, collectionID UniqueID) error {
+	return b.RefreshCollectionFunc(ctx, collectionID)
+}
+
 func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
@@ -166,6 +167,10 @@ type QuotaCenter struct {
 
 	rateLimiter *rlinternal.RateLimiterTree
 
+	// the daily quota usage of users, and the roles of users to get the user level limits.
+	userQuotaUsage *userQuotaUsage
+	userRoles      map[string][]string
+
 	tsoAllocator tso.Allocator
 
 	rateAllocateStrategy RateAllocateStrategy
@@ -191,6 +196,8 @@ func NewQuotaCenter(proxies proxyutil.ProxyClientManagerInterface, mixCoord type
 		readableCollections:  make(map[int64]map[int64][]int64, 0),
 		writableCollections:  make(map[int64]map[int64][]int64, 0),
 		rateLimiter:          rlinternal.NewRateLimiterTree(initInfLimiter(internalpb.RateScope_Cluster, allOps)),
+		userQuotaUsage:       newUserQuotaUsage(nil),
+		userRoles:            make(map[string][]string),
 		rateAllocateStrategy: DefaultRateAllocateStrategy,
 		stopChan:             make(chan struct{}),
 	}
@@ -571,9 +578,39 @@ func (q *QuotaCenter) collectMetrics() error {
 		metrics.RootCoordTtDelay.DeleteLabelValues(typeutil.QueryNodeRole, strconv.FormatInt(oldQN, 10))
 		metrics.RootCoordTtDelay.DeleteLabelValues(typeutil.StreamingNodeRole, strconv.FormatInt(oldQN, 10))
 	}
+
+	if Params.QuotaConfig.UserLimitEnabled.GetAsBool() {
+		q.collectUserQuotaUsage(ctx)
+	}
 	return nil
 }
 
+// collectUserQuotaUsage accumulates the daily quota usage of users reported by proxies,
+// the usage of the dropped users is removed.
+func (q *QuotaCenter) collectUserQuotaUsage(ctx context.Context) {
+	users, err := q.meta.SelectUser(ctx, util.DefaultTenant, nil, true)
+	if err != nil {
+		// the usage reported by proxies is accumulated in the next round.
+		log.Warn("quotaCenter list users failed", zap.Error(err))
+		return
+	}
+	userRoles := make(map[string][]string, len(users))
+	for _, user := range users {
+		userRoles[user.GetUser().GetName()] = lo.Map(user.GetRoles(), func(role *milvuspb.RoleEntity, _ int) string {
+			return role.GetName()
+		})
+	}
+	q.userRoles = userRoles
+
+	err = q.userQuotaUsage.update(ctx, time.Now(), q.proxyMetrics, func(user string) bool {
+		_, ok := userRoles[user]
+		return ok
+	})
+	if err != nil {
+		log.Warn("quotaCenter update user quota usage failed", zap.Error(err))
+	}
+}
+
 func getDbPropertyWithAction(db *model.Database, property string, actionFunc func(bool)) {
 	if db == nil || property == "" || actionFunc == nil {
 		return
@@ -1263,11 +1300,45 @@ func (q *QuotaCenter) calculateRates() error {
 	}
 
 	q.calculateDBDDLRates()
+	q.calculateUserRates()
 
 	// log.Debug("QuotaCenter calculates rate done", zap.Any("rates", q.currentRates))
 	return nil
 }
 
+// calculateUserRates sets the user level limits of the users which are active in the day,
+// the users exhausting the daily quota are denied in the whole cluster.
+func (q *QuotaCenter) calculateUserRates() {
+	if !Params.QuotaConfig.UserLimitEnabled.GetAsBool() {
+		return
+	}
+	for user, usage := range q.userQuotaUsage.getUsers() {
+		limits := quota.GetUserLimits(user, q.userRoles[user])
+		userLimiters := q.rateLimiter.GetOrCreateUserLimiters(user, func() *rlinternal.RateLimiterNode {
+			return rlinternal.NewRateLimiterNode(internalpb.RateScope_User)
+		})
+		setUserLimiter := func(rateTypes typeutil.Set[internalpb.RateType], rate float64) {
+			rateTypes.Range(func(rt internalpb.RateType) bool {
+				limiter, _ := userLimiters.GetLimiters().GetOrInsert(rt, ratelimitutil.NewLimiter(Inf, 0))
+				limiter.SetLimit(Limit(rate))
+				return true
+			})
+		}
+		setUserLimiter(dmlRateTypes, limits.DMLRate)
+		setUserLimiter(dqlRateTypes, limits.DQLRate)
+
+		if usage.Requests >= limits.DailyRequests {
+			setUserLimiter(dmlRateTypes, 0)
+			setUserLimiter(dqlRateTypes, 0)
+			userLimiters.GetQuotaStates().Insert(milvuspb.QuotaState_DenyToWrite, commonpb.ErrorCode_RateLimit)
+			userLimiters.GetQuotaStates().Insert(milvuspb.QuotaState_DenyToRead, commonpb.ErrorCode_RateLimit)
+		} else if usage.Bytes >= limits.DailyBytes {
+			setUserLimiter(dmlRateTypes, 0)
+			userLimiters.GetQuotaStates().Insert(milvuspb.QuotaState_DenyToWrite, commonpb.ErrorCode_RateLimit)
+		}
+	}
+}
+
 func (q *QuotaCenter) resetAllCurrentRates() error {
 	clusterLimiter := newParamLimiterFunc(internalpb.RateScope_Cluster, allOps)()
 	q.rateLimiter = rlinternal.NewRateLimiterTree(clusterLimiter)
@@ -1546,14 +1617,21 @@ func (q *QuotaCenter) toRatesRequest() *proxypb.SetRatesRequest {
 		Children: dbLimiters,
 	}
 
+	userLimiters := make(map[string]*proxypb.Limiter, q.rateLimiter.GetUsers().Len())
+	q.rateLimiter.GetUsers().Range(func(user string, userRateLimiters *rlinternal.RateLimiterNode) bool {
+		userLimiters[user] = q.toRequestLimiter(userRateLimiters)
+		return true
+	})
+
 	timestamp := tsoutil.ComposeTSByTime(time.Now(), 0)
 	return &proxypb.SetRatesRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgID(int64(timestamp)),
 			commonpbutil.WithTimeStamp(timestamp),
 		),
-		Rates:       []*proxypb.CollectionRate{},
-		RootLimiter: clusterLimiter,
+		Rates:        []*proxypb.CollectionRate{},
+		RootLimiter:  clusterLimiter,
+		UserLimiters: userLimiters,
 	}
 }
 
@@ -1634,6 +1712,7 @@ func (q *QuotaCenter) getQuotaMetrics() *internalpb.GetQuotaMetricsResponse {
 		DataNodeMetrics:  q.dataNodeMetrics,
 		ProxyMetrics:     q.proxyMetrics,
 		DataCoordMetrics: q.dataCoordMetrics,
+		UserMetrics:      q.getUserQuotaMetrics(),
 	}
 
 	responseString, err := metricsinfo.MarshalComponentInfos(quotaCenterMetrics)
@@ -1648,3 +1727,19 @@ func (q *QuotaCenter) getQuotaMetrics() *internalpb.GetQuotaMetricsResponse {
 		MetricsInfo: responseString,
 	}
 }
+
+// getUserQuotaMetrics returns the daily quota usage of users in the whole cluster.
+func (q *QuotaCenter) getUserQuotaMetrics() map[string]*metricsinfo.UserQuotaMetrics {
+	userMetrics := make(map[string]*metricsinfo.UserQuotaMetrics)
+	for user, usage := range q.userQuotaUsage.getUsers() {
+		limits := quota.GetUserLimits(user, q.userRoles[user])
+		userMetrics[user] = &metricsinfo.UserQuotaMetrics{
+			User:             user,
+			DailyRequests:    usage.Requests,
+			MaxDailyRequests: limits.DailyRequests,
+			DailyBytes:       usage.Bytes,
+			MaxDailyBytes:    limits.DailyBytes,
+		}
+	}
+	return userMetrics
+}
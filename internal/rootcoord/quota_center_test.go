@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -1766,6 +1767,86 @@ func TestCheckDiskQuota(t *testing.T) {
 	})
 }
 
+func TestQuotaCenterUserRates(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.UserLimitEnabled.Key)
+	params.Save(params.QuotaConfig.UserDQLMaxRate.Key, "100")
+	defer params.Reset(params.QuotaConfig.UserDQLMaxRate.Key)
+	params.Save(params.QuotaConfig.UserMaxDailyRequests.Key, "10")
+	defer params.Reset(params.QuotaConfig.UserMaxDailyRequests.Key)
+	params.Save(params.QuotaConfig.UserLimitsPerRole.Key, `{"admin": {"dailyRequests": -1, "dailyBytes": 1}}`)
+	defer params.Reset(params.QuotaConfig.UserLimitsPerRole.Key)
+
+	ctx := context.Background()
+	meta := mockrootcoord.NewIMetaTable(t)
+	pcm := proxyutil.NewMockProxyClientManager(t)
+	pcm.EXPECT().GetProxyCount().Return(2)
+	quotaCenter := NewQuotaCenter(pcm, mocks.NewMixCoord(t), newMockTsoAllocator(), meta)
+
+	meta.EXPECT().SelectUser(mock.Anything, mock.Anything, mock.Anything, true).Return([]*milvuspb.UserResult{
+		{User: &milvuspb.UserEntity{Name: "alice"}},
+		{User: &milvuspb.UserEntity{Name: "bob"}, Roles: []*milvuspb.RoleEntity{{Name: "admin"}}},
+		{User: &milvuspb.UserEntity{Name: "carol"}},
+	}, nil).Once()
+	quotaCenter.proxyMetrics = map[int64]*metricsinfo.ProxyQuotaMetrics{
+		1: {UserMetrics: []metricsinfo.UserQuotaMetrics{
+			{User: "alice", DailyRequests: 6},
+			{User: "bob", DailyRequests: 20, DailyBytes: 1024 * 1024},
+			{User: "carol", DailyRequests: 1},
+			{User: "dropped", DailyRequests: 1},
+		}},
+		2: {UserMetrics: []metricsinfo.UserQuotaMetrics{{User: "alice", DailyRequests: 4}}},
+	}
+	quotaCenter.collectUserQuotaUsage(ctx)
+	quotaCenter.calculateUserRates()
+
+	userMetrics := quotaCenter.getUserQuotaMetrics()
+	assert.Len(t, userMetrics, 3)
+	assert.Equal(t, &metricsinfo.UserQuotaMetrics{
+		User: "alice", DailyRequests: 10, MaxDailyRequests: 10, MaxDailyBytes: math.MaxFloat64,
+	}, userMetrics["alice"])
+	assert.Equal(t, math.MaxFloat64, userMetrics["bob"].MaxDailyRequests)
+
+	userLimiters := quotaCenter.toRatesRequest().GetUserLimiters()
+	assert.Len(t, userLimiters, 3)
+	getRate := func(limiter *proxypb.Limiter, rt internalpb.RateType) (float64, bool) {
+		for _, rate := range limiter.GetRates() {
+			if rate.GetRt() == rt {
+				return rate.GetR(), true
+			}
+		}
+		return 0, false
+	}
+
+	// the rates are split across proxies.
+	r, ok := getRate(userLimiters["carol"], internalpb.RateType_DQLSearch)
+	assert.True(t, ok)
+	assert.Equal(t, float64(50), r)
+	_, ok = getRate(userLimiters["carol"], internalpb.RateType_DMLInsert)
+	assert.False(t, ok)
+	assert.Empty(t, userLimiters["carol"].GetStates())
+
+	// the daily requests of alice are exhausted in the cluster.
+	r, _ = getRate(userLimiters["alice"], internalpb.RateType_DQLQuery)
+	assert.Equal(t, float64(0), r)
+	assert.ElementsMatch(t, []milvuspb.QuotaState{milvuspb.QuotaState_DenyToRead, milvuspb.QuotaState_DenyToWrite}, userLimiters["alice"].GetStates())
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, userLimiters["alice"].GetCodes()[0])
+
+	// the daily bytes of bob are exhausted, only writing is denied.
+	r, _ = getRate(userLimiters["bob"], internalpb.RateType_DMLInsert)
+	assert.Equal(t, float64(0), r)
+	r, _ = getRate(userLimiters["bob"], internalpb.RateType_DQLSearch)
+	assert.Equal(t, float64(50), r)
+	assert.Equal(t, []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, userLimiters["bob"].GetStates())
+
+	// the usage is kept if failed to list users.
+	meta.EXPECT().SelectUser(mock.Anything, mock.Anything, mock.Anything, true).Return(nil, errors.New("mock error")).Once()
+	quotaCenter.collectUserQuotaUsage(ctx)
+	assert.Len(t, quotaCenter.getUserQuotaMetrics(), 3)
+}
+
 func TestTORequestLimiter(t *testing.T) {
 	ctx := context.Background()
 	qc := mocks.NewMixCoord(t)
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// restoreSnapshotTask creates a new collection with the schema of the snapshot, the segments of the snapshot
+// are registered into the new collection by datacoord before the collection becomes available,
+// which share the binlogs and index files with the snapshot segments without copying them.
+// The new collection is removed by the undo steps if the restore fails.
+type restoreSnapshotTask struct {
+	createCollectionTask
+	RestoreReq *internalpb.RestoreSnapshotRequest
+}
+
+func (t *restoreSnapshotTask) Prepare(ctx context.Context) error {
+	if t.RestoreReq == nil {
+		return errors.New("empty requests")
+	}
+	if t.RestoreReq.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the name of the snapshot is empty")
+	}
+	if t.RestoreReq.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the name of the collection to restore is empty")
+	}
+	dbName := t.RestoreReq.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+
+	snapshot, err := t.core.broker.GetSnapshot(ctx, t.RestoreReq.GetSnapshotName())
+	if err != nil {
+		return err
+	}
+	if _, err := t.core.meta.GetCollectionByName(ctx, dbName, t.RestoreReq.GetCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return merr.WrapErrParameterInvalidMsg("collection %s already exists in database %s", t.RestoreReq.GetCollectionName(), dbName)
+	}
+
+	partitions := make([]*model.Partition, 0, len(snapshot.GetPartitions()))
+	for _, partition := range snapshot.GetPartitions() {
+		partitions = append(partitions, &model.Partition{
+			PartitionID:   partition.GetPartitionID(),
+			PartitionName: partition.GetPartitionName(),
+		})
+	}
+
+	t.Req = &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           dbName,
+		CollectionName:   t.RestoreReq.GetCollectionName(),
+		ShardsNum:        int32(len(snapshot.GetVchannels())),
+		ConsistencyLevel: snapshot.GetConsistencyLevel(),
+		Properties:       snapshot.GetProperties(),
+	}
+	// keep the field ids of the snapshot, the shared binlogs are organized by field id.
+	t.schema = proto.Clone(snapshot.GetSchema()).(*schemapb.CollectionSchema)
+	t.schema.Name = t.RestoreReq.GetCollectionName()
+	if _, err := typeutil.GetPartitionKeyFieldSchema(t.schema); err == nil {
+		t.Req.NumPartitions = int64(len(partitions))
+	}
+
+	if err := t.prepareCopiedCollection(ctx, partitions); err != nil {
+		return err
+	}
+
+	partitionMapping := make(map[UniqueID]UniqueID, len(partitions))
+	for i, partition := range partitions {
+		partitionMapping[partition.PartitionID] = t.partIDs[i]
+	}
+	t.dataSteps = []nestedStep{
+		&restoreSnapshotSegmentsStep{
+			baseStep: baseStep{core: t.core},
+			info: &restoreInfo{
+				ts:               t.GetTs(),
+				snapshotName:     snapshot.GetName(),
+				collectionID:     t.collID,
+				vChannels:        t.channels.virtualChannels,
+				schema:           t.schema,
+				partitionMapping: partitionMapping,
+			},
+		},
+	}
+	return nil
+}
+
+func (t *restoreSnapshotTask) GetLockerKey() LockerKey {
+	return NewLockerKeyChain(
+		NewClusterLockerKey(true),
+	)
+}
@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func Test_restoreSnapshotTask_Prepare(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNum.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNum.Key)
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNumPerDB.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNumPerDB.Key)
+
+	// the field 101 was dropped and the field 103 was added before the snapshot is created.
+	snapshot := &datapb.SnapshotInfo{
+		SnapshotID:     1,
+		Name:           "snapshot",
+		DbName:         util.DefaultDBName,
+		CollectionID:   100,
+		CollectionName: "source",
+		Schema: &schemapb.CollectionSchema{
+			Name: "source",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: 103, Name: "added", DataType: schemapb.DataType_Int64, Nullable: true},
+			},
+		},
+		Vchannels: []string{"source-dml_0_100v0", "source-dml_1_100v1"},
+		Partitions: []*datapb.SnapshotPartition{
+			{PartitionID: 101, PartitionName: "_default"},
+			{PartitionID: 102, PartitionName: "p1"},
+		},
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+	}
+
+	newTask := func(core *Core, req *internalpb.RestoreSnapshotRequest) *restoreSnapshotTask {
+		return &restoreSnapshotTask{
+			createCollectionTask: createCollectionTask{
+				baseTask: newBaseTask(context.Background(), core),
+			},
+			RestoreReq: req,
+		}
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		core := newTestCore()
+		task := newTask(core, nil)
+		assert.Error(t, task.Prepare(context.Background()))
+
+		task = newTask(core, &internalpb.RestoreSnapshotRequest{CollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+
+		task = newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snapshot"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("snapshot not found", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GetSnapshotFunc = func(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+			return nil, merr.WrapErrParameterInvalidMsg("snapshot %s not found", name)
+		}
+		core := newTestCore(withBroker(broker))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snapshot", CollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("target exists", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GetSnapshotFunc = func(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+			return snapshot, nil
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "target", mock.Anything).Return(&model.Collection{}, nil)
+		core := newTestCore(withBroker(broker), withMeta(meta))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snapshot", CollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("failed to get database", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GetSnapshotFunc = func(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+			return snapshot, nil
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db2", "target", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("target"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", mock.Anything).Return(nil, errors.New("mock"))
+		core := newTestCore(withBroker(broker), withMeta(meta))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snapshot", DbName: "db2", CollectionName: "target"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+
+		broker := newMockBroker()
+		broker.GetSnapshotFunc = func(ctx context.Context, name string) (*datapb.SnapshotInfo, error) {
+			return snapshot, nil
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "target", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("target"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, util.DefaultDBName, mock.Anything).Return(model.NewDefaultDatabase(nil), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{util.DefaultDBID: {100}})
+		meta.EXPECT().GetGeneralCount(mock.Anything).Return(0)
+
+		ticker := newRocksMqTtSynchronizer()
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta), withBroker(broker))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snapshot", CollectionName: "target"})
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+
+		// the field ids of the snapshot are kept.
+		assert.Equal(t, "target", task.schema.GetName())
+		assert.Equal(t, "source", snapshot.GetSchema().GetName())
+		assert.Len(t, task.schema.GetFields(), 5)
+		for i, field := range task.schema.GetFields() {
+			assert.Equal(t, snapshot.GetSchema().GetFields()[i].GetFieldID(), field.GetFieldID())
+		}
+		assert.Equal(t, []string{"_default", "p1"}, task.partitionNames)
+		assert.Len(t, task.partIDs, 2)
+		assert.Len(t, task.channels.virtualChannels, 2)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, task.Req.GetConsistencyLevel())
+
+		assert.Len(t, task.dataSteps, 1)
+		step := task.dataSteps[0].(*restoreSnapshotSegmentsStep)
+		assert.Equal(t, "snapshot", step.info.snapshotName)
+		assert.Equal(t, task.collID, step.info.collectionID)
+		assert.Equal(t, task.channels.virtualChannels, step.info.vChannels)
+		assert.Equal(t, map[UniqueID]UniqueID{101: task.partIDs[0], 102: task.partIDs[1]}, step.info.partitionMapping)
+	})
+}
+
+func Test_restoreSnapshotSegmentsStep_Execute(t *testing.T) {
+	broker := newMockBroker()
+	broker.RestoreSnapshotSegmentsFunc = func(ctx context.Context, info *restoreInfo) error {
+		if info.collectionID == 0 {
+			return errors.New("mock")
+		}
+		return nil
+	}
+	core := newTestCore(withBroker(broker))
+
+	s := &restoreSnapshotSegmentsStep{baseStep: baseStep{core: core}, info: &restoreInfo{collectionID: 0}}
+	_, err := s.Execute(context.Background())
+	assert.Error(t, err)
+
+	s = &restoreSnapshotSegmentsStep{baseStep: baseStep{core: core}, info: &restoreInfo{collectionID: 200, snapshotName: "snapshot"}}
+	_, err = s.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s.Desc())
+}
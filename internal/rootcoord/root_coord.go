@@ -331,21 +331,21 @@ func (c *Core) initMetaTable(initCtx context.Context) error {
 		switch Params.MetaStoreCfg.MetaStoreType.GetValue() {
 		case util.MetaStoreTypeEtcd:
 			log.Ctx(initCtx).Info("Using etcd as meta storage.")
-			var ss *kvmetastore.SuffixSnapshot
+			var ss kv.SnapShotKV
 			var err error
 
 			metaKV := c.metaKVCreator()
-			if ss, err = kvmetastore.NewSuffixSnapshot(metaKV, kvmetastore.SnapshotsSep, Params.EtcdCfg.MetaRootPath.GetValue(), kvmetastore.SnapshotPrefix); err != nil {
+			if ss, err = kvmetastore.NewSnapshotKV(initCtx, metaKV, Params.EtcdCfg.MetaRootPath.GetValue()); err != nil {
 				return err
 			}
 			catalog = kvmetastore.NewCatalog(metaKV, ss)
 		case util.MetaStoreTypeTiKV:
 			log.Ctx(initCtx).Info("Using tikv as meta storage.")
-			var ss *kvmetastore.SuffixSnapshot
+			var ss kv.SnapShotKV
 			var err error
 
 			metaKV := c.metaKVCreator()
-			if ss, err = kvmetastore.NewSuffixSnapshot(metaKV, kvmetastore.SnapshotsSep, Params.TiKVCfg.MetaRootPath.GetValue(), kvmetastore.SnapshotPrefix); err != nil {
+			if ss, err = kvmetastore.NewSnapshotKV(initCtx, metaKV, Params.TiKVCfg.MetaRootPath.GetValue()); err != nil {
 				return err
 			}
 			catalog = kvmetastore.NewCatalog(metaKV, ss)
@@ -456,6 +456,7 @@ func (c *Core) initInternal() error {
 	c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
 	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.mixCoord, c.tsoAllocator, c.meta)
+	c.quotaCenter.userQuotaUsage = newUserQuotaUsage(c.metaKVCreator())
 	log.Debug("RootCoord init QuotaCenter done")
 
 	if err := c.initCredentials(initCtx); err != nil {
@@ -2983,6 +2984,120 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	return merr.Success(), nil
 }
 
+func (c *Core) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.String("newDbName", req.GetNewDbName()),
+		zap.String("newCollectionName", req.GetNewCollectionName()))
+	log.Info("received request to clone collection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CloneCollection")
+	t := &cloneCollectionTask{
+		createCollectionTask: createCollectionTask{
+			baseTask: newBaseTask(ctx, c),
+		},
+		CloneReq: req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to clone collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to clone collection", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CloneCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to clone collection", zap.Uint64("ts", t.GetTs()), zap.Int64("collectionID", t.collID))
+	return merr.Success(), nil
+}
+
+// RestoreSnapshot creates a new collection with the schema of the snapshot and restores the data of the snapshot into it.
+func (c *Core) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("snapshotName", req.GetSnapshotName()),
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()))
+	log.Info("received request to restore snapshot")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RestoreSnapshot")
+	t := &restoreSnapshotTask{
+		createCollectionTask: createCollectionTask{
+			baseTask: newBaseTask(ctx, c),
+		},
+		RestoreReq: req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to restore snapshot", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to restore snapshot", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RestoreSnapshot").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to restore snapshot", zap.Uint64("ts", t.GetTs()), zap.Int64("collectionID", t.collID))
+	return merr.Success(), nil
+}
+
+// TruncateCollection drops all data of the collection but keeps the schema, indexes and aliases.
+func (c *Core) TruncateCollection(ctx context.Context, req *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()))
+	log.Info("received request to truncate collection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("TruncateCollection")
+	t := &truncateCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to truncate collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to truncate collection", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("TruncateCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to truncate collection", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
 func (c *Core) DescribeDatabase(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return &rootcoordpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
@@ -173,6 +173,98 @@ func (s *unwatchChannelsStep) Weight() stepPriority {
 	return stepPriorityNormal
 }
 
+type cloneSegmentsStep struct {
+	baseStep
+	info *cloneInfo
+}
+
+func (s *cloneSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.CloneSegments(ctx, s.info)
+	return nil, err
+}
+
+func (s *cloneSegmentsStep) Desc() string {
+	return fmt.Sprintf("clone segments, ts: %d, source collection: %d, collection: %d",
+		s.info.ts, s.info.sourceCollectionID, s.info.collectionID)
+}
+
+type broadcastTruncateCollectionStep struct {
+	baseStep
+	collection *model.Collection
+}
+
+func (s *broadcastTruncateCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	msg, err := message.NewTruncateCollectionMessageBuilderV2().
+		WithBroadcast(s.collection.VirtualChannelNames).
+		WithHeader(&message.TruncateCollectionMessageHeader{
+			CollectionId: s.collection.CollectionID,
+		}).
+		WithBody(&message.TruncateCollectionMessageBody{}).
+		BuildBroadcast()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := streaming.WAL().Broadcast().Append(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info("broadcast truncate collection success",
+		zap.Int64("collectionID", s.collection.CollectionID),
+		zap.Uint64("broadcastID", resp.BroadcastID),
+		zap.Any("appendResults", resp.AppendResults),
+	)
+	return nil, nil
+}
+
+func (s *broadcastTruncateCollectionStep) Desc() string {
+	return fmt.Sprintf("broadcast truncate collection, collection: %d", s.collection.CollectionID)
+}
+
+type truncateSegmentsStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *truncateSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.TruncateSegments(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *truncateSegmentsStep) Desc() string {
+	return fmt.Sprintf("truncate segments, collection: %d, ts: %d", s.collectionID, s.ts)
+}
+
+type refreshCollectionStep struct {
+	baseStep
+	collectionID UniqueID
+}
+
+func (s *refreshCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.RefreshCollection(ctx, s.collectionID)
+	return nil, err
+}
+
+func (s *refreshCollectionStep) Desc() string {
+	return fmt.Sprintf("refresh collection, collection: %d", s.collectionID)
+}
+
+type restoreSnapshotSegmentsStep struct {
+	baseStep
+	info *restoreInfo
+}
+
+func (s *restoreSnapshotSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.RestoreSnapshotSegments(ctx, s.info)
+	return nil, err
+}
+
+func (s *restoreSnapshotSegmentsStep) Desc() string {
+	return fmt.Sprintf("restore snapshot segments, ts: %d, snapshot: %s, collection: %d",
+		s.info.ts, s.info.snapshotName, s.info.collectionID)
+}
+
 type changeCollectionStateStep struct {
 	baseStep
 	collectionID UniqueID
@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// truncateCollectionTask drops all data of the collection but keeps the schema, indexes and aliases.
+// The truncate message is broadcast to the vchannels of the collection, the wal fences the growing segments
+// and the flusher drops them when consuming the message. The segments known by datacoord before the task ts
+// are marked as dropped, then the query target is refreshed to release the dropped segments.
+type truncateCollectionTask struct {
+	baseTask
+	Req *internalpb.TruncateCollectionRequest
+}
+
+func (t *truncateCollectionTask) Prepare(ctx context.Context) error {
+	if t.Req == nil {
+		return errors.New("empty requests")
+	}
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return merr.WrapErrServiceUnavailable("streaming service is not enabled", "truncate collection requires the streaming service")
+	}
+	if t.Req.GetDbName() == "" {
+		t.Req.DbName = util.DefaultDBName
+	}
+	return nil
+}
+
+func (t *truncateCollectionTask) Execute(ctx context.Context) error {
+	collMeta, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	if !collMeta.Available() {
+		return merr.WrapErrCollectionNotFound(t.Req.GetCollectionName())
+	}
+	aliases := t.core.meta.ListAliasesByID(ctx, collMeta.CollectionID)
+	return executeTruncateCollectionTaskSteps(ctx, t.core, collMeta, t.Req.GetDbName(), aliases, t.GetTs())
+}
+
+func (t *truncateCollectionTask) GetLockerKey() LockerKey {
+	collection := t.core.getCollectionIDStr(t.ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), 0)
+	return NewLockerKeyChain(
+		NewClusterLockerKey(false),
+		NewDatabaseLockerKey(t.Req.GetDbName(), false),
+		NewCollectionLockerKey(collection, true),
+	)
+}
+
+func executeTruncateCollectionTaskSteps(ctx context.Context,
+	core *Core,
+	col *model.Collection,
+	dbName string,
+	aliases []string,
+	ts Timestamp,
+) error {
+	redoTask := newBaseRedoTask(core.stepExecutor)
+
+	redoTask.AddSyncStep(&broadcastTruncateCollectionStep{
+		baseStep:   baseStep{core: core},
+		collection: col,
+	})
+	// the truncate message is appended after ts, so the segments before ts are always truncated,
+	// the growing segments between ts and the truncate message are dropped by the flusher.
+	redoTask.AddSyncStep(&truncateSegmentsStep{
+		baseStep:     baseStep{core: core},
+		collectionID: col.CollectionID,
+		ts:           ts,
+	})
+	// the search results cached by proxy are out of date.
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          dbName,
+		collectionNames: append(aliases, col.Name),
+		collectionID:    col.CollectionID,
+		ts:              ts,
+	})
+	redoTask.AddSyncStep(&refreshCollectionStep{
+		baseStep:     baseStep{core: core},
+		collectionID: col.CollectionID,
+	})
+
+	return redoTask.Execute(ctx)
+}
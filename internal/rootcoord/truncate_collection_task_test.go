@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func Test_truncateCollectionTask_Prepare(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		task := &truncateCollectionTask{baseTask: newBaseTask(context.Background(), newTestCore())}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("streaming not enabled", func(t *testing.T) {
+		task := &truncateCollectionTask{
+			baseTask: newBaseTask(context.Background(), newTestCore()),
+			Req:      &internalpb.TruncateCollectionRequest{CollectionName: "coll"},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		streamingutil.SetStreamingServiceEnabled()
+		defer streamingutil.UnsetStreamingServiceEnabled()
+
+		task := &truncateCollectionTask{
+			baseTask: newBaseTask(context.Background(), newTestCore()),
+			Req:      &internalpb.TruncateCollectionRequest{CollectionName: "coll"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Equal(t, util.DefaultDBName, task.Req.GetDbName())
+	})
+}
+
+func Test_truncateCollectionTask_Execute(t *testing.T) {
+	coll := &model.Collection{
+		CollectionID:        100,
+		Name:                "coll",
+		VirtualChannelNames: []string{"ch-0_100v0", "ch-1_100v1"},
+		State:               pb.CollectionState_CollectionCreated,
+	}
+
+	t.Run("collection not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "coll", mock.Anything).
+			Return(nil, merr.WrapErrCollectionNotFound("coll"))
+		core := newTestCore(withMeta(meta))
+		task := &truncateCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.TruncateCollectionRequest{DbName: util.DefaultDBName, CollectionName: "coll"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("collection not available", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "coll", mock.Anything).
+			Return(&model.Collection{Name: "coll", State: pb.CollectionState_CollectionDropping}, nil)
+		core := newTestCore(withMeta(meta))
+		task := &truncateCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.TruncateCollectionRequest{DbName: util.DefaultDBName, CollectionName: "coll"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		b := mock_streaming.NewMockBroadcast(t)
+		b.EXPECT().Append(mock.Anything, mock.Anything).Return(&types.BroadcastAppendResult{}, nil)
+		wal := mock_streaming.NewMockWALAccesser(t)
+		wal.EXPECT().Broadcast().Return(b)
+		streaming.SetWALForTest(wal)
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "coll", mock.Anything).Return(coll, nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything, coll.CollectionID).Return([]string{"alias"})
+
+		truncateTs := Timestamp(0)
+		refreshed := false
+		broker := newMockBroker()
+		broker.TruncateSegmentsFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			truncateTs = ts
+			return nil
+		}
+		broker.RefreshCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			refreshed = true
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker), withValidProxyManager())
+		task := &truncateCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.TruncateCollectionRequest{DbName: util.DefaultDBName, CollectionName: "coll"},
+		}
+		task.SetTs(1000)
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, Timestamp(1000), truncateTs)
+		assert.True(t, refreshed)
+	})
+}
+
+func Test_truncateSegmentsStep_Execute(t *testing.T) {
+	broker := newMockBroker()
+	broker.TruncateSegmentsFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+		return errors.New("mock")
+	}
+	core := newTestCore(withBroker(broker))
+
+	s := &truncateSegmentsStep{baseStep: baseStep{core: core}, collectionID: 100, ts: 1000}
+	_, err := s.Execute(context.Background())
+	assert.Error(t, err)
+	assert.NotEmpty(t, s.Desc())
+
+	broker.RefreshCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+		return errors.New("mock")
+	}
+	r := &refreshCollectionStep{baseStep: baseStep{core: core}, collectionID: 100}
+	_, err = r.Execute(context.Background())
+	assert.Error(t, err)
+	assert.NotEmpty(t, r.Desc())
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/json"
+	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// userDailyUsage is the daily quota usage of a user.
+type userDailyUsage struct {
+	Requests float64 `json:"requests"`
+	Bytes    float64 `json:"bytes"`
+}
+
+// growth returns the usage grown from last to cur,
+// the whole cur is new if it's less than last, which means the proxy has reset its usage.
+func (cur userDailyUsage) growth(last userDailyUsage) userDailyUsage {
+	grown := cur
+	if cur.Requests >= last.Requests {
+		grown.Requests = cur.Requests - last.Requests
+	}
+	if cur.Bytes >= last.Bytes {
+		grown.Bytes = cur.Bytes - last.Bytes
+	}
+	return grown
+}
+
+// userQuotaUsageInfo is the persisted format of userQuotaUsage.
+type userQuotaUsageInfo struct {
+	Day     string                              `json:"day"`
+	Users   map[string]*userDailyUsage          `json:"users"`
+	Proxies map[int64]map[string]userDailyUsage `json:"proxies"`
+}
+
+// userQuotaUsage accumulates the daily quota usage of users in the whole cluster.
+//
+// Each proxy reports the usage of the day served by itself, which is lost when the proxy restarts,
+// so the usage is accumulated by the growth of the reported usage since the last report of the proxy.
+// The accumulated usage is persisted into the meta kv to survive the restart of rootcoord.
+type userQuotaUsage struct {
+	kv     kv.MetaKv // nil means the usage is kept in memory only
+	loaded bool
+
+	day     string                              // the UTC day that the usage is accumulated in
+	users   map[string]*userDailyUsage          // user -> usage of the day
+	proxies map[int64]map[string]userDailyUsage // proxy id -> user -> usage last reported by the proxy
+}
+
+func newUserQuotaUsage(metaKV kv.MetaKv) *userQuotaUsage {
+	return &userQuotaUsage{
+		kv:      metaKV,
+		users:   make(map[string]*userDailyUsage),
+		proxies: make(map[int64]map[string]userDailyUsage),
+	}
+}
+
+func (u *userQuotaUsage) load(ctx context.Context) error {
+	if u.loaded || u.kv == nil {
+		return nil
+	}
+	value, err := u.kv.Load(ctx, kvmetastore.UserDailyQuotaUsageKey)
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		u.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	info := &userQuotaUsageInfo{}
+	if err := json.Unmarshal([]byte(value), info); err != nil {
+		return err
+	}
+	u.day = info.Day
+	if info.Users != nil {
+		u.users = info.Users
+	}
+	if info.Proxies != nil {
+		u.proxies = info.Proxies
+	}
+	u.loaded = true
+	return nil
+}
+
+func (u *userQuotaUsage) save(ctx context.Context) error {
+	if u.kv == nil {
+		return nil
+	}
+	value, err := json.Marshal(&userQuotaUsageInfo{
+		Day:     u.day,
+		Users:   u.users,
+		Proxies: u.proxies,
+	})
+	if err != nil {
+		return err
+	}
+	return u.kv.Save(ctx, kvmetastore.UserDailyQuotaUsageKey, string(value))
+}
+
+// update accumulates the usage reported by the proxies at time now,
+// and removes the usage of the users which are not valid any more, e.g. dropped.
+func (u *userQuotaUsage) update(ctx context.Context, now time.Time, proxyMetrics map[int64]*metricsinfo.ProxyQuotaMetrics, isValidUser func(string) bool) error {
+	if err := u.load(ctx); err != nil {
+		return err
+	}
+
+	changed := false
+	if day := now.UTC().Format(time.DateOnly); day != u.day {
+		u.day = day
+		u.users = make(map[string]*userDailyUsage)
+		changed = true
+	}
+	proxies := make(map[int64]map[string]userDailyUsage, len(proxyMetrics))
+	for proxyID, pm := range proxyMetrics {
+		last := u.proxies[proxyID]
+		reported := make(map[string]userDailyUsage, len(pm.UserMetrics))
+		for _, um := range pm.UserMetrics {
+			cur := userDailyUsage{Requests: um.DailyRequests, Bytes: um.DailyBytes}
+			reported[um.User] = cur
+			if cur == last[um.User] {
+				continue
+			}
+			changed = true
+			grown := cur.growth(last[um.User])
+			if grown.Requests == 0 && grown.Bytes == 0 {
+				continue
+			}
+			usage, ok := u.users[um.User]
+			if !ok {
+				usage = &userDailyUsage{}
+				u.users[um.User] = usage
+			}
+			usage.Requests += grown.Requests
+			usage.Bytes += grown.Bytes
+		}
+		proxies[proxyID] = reported
+	}
+	u.proxies = proxies
+	for user := range u.users {
+		if !isValidUser(user) {
+			delete(u.users, user)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return u.save(ctx)
+}
+
+// getUsers returns the usage of the users which are active in the day.
+func (u *userQuotaUsage) getUsers() map[string]*userDailyUsage {
+	return u.users
+}
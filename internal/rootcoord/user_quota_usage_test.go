@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+func TestUserQuotaUsage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	allValid := func(string) bool { return true }
+	report := func(usage map[int64][]metricsinfo.UserQuotaMetrics) map[int64]*metricsinfo.ProxyQuotaMetrics {
+		proxyMetrics := make(map[int64]*metricsinfo.ProxyQuotaMetrics)
+		for proxyID, userMetrics := range usage {
+			proxyMetrics[proxyID] = &metricsinfo.ProxyQuotaMetrics{UserMetrics: userMetrics}
+		}
+		return proxyMetrics
+	}
+
+	metaKV := mocks.NewMetaKv(t)
+	var saved string
+	metaKV.EXPECT().Load(mock.Anything, kvmetastore.UserDailyQuotaUsageKey).Return("", merr.WrapErrIoKeyNotFound(kvmetastore.UserDailyQuotaUsageKey)).Once()
+	metaKV.EXPECT().Save(mock.Anything, kvmetastore.UserDailyQuotaUsageKey, mock.Anything).RunAndReturn(func(ctx context.Context, key string, value string) error {
+		saved = value
+		return nil
+	})
+
+	usage := newUserQuotaUsage(metaKV)
+	err := usage.update(ctx, now, report(map[int64][]metricsinfo.UserQuotaMetrics{
+		1: {{User: "alice", DailyRequests: 1, DailyBytes: 100}, {User: "bob"}},
+		2: {{User: "alice", DailyRequests: 2, DailyBytes: 200}},
+	}), allValid)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*userDailyUsage{"alice": {Requests: 3, Bytes: 300}}, usage.getUsers())
+
+	// the usage grown since the last report is accumulated, the proxy 2 restarts and reports from zero.
+	err = usage.update(ctx, now, report(map[int64][]metricsinfo.UserQuotaMetrics{
+		1: {{User: "alice", DailyRequests: 5, DailyBytes: 100}, {User: "bob", DailyRequests: 1}},
+		2: {{User: "alice", DailyRequests: 1}},
+	}), allValid)
+	assert.NoError(t, err)
+	assert.Equal(t, &userDailyUsage{Requests: 8, Bytes: 300}, usage.getUsers()["alice"])
+	assert.Equal(t, &userDailyUsage{Requests: 1}, usage.getUsers()["bob"])
+
+	// the usage is recovered from the meta kv after restart.
+	metaKV.EXPECT().Load(mock.Anything, kvmetastore.UserDailyQuotaUsageKey).Return(saved, nil).Once()
+	usage = newUserQuotaUsage(metaKV)
+	err = usage.update(ctx, now, report(map[int64][]metricsinfo.UserQuotaMetrics{
+		1: {{User: "alice", DailyRequests: 6, DailyBytes: 100}, {User: "bob", DailyRequests: 1}},
+		2: {{User: "alice", DailyRequests: 1}},
+	}), func(user string) bool { return user != "bob" })
+	assert.NoError(t, err)
+	// the usage of the dropped user is removed.
+	assert.Equal(t, map[string]*userDailyUsage{"alice": {Requests: 9, Bytes: 300}}, usage.getUsers())
+
+	// the usage is reset in the next day.
+	err = usage.update(ctx, now.Add(12*time.Hour), report(map[int64][]metricsinfo.UserQuotaMetrics{
+		1: {{User: "alice", DailyRequests: 1}},
+	}), allValid)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*userDailyUsage{"alice": {Requests: 1}}, usage.getUsers())
+
+	// nothing is accumulated if failed to load the usage.
+	metaKV.EXPECT().Load(mock.Anything, kvmetastore.UserDailyQuotaUsageKey).Return("", errors.New("mock error")).Once()
+	usage = newUserQuotaUsage(metaKV)
+	assert.Error(t, usage.update(ctx, now, nil, allValid))
+
+	// the usage is kept in memory only without meta kv.
+	usage = newUserQuotaUsage(nil)
+	assert.NoError(t, usage.update(ctx, now, report(map[int64][]metricsinfo.UserQuotaMetrics{
+		1: {{User: "alice", DailyRequests: 1}},
+	}), allValid))
+	assert.Len(t, usage.getUsers(), 1)
+}
@@ -18,6 +18,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -194,3 +195,32 @@ func (AzureObjectStorage *AzureObjectStorage) RemoveObject(ctx context.Context,
 	_, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).Delete(ctx, &blob.DeleteOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+// CopyObject starts an asynchronous copy on the server side and waits until it's done,
+// copying inside the same storage account is usually completed immediately.
+func (AzureObjectStorage *AzureObjectStorage) CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error {
+	containerClient := AzureObjectStorage.Client.NewContainerClient(bucketName)
+	srcURL := containerClient.NewBlockBlobClient(srcObjectName).URL()
+	dstClient := containerClient.NewBlockBlobClient(dstObjectName)
+	resp, err := dstClient.StartCopyFromURL(ctx, srcURL, &blob.StartCopyFromURLOptions{})
+	if err != nil {
+		return checkObjectStorageError(srcObjectName, err)
+	}
+	status := resp.CopyStatus
+	for status != nil && *status == blob.CopyStatusTypePending {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+		props, err := dstClient.GetProperties(ctx, &blob.GetPropertiesOptions{})
+		if err != nil {
+			return checkObjectStorageError(dstObjectName, err)
+		}
+		status = props.CopyStatus
+	}
+	if status != nil && *status != blob.CopyStatusTypeSuccess {
+		return merr.WrapErrIoFailedReason(fmt.Sprintf("copy from %s to %s is %s", srcObjectName, dstObjectName, *status))
+	}
+	return nil
+}
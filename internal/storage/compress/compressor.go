@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	Zstd   = "zstd"
+	Lz4    = "lz4"
+	Snappy = "snappy"
+	Brotli = "brotli"
+
+	// DefaultCompressor is used when the collection does not specify any codec.
+	DefaultCompressor = Zstd
+)
+
+// Compressor describes a codec which could be used to compress insert binlogs and deltalogs.
+// Stats logs are out of scope, they keep their own serialization and are never compressed by it.
+// The actual encoding & decoding is done by the parquet codec registered for Codec(),
+// readers pick the codec recorded in the column chunk metadata,
+// so binlogs written with different compressors could be mixed in one segment.
+type Compressor interface {
+	// Name is the value used in collection properties.
+	Name() string
+	// Codec returns the parquet compression type.
+	Codec() compress.Compression
+	// DefaultLevel returns the level used if not specified.
+	DefaultLevel() int
+	// ValidateLevel checks whether the level is supported.
+	ValidateLevel(level int) error
+}
+
+type compressor struct {
+	name         string
+	codec        compress.Compression
+	defaultLevel int
+	minLevel     int
+	maxLevel     int
+}
+
+func (c *compressor) Name() string {
+	return c.name
+}
+
+func (c *compressor) Codec() compress.Compression {
+	return c.codec
+}
+
+func (c *compressor) DefaultLevel() int {
+	return c.defaultLevel
+}
+
+func (c *compressor) ValidateLevel(level int) error {
+	if level < c.minLevel || level > c.maxLevel {
+		return merr.WrapErrParameterInvalidRange(c.minLevel, c.maxLevel, level,
+			"invalid compression level for "+c.name)
+	}
+	return nil
+}
+
+var (
+	compressorsMu sync.RWMutex
+	compressors   = map[string]Compressor{}
+)
+
+// Register adds or overrides a compressor, the name is case-insensitive.
+func Register(c Compressor) {
+	compressorsMu.Lock()
+	defer compressorsMu.Unlock()
+	compressors[strings.ToLower(c.Name())] = c
+}
+
+// Get returns the compressor with the name.
+func Get(name string) (Compressor, bool) {
+	compressorsMu.RLock()
+	defer compressorsMu.RUnlock()
+	c, ok := compressors[strings.ToLower(name)]
+	return c, ok
+}
+
+// Names returns the names of all registered compressors in order.
+func Names() []string {
+	compressorsMu.RLock()
+	defer compressorsMu.RUnlock()
+	names := make([]string, 0, len(compressors))
+	for name := range compressors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Config is the codec & level used to write binlogs.
+// The zero value means the collection does not specify one, so each writer keeps its own default,
+// i.e. zstd with level 3 for insert binlogs and uncompressed for multi-field deltalogs.
+type Config struct {
+	Compressor Compressor
+	Level      int
+}
+
+// DefaultConfig returns the codec & level of insert binlogs when a collection does not specify one, zstd with level 3.
+func DefaultConfig() Config {
+	c, _ := Get(DefaultCompressor)
+	return Config{Compressor: c, Level: c.DefaultLevel()}
+}
+
+// WriterProperties returns the parquet writer properties applying the config.
+// The default config is applied if c is the zero value.
+func (c Config) WriterProperties(opts ...parquet.WriterProperty) *parquet.WriterProperties {
+	if c.IsZero() {
+		c = DefaultConfig()
+	}
+	return parquet.NewWriterProperties(append([]parquet.WriterProperty{
+		parquet.WithCompression(c.Compressor.Codec()),
+		parquet.WithCompressionLevel(c.Level),
+	}, opts...)...)
+}
+
+// IsZero returns whether the config is not specified.
+func (c Config) IsZero() bool {
+	return c.Compressor == nil
+}
+
+func parseConfig(props []*commonpb.KeyValuePair) (Config, error) {
+	name, levelStr := "", ""
+	for _, kv := range props {
+		switch kv.GetKey() {
+		case common.CollectionBinlogCompressionKey:
+			name = strings.TrimSpace(kv.GetValue())
+		case common.CollectionBinlogCompressionLevelKey:
+			levelStr = strings.TrimSpace(kv.GetValue())
+		}
+	}
+	if name == "" && levelStr == "" {
+		return Config{}, nil
+	}
+	if name == "" {
+		name = DefaultCompressor
+	}
+	c, ok := Get(name)
+	if !ok {
+		return Config{}, merr.WrapErrParameterInvalidMsg("unsupported binlog compression %s, supported: %s",
+			name, strings.Join(Names(), ","))
+	}
+	cfg := Config{Compressor: c, Level: c.DefaultLevel()}
+	if levelStr != "" {
+		level, err := strconv.Atoi(levelStr)
+		if err != nil {
+			return Config{}, merr.WrapErrParameterInvalidMsg("invalid binlog compression level %s", levelStr)
+		}
+		if err := c.ValidateLevel(level); err != nil {
+			return Config{}, err
+		}
+		cfg.Level = level
+	}
+	return cfg, nil
+}
+
+// ValidateConfig checks the binlog compression properties of a collection.
+func ValidateConfig(props ...*commonpb.KeyValuePair) error {
+	_, err := parseConfig(props)
+	return err
+}
+
+// GetConfig returns the binlog compression config from collection properties,
+// the zero config is returned if the properties are not set or illegal.
+func GetConfig(props []*commonpb.KeyValuePair) Config {
+	cfg, err := parseConfig(props)
+	if err != nil {
+		log.Warn("illegal binlog compression properties, use default", zap.Error(err))
+		return Config{}
+	}
+	return cfg
+}
+
+func init() {
+	Register(&compressor{name: Zstd, codec: compress.Codecs.Zstd, defaultLevel: 3, minLevel: 1, maxLevel: 22})
+	Register(&compressor{name: Lz4, codec: Lz4Raw, defaultLevel: 0, minLevel: 0, maxLevel: 9})
+	Register(&compressor{name: Snappy, codec: compress.Codecs.Snappy, defaultLevel: 0, minLevel: 0, maxLevel: 0})
+	Register(&compressor{name: Brotli, codec: compress.Codecs.Brotli, defaultLevel: 6, minLevel: 0, maxLevel: 11})
+}
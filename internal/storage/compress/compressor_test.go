@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func genData(n int) []byte {
+	r := rand.New(rand.NewSource(0))
+	data := make([]byte, n)
+	for i := range data {
+		// half random to keep the data compressible
+		if i%2 == 0 {
+			data[i] = byte(r.Intn(256))
+		} else {
+			data[i] = byte(i % 7)
+		}
+	}
+	return data
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	data := genData(64 << 10)
+	for _, name := range Names() {
+		c, ok := Get(name)
+		require.True(t, ok)
+		codec, err := compress.GetCodec(c.Codec())
+		require.NoError(t, err, name)
+
+		for _, level := range []int{c.DefaultLevel(), 1} {
+			if c.ValidateLevel(level) != nil {
+				continue
+			}
+			encoded := codec.EncodeLevel(nil, data, level)
+			assert.LessOrEqual(t, int64(len(encoded)), codec.CompressBound(int64(len(data))), name)
+
+			decoded := codec.Decode(make([]byte, len(data)), encoded)
+			assert.Equal(t, data, decoded, name)
+			decoded = codec.Decode(nil, encoded)
+			assert.Equal(t, data, decoded, name)
+		}
+
+		var buf bytes.Buffer
+		w, err := codec.NewWriterLevel(&buf, c.DefaultLevel())
+		require.NoError(t, err, name)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		r := codec.NewReader(&buf)
+		decoded, err := io.ReadAll(r)
+		require.NoError(t, err, name)
+		assert.Equal(t, data, decoded, name)
+		r.Close()
+	}
+}
+
+func TestParquetRoundTrip(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "0", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewInt64Builder(memory.DefaultAllocator)
+	defer builder.Release()
+	for i := 0; i < 10000; i++ {
+		builder.Append(int64(i % 100))
+	}
+	arr := builder.NewArray()
+	defer arr.Release()
+	rec := array.NewRecord(schema, []arrow.Array{arr}, int64(arr.Len()))
+	defer rec.Release()
+
+	for _, name := range Names() {
+		c, _ := Get(name)
+		cfg := Config{Compressor: c, Level: c.DefaultLevel()}
+		var buf bytes.Buffer
+		fw, err := pqarrow.NewFileWriter(schema, &buf, cfg.WriterProperties(), pqarrow.DefaultWriterProps())
+		require.NoError(t, err)
+		require.NoError(t, fw.Write(rec))
+		require.NoError(t, fw.Close())
+
+		reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		col, err := reader.MetaData().RowGroup(0).ColumnChunk(0)
+		require.NoError(t, err)
+		assert.Equal(t, c.Codec(), col.Compression(), name)
+
+		fr, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		require.NoError(t, err)
+		table, err := fr.ReadTable(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(arr.Len()), table.NumRows())
+		assert.Equal(t, arr.(*array.Int64).Int64Values(), table.Column(0).Data().Chunk(0).(*array.Int64).Int64Values(), name)
+		table.Release()
+		reader.Close()
+	}
+}
+
+func TestConfig(t *testing.T) {
+	assert.ElementsMatch(t, []string{Brotli, Lz4, Snappy, Zstd}, Names())
+
+	kv := func(pairs ...string) []*commonpb.KeyValuePair {
+		ret := make([]*commonpb.KeyValuePair, 0)
+		for i := 0; i < len(pairs); i += 2 {
+			ret = append(ret, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return ret
+	}
+
+	// not specified, each writer keeps its own default
+	cfg := GetConfig(nil)
+	assert.True(t, cfg.IsZero())
+
+	cfg = GetConfig(kv(common.CollectionBinlogCompressionLevelKey, "5"))
+	assert.Equal(t, Zstd, cfg.Compressor.Name())
+	assert.Equal(t, 5, cfg.Level)
+
+	cfg = GetConfig(kv(common.CollectionBinlogCompressionKey, "LZ4"))
+	assert.Equal(t, Lz4, cfg.Compressor.Name())
+	assert.Equal(t, Lz4Raw, cfg.Compressor.Codec())
+	assert.Equal(t, 0, cfg.Level)
+
+	cfg = GetConfig(kv(common.CollectionBinlogCompressionKey, "brotli", common.CollectionBinlogCompressionLevelKey, "9"))
+	assert.Equal(t, Brotli, cfg.Compressor.Name())
+	assert.Equal(t, 9, cfg.Level)
+
+	invalids := [][]*commonpb.KeyValuePair{
+		kv(common.CollectionBinlogCompressionKey, "gzip"),
+		kv(common.CollectionBinlogCompressionKey, "zstd", common.CollectionBinlogCompressionLevelKey, "23"),
+		kv(common.CollectionBinlogCompressionKey, "snappy", common.CollectionBinlogCompressionLevelKey, "1"),
+		kv(common.CollectionBinlogCompressionLevelKey, "abc"),
+	}
+	for _, props := range invalids {
+		assert.Error(t, ValidateConfig(props...))
+		// fallback to not specified for illegal properties
+		assert.True(t, GetConfig(props).IsZero())
+	}
+	assert.NoError(t, ValidateConfig(kv(common.CollectionBinlogCompressionKey, "lz4", common.CollectionBinlogCompressionLevelKey, "9")...))
+
+	props := Config{}.WriterProperties()
+	assert.Equal(t, compress.Codecs.Zstd, props.Compression())
+	assert.Equal(t, 3, props.CompressionLevel())
+}
+
+func TestLz4Corrupted(t *testing.T) {
+	codec, err := compress.GetCodec(Lz4Raw)
+	require.NoError(t, err)
+
+	encoded := codec.Encode(nil, genData(1024))
+	assert.NotPanics(t, func() {
+		decoded := codec.Decode(make([]byte, 1024), encoded[:len(encoded)/2])
+		assert.Empty(t, decoded)
+	})
+}
+
+func TestLz4Literals(t *testing.T) {
+	codec, err := compress.GetCodec(Lz4Raw)
+	require.NoError(t, err)
+
+	for _, n := range []int{0, 1, 14, 15, 16, 269, 270, 271, 1000} {
+		data := genData(n)
+		encoded := appendLz4Literals(nil, data)
+		assert.LessOrEqual(t, int64(len(encoded)), codec.CompressBound(int64(n)), n)
+		decoded := codec.Decode(make([]byte, n), encoded)
+		assert.Equal(t, data, decoded[:n], n)
+	}
+}
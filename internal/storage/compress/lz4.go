@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"io"
+
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/pierrec/lz4/v4"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+// Lz4Raw is the parquet LZ4_RAW codec, i.e. the plain LZ4 block format without any framing.
+// Arrow go does not expose it in compress.Codecs since only the hadoop framed LZ4 is defined there.
+const Lz4Raw = compress.Compression(7)
+
+// lz4Codec implements the parquet LZ4_RAW codec.
+// Level 0 uses the fast compressor, level 1~9 use the high compression one.
+type lz4Codec struct{}
+
+func (lz4Codec) Encode(dst, src []byte) []byte {
+	return lz4Codec{}.EncodeLevel(dst, src, 0)
+}
+
+func (lz4Codec) EncodeLevel(dst, src []byte, level int) []byte {
+	encoded, err := lz4EncodeBlock(dst, src, level)
+	if err != nil {
+		// parquet codecs can't return errors, store the data as literals so the block is still valid.
+		log.Warn("failed to compress lz4 block, store it uncompressed", zap.Int("size", len(src)), zap.Error(err))
+		return appendLz4Literals(dst[:0], src)
+	}
+	return encoded
+}
+
+func (lz4Codec) Decode(dst, src []byte) []byte {
+	decoded, err := lz4DecodeBlock(dst, src)
+	if err != nil {
+		// parquet codecs can't return errors, the page reader reports the unexpected size as an error.
+		log.Warn("failed to decompress lz4 block", zap.Int("size", len(src)), zap.Error(err))
+		return dst[:0]
+	}
+	return decoded
+}
+
+func lz4EncodeBlock(dst, src []byte, level int) ([]byte, error) {
+	bound := lz4.CompressBlockBound(len(src))
+	if cap(dst) < bound {
+		dst = make([]byte, bound)
+	}
+	dst = dst[:bound]
+
+	var n int
+	var err error
+	if level <= 0 {
+		n, err = lz4.CompressBlock(src, dst, nil)
+	} else {
+		n, err = lz4.CompressBlockHC(src, dst, lz4HCLevel(level), nil, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dst[:n], nil
+}
+
+func lz4DecodeBlock(dst, src []byte) ([]byte, error) {
+	if len(dst) == 0 {
+		// size of the uncompressed data is unknown, grow the buffer until it fits
+		dst = make([]byte, len(src)*4+64)
+	}
+	for {
+		n, err := lz4.UncompressBlock(src, dst)
+		if err == nil {
+			return dst[:n], nil
+		}
+		if err != lz4.ErrInvalidSourceShortBuffer || len(dst) >= 255*len(src)+64 {
+			return nil, err
+		}
+		dst = make([]byte, len(dst)*2)
+	}
+}
+
+// appendLz4Literals appends a block which holds src as literals only.
+func appendLz4Literals(dst, src []byte) []byte {
+	n := len(src)
+	if n < 15 {
+		dst = append(dst, byte(n<<4))
+	} else {
+		dst = append(dst, 0xF0)
+		for n -= 15; n >= 255; n -= 255 {
+			dst = append(dst, 255)
+		}
+		dst = append(dst, byte(n))
+	}
+	return append(dst, src...)
+}
+
+func (lz4Codec) CompressBound(len int64) int64 {
+	return int64(lz4.CompressBlockBound(int(len)))
+}
+
+// NewReader and NewWriter work on the LZ4 frame format, since the block format
+// carries no length information to be used as a stream.
+func (lz4Codec) NewReader(r io.Reader) io.ReadCloser {
+	return io.NopCloser(lz4.NewReader(r))
+}
+
+func (lz4Codec) NewWriter(w io.Writer) io.WriteCloser {
+	return lz4.NewWriter(w)
+}
+
+func (lz4Codec) NewWriterLevel(w io.Writer, level int) (io.WriteCloser, error) {
+	writer := lz4.NewWriter(w)
+	if level > 0 {
+		if err := writer.Apply(lz4.CompressionLevelOption(lz4HCLevel(level))); err != nil {
+			return nil, err
+		}
+	}
+	return writer, nil
+}
+
+func lz4HCLevel(level int) lz4.CompressionLevel {
+	if level > 9 {
+		level = 9
+	}
+	return lz4.CompressionLevel(1 << (8 + level))
+}
+
+func init() {
+	compress.RegisterCodec(Lz4Raw, lz4Codec{})
+}
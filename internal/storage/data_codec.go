@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/json"
+	binlogcompress "github.com/milvus-io/milvus/internal/storage/compress"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
@@ -255,6 +256,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 	}
 
+	compressCfg := binlogcompress.GetConfig(insertCodec.Schema.GetSchema().GetProperties())
 	for _, field := range insertCodec.Schema.Schema.Fields {
 		// check insert data contain this field
 		// must be all missing or all exists
@@ -283,7 +285,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID, field.GetNullable())
 
 		// get payload writing configs, including nullable and fallback encoding method
-		opts := []PayloadWriterOptions{WithNullable(field.GetNullable()), WithWriterProps(getFieldWriterProps(field))}
+		opts := []PayloadWriterOptions{WithNullable(field.GetNullable()), WithWriterProps(getFieldWriterProps(field, compressCfg))}
 
 		if typeutil.IsVectorType(field.DataType) && !typeutil.IsSparseFloatVectorType(field.DataType) {
 			dim, err := typeutil.GetDim(field)
@@ -758,7 +760,7 @@ func NewDeleteCodec() *DeleteCodec {
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
 	field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_String}
-	opts := []PayloadWriterOptions{WithWriterProps(getFieldWriterProps(field))}
+	opts := []PayloadWriterOptions{WithWriterProps(getFieldWriterProps(field, binlogcompress.Config{}))}
 	eventWriter, err := binlogWriter.NextDeleteEventWriter(opts...)
 	if err != nil {
 		binlogWriter.Close()
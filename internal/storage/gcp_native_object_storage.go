@@ -154,6 +154,12 @@ func (gcs *GcpNativeObjectStorage) RemoveObject(ctx context.Context, bucketName,
 	return nil
 }
 
+func (gcs *GcpNativeObjectStorage) CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error {
+	bucket := gcs.client.Bucket(bucketName)
+	_, err := bucket.Object(dstObjectName).CopierFrom(bucket.Object(srcObjectName)).Run(ctx)
+	return checkObjectStorageError(srcObjectName, err)
+}
+
 func (gcs *GcpNativeObjectStorage) DeleteBucket(ctx context.Context, bucketName string) error {
 	bucket := gcs.client.Bucket(bucketName)
 
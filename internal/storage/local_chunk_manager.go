@@ -96,6 +96,31 @@ func (lcm *LocalChunkManager) Write(ctx context.Context, filePath string, conten
 	return WriteFile(filePath, content, os.ModePerm)
 }
 
+// Copy copies the file by streaming, the content is not loaded into memory at once.
+func (lcm *LocalChunkManager) Copy(ctx context.Context, srcFilePath string, dstFilePath string) error {
+	src, err := Open(srcFilePath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(path.Dir(dstFilePath), os.ModePerm); err != nil {
+		return merr.WrapErrIoFailed(dstFilePath, err)
+	}
+	dst, err := os.OpenFile(dstFilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return merr.WrapErrIoFailed(dstFilePath, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return merr.WrapErrIoFailed(dstFilePath, err)
+	}
+	if err := dst.Close(); err != nil {
+		return merr.WrapErrIoFailed(dstFilePath, err)
+	}
+	return nil
+}
+
 // MultiWrite writes the data to local storage.
 func (lcm *LocalChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	var el error
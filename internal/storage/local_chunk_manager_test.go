@@ -396,6 +396,31 @@ func TestLocalCM(t *testing.T) {
 		assert.NotNil(t, reader)
 	})
 
+	t.Run("test Copy", func(t *testing.T) {
+		testCopyRoot := "copy"
+
+		testCM := NewLocalChunkManager(objectstorage.RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		key := path.Join(localPath, testCopyRoot, "TestLocalKV_Copy_key")
+		value := []byte("TestLocalKV_Copy_value")
+		err := testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		dstKey := path.Join(localPath, testCopyRoot, "trash", "TestLocalKV_Copy_key")
+		err = testCM.Copy(ctx, key, dstKey)
+		assert.NoError(t, err)
+		got, err := testCM.Read(ctx, dstKey)
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+		exist, err := testCM.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		err = testCM.Copy(ctx, path.Join(localPath, testCopyRoot, "not_exist"), dstKey)
+		assert.Error(t, err)
+	})
+
 	t.Run("test Path", func(t *testing.T) {
 		testGetSizeRoot := "get_path"
 
@@ -108,3 +108,10 @@ func (minioObjectStorage *MinioObjectStorage) RemoveObject(ctx context.Context,
 	err := minioObjectStorage.Client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+func (minioObjectStorage *MinioObjectStorage) CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error {
+	_, err := minioObjectStorage.Client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: bucketName, Object: dstObjectName},
+		minio.CopySrcOptions{Bucket: bucketName, Object: srcObjectName})
+	return checkObjectStorageError(srcObjectName, err)
+}
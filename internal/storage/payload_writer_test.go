@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	binlogcompress "github.com/milvus-io/milvus/internal/storage/compress"
 )
 
 func TestPayloadWriter_Failed(t *testing.T) {
@@ -303,7 +304,7 @@ func TestParquetEncoding(t *testing.T) {
 	t.Run("test int64 pk", func(t *testing.T) {
 		field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
 
-		w, err := NewPayloadWriter(schemapb.DataType_Int64, WithWriterProps(getFieldWriterProps(field)))
+		w, err := NewPayloadWriter(schemapb.DataType_Int64, WithWriterProps(getFieldWriterProps(field, binlogcompress.Config{})))
 
 		assert.NoError(t, err)
 		err = w.AddDataToPayload([]int64{1, 2, 3}, nil)
@@ -317,7 +318,7 @@ func TestParquetEncoding(t *testing.T) {
 	t.Run("test string pk", func(t *testing.T) {
 		field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_String}
 
-		w, err := NewPayloadWriter(schemapb.DataType_String, WithWriterProps(getFieldWriterProps(field)))
+		w, err := NewPayloadWriter(schemapb.DataType_String, WithWriterProps(getFieldWriterProps(field, binlogcompress.Config{})))
 
 		assert.NoError(t, err)
 		err = w.AddOneStringToPayload("1", true)
@@ -65,6 +65,12 @@ func printBinlogFile(filename string) error {
 	b := make([]byte, fileInfo.Size())
 	at.ReadAt(b, 0)
 
+	return PrintBinlog(b)
+}
+
+// PrintBinlog prints the descriptor and all the events of the binlog content to stdout.
+// nolint
+func PrintBinlog(b []byte) error {
 	r, err := NewBinlogReader(b)
 	if err != nil {
 		return err
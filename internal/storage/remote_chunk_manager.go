@@ -55,6 +55,8 @@ type ObjectStorage interface {
 	// 2. underlying walking failed or context canceled, WalkWithPrefix will stop and return a error.
 	WalkWithObjects(ctx context.Context, bucketName string, prefix string, recursive bool, walkFunc ChunkObjectWalkFunc) error
 	RemoveObject(ctx context.Context, bucketName, objectName string) error
+	// CopyObject copies the object inside the bucket on the server side.
+	CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error
 }
 
 // RemoteChunkManager is responsible for read and write data stored in mminio.
@@ -307,6 +309,16 @@ func (mcm *RemoteChunkManager) RemoveWithPrefix(ctx context.Context, prefix stri
 	return err
 }
 
+// Copy copies the object on the server side, the data is not transferred through milvus.
+func (mcm *RemoteChunkManager) Copy(ctx context.Context, srcFilePath string, dstFilePath string) error {
+	err := mcm.client.CopyObject(ctx, mcm.bucketName, srcFilePath, dstFilePath)
+	if err != nil {
+		log.Warn("failed to copy object", zap.String("bucket", mcm.bucketName), zap.String("src", srcFilePath), zap.String("dst", dstFilePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (mcm *RemoteChunkManager) WalkWithPrefix(ctx context.Context, prefix string, recursive bool, walkFunc ChunkObjectWalkFunc) (err error) {
 	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataWalkLabel, metrics.TotalLabel).Inc()
 	logger := log.With(zap.String("prefix", prefix), zap.Bool("recursive", recursive))
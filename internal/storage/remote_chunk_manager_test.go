@@ -387,6 +387,34 @@ func TestMinioChunkManager(t *testing.T) {
 		assert.Equal(t, int64(0), size)
 	})
 
+	t.Run("test Copy", func(t *testing.T) {
+		testCopyRoot := path.Join(testMinIOKVRoot, "copy")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newMinioChunkManager(ctx, testBucket, testCopyRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testCopyRoot)
+
+		key := path.Join(testCopyRoot, "TestMinIOKV_Copy_key")
+		value := []byte("TestMinIOKV_Copy_value")
+		err = testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		dstKey := path.Join(testCopyRoot, "trash", "TestMinIOKV_Copy_key")
+		err = testCM.Copy(ctx, key, dstKey)
+		assert.NoError(t, err)
+		got, err := testCM.Read(ctx, dstKey)
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+		exist, err := testCM.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		err = testCM.Copy(ctx, path.Join(testCopyRoot, "not_exist"), dstKey)
+		assert.Error(t, err)
+	})
+
 	t.Run("test Path", func(t *testing.T) {
 		testGetPathRoot := path.Join(testMinIOKVRoot, "get_path")
 		ctx, cancel := context.WithCancel(context.Background())
@@ -841,6 +869,34 @@ func TestAzureChunkManager(t *testing.T) {
 		assert.Equal(t, int64(0), size)
 	})
 
+	t.Run("test Copy", func(t *testing.T) {
+		testCopyRoot := path.Join(testMinIOKVRoot, "copy")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testBucket, testCopyRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testCopyRoot)
+
+		key := path.Join(testCopyRoot, "TestMinIOKV_Copy_key")
+		value := []byte("TestMinIOKV_Copy_value")
+		err = testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		dstKey := path.Join(testCopyRoot, "trash", "TestMinIOKV_Copy_key")
+		err = testCM.Copy(ctx, key, dstKey)
+		assert.NoError(t, err)
+		got, err := testCM.Read(ctx, dstKey)
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+		exist, err := testCM.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		err = testCM.Copy(ctx, path.Join(testCopyRoot, "not_exist"), dstKey)
+		assert.Error(t, err)
+	})
+
 	t.Run("test Path", func(t *testing.T) {
 		testGetPathRoot := path.Join(testMinIOKVRoot, "get_path")
 		ctx, cancel := context.WithCancel(context.Background())
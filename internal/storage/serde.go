@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	binlogcompress "github.com/milvus-io/milvus/internal/storage/compress"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -517,18 +518,12 @@ var serdeMap = func() map[schemapb.DataType]serdeEntry {
 // Since parquet does not support custom fallback encoding for now,
 // we disable dict encoding for primary key.
 // It can be scale to all fields once parquet fallback encoding is available.
-func getFieldWriterProps(field *schemapb.FieldSchema) *parquet.WriterProperties {
+// The codec and level follow cfg, zero value means zstd with level 3.
+func getFieldWriterProps(field *schemapb.FieldSchema, cfg binlogcompress.Config) *parquet.WriterProperties {
 	if field.GetIsPrimaryKey() {
-		return parquet.NewWriterProperties(
-			parquet.WithCompression(compress.Codecs.Zstd),
-			parquet.WithCompressionLevel(3),
-			parquet.WithDictionaryDefault(false),
-		)
+		return cfg.WriterProperties(parquet.WithDictionaryDefault(false))
 	}
-	return parquet.NewWriterProperties(
-		parquet.WithCompression(compress.Codecs.Zstd),
-		parquet.WithCompressionLevel(3),
-	)
+	return cfg.WriterProperties()
 }
 
 type DeserializeReader[T any] interface {
@@ -767,10 +762,12 @@ func (mfw *multiFieldRecordWriter) Close() error {
 	return mfw.fw.Close()
 }
 
-func newMultiFieldRecordWriter(fieldIDs []FieldID, fields []arrow.Field, writer io.Writer) (*multiFieldRecordWriter, error) {
+func newMultiFieldRecordWriter(fieldIDs []FieldID, fields []arrow.Field, writer io.Writer, props ...parquet.WriterProperty) (*multiFieldRecordWriter, error) {
 	schema := arrow.NewSchema(fields, nil)
 	fw, err := pqarrow.NewFileWriter(schema, writer,
-		parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(math.MaxInt64)), // No additional grouping for now.
+		parquet.NewWriterProperties(append([]parquet.WriterProperty{
+			parquet.WithMaxRowGroupLength(math.MaxInt64), // No additional grouping for now.
+		}, props...)...),
 		pqarrow.DefaultWriterProps())
 	if err != nil {
 		return nil, err
@@ -28,12 +28,14 @@ import (
 	"github.com/apache/arrow/go/v17/arrow"
 	"github.com/apache/arrow/go/v17/arrow/array"
 	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/json"
+	binlogcompress "github.com/milvus-io/milvus/internal/storage/compress"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
@@ -323,7 +325,31 @@ func newDeltalogOneFieldReader(blobs []*Blob) (*DeserializeReaderImpl[*DeleteLog
 	}), nil
 }
 
+// StreamWriterOption configures binlog & deltalog stream writers.
+type StreamWriterOption func(*streamWriterOptions)
+
+type streamWriterOptions struct {
+	// codec and level of the written binlog, zero value means the default one.
+	compression binlogcompress.Config
+}
+
+// WithStreamCompression sets the codec and level used to write the binlog.
+func WithStreamCompression(cfg binlogcompress.Config) StreamWriterOption {
+	return func(o *streamWriterOptions) {
+		o.compression = cfg
+	}
+}
+
+func newStreamWriterOptions(opts ...StreamWriterOption) streamWriterOptions {
+	o := streamWriterOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 type BinlogStreamWriter struct {
+	streamWriterOptions
 	collectionID UniqueID
 	partitionID  UniqueID
 	segmentID    UniqueID
@@ -338,7 +364,7 @@ func (bsw *BinlogStreamWriter) GetRecordWriter() (RecordWriter, error) {
 		return bsw.rw, nil
 	}
 
-	rw, err := newSingleFieldRecordWriter(bsw.fieldSchema, &bsw.buf, WithRecordWriterProps(getFieldWriterProps(bsw.fieldSchema)))
+	rw, err := newSingleFieldRecordWriter(bsw.fieldSchema, &bsw.buf, WithRecordWriterProps(getFieldWriterProps(bsw.fieldSchema, bsw.compression)))
 	if err != nil {
 		return nil, err
 	}
@@ -399,15 +425,17 @@ func (bsw *BinlogStreamWriter) writeBinlogHeaders(w io.Writer) error {
 }
 
 func NewBinlogStreamWriters(collectionID, partitionID, segmentID UniqueID,
-	schema []*schemapb.FieldSchema,
+	schema []*schemapb.FieldSchema, opts ...StreamWriterOption,
 ) map[FieldID]*BinlogStreamWriter {
+	options := newStreamWriterOptions(opts...)
 	bws := make(map[FieldID]*BinlogStreamWriter, len(schema))
 	for _, f := range schema {
 		bws[f.FieldID] = &BinlogStreamWriter{
-			collectionID: collectionID,
-			partitionID:  partitionID,
-			segmentID:    segmentID,
-			fieldSchema:  f,
+			streamWriterOptions: options,
+			collectionID:        collectionID,
+			partitionID:         partitionID,
+			segmentID:           segmentID,
+			fieldSchema:         f,
 		}
 	}
 	return bws
@@ -552,7 +580,8 @@ func (c *CompositeBinlogRecordWriter) Write(r Record) error {
 
 func (c *CompositeBinlogRecordWriter) initWriters() error {
 	if c.rw == nil {
-		c.fieldWriters = NewBinlogStreamWriters(c.collectionID, c.partitionID, c.segmentID, c.schema.Fields)
+		c.fieldWriters = NewBinlogStreamWriters(c.collectionID, c.partitionID, c.segmentID, c.schema.Fields,
+			WithStreamCompression(binlogcompress.GetConfig(c.schema.GetProperties())))
 		rws := make(map[FieldID]RecordWriter, len(c.fieldWriters))
 		for fid, w := range c.fieldWriters {
 			rw, err := w.GetRecordWriter()
@@ -845,6 +874,7 @@ func NewBinlogSerializeWriter(schema *schemapb.CollectionSchema, partitionID, se
 }
 
 type DeltalogStreamWriter struct {
+	streamWriterOptions
 	collectionID UniqueID
 	partitionID  UniqueID
 	segmentID    UniqueID
@@ -858,7 +888,7 @@ func (dsw *DeltalogStreamWriter) GetRecordWriter() (RecordWriter, error) {
 	if dsw.rw != nil {
 		return dsw.rw, nil
 	}
-	rw, err := newSingleFieldRecordWriter(dsw.fieldSchema, &dsw.buf, WithRecordWriterProps(getFieldWriterProps(dsw.fieldSchema)))
+	rw, err := newSingleFieldRecordWriter(dsw.fieldSchema, &dsw.buf, WithRecordWriterProps(getFieldWriterProps(dsw.fieldSchema, dsw.compression)))
 	if err != nil {
 		return nil, err
 	}
@@ -915,11 +945,12 @@ func (dsw *DeltalogStreamWriter) writeDeltalogHeaders(w io.Writer) error {
 	return nil
 }
 
-func newDeltalogStreamWriter(collectionID, partitionID, segmentID UniqueID) *DeltalogStreamWriter {
+func newDeltalogStreamWriter(collectionID, partitionID, segmentID UniqueID, opts ...StreamWriterOption) *DeltalogStreamWriter {
 	return &DeltalogStreamWriter{
-		collectionID: collectionID,
-		partitionID:  partitionID,
-		segmentID:    segmentID,
+		streamWriterOptions: newStreamWriterOptions(opts...),
+		collectionID:        collectionID,
+		partitionID:         partitionID,
+		segmentID:           segmentID,
 		fieldSchema: &schemapb.FieldSchema{
 			FieldID:  common.RowIDField,
 			Name:     "delta",
@@ -1055,16 +1086,30 @@ func newSimpleArrowRecordReader(blobs []*Blob) (*simpleArrowRecordReader, error)
 	}, nil
 }
 
-func newMultiFieldDeltalogStreamWriter(collectionID, partitionID, segmentID UniqueID, pkType schemapb.DataType) *MultiFieldDeltalogStreamWriter {
+func newMultiFieldDeltalogStreamWriter(collectionID, partitionID, segmentID UniqueID, pkType schemapb.DataType, opts ...StreamWriterOption) *MultiFieldDeltalogStreamWriter {
 	return &MultiFieldDeltalogStreamWriter{
-		collectionID: collectionID,
-		partitionID:  partitionID,
-		segmentID:    segmentID,
-		pkType:       pkType,
+		streamWriterOptions: newStreamWriterOptions(opts...),
+		collectionID:        collectionID,
+		partitionID:         partitionID,
+		segmentID:           segmentID,
+		pkType:              pkType,
+	}
+}
+
+// multiFieldDeltalogWriterProps returns the compression properties of multi-field deltalogs,
+// they are written uncompressed unless the collection specifies a codec.
+func multiFieldDeltalogWriterProps(cfg binlogcompress.Config) []parquet.WriterProperty {
+	if cfg.IsZero() {
+		return nil
+	}
+	return []parquet.WriterProperty{
+		parquet.WithCompression(cfg.Compressor.Codec()),
+		parquet.WithCompressionLevel(cfg.Level),
 	}
 }
 
 type MultiFieldDeltalogStreamWriter struct {
+	streamWriterOptions
 	collectionID UniqueID
 	partitionID  UniqueID
 	segmentID    UniqueID
@@ -1093,7 +1138,7 @@ func (dsw *MultiFieldDeltalogStreamWriter) GetRecordWriter() (RecordWriter, erro
 		},
 	}
 
-	rw, err := newMultiFieldRecordWriter(fieldIDs, fields, &dsw.buf)
+	rw, err := newMultiFieldRecordWriter(fieldIDs, fields, &dsw.buf, multiFieldDeltalogWriterProps(dsw.compression)...)
 	if err != nil {
 		return nil, err
 	}
@@ -1275,14 +1320,15 @@ func CreateDeltalogReader(blobs []*Blob) (*DeserializeReaderImpl[*DeleteLog], er
 }
 
 func CreateDeltalogWriter(collectionID, partitionID, segmentID UniqueID, pkType schemapb.DataType, batchSize int,
+	opts ...StreamWriterOption,
 ) (*SerializeWriterImpl[*DeleteLog], func() (*Blob, error), error) {
 	format := paramtable.Get().DataNodeCfg.DeltalogFormat.GetValue()
 	if format == "json" {
-		eventWriter := newDeltalogStreamWriter(collectionID, partitionID, segmentID)
+		eventWriter := newDeltalogStreamWriter(collectionID, partitionID, segmentID, opts...)
 		writer, err := newDeltalogSerializeWriter(eventWriter, batchSize)
 		return writer, eventWriter.Finalize, err
 	} else if format == "parquet" {
-		eventWriter := newMultiFieldDeltalogStreamWriter(collectionID, partitionID, segmentID, pkType)
+		eventWriter := newMultiFieldDeltalogStreamWriter(collectionID, partitionID, segmentID, pkType, opts...)
 		writer, err := newDeltalogMultiFieldWriter(eventWriter, batchSize)
 		return writer, eventWriter.Finalize, err
 	}
@@ -30,6 +30,7 @@ import (
 	"github.com/apache/arrow/go/v17/arrow"
 	"github.com/apache/arrow/go/v17/arrow/array"
 	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
 	"github.com/apache/arrow/go/v17/parquet/file"
 	"github.com/apache/arrow/go/v17/parquet/pqarrow"
 	"github.com/samber/lo"
@@ -39,8 +40,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	binlogcompress "github.com/milvus-io/milvus/internal/storage/compress"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 )
 
 func TestBinlogDeserializeReader(t *testing.T) {
@@ -257,6 +260,67 @@ func TestBinlogValueWriter(t *testing.T) {
 	})
 }
 
+func TestBinlogMixedCompression(t *testing.T) {
+	size := 16
+	schema := generateTestSchema()
+	rekey := func(blobs []*Blob, logID int64) {
+		for _, b := range blobs {
+			fieldID, _ := parseBlobKey(b.Key)
+			b.Key = metautil.BuildInsertLogPath("", 0, 0, 0, fieldID, logID)
+		}
+	}
+
+	// chunk written with default zstd codec
+	allBlobs, err := generateTestData(size)
+	assert.NoError(t, err)
+	rekey(allBlobs, 1)
+
+	for i, name := range []string{binlogcompress.Lz4, binlogcompress.Snappy, binlogcompress.Brotli} {
+		cfg := binlogcompress.GetConfig([]*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKey, Value: name},
+		})
+		assert.Equal(t, name, cfg.Compressor.Name())
+
+		blobs, err := generateTestData(size)
+		assert.NoError(t, err)
+		reader, err := NewBinlogDeserializeReader(schema, MakeBlobsReader(blobs))
+		assert.NoError(t, err)
+
+		writers := NewBinlogStreamWriters(0, 0, 0, schema.Fields, WithStreamCompression(cfg))
+		writer, err := NewBinlogSerializeWriter(schema, 0, 0, writers, 7)
+		assert.NoError(t, err)
+		for j := 1; j <= size; j++ {
+			value, err := reader.NextValue()
+			assert.NoError(t, err)
+			assert.NoError(t, writer.WriteValue(*value))
+		}
+		reader.Close()
+		assert.NoError(t, writer.Close())
+
+		newBlobs := make([]*Blob, 0, len(writers))
+		for _, w := range writers {
+			assert.Equal(t, cfg.Compressor.Codec(), w.rw.writerProps.Compression())
+			blob, err := w.Finalize()
+			assert.NoError(t, err)
+			newBlobs = append(newBlobs, blob)
+		}
+		rekey(newBlobs, int64(i+2))
+		allBlobs = append(allBlobs, newBlobs...)
+	}
+
+	// codec of each chunk is detected by the reader
+	reader, err := NewBinlogDeserializeReader(schema, MakeBlobsReader(allBlobs))
+	assert.NoError(t, err)
+	defer reader.Close()
+	for i := 0; i < 4*size; i++ {
+		value, err := reader.NextValue()
+		assert.NoError(t, err)
+		assertTestData(t, i%size+1, *value)
+	}
+	_, err = reader.NextValue()
+	assert.Equal(t, io.EOF, err)
+}
+
 func TestSize(t *testing.T) {
 	t.Run("test array of int", func(t *testing.T) {
 		size := 100
@@ -570,6 +634,17 @@ func TestDeltalogPkTsSeparateFormat(t *testing.T) {
 		assert.NotNil(t, blob)
 	})
 
+	t.Run("test compression", func(t *testing.T) {
+		// uncompressed as before if the collection does not specify a codec
+		assert.Empty(t, multiFieldDeltalogWriterProps(binlogcompress.GetConfig(nil)))
+
+		cfg := binlogcompress.GetConfig([]*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKey, Value: binlogcompress.Lz4},
+		})
+		props := parquet.NewWriterProperties(multiFieldDeltalogWriterProps(cfg)...)
+		assert.Equal(t, binlogcompress.Lz4Raw, props.Compression())
+	})
+
 	testCases := []struct {
 		name     string
 		pkType   schemapb.DataType
@@ -42,6 +42,11 @@ type Balancer interface {
 	// Trigger is a hint to trigger a balance.
 	Trigger(ctx context.Context) error
 
+	// DryRunBalance runs the balance policy on the current layout without applying it,
+	// and returns the proposed assignment diff.
+	// If the policyName is empty, the configured balance policy will be used.
+	DryRunBalance(ctx context.Context, policyName string) (*DryRunResult, error)
+
 	// Close close the balancer.
 	Close()
 }
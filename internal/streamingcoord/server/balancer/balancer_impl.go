@@ -118,6 +118,42 @@ func (b *balancerImpl) Trigger(ctx context.Context) error {
 	return b.sendRequestAndWaitFinish(ctx, newOpTrigger(ctx))
 }
 
+// DryRunBalance runs the balance policy on the current layout without applying it.
+func (b *balancerImpl) DryRunBalance(ctx context.Context, policyName string) (*DryRunResult, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	if policyName == "" {
+		policyName = paramtable.Get().StreamingCfg.WALBalancerPolicyName.GetValue()
+	}
+	policyBuilder, err := getPolicy(policyName)
+	if err != nil {
+		return nil, err
+	}
+	// use a new policy instance to avoid the state of the working policy is modified.
+	policy := policyBuilder.Build()
+	policy.SetLogger(b.Logger().With(zap.String("dryRunPolicy", policyBuilder.Name())))
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	currentLayout, err := b.generateCurrentLayout(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expectedLayout, err := policy.Balance(currentLayout)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to dry run balance")
+	}
+	result := newDryRunResult(policyBuilder.Name(), currentLayout, expectedLayout)
+	b.Logger().Info("dry run balance done",
+		zap.String("dryRunPolicy", result.Policy),
+		zap.Int("unchangedCount", result.UnchangedCount),
+		zap.Int("changedCount", len(result.Changes)))
+	return result, nil
+}
+
 // sendRequestAndWaitFinish send a request to the background task and wait for it to finish.
 func (b *balancerImpl) sendRequestAndWaitFinish(ctx context.Context, newReq *request) error {
 	select {
@@ -327,20 +363,12 @@ func (b *balancerImpl) balanceUntilNoChanged(ctx context.Context) error {
 // Return a channel to notify the balance trigger again.
 func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 	b.Logger().Info("start to balance")
-	pchannelView := b.channelMetaManager.CurrentPChannelsView()
-
-	b.Logger().Info("collect all status...")
-	nodeStatus, err := resource.Resource().StreamingNodeManagerClient().CollectAllStatus(ctx)
+	currentLayout, err := b.generateCurrentLayout(ctx)
 	if err != nil {
-		return false, errors.Wrap(err, "fail to collect all status")
+		return false, err
 	}
 
 	// call the balance strategy to generate the expected layout.
-	accessMode := types.AccessModeRO
-	if b.channelMetaManager.IsStreamingEnabledOnce() {
-		accessMode = types.AccessModeRW
-	}
-	currentLayout := generateCurrentLayout(pchannelView, nodeStatus, accessMode)
 	expectedLayout, err := b.policy.Balance(currentLayout)
 	if err != nil {
 		return false, errors.Wrap(err, "fail to balance")
@@ -360,6 +388,23 @@ func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 	return true, b.applyBalanceResultToStreamingNode(ctx, modifiedChannels)
 }
 
+// generateCurrentLayout collects the status of all streaming nodes and generates the current layout.
+func (b *balancerImpl) generateCurrentLayout(ctx context.Context) (CurrentLayout, error) {
+	pchannelView := b.channelMetaManager.CurrentPChannelsView()
+
+	b.Logger().Info("collect all status...")
+	nodeStatus, err := resource.Resource().StreamingNodeManagerClient().CollectAllStatus(ctx)
+	if err != nil {
+		return CurrentLayout{}, errors.Wrap(err, "fail to collect all status")
+	}
+
+	accessMode := types.AccessModeRO
+	if b.channelMetaManager.IsStreamingEnabledOnce() {
+		accessMode = types.AccessModeRW
+	}
+	return generateCurrentLayout(pchannelView, nodeStatus, accessMode), nil
+}
+
 // applyBalanceResultToStreamingNode apply the balance result to streaming node.
 func (b *balancerImpl) applyBalanceResultToStreamingNode(ctx context.Context, modifiedChannels map[types.ChannelID]*channel.PChannelMeta) error {
 	b.Logger().Info("balance result need to be applied...", zap.Int("modifiedChannelCount", len(modifiedChannels)))
@@ -173,6 +173,20 @@ func TestBalancer(t *testing.T) {
 	b.Trigger(ctx)
 	checkReady()
 
+	// dry run should not change the assignment.
+	result, err := b.DryRunBalance(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "vchannelFair", result.Policy)
+	assert.Equal(t, 3, result.TotalChannels)
+	assert.Equal(t, 3, result.UnchangedCount)
+	assert.Empty(t, result.Changes)
+	result, err = b.DryRunBalance(ctx, "minMovement")
+	assert.NoError(t, err)
+	assert.Equal(t, "minMovement", result.Policy)
+	assert.Equal(t, 3, result.UnchangedCount)
+	_, err = b.DryRunBalance(ctx, "notExistPolicy")
+	assert.Error(t, err)
+
 	// create a inifite block watcher and can be interrupted by close of balancer.
 	f := syncutil.NewFuture[error]()
 	go func() {
@@ -186,6 +200,49 @@ func TestBalancer(t *testing.T) {
 
 	b.Close()
 	assert.ErrorIs(t, f.Get(), balancer.ErrBalancerClosed)
+	_, err = b.DryRunBalance(ctx, "")
+	assert.Error(t, err)
+}
+
+func TestCurrentLayoutSubLayout(t *testing.T) {
+	c1 := types.ChannelID{Name: "c1"}
+	c2 := types.ChannelID{Name: "c2"}
+	c3 := types.ChannelID{Name: "c3"}
+	layout := balancer.CurrentLayout{
+		Channels: map[types.ChannelID]types.PChannelInfo{
+			c1: {Name: "c1"},
+			c2: {Name: "c2"},
+			c3: {Name: "c3"},
+		},
+		Stats: map[types.ChannelID]channel.PChannelStatsView{
+			c1: {VChannels: map[string]int64{"vc1": 1}},
+			c2: {VChannels: map[string]int64{}},
+			c3: {VChannels: map[string]int64{}},
+		},
+		AllNodesInfo: map[int64]types.StreamingNodeStatus{
+			1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1}},
+			2: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 2}},
+		},
+		ChannelsToNodes: map[types.ChannelID]int64{
+			c1: 1,
+			c2: 2,
+		},
+		ExpectedAccessMode: map[types.ChannelID]types.AccessMode{
+			c1: types.AccessModeRW,
+			c2: types.AccessModeRW,
+			c3: types.AccessModeRW,
+		},
+	}
+
+	sub := layout.SubLayout([]types.ChannelID{c1, c2, {Name: "c4"}}, []int64{1, 3})
+	assert.Equal(t, 2, sub.TotalChannels())
+	assert.Equal(t, 1, sub.TotalNodes())
+	assert.Equal(t, 1, sub.TotalVChannels())
+	assert.Equal(t, int64(1), sub.ChannelsToNodes[c1])
+	// the channel assigned to the node out of sub layout should be seen as unassigned.
+	_, ok := sub.ChannelsToNodes[c2]
+	assert.False(t, ok)
+	assert.Len(t, sub.ExpectedAccessMode, 2)
 }
 
 func TestBalancer_WithRecoveryLag(t *testing.T) {
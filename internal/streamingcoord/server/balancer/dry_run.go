@@ -0,0 +1,45 @@
+package balancer
+
+import "sort"
+
+// DryRunResult is the result of a dry-run balance.
+type DryRunResult struct {
+	Policy         string              `json:"policy"`          // Policy is the name of the policy that generates the result.
+	TotalChannels  int                 `json:"total_channels"`  // TotalChannels is the count of all pchannels.
+	UnchangedCount int                 `json:"unchanged_count"` // UnchangedCount is the count of pchannels that keep the current assignment.
+	Changes        []DryRunChannelDiff `json:"changes"`         // Changes is the pchannels whose assignment will be changed.
+}
+
+// DryRunChannelDiff is the proposed assignment change of a pchannel.
+type DryRunChannelDiff struct {
+	Channel    string `json:"channel"`
+	AccessMode string `json:"access_mode"`
+	FromNodeID int64  `json:"from_node_id,omitempty"` // FromNodeID is 0 if the pchannel is not assigned to any available node now.
+	ToNodeID   int64  `json:"to_node_id"`
+}
+
+// newDryRunResult compares the current layout and the expected layout to generate the dry-run result.
+func newDryRunResult(policyName string, currentLayout CurrentLayout, expectedLayout ExpectedLayout) *DryRunResult {
+	result := &DryRunResult{
+		Policy:        policyName,
+		TotalChannels: currentLayout.TotalChannels(),
+		Changes:       make([]DryRunChannelDiff, 0),
+	}
+	for id, assignment := range expectedLayout.ChannelAssignment {
+		fromNodeID, assigned := currentLayout.ChannelsToNodes[id]
+		if assigned && fromNodeID == assignment.Node.ServerID && currentLayout.Channels[id].AccessMode == assignment.Channel.AccessMode {
+			result.UnchangedCount++
+			continue
+		}
+		result.Changes = append(result.Changes, DryRunChannelDiff{
+			Channel:    id.Name,
+			AccessMode: assignment.Channel.AccessMode.String(),
+			FromNodeID: fromNodeID,
+			ToNodeID:   assignment.Node.ServerID,
+		})
+	}
+	sort.Slice(result.Changes, func(i, j int) bool {
+		return result.Changes[i].Channel < result.Changes[j].Channel
+	})
+	return result
+}
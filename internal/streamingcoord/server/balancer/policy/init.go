@@ -2,9 +2,13 @@ package vchannelfair
 
 import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/minmovement"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/resourcegroup"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/vchannelfair"
 )
 
 func init() {
 	balancer.RegisterPolicy(&vchannelfair.PolicyBuilder{})
+	balancer.RegisterPolicy(&resourcegroup.PolicyBuilder{})
+	balancer.RegisterPolicy(&minmovement.PolicyBuilder{})
 }
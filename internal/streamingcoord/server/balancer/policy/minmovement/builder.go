@@ -0,0 +1,58 @@
+package minmovement
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/vchannelfair"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	policyName = "minMovement"
+)
+
+// PolicyBuilder is a builder to build min movement policy.
+type PolicyBuilder struct{}
+
+// Name returns the name of the min movement policy.
+func (b *PolicyBuilder) Name() string {
+	return policyName
+}
+
+// Build creates a new min movement policy.
+func (b *PolicyBuilder) Build() balancer.Policy {
+	cfg := newMinMovementPolicyConfig()
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
+	return &policy{
+		cfg:   cfg,
+		inner: (&vchannelfair.PolicyBuilder{}).Build(),
+	}
+}
+
+// newMinMovementPolicyConfig creates a new min movement policy config.
+func newMinMovementPolicyConfig() policyConfig {
+	params := paramtable.Get()
+	return policyConfig{
+		MaxReassignPerRound: params.StreamingCfg.WALBalancerPolicyMinMovementMaxReassignPerRound.GetAsInt(),
+		RoundInterval:       params.StreamingCfg.WALBalancerTriggerInterval.GetAsDurationByParse(),
+	}
+}
+
+// policyConfig is the config for min movement policy.
+type policyConfig struct {
+	MaxReassignPerRound int
+	RoundInterval       time.Duration
+}
+
+// Validate validates the min movement policy config.
+func (c policyConfig) Validate() error {
+	if c.MaxReassignPerRound < 0 || c.RoundInterval < 0 {
+		return errors.Errorf("invalid min movement policy config, %+v", c)
+	}
+	return nil
+}
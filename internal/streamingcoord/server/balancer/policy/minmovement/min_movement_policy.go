@@ -0,0 +1,123 @@
+package minmovement
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+)
+
+var _ balancer.Policy = &policy{}
+
+// policy is a policy that limits the pchannel movement of each balance round.
+// The target layout is generated by vchannel fair policy,
+// but only at most MaxReassignPerRound pchannels can be moved from one available streaming node to another in one round,
+// the pchannels that are not assigned to any available streaming node are always assigned.
+// A round is a time window of RoundInterval rather than a call of Balance,
+// because the balancer keeps calling Balance until the layout is not changed.
+// So the streaming nodes can be rolled out without mass pchannel churn.
+type policy struct {
+	log.Binder
+	cfg   policyConfig
+	inner balancer.Policy
+	moves []time.Time // the time of the pchannel movements in the current round.
+}
+
+// Name returns the name of the policy.
+func (p *policy) Name() string {
+	return policyName
+}
+
+// SetLogger sets the logger of the policy and the inner policy.
+func (p *policy) SetLogger(logger *log.MLogger) {
+	p.Binder.SetLogger(logger)
+	p.inner.SetLogger(logger)
+}
+
+// Balance generates the target layout by inner policy and caps the movement of pchannels.
+func (p *policy) Balance(currentLayout balancer.CurrentLayout) (balancer.ExpectedLayout, error) {
+	if currentLayout.TotalNodes() == 0 {
+		return balancer.ExpectedLayout{}, errors.New("no available streaming node")
+	}
+	// update policy configuration before balancing.
+	p.updatePolicyConfiguration()
+
+	target, err := p.inner.Balance(currentLayout)
+	if err != nil {
+		return balancer.ExpectedLayout{}, err
+	}
+
+	now := time.Now()
+	quota := p.movementQuota(now)
+	assignments := make(map[types.ChannelID]types.PChannelInfoAssigned, len(target.ChannelAssignment))
+	moved := make([]types.ChannelID, 0, quota)
+	skipped := 0
+	// Move the pchannels with more vchannels first to make the balance converge faster.
+	for _, channelID := range currentLayout.GetAllPChannelsSortedByVChannelCountDesc() {
+		assignment, ok := target.ChannelAssignment[channelID]
+		if !ok {
+			continue
+		}
+		currentNodeID, assigned := currentLayout.ChannelsToNodes[channelID]
+		if !assigned || currentNodeID == assignment.Node.ServerID {
+			assignments[channelID] = assignment
+			continue
+		}
+		if len(moved) < quota && currentLayout.AllowRebalance(channelID) {
+			assignments[channelID] = assignment
+			moved = append(moved, channelID)
+			continue
+		}
+		// keep the pchannel at current node.
+		assignment.Node = currentLayout.AllNodesInfo[currentNodeID].StreamingNodeInfo
+		assignments[channelID] = assignment
+		skipped++
+	}
+	for channelID, assignment := range target.ChannelAssignment {
+		// the pchannel without stats should never be moved.
+		if _, ok := assignments[channelID]; !ok {
+			if currentNodeID, assigned := currentLayout.ChannelsToNodes[channelID]; assigned {
+				assignment.Node = currentLayout.AllNodesInfo[currentNodeID].StreamingNodeInfo
+			}
+			assignments[channelID] = assignment
+		}
+	}
+	for range moved {
+		p.moves = append(p.moves, now)
+	}
+	if len(moved) > 0 || skipped > 0 {
+		p.Logger().Info("min movement policy limits the pchannel movement",
+			zap.Stringers("movedChannelIDs", moved),
+			zap.Int("skippedMovement", skipped),
+			zap.Int("movedInRound", len(p.moves)),
+			zap.Int("maxReassignPerRound", p.cfg.MaxReassignPerRound),
+			zap.Duration("roundInterval", p.cfg.RoundInterval))
+	}
+	return balancer.ExpectedLayout{ChannelAssignment: assignments}, nil
+}
+
+// movementQuota returns the count of pchannels that can still be moved in the round,
+// the movements out of the round are forgotten.
+func (p *policy) movementQuota(now time.Time) int {
+	expired := 0
+	for expired < len(p.moves) && now.Sub(p.moves[expired]) >= p.cfg.RoundInterval {
+		expired++
+	}
+	p.moves = p.moves[expired:]
+	return max(p.cfg.MaxReassignPerRound-len(p.moves), 0)
+}
+
+// updatePolicyConfiguration will update the policy configuration.
+func (p *policy) updatePolicyConfiguration() {
+	newCfg := newMinMovementPolicyConfig()
+	if err := newCfg.Validate(); err != nil {
+		p.Logger().Warn("invalid new incoming min movement policy config", zap.Any("new", newCfg))
+	} else if p.cfg != newCfg {
+		p.Logger().Info("min movement policy config updated", zap.Any("old", p.cfg), zap.Any("new", newCfg))
+		p.cfg = newCfg
+	}
+}
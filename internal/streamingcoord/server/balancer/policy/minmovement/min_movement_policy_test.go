@@ -0,0 +1,131 @@
+package minmovement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMinMovementPolicy(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	b := &PolicyBuilder{}
+	assert.Equal(t, "minMovement", b.Name())
+	p := b.Build()
+	p.SetLogger(log.With())
+	assert.Equal(t, "minMovement", p.Name())
+
+	_, err := p.Balance(balancer.CurrentLayout{})
+	assert.Error(t, err)
+
+	layout := newLayout(map[string]int64{
+		"c1": 1,
+		"c2": 1,
+		"c3": 1,
+		"c4": 1,
+		"c5": 1,
+		"c6": 1,
+		"c7": -1,
+	}, []int64{1, 2})
+
+	// at most one assigned channel can be moved, the unassigned channel is always assigned.
+	expected, err := p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 7)
+	assert.Equal(t, 1, countMoved(layout, expected))
+	assert.NotZero(t, expected.ChannelAssignment[newChannelID("c7")].Node.ServerID)
+
+	// the cap is applied to the round rather than the call of balance.
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 7)
+	assert.Equal(t, 0, countMoved(layout, expected))
+	assert.NotZero(t, expected.ChannelAssignment[newChannelID("c7")].Node.ServerID)
+
+	// the movements of last round are forgotten.
+	mp := p.(*policy)
+	mp.moves[0] = mp.moves[0].Add(-mp.cfg.RoundInterval)
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, countMoved(layout, expected))
+	assert.Len(t, mp.moves, 1)
+
+	params.Save(params.StreamingCfg.WALBalancerPolicyMinMovementMaxReassignPerRound.Key, "0")
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 7)
+	assert.Equal(t, 0, countMoved(layout, expected))
+
+	// invalid config should be ignored.
+	params.Save(params.StreamingCfg.WALBalancerPolicyMinMovementMaxReassignPerRound.Key, "-1")
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, countMoved(layout, expected))
+
+	params.Save(params.StreamingCfg.WALBalancerPolicyMinMovementMaxReassignPerRound.Key, "10")
+	defer params.Reset(params.StreamingCfg.WALBalancerPolicyMinMovementMaxReassignPerRound.Key)
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Greater(t, countMoved(layout, expected), 1)
+
+	// the channel that is not allowed to rebalance should never be moved.
+	layout.Config.AllowRebalance = false
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, countMoved(layout, expected))
+}
+
+func countMoved(layout balancer.CurrentLayout, expected balancer.ExpectedLayout) int {
+	cnt := 0
+	for id, assignment := range expected.ChannelAssignment {
+		if nodeID, ok := layout.ChannelsToNodes[id]; ok && nodeID != assignment.Node.ServerID {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func newChannelID(name string) types.ChannelID {
+	return types.ChannelID{Name: name}
+}
+
+// newLayout creates a new layout for test, the channel is unassigned if the node id is negative.
+func newLayout(channels map[string]int64, serverIDs []int64) balancer.CurrentLayout {
+	layout := balancer.CurrentLayout{
+		Config: balancer.CommonBalancePolicyConfig{
+			AllowRebalance:                     true,
+			AllowRebalanceRecoveryLagThreshold: 1 * time.Second,
+			MinRebalanceIntervalThreshold:      1 * time.Second,
+		},
+		Channels:           make(map[channel.ChannelID]types.PChannelInfo),
+		Stats:              make(map[channel.ChannelID]channel.PChannelStatsView),
+		AllNodesInfo:       make(map[int64]types.StreamingNodeStatus),
+		ChannelsToNodes:    make(map[types.ChannelID]int64),
+		ExpectedAccessMode: make(map[channel.ChannelID]types.AccessMode),
+	}
+	for _, id := range serverIDs {
+		layout.AllNodesInfo[id] = types.StreamingNodeStatus{
+			StreamingNodeInfo: types.StreamingNodeInfo{ServerID: id},
+		}
+	}
+	for c, node := range channels {
+		layout.Stats[newChannelID(c)] = channel.PChannelStatsView{VChannels: make(map[string]int64)}
+		if node > 0 {
+			layout.ChannelsToNodes[newChannelID(c)] = node
+		}
+		layout.Channels[newChannelID(c)] = types.PChannelInfo{
+			Name:       c,
+			AccessMode: types.AccessModeRW,
+		}
+		layout.ExpectedAccessMode[newChannelID(c)] = types.AccessModeRW
+	}
+	return layout
+}
@@ -0,0 +1,49 @@
+package resourcegroup
+
+import (
+	"maps"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/vchannelfair"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	policyName = "resourceGroupAware"
+)
+
+// PolicyBuilder is a builder to build resource group aware policy.
+type PolicyBuilder struct{}
+
+// Name returns the name of the resource group aware policy.
+func (b *PolicyBuilder) Name() string {
+	return policyName
+}
+
+// Build creates a new resource group aware policy.
+func (b *PolicyBuilder) Build() balancer.Policy {
+	return &policy{
+		cfg:   newResourceGroupPolicyConfig(),
+		inner: (&vchannelfair.PolicyBuilder{}).Build(),
+	}
+}
+
+// newResourceGroupPolicyConfig creates a new resource group aware policy config.
+func newResourceGroupPolicyConfig() policyConfig {
+	params := paramtable.Get()
+	return policyConfig{
+		NodeLabel:              params.StreamingCfg.WALBalancerPolicyResourceGroupNodeLabel.GetValue(),
+		PChannelResourceGroups: params.StreamingCfg.WALBalancerPolicyResourceGroupPChannels.GetAsJSONMap(),
+	}
+}
+
+// policyConfig is the config for resource group aware policy.
+type policyConfig struct {
+	NodeLabel              string            // NodeLabel is the server label key that indicates the resource group of the streaming node.
+	PChannelResourceGroups map[string]string // PChannelResourceGroups maps the pchannel name to the resource group.
+}
+
+// Equal returns true if the two configs are the same.
+func (c policyConfig) Equal(other policyConfig) bool {
+	return c.NodeLabel == other.NodeLabel && maps.Equal(c.PChannelResourceGroups, other.PChannelResourceGroups)
+}
@@ -0,0 +1,121 @@
+package resourcegroup
+
+import (
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+)
+
+// defaultResourceGroup is the resource group of the streaming node without resource group label,
+// and the resource group of the pchannel that is not pinned to any resource group.
+const defaultResourceGroup = ""
+
+// allNodesGroupName is the name of the group that contains all streaming nodes,
+// it's only used for logging when the pchannels fallback to all streaming nodes.
+const allNodesGroupName = "__all__"
+
+var _ balancer.Policy = &policy{}
+
+// policy is a policy that pins the pchannels to the streaming nodes of its resource group.
+// The streaming nodes are grouped by the server label, and the pchannels are balanced inside the group by vchannel fair policy.
+type policy struct {
+	log.Binder
+	cfg   policyConfig
+	inner balancer.Policy
+}
+
+// Name returns the name of the policy.
+func (p *policy) Name() string {
+	return policyName
+}
+
+// SetLogger sets the logger of the policy and the inner policy.
+func (p *policy) SetLogger(logger *log.MLogger) {
+	p.Binder.SetLogger(logger)
+	p.inner.SetLogger(logger)
+}
+
+// Balance groups the channels and nodes by resource group, and balance the channels in each group.
+func (p *policy) Balance(currentLayout balancer.CurrentLayout) (balancer.ExpectedLayout, error) {
+	if currentLayout.TotalNodes() == 0 {
+		return balancer.ExpectedLayout{}, errors.New("no available streaming node")
+	}
+	// update policy configuration before balancing.
+	p.updatePolicyConfiguration()
+
+	assignments := make(map[types.ChannelID]types.PChannelInfoAssigned, currentLayout.TotalChannels())
+	for _, g := range p.groupLayout(currentLayout) {
+		subLayout := currentLayout.SubLayout(g.channelIDs, g.nodeIDs)
+		expected, err := p.inner.Balance(subLayout)
+		if err != nil {
+			return balancer.ExpectedLayout{}, errors.Wrapf(err, "fail to balance resource group %s", g.name)
+		}
+		for channelID, assignment := range expected.ChannelAssignment {
+			assignments[channelID] = assignment
+		}
+	}
+	return balancer.ExpectedLayout{ChannelAssignment: assignments}, nil
+}
+
+// group is a set of pchannels and the streaming nodes that can serve them.
+type group struct {
+	name       string
+	nodeIDs    []int64
+	channelIDs []types.ChannelID
+}
+
+// groupLayout groups the streaming nodes by the resource group label, and the pchannels by the resource group that can serve it.
+// If there's no available streaming node in the resource group of the pchannel,
+// the pchannel will fallback to the default resource group, and then all streaming nodes.
+func (p *policy) groupLayout(currentLayout balancer.CurrentLayout) []*group {
+	groups := make(map[string]*group)
+	allNodes := &group{name: allNodesGroupName}
+	for nodeID, info := range currentLayout.AllNodesInfo {
+		rg := info.Labels[p.cfg.NodeLabel]
+		if _, ok := groups[rg]; !ok {
+			groups[rg] = &group{name: rg}
+		}
+		groups[rg].nodeIDs = append(groups[rg].nodeIDs, nodeID)
+		allNodes.nodeIDs = append(allNodes.nodeIDs, nodeID)
+	}
+
+	for channelID := range currentLayout.Channels {
+		rg := p.cfg.PChannelResourceGroups[channelID.Name]
+		if _, ok := groups[rg]; !ok && rg != defaultResourceGroup {
+			p.Logger().Warn("no available streaming node in resource group, fallback to default resource group",
+				zap.String("channel", channelID.Name),
+				zap.String("resourceGroup", rg))
+			rg = defaultResourceGroup
+		}
+		g, ok := groups[rg]
+		if !ok {
+			p.Logger().Warn("no available streaming node in default resource group, fallback to all streaming nodes",
+				zap.String("channel", channelID.Name))
+			g = allNodes
+		}
+		g.channelIDs = append(g.channelIDs, channelID)
+	}
+
+	result := make([]*group, 0, len(groups)+1)
+	for _, g := range groups {
+		if len(g.channelIDs) > 0 {
+			result = append(result, g)
+		}
+	}
+	if len(allNodes.channelIDs) > 0 {
+		result = append(result, allNodes)
+	}
+	return result
+}
+
+// updatePolicyConfiguration will update the policy configuration.
+func (p *policy) updatePolicyConfiguration() {
+	newCfg := newResourceGroupPolicyConfig()
+	if !p.cfg.Equal(newCfg) {
+		p.Logger().Info("resource group policy config updated", zap.Any("old", p.cfg), zap.Any("new", newCfg))
+		p.cfg = newCfg
+	}
+}
@@ -0,0 +1,123 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestResourceGroupPolicy(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALBalancerPolicyResourceGroupPChannels.Key, `{"c1": "rg1", "c2": "rg1", "c3": "rg2", "c4": "rg3"}`)
+	defer params.Reset(params.StreamingCfg.WALBalancerPolicyResourceGroupPChannels.Key)
+
+	b := &PolicyBuilder{}
+	assert.Equal(t, "resourceGroupAware", b.Name())
+	p := b.Build()
+	p.SetLogger(log.With())
+	assert.Equal(t, "resourceGroupAware", p.Name())
+
+	_, err := p.Balance(balancer.CurrentLayout{})
+	assert.Error(t, err)
+
+	// c1, c2 should be pinned to rg1, c3 to rg2,
+	// c4 should fallback to default group because there's no node in rg3.
+	expected, err := p.Balance(newLayout(map[string]int64{
+		"c1": 3,
+		"c2": -1,
+		"c3": -1,
+		"c4": -1,
+		"c5": 1,
+		"c6": -1,
+	}, map[int64]string{
+		1: "rg1",
+		2: "rg1",
+		3: "",
+		4: "rg2",
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 6)
+	assert.Contains(t, []int64{1, 2}, expected.ChannelAssignment[newChannelID("c1")].Node.ServerID)
+	assert.Contains(t, []int64{1, 2}, expected.ChannelAssignment[newChannelID("c2")].Node.ServerID)
+	assert.NotEqual(t, expected.ChannelAssignment[newChannelID("c1")].Node.ServerID, expected.ChannelAssignment[newChannelID("c2")].Node.ServerID)
+	assert.Equal(t, int64(4), expected.ChannelAssignment[newChannelID("c3")].Node.ServerID)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[newChannelID("c4")].Node.ServerID)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[newChannelID("c5")].Node.ServerID)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[newChannelID("c6")].Node.ServerID)
+
+	// no default group, the unpinned channels should fallback to all nodes.
+	expected, err = p.Balance(newLayout(map[string]int64{
+		"c1": -1,
+		"c3": -1,
+		"c5": -1,
+	}, map[int64]string{
+		1: "rg1",
+		4: "rg2",
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 3)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[newChannelID("c1")].Node.ServerID)
+	assert.Equal(t, int64(4), expected.ChannelAssignment[newChannelID("c3")].Node.ServerID)
+	assert.Contains(t, []int64{1, 4}, expected.ChannelAssignment[newChannelID("c5")].Node.ServerID)
+
+	// config can be updated dynamically.
+	params.Save(params.StreamingCfg.WALBalancerPolicyResourceGroupNodeLabel.Key, "ZONE")
+	defer params.Reset(params.StreamingCfg.WALBalancerPolicyResourceGroupNodeLabel.Key)
+	expected, err = p.Balance(newLayout(map[string]int64{
+		"c1": -1,
+	}, map[int64]string{
+		1: "rg1",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[newChannelID("c1")].Node.ServerID)
+}
+
+func newChannelID(name string) types.ChannelID {
+	return types.ChannelID{Name: name}
+}
+
+// newLayout creates a new layout for test, the channel is unassigned if the node id is negative.
+func newLayout(channels map[string]int64, nodes map[int64]string) balancer.CurrentLayout {
+	layout := balancer.CurrentLayout{
+		Config: balancer.CommonBalancePolicyConfig{
+			AllowRebalance:                     true,
+			AllowRebalanceRecoveryLagThreshold: 1 * time.Second,
+			MinRebalanceIntervalThreshold:      1 * time.Second,
+		},
+		Channels:           make(map[channel.ChannelID]types.PChannelInfo),
+		Stats:              make(map[channel.ChannelID]channel.PChannelStatsView),
+		AllNodesInfo:       make(map[int64]types.StreamingNodeStatus),
+		ChannelsToNodes:    make(map[types.ChannelID]int64),
+		ExpectedAccessMode: make(map[channel.ChannelID]types.AccessMode),
+	}
+	label := paramtable.Get().StreamingCfg.WALBalancerPolicyResourceGroupNodeLabel.GetValue()
+	for id, rg := range nodes {
+		info := types.StreamingNodeStatus{
+			StreamingNodeInfo: types.StreamingNodeInfo{ServerID: id},
+		}
+		if rg != "" {
+			info.Labels = map[string]string{label: rg}
+		}
+		layout.AllNodesInfo[id] = info
+	}
+	for c, node := range channels {
+		layout.Stats[newChannelID(c)] = channel.PChannelStatsView{VChannels: make(map[string]int64)}
+		if node > 0 {
+			layout.ChannelsToNodes[newChannelID(c)] = node
+		}
+		layout.Channels[newChannelID(c)] = types.PChannelInfo{
+			Name:       c,
+			AccessMode: types.AccessModeRW,
+		}
+		layout.ExpectedAccessMode[newChannelID(c)] = types.AccessModeRW
+	}
+	return layout
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
@@ -69,6 +70,44 @@ func (layout *CurrentLayout) TotalVChannelsOfCollection() map[int64]int {
 	return cnt
 }
 
+// SubLayout returns a sub layout that only contains the given channels and nodes.
+// The assignment of a channel to a node that is not in the sub layout will be dropped,
+// so the channel will be seen as a new incoming channel by the policy.
+func (layout *CurrentLayout) SubLayout(channelIDs []types.ChannelID, nodeIDs []int64) CurrentLayout {
+	sub := CurrentLayout{
+		Config:             layout.Config,
+		Channels:           make(map[channel.ChannelID]types.PChannelInfo, len(channelIDs)),
+		Stats:              make(map[channel.ChannelID]channel.PChannelStatsView, len(channelIDs)),
+		AllNodesInfo:       make(map[int64]types.StreamingNodeStatus, len(nodeIDs)),
+		ChannelsToNodes:    make(map[types.ChannelID]int64, len(channelIDs)),
+		ExpectedAccessMode: make(map[channel.ChannelID]types.AccessMode, len(channelIDs)),
+	}
+	for _, nodeID := range nodeIDs {
+		if info, ok := layout.AllNodesInfo[nodeID]; ok {
+			sub.AllNodesInfo[nodeID] = info
+		}
+	}
+	for _, id := range channelIDs {
+		info, ok := layout.Channels[id]
+		if !ok {
+			continue
+		}
+		sub.Channels[id] = info
+		if stats, ok := layout.Stats[id]; ok {
+			sub.Stats[id] = stats
+		}
+		if mode, ok := layout.ExpectedAccessMode[id]; ok {
+			sub.ExpectedAccessMode[id] = mode
+		}
+		if nodeID, ok := layout.ChannelsToNodes[id]; ok {
+			if _, ok := sub.AllNodesInfo[nodeID]; ok {
+				sub.ChannelsToNodes[id] = nodeID
+			}
+		}
+	}
+	return sub
+}
+
 // TotalNodes returns the total number of nodes in the layout.
 func (layout *CurrentLayout) TotalNodes() int {
 	return len(layout.AllNodesInfo)
@@ -193,9 +232,18 @@ func RegisterPolicy(b PolicyBuilder) {
 
 // mustGetPolicy returns the walimpls builder by name.
 func mustGetPolicy(name string) PolicyBuilder {
+	b, err := getPolicy(name)
+	if err != nil {
+		panic(err.Error())
+	}
+	return b
+}
+
+// getPolicy returns the policy builder by name, return error if the policy is not registered.
+func getPolicy(name string) (PolicyBuilder, error) {
 	b, ok := policiesBuilders.Get(name)
 	if !ok {
-		panic("policy not found: " + name)
+		return nil, errors.Errorf("policy not found: %s", name)
 	}
-	return b
+	return b, nil
 }
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+)
+
+// this file contains streamingcoord management restful API handler
+var mgrRouteRegisterOnce sync.Once
+
+// registerMgrRoute registers the management restful api of streamingcoord into the http server of current process.
+func (s *Server) registerMgrRoute() {
+	mgrRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        management.RouteWALBalanceDryRun,
+			HandlerFunc: s.DryRunWALBalance,
+		})
+	})
+}
+
+// DryRunWALBalance runs the wal balance policy on the current layout without applying it,
+// the policy can be specified by the query parameter `policy`, the configured one is used if not specified.
+func (s *Server) DryRunWALBalance(w http.ResponseWriter, req *http.Request) {
+	if !s.balancer.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"msg": "failed to dry run wal balance, balancer is not ready"}`))
+		return
+	}
+	policyName := req.URL.Query().Get("policy")
+	result, err := s.balancer.Get().DryRunBalance(req.Context(), policyName)
+	if err != nil {
+		s.logger.Warn("failed to dry run wal balance", zap.String("policy", policyName), zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to dry run wal balance, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to marshal dry run result, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
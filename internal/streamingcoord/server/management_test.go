@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+func TestDryRunWALBalance(t *testing.T) {
+	s := &Server{
+		logger:   log.With(),
+		balancer: syncutil.NewFuture[balancer.Balancer](),
+	}
+	dryRun := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.DryRunWALBalance(w, httptest.NewRequest(http.MethodGet, management.RouteWALBalanceDryRun+query, nil))
+		return w
+	}
+
+	// balancer is not ready.
+	assert.Equal(t, http.StatusServiceUnavailable, dryRun("").Code)
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().DryRunBalance(mock.Anything, "minMovement").Return(&balancer.DryRunResult{
+		Policy:         "minMovement",
+		TotalChannels:  2,
+		UnchangedCount: 1,
+		Changes:        []balancer.DryRunChannelDiff{{Channel: "c1", AccessMode: "rw", FromNodeID: 1, ToNodeID: 2}},
+	}, nil)
+	b.EXPECT().DryRunBalance(mock.Anything, "").Return(nil, errors.New("policy not found"))
+	s.balancer.Set(b)
+
+	w := dryRun("?policy=minMovement")
+	assert.Equal(t, http.StatusOK, w.Code)
+	result := &balancer.DryRunResult{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+	assert.Equal(t, "minMovement", result.Policy)
+	assert.Equal(t, 1, result.UnchangedCount)
+	assert.Equal(t, int64(2), result.Changes[0].ToNodeID)
+
+	w = dryRun("")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "policy not found")
+}
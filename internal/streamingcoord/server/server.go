@@ -45,6 +45,7 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		s.logger.Warn("init basic component of streamingcoord failed", zap.Error(err))
 		return err
 	}
+	s.registerMgrRoute()
 	// Init all grpc service of streamingcoord server.
 	s.logger.Info("streamingcoord initialized")
 	return nil
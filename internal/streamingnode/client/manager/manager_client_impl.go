@@ -108,6 +108,7 @@ func (c *managerClientImpl) getAllStreamingNodeStatus(ctx context.Context, state
 	for serverID, session := range state.Sessions() {
 		serverID := serverID
 		address := session.Address
+		labels := session.ServerLabels
 		g.Go(func() error {
 			ctx := contextutil.WithPickServerID(ctx, serverID)
 			resp, err := manager.CollectStatus(ctx, &streamingpb.StreamingNodeManagerCollectStatusRequest{})
@@ -124,6 +125,7 @@ func (c *managerClientImpl) getAllStreamingNodeStatus(ctx context.Context, state
 					Address:  address,
 				},
 				Metrics: types.NewStreamingNodeBalanceAttrsFromProto(resp.Metrics),
+				Labels:  labels,
 				Err:     err,
 			}
 			log.Debug("collect status success", zap.Int64("serverID", serverID), zap.Any("status", resp))
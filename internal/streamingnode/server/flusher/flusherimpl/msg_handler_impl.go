@@ -104,3 +104,8 @@ func (impl *msgHandlerImpl) HandleManualFlush(flushMsg message.ImmutableManualFl
 func (impl *msgHandlerImpl) HandleSchemaChange(ctx context.Context, msg message.ImmutableSchemaChangeMessageV2) error {
 	return impl.wbMgr.SealSegments(context.Background(), msg.VChannel(), msg.Header().FlushedSegmentIds)
 }
+
+func (impl *msgHandlerImpl) HandleTruncateCollection(ctx context.Context, msg message.ImmutableTruncateCollectionMessageV2) error {
+	impl.wbMgr.TruncateSegments(msg.VChannel())
+	return nil
+}
@@ -133,3 +133,27 @@ func TestFlushMsgHandler_HandlSchemaChange(t *testing.T) {
 	err = handler.HandleSchemaChange(context.Background(), im)
 	assert.NoError(t, err)
 }
+
+func TestFlushMsgHandler_HandleTruncateCollection(t *testing.T) {
+	vchannel := "ch-0"
+
+	wbMgr := writebuffer.NewMockBufferManager(t)
+	wbMgr.EXPECT().TruncateSegments(vchannel).Return()
+
+	msg := message.NewTruncateCollectionMessageBuilderV2().
+		WithBroadcast([]string{vchannel}).
+		WithHeader(&message.TruncateCollectionMessageHeader{
+			CollectionId: 0,
+			SegmentIds:   []int64{1},
+		}).
+		WithBody(&message.TruncateCollectionMessageBody{}).
+		MustBuildBroadcast().
+		WithBroadcastID(1).
+		SplitIntoMutableMessage()[0]
+
+	handler := newMsgHandler(wbMgr)
+	msgID := mock_message.NewMockMessageID(t)
+	im := message.MustAsImmutableTruncateCollectionMessageV2(msg.IntoImmutableMessage(msgID))
+	err := handler.HandleTruncateCollection(context.Background(), im)
+	assert.NoError(t, err)
+}
@@ -31,16 +31,17 @@ type shardInterceptor struct {
 // initOpTable initializes the operation table for the segment interceptor.
 func (impl *shardInterceptor) initOpTable() {
 	impl.ops = map[message.MessageType]interceptors.AppendInterceptorCall{
-		message.MessageTypeCreateCollection: impl.handleCreateCollection,
-		message.MessageTypeDropCollection:   impl.handleDropCollection,
-		message.MessageTypeCreatePartition:  impl.handleCreatePartition,
-		message.MessageTypeDropPartition:    impl.handleDropPartition,
-		message.MessageTypeInsert:           impl.handleInsertMessage,
-		message.MessageTypeDelete:           impl.handleDeleteMessage,
-		message.MessageTypeManualFlush:      impl.handleManualFlushMessage,
-		message.MessageTypeSchemaChange:     impl.handleSchemaChange,
-		message.MessageTypeCreateSegment:    impl.handleCreateSegment,
-		message.MessageTypeFlush:            impl.handleFlushSegment,
+		message.MessageTypeCreateCollection:   impl.handleCreateCollection,
+		message.MessageTypeDropCollection:     impl.handleDropCollection,
+		message.MessageTypeCreatePartition:    impl.handleCreatePartition,
+		message.MessageTypeDropPartition:      impl.handleDropPartition,
+		message.MessageTypeInsert:             impl.handleInsertMessage,
+		message.MessageTypeDelete:             impl.handleDeleteMessage,
+		message.MessageTypeManualFlush:        impl.handleManualFlushMessage,
+		message.MessageTypeSchemaChange:       impl.handleSchemaChange,
+		message.MessageTypeTruncateCollection: impl.handleTruncateCollection,
+		message.MessageTypeCreateSegment:      impl.handleCreateSegment,
+		message.MessageTypeFlush:              impl.handleFlushSegment,
 	}
 }
 
@@ -241,6 +242,23 @@ func (impl *shardInterceptor) handleSchemaChange(ctx context.Context, msg messag
 	return appendOp(ctx, msg)
 }
 
+// handleTruncateCollection handles the truncate collection message.
+func (impl *shardInterceptor) handleTruncateCollection(ctx context.Context, msg message.MutableMessage, appendOp interceptors.Append) (message.MessageID, error) {
+	truncateMsg := message.MustAsMutableTruncateCollectionMessageV2(msg)
+	header := truncateMsg.Header()
+	segmentIDs, err := impl.shardManager.FlushAndFenceSegmentAllocUntil(header.GetCollectionId(), msg.TimeTick())
+	if err != nil {
+		return nil, status.NewUnrecoverableError(err.Error())
+	}
+
+	// Modify the header of truncate message, carry with the all growing segment ids before truncate,
+	// the data of these segments should be dropped by the consumer.
+	header.SegmentIds = segmentIDs
+	truncateMsg.OverwriteHeader(header)
+
+	return appendOp(ctx, msg)
+}
+
 // handleCreateSegment handles the create segment message.
 func (impl *shardInterceptor) handleCreateSegment(ctx context.Context, msg message.MutableMessage, appendOp interceptors.Append) (message.MessageID, error) {
 	createSegmentMsg := message.MustAsMutableCreateSegmentMessageV2(msg)
@@ -252,4 +252,24 @@ func TestShardInterceptor(t *testing.T) {
 	msgID, err = i.DoAppend(ctx, msg, appender)
 	assert.Error(t, err)
 	assert.Nil(t, msgID)
+
+	msg = message.NewTruncateCollectionMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&messagespb.TruncateCollectionMessageHeader{
+			CollectionId: 1,
+		}).
+		WithBody(&messagespb.TruncateCollectionMessageBody{}).
+		MustBuildMutable().WithTimeTick(1)
+	shardManager.EXPECT().FlushAndFenceSegmentAllocUntil(mock.Anything, mock.Anything).Unset()
+	shardManager.EXPECT().FlushAndFenceSegmentAllocUntil(mock.Anything, mock.Anything).Return([]int64{1, 2}, nil)
+	msgID, err = i.DoAppend(ctx, msg, appender)
+	assert.NoError(t, err)
+	assert.NotNil(t, msgID)
+	assert.Equal(t, []int64{1, 2}, message.MustAsMutableTruncateCollectionMessageV2(msg).Header().GetSegmentIds())
+
+	shardManager.EXPECT().FlushAndFenceSegmentAllocUntil(mock.Anything, mock.Anything).Unset()
+	shardManager.EXPECT().FlushAndFenceSegmentAllocUntil(mock.Anything, mock.Anything).Return(nil, mockErr)
+	msgID, err = i.DoAppend(ctx, msg, appender)
+	assert.Error(t, err)
+	assert.Nil(t, msgID)
 }
@@ -259,6 +259,9 @@ func (r *recoveryStorageImpl) handleMessage(msg message.ImmutableMessage) {
 	case message.MessageTypeSchemaChange:
 		immutableMsg := message.MustAsImmutableCollectionSchemaChangeV2(msg)
 		r.handleSchemaChange(immutableMsg)
+	case message.MessageTypeTruncateCollection:
+		immutableMsg := message.MustAsImmutableTruncateCollectionMessageV2(msg)
+		r.handleTruncateCollection(immutableMsg)
 	case message.MessageTypeTimeTick:
 		// nothing, the time tick message make no recovery operation.
 	default:
@@ -425,6 +428,16 @@ func (r *recoveryStorageImpl) handleSchemaChange(msg message.ImmutableSchemaChan
 	// TODO: persist the schema change into recoveryinfo
 }
 
+// handleTruncateCollection handles the truncate collection message.
+func (r *recoveryStorageImpl) handleTruncateCollection(msg message.ImmutableTruncateCollectionMessageV2) {
+	// the growing segments before truncate are fenced by the wal, so they can be seen as flushed.
+	segments := make(map[int64]struct{}, len(msg.Header().SegmentIds))
+	for _, segmentID := range msg.Header().SegmentIds {
+		segments[segmentID] = struct{}{}
+	}
+	r.flushSegments(msg, segments)
+}
+
 // detectInconsistency detects the inconsistency in the recovery storage.
 func (r *recoveryStorageImpl) detectInconsistency(msg message.ImmutableMessage, reason string, extra ...zap.Field) {
 	fields := make([]zap.Field, 0, len(extra)+2)
@@ -272,6 +272,7 @@ func (b *streamBuilder) generateStreamMessage() []message.ImmutableMessage {
 		{op: b.createTxn, rate: 5},
 		{op: b.createManualFlush, rate: 2},
 		{op: b.createSchemaChange, rate: 1},
+		{op: b.createTruncateCollection, rate: 1},
 	}
 	ops := make([]func() message.ImmutableMessage, 0)
 	for _, opRate := range opRates {
@@ -589,6 +590,34 @@ func (b *streamBuilder) createSchemaChange() message.ImmutableMessage {
 	return nil
 }
 
+func (b *streamBuilder) createTruncateCollection() message.ImmutableMessage {
+	for collectionID, collection := range b.collectionIDs {
+		if rand.Int31n(3) < 1 {
+			continue
+		}
+		segmentIDs := make([]int64, 0)
+		for partitionID := range collection {
+			for segmentID := range collection[partitionID] {
+				segmentIDs = append(segmentIDs, segmentID)
+				delete(collection[partitionID], segmentID)
+			}
+		}
+		b.nextMessage()
+		return message.NewTruncateCollectionMessageBuilderV2().
+			WithVChannel(b.vchannels[collectionID]).
+			WithHeader(&message.TruncateCollectionMessageHeader{
+				CollectionId: collectionID,
+				SegmentIds:   segmentIDs,
+			}).
+			WithBody(&message.TruncateCollectionMessageBody{}).
+			MustBuildMutable().
+			WithTimeTick(b.timetick).
+			WithLastConfirmed(rmq.NewRmqID(b.lastConfirmedMessageID)).
+			IntoImmutableMessage(rmq.NewRmqID(b.messageID))
+	}
+	return nil
+}
+
 func (b *streamBuilder) createInsert() message.ImmutableMessage {
 	for collectionID, collection := range b.collectionIDs {
 		if rand.Int31n(3) < 1 {
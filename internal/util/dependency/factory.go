@@ -21,6 +21,8 @@ const (
 	mqTypeKafka      = "kafka"
 	mqTypePulsar     = "pulsar"
 	mqTypeWoodpecker = "woodpecker"
+	mqTypeFileWAL    = "filewal"
+	mqTypeNats       = "nats"
 )
 
 type mqEnable struct {
@@ -93,7 +95,9 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 		f.msgStreamFactory = msgstream.NewPmsFactory(&params.ServiceParam)
 	case mqTypeKafka:
 		f.msgStreamFactory = msgstream.NewKmsFactory(&params.ServiceParam)
-	case mqTypeWoodpecker:
+	case mqTypeWoodpecker, mqTypeFileWAL, mqTypeNats:
+		// woodpecker, filewal and nats are only available as the wal of streaming service,
+		// so the msgstream of them is a no-op implementation.
 		f.msgStreamFactory = msgstream.NewWpmsFactory(&params.ServiceParam)
 	}
 	if f.msgStreamFactory == nil {
@@ -131,11 +135,11 @@ func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 
 // Validate mq type.
 func validateMQType(standalone bool, mqType string) error {
-	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker {
+	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker && mqType != mqTypeFileWAL && mqType != mqTypeNats {
 		return errors.Newf("mq type %s is invalid", mqType)
 	}
-	if !standalone && mqType == mqTypeRocksmq {
-		return errors.Newf("mq %s is only valid in standalone mode")
+	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypeFileWAL) {
+		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
 }
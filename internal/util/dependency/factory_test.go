@@ -14,6 +14,10 @@ func TestValidateMQType(t *testing.T) {
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
 	assert.NoError(t, validateMQType(true, mqTypeWoodpecker))
 	assert.NoError(t, validateMQType(false, mqTypeWoodpecker))
+	assert.NoError(t, validateMQType(true, mqTypeFileWAL))
+	assert.Error(t, validateMQType(false, mqTypeFileWAL))
+	assert.NoError(t, validateMQType(true, mqTypeNats))
+	assert.NoError(t, validateMQType(false, mqTypeNats))
 }
 
 func TestSelectMQType(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 type TextEmbeddingMode int
@@ -103,6 +104,20 @@ const (
 	cohereAIAKEnvStr string = "MILVUSAI_COHERE_API_KEY"
 )
 
+// rerank services, cohere and voyageai share the api keys with their embedding providers
+
+const (
+	CohereRerankAKEnvStr     string = cohereAIAKEnvStr
+	VoyageAIRerankAKEnvStr   string = voyageAIAKEnvStr
+	JinaAIAKEnvStr           string = "MILVUSAI_JINAAI_API_KEY"
+	OpenAICompatibleAKEnvStr string = "MILVUSAI_OPENAI_COMPATIBLE_API_KEY"
+
+	EnableCohereRerankEnvStr     string = "MILVUSAI_ENABLE_COHERE_RERANK"
+	EnableVoyageAIRerankEnvStr   string = "MILVUSAI_ENABLE_VOYAGEAI_RERANK"
+	EnableJinaAIRerankEnvStr     string = "MILVUSAI_ENABLE_JINAAI_RERANK"
+	EnableOpenAICompatibleEnvStr string = "MILVUSAI_ENABLE_OPENAI_COMPATIBLE_RERANK"
+)
+
 // siliconflow
 
 const (
@@ -122,6 +137,25 @@ const (
 	EnableVllmEnvStr string = "MILVUSAI_ENABLE_VLLM"
 )
 
+// onnx
+
+const (
+	modelPathParamKey string = "model_path"
+	maxLengthParamKey string = "max_length"
+	poolingParamKey   string = "pooling"
+
+	enableConfKey         string = "enable"
+	libraryPathConfKey    string = "library_path"
+	cacheDirConfKey       string = "cache_dir"
+	intraOpThreadsConfKey string = "intra_op_threads"
+)
+
+// ParseRerankAKAndURL returns the api key and url of a rerank service, in the same order as the embedding providers:
+// function param > milvus.yaml > env.
+func ParseRerankAKAndURL(params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
+	return parseAKAndURL(credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()), params, confParams, apiKeyEnv)
+}
+
 func parseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
 	// function param > yaml > env
 	var err error
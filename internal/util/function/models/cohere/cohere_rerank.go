@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+type RerankRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+
+	Query string `json:"query"`
+
+	Documents []string `json:"documents"`
+
+	// Long documents will be automatically truncated to the specified number of tokens.
+	MaxTokensPerDoc int64 `json:"max_tokens_per_doc,omitempty"`
+}
+
+type RerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+type RerankResponse struct {
+	Id      string         `json:"id"`
+	Results []RerankResult `json:"results"`
+}
+
+// Rerank calls the rerank api of cohere with the client, the url of the client should be the rerank url.
+// The results are ordered by relevance score as returned by the service.
+func (c *CohereEmbedding) Rerank(ctx context.Context, modelName string, query string, documents []string, maxTokensPerDoc int64, timeoutSec int64) (*RerankResponse, error) {
+	var r RerankRequest
+	r.Model = modelName
+	r.Query = query
+	r.Documents = documents
+	r.MaxTokensPerDoc = maxTokensPerDoc
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"accept":        "application/json",
+		"Content-Type":  "application/json",
+		"Authorization": fmt.Sprintf("bearer %s", c.apiKey),
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
+	if err != nil {
+		return nil, err
+	}
+	var res RerankResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, err
+}
@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankOK(t *testing.T) {
+	var req RerankRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "mock", "results": [{"index": 1, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}]}`))
+	}))
+	defer ts.Close()
+
+	c := NewCohereEmbeddingClient("mock_key", ts.URL)
+	ret, err := c.Rerank(context.Background(), "rerank-v3.5", "query", []string{"t1", "t2"}, 512, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []RerankResult{{Index: 1, RelevanceScore: 0.9}, {Index: 0, RelevanceScore: 0.1}}, ret.Results)
+	assert.Equal(t, RerankRequest{Model: "rerank-v3.5", Query: "query", Documents: []string{"t1", "t2"}, MaxTokensPerDoc: 512}, req)
+}
+
+func TestRerankFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c := NewCohereEmbeddingClient("mock_key", ts.URL)
+	_, err := c.Rerank(context.Background(), "rerank-v3.5", "query", []string{"t1"}, 0, 0)
+	assert.Error(t, err)
+}
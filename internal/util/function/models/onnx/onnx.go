@@ -0,0 +1,341 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package onnx
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ort "github.com/yalue/onnxruntime_go"
+)
+
+const (
+	ModelFile = "model.onnx"
+
+	inputIDsName      = "input_ids"
+	attentionMaskName = "attention_mask"
+	tokenTypeIDsName  = "token_type_ids"
+
+	sentenceEmbeddingName = "sentence_embedding"
+)
+
+// modelIdleTimeout is how long a loaded model is kept after its last use,
+// the sessions of dropped functions or collections are released after it.
+const modelIdleTimeout = 10 * time.Minute
+
+var (
+	initOnce sync.Once
+	initErr  error
+
+	cacheMu     sync.Mutex
+	cache       = make(map[modelKey]*cacheEntry)
+	janitorOnce sync.Once
+)
+
+// loadSession is a variable for mocking in unit tests
+var loadSession = newModelSession
+
+// Init loads the onnxruntime shared library, the default library of the system is used if libraryPath is empty.
+// Only the first call takes effect.
+func Init(libraryPath string) error {
+	initOnce.Do(func() {
+		if libraryPath != "" {
+			ort.SetSharedLibraryPath(libraryPath)
+		}
+		if err := ort.InitializeEnvironment(); err != nil {
+			initErr = fmt.Errorf("Init onnxruntime failed, err: %s", err)
+		}
+	})
+	return initErr
+}
+
+// SessionOptions are the options to create the onnxruntime session of a model.
+type SessionOptions struct {
+	// IntraOpThreads is the number of threads used by each operator, the default of onnxruntime is used if 0.
+	IntraOpThreads int
+}
+
+// modelKey identifies a loaded model, the same directory loaded with different options gets different sessions.
+type modelKey struct {
+	dir     string
+	options SessionOptions
+}
+
+type cacheEntry struct {
+	// closed when the session is loaded
+	ready   chan struct{}
+	session *modelSession
+	err     error
+
+	inflight int
+	lastUsed time.Time
+}
+
+// modelSession is the loaded onnxruntime session and the tokenizer of a model.
+type modelSession struct {
+	session   *ort.DynamicAdvancedSession
+	tokenizer *WordPieceTokenizer
+
+	inputNames []string
+	// the output is sentence embeddings with shape [batch, dim] if true,
+	// otherwise token embeddings with shape [batch, seqLen, dim] which need pooling
+	pooled bool
+	dim    int64
+}
+
+func (s *modelSession) destroy() {
+	if s.session != nil {
+		s.session.Destroy()
+	}
+}
+
+// Model is a text embedding model in ONNX format, with the WordPiece tokenizer,
+// e.g. the models exported from sentence-transformers by optimum.
+// Sessions are cached by directory and options, and shared by all the functions since a session is safe
+// to be run concurrently. A session is released after idle for a while and loaded again on the next use.
+type Model struct {
+	key modelKey
+	dim int64
+}
+
+// LoadModel loads model.onnx and the tokenizer from the directory.
+func LoadModel(dir string, options SessionOptions) (*Model, error) {
+	key := modelKey{dir: dir, options: options}
+	entry, err := acquire(key)
+	if err != nil {
+		return nil, err
+	}
+	defer release(entry)
+	return &Model{key: key, dim: entry.session.dim}, nil
+}
+
+// acquire returns the loaded session of the model, loads it if not cached.
+// The model is loaded outside the lock, so loading a large model doesn't block the others.
+func acquire(key modelKey) (*cacheEntry, error) {
+	janitorOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				releaseIdleModels(now)
+			}
+		}()
+	})
+
+	cacheMu.Lock()
+	entry, ok := cache[key]
+	if !ok {
+		entry = &cacheEntry{ready: make(chan struct{})}
+		cache[key] = entry
+	}
+	entry.inflight++
+	cacheMu.Unlock()
+
+	if !ok {
+		session, err := loadSession(key)
+		cacheMu.Lock()
+		entry.session, entry.err = session, err
+		if err != nil {
+			// not cached, so the next call tries again
+			delete(cache, key)
+		}
+		cacheMu.Unlock()
+		close(entry.ready)
+	}
+	<-entry.ready
+	if entry.err != nil {
+		release(entry)
+		return nil, entry.err
+	}
+	return entry, nil
+}
+
+func release(entry *cacheEntry) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	entry.inflight--
+	entry.lastUsed = time.Now()
+}
+
+// releaseIdleModels destroys the sessions not used since modelIdleTimeout before now.
+func releaseIdleModels(now time.Time) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	for key, entry := range cache {
+		if entry.inflight > 0 || entry.session == nil || now.Sub(entry.lastUsed) < modelIdleTimeout {
+			continue
+		}
+		delete(cache, key)
+		entry.session.destroy()
+	}
+}
+
+func newModelSession(key modelKey) (*modelSession, error) {
+	tokenizer, err := LoadWordPieceTokenizer(key.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	modelPath := filepath.Join(key.dir, ModelFile)
+	inputs, outputs, err := ort.GetInputOutputInfo(modelPath)
+	if err != nil {
+		return nil, fmt.Errorf("Load onnx model [%s] failed, err: %s", modelPath, err)
+	}
+	inputNames := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		switch input.Name {
+		case inputIDsName, attentionMaskName, tokenTypeIDsName:
+			inputNames = append(inputNames, input.Name)
+		default:
+			return nil, fmt.Errorf("Unsupported input [%s] of onnx model, only supports [%s/%s/%s]", input.Name, inputIDsName, attentionMaskName, tokenTypeIDsName)
+		}
+	}
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("Onnx model [%s] has no output", modelPath)
+	}
+	output := outputs[0]
+	for _, o := range outputs {
+		if o.Name == sentenceEmbeddingName {
+			output = o
+		}
+	}
+	if len(output.Dimensions) != 2 && len(output.Dimensions) != 3 {
+		return nil, fmt.Errorf("Unsupported output [%s] of onnx model, the shape is %s", output.Name, output.Dimensions)
+	}
+
+	options, err := ort.NewSessionOptions()
+	if err != nil {
+		return nil, err
+	}
+	defer options.Destroy()
+	if key.options.IntraOpThreads > 0 {
+		if err := options.SetIntraOpNumThreads(key.options.IntraOpThreads); err != nil {
+			return nil, err
+		}
+	}
+	session, err := ort.NewDynamicAdvancedSession(modelPath, inputNames, []string{output.Name}, options)
+	if err != nil {
+		return nil, fmt.Errorf("Create onnx session failed, err: %s", err)
+	}
+
+	return &modelSession{
+		session:    session,
+		tokenizer:  tokenizer,
+		inputNames: inputNames,
+		pooled:     len(output.Dimensions) == 2,
+		dim:        output.Dimensions[len(output.Dimensions)-1],
+	}, nil
+}
+
+// Dim returns the dim of embeddings, or a non-positive value if it's dynamic in the model.
+func (m *Model) Dim() int64 {
+	return m.dim
+}
+
+// Embed tokenizes the texts, runs the model and returns the sentence embeddings.
+func (m *Model) Embed(texts []string, maxLength int, pooling PoolingType, needNormalize bool) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+	entry, err := acquire(m.key)
+	if err != nil {
+		return nil, err
+	}
+	defer release(entry)
+	return entry.session.embed(texts, maxLength, pooling, needNormalize)
+}
+
+func (m *modelSession) embed(texts []string, maxLength int, pooling PoolingType, needNormalize bool) ([][]float32, error) {
+	batch := len(texts)
+	ids := make([][]int64, 0, batch)
+	seqLen := 0
+	for _, text := range texts {
+		tokens := m.tokenizer.Encode(text, maxLength)
+		ids = append(ids, tokens)
+		seqLen = max(seqLen, len(tokens))
+	}
+
+	inputIDs := make([]int64, batch*seqLen)
+	attentionMask := make([]int64, batch*seqLen)
+	mask := make([][]int64, batch)
+	for b, tokens := range ids {
+		for s := 0; s < seqLen; s++ {
+			if s < len(tokens) {
+				inputIDs[b*seqLen+s] = tokens[s]
+				attentionMask[b*seqLen+s] = 1
+			} else {
+				inputIDs[b*seqLen+s] = m.tokenizer.PadID()
+			}
+		}
+		mask[b] = attentionMask[b*seqLen : (b+1)*seqLen]
+	}
+
+	shape := ort.NewShape(int64(batch), int64(seqLen))
+	inputs := make([]ort.Value, 0, len(m.inputNames))
+	defer func() {
+		for _, input := range inputs {
+			input.Destroy()
+		}
+	}()
+	for _, name := range m.inputNames {
+		var data []int64
+		switch name {
+		case inputIDsName:
+			data = inputIDs
+		case attentionMaskName:
+			data = attentionMask
+		case tokenTypeIDsName:
+			data = make([]int64, batch*seqLen)
+		}
+		tensor, err := ort.NewTensor(shape, data)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, tensor)
+	}
+
+	outputs := []ort.Value{nil}
+	if err := m.session.Run(inputs, outputs); err != nil {
+		return nil, fmt.Errorf("Run onnx model failed, err: %s", err)
+	}
+	defer outputs[0].Destroy()
+	tensor, ok := outputs[0].(*ort.Tensor[float32])
+	if !ok {
+		return nil, fmt.Errorf("Unsupported output type of onnx model, only float32 is supported")
+	}
+	outShape := tensor.GetShape()
+	dim := int(outShape[len(outShape)-1])
+	data := tensor.GetData()
+
+	var embs [][]float32
+	if m.pooled {
+		embs = make([][]float32, batch)
+		for b := 0; b < batch; b++ {
+			embs[b] = append([]float32{}, data[b*dim:(b+1)*dim]...)
+		}
+	} else {
+		embs = pool(data, mask, seqLen, dim, pooling)
+	}
+	if needNormalize {
+		normalize(embs)
+	}
+	return embs, nil
+}
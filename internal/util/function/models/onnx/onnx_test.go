@@ -0,0 +1,103 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package onnx
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestModelCache(t *testing.T) {
+	loads := atomic.NewInt32(0)
+	block := make(chan struct{})
+	loadSession = func(key modelKey) (*modelSession, error) {
+		loads.Inc()
+		switch key.dir {
+		case "slow":
+			<-block
+		case "broken":
+			return nil, errors.New("mock error")
+		}
+		return &modelSession{dim: int64(8 + key.options.IntraOpThreads)}, nil
+	}
+	defer func() {
+		loadSession = newModelSession
+		cacheMu.Lock()
+		cache = make(map[modelKey]*cacheEntry)
+		cacheMu.Unlock()
+	}()
+
+	// cached by directory and options
+	m1, err := LoadModel("dir", SessionOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), m1.Dim())
+	_, err = LoadModel("dir", SessionOptions{})
+	require.NoError(t, err)
+	m2, err := LoadModel("dir", SessionOptions{IntraOpThreads: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), m2.Dim())
+	assert.Equal(t, int32(2), loads.Load())
+
+	// loading a model doesn't block the others
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := LoadModel("slow", SessionOptions{})
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool { return loads.Load() == 3 }, time.Second, time.Millisecond)
+	_, err = LoadModel("dir", SessionOptions{})
+	require.NoError(t, err)
+	close(block)
+	wg.Wait()
+
+	// failures are not cached
+	_, err = LoadModel("broken", SessionOptions{})
+	assert.Error(t, err)
+	_, err = LoadModel("broken", SessionOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, int32(5), loads.Load())
+
+	// sessions in use are not released
+	entry, err := acquire(m1.key)
+	require.NoError(t, err)
+	releaseIdleModels(time.Now().Add(modelIdleTimeout))
+	cacheMu.Lock()
+	assert.Len(t, cache, 1)
+	assert.Contains(t, cache, m1.key)
+	cacheMu.Unlock()
+
+	// idle sessions are released, and loaded again on the next use
+	release(entry)
+	releaseIdleModels(time.Now().Add(modelIdleTimeout))
+	cacheMu.Lock()
+	assert.Len(t, cache, 0)
+	cacheMu.Unlock()
+	entry, err = acquire(m1.key)
+	require.NoError(t, err)
+	release(entry)
+	assert.Equal(t, int32(6), loads.Load())
+}
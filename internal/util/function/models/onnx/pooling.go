@@ -0,0 +1,92 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package onnx
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+type PoolingType string
+
+const (
+	MeanPooling PoolingType = "mean"
+	CLSPooling  PoolingType = "cls"
+)
+
+func ParsePoolingType(s string) (PoolingType, error) {
+	switch PoolingType(strings.ToLower(s)) {
+	case MeanPooling:
+		return MeanPooling, nil
+	case CLSPooling:
+		return CLSPooling, nil
+	default:
+		return "", fmt.Errorf("Unsupported pooling type [%s], only supports [%s/%s]", s, MeanPooling, CLSPooling)
+	}
+}
+
+// pool converts the token embeddings with shape [batch, seqLen, dim] into sentence embeddings,
+// padding tokens are excluded by the attention mask.
+func pool(hidden []float32, mask [][]int64, seqLen, dim int, pooling PoolingType) [][]float32 {
+	result := make([][]float32, len(mask))
+	for b := range mask {
+		emb := make([]float32, dim)
+		offset := b * seqLen * dim
+		if pooling == CLSPooling {
+			copy(emb, hidden[offset:offset+dim])
+			result[b] = emb
+			continue
+		}
+		count := 0
+		for s := 0; s < seqLen; s++ {
+			if mask[b][s] == 0 {
+				continue
+			}
+			count++
+			token := hidden[offset+s*dim : offset+(s+1)*dim]
+			for d := range emb {
+				emb[d] += token[d]
+			}
+		}
+		if count > 0 {
+			for d := range emb {
+				emb[d] /= float32(count)
+			}
+		}
+		result[b] = emb
+	}
+	return result
+}
+
+func normalize(embs [][]float32) {
+	for _, emb := range embs {
+		var sum float64
+		for _, v := range emb {
+			sum += float64(v) * float64(v)
+		}
+		norm := math.Sqrt(sum)
+		if norm == 0 {
+			continue
+		}
+		for i := range emb {
+			emb[i] = float32(float64(emb[i]) / norm)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package onnx
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	VocabFile           = "vocab.txt"
+	TokenizerFile       = "tokenizer.json"
+	TokenizerConfigFile = "tokenizer_config.json"
+
+	clsToken = "[CLS]"
+	sepToken = "[SEP]"
+	padToken = "[PAD]"
+	unkToken = "[UNK]"
+
+	subwordPrefix        = "##"
+	maxInputCharsPerWord = 100
+)
+
+// WordPieceTokenizer is the tokenizer of BERT-like models, which most sentence-transformers are based on.
+// It's equivalent to the BertTokenizer of huggingface transformers.
+type WordPieceTokenizer struct {
+	vocab     map[string]int64
+	lowerCase bool
+
+	clsID int64
+	sepID int64
+	padID int64
+	unkID int64
+}
+
+func NewWordPieceTokenizer(vocab map[string]int64, lowerCase bool) (*WordPieceTokenizer, error) {
+	t := &WordPieceTokenizer{
+		vocab:     vocab,
+		lowerCase: lowerCase,
+	}
+	for token, id := range map[string]*int64{clsToken: &t.clsID, sepToken: &t.sepID, padToken: &t.padID, unkToken: &t.unkID} {
+		v, ok := vocab[token]
+		if !ok {
+			return nil, fmt.Errorf("Special token %s not found in vocab", token)
+		}
+		*id = v
+	}
+	return t, nil
+}
+
+// LoadWordPieceTokenizer loads the tokenizer from the model directory,
+// tokenizer.json is preferred, otherwise vocab.txt is used.
+func LoadWordPieceTokenizer(dir string) (*WordPieceTokenizer, error) {
+	if _, err := os.Stat(filepath.Join(dir, TokenizerFile)); err == nil {
+		return loadFromTokenizerJSON(filepath.Join(dir, TokenizerFile))
+	}
+	vocab, err := loadVocab(filepath.Join(dir, VocabFile))
+	if err != nil {
+		return nil, err
+	}
+	lowerCase := true
+	if bs, err := os.ReadFile(filepath.Join(dir, TokenizerConfigFile)); err == nil {
+		conf := struct {
+			DoLowerCase *bool `json:"do_lower_case"`
+		}{}
+		if err := json.Unmarshal(bs, &conf); err != nil {
+			return nil, fmt.Errorf("Parse %s failed, err: %s", TokenizerConfigFile, err)
+		}
+		if conf.DoLowerCase != nil {
+			lowerCase = *conf.DoLowerCase
+		}
+	}
+	return NewWordPieceTokenizer(vocab, lowerCase)
+}
+
+func loadVocab(path string) (map[string]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Open vocab file failed, err: %s", err)
+	}
+	defer f.Close()
+
+	vocab := make(map[string]int64)
+	scanner := bufio.NewScanner(f)
+	for id := int64(0); scanner.Scan(); id++ {
+		vocab[strings.TrimRight(scanner.Text(), "\r")] = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Read vocab file failed, err: %s", err)
+	}
+	return vocab, nil
+}
+
+func loadFromTokenizerJSON(path string) (*WordPieceTokenizer, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Read %s failed, err: %s", TokenizerFile, err)
+	}
+	conf := struct {
+		Normalizer *struct {
+			Lowercase *bool `json:"lowercase"`
+		} `json:"normalizer"`
+		Model struct {
+			Type  string           `json:"type"`
+			Vocab map[string]int64 `json:"vocab"`
+		} `json:"model"`
+	}{}
+	if err := json.Unmarshal(bs, &conf); err != nil {
+		return nil, fmt.Errorf("Parse %s failed, err: %s", TokenizerFile, err)
+	}
+	if conf.Model.Type != "WordPiece" {
+		return nil, fmt.Errorf("Only WordPiece tokenizer is supported, but got [%s]", conf.Model.Type)
+	}
+	lowerCase := true
+	if conf.Normalizer != nil && conf.Normalizer.Lowercase != nil {
+		lowerCase = *conf.Normalizer.Lowercase
+	}
+	return NewWordPieceTokenizer(conf.Model.Vocab, lowerCase)
+}
+
+func (t *WordPieceTokenizer) PadID() int64 {
+	return t.padID
+}
+
+// Encode converts the text into token ids, with [CLS] and [SEP] added,
+// and truncated to maxLength if it's positive.
+func (t *WordPieceTokenizer) Encode(text string, maxLength int) []int64 {
+	ids := []int64{t.clsID}
+	for _, word := range t.basicTokenize(text) {
+		ids = append(ids, t.wordPiece(word)...)
+	}
+	if maxLength > 1 && len(ids) > maxLength-1 {
+		ids = ids[:maxLength-1]
+	}
+	return append(ids, t.sepID)
+}
+
+// basicTokenize cleans the text, splits it by whitespaces and punctuations, and treats each CJK character as a word.
+func (t *WordPieceTokenizer) basicTokenize(text string) []string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch {
+		case r == 0 || r == unicode.ReplacementChar || isControl(r):
+			continue
+		case unicode.IsSpace(r):
+			sb.WriteRune(' ')
+		case isChineseChar(r):
+			sb.WriteRune(' ')
+			sb.WriteRune(r)
+			sb.WriteRune(' ')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	words := make([]string, 0)
+	for _, word := range strings.Fields(sb.String()) {
+		if t.lowerCase {
+			word = stripAccents(strings.ToLower(word))
+		}
+		words = append(words, splitOnPunctuation(word)...)
+	}
+	return words
+}
+
+// wordPiece splits the word into sub words with the greedy longest-match-first algorithm.
+func (t *WordPieceTokenizer) wordPiece(word string) []int64 {
+	runes := []rune(word)
+	if len(runes) > maxInputCharsPerWord {
+		return []int64{t.unkID}
+	}
+	ids := make([]int64, 0)
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		found := false
+		for ; start < end; end-- {
+			sub := string(runes[start:end])
+			if start > 0 {
+				sub = subwordPrefix + sub
+			}
+			if id, ok := t.vocab[sub]; ok {
+				ids = append(ids, id)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return []int64{t.unkID}
+		}
+		start = end
+	}
+	return ids
+}
+
+func stripAccents(word string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(word) {
+		if !unicode.Is(unicode.Mn, r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func splitOnPunctuation(word string) []string {
+	result := make([]string, 0)
+	current := make([]rune, 0)
+	for _, r := range word {
+		if isPunctuation(r) {
+			if len(current) > 0 {
+				result = append(result, string(current))
+				current = current[:0]
+			}
+			result = append(result, string(r))
+			continue
+		}
+		current = append(current, r)
+	}
+	if len(current) > 0 {
+		result = append(result, string(current))
+	}
+	return result
+}
+
+func isControl(r rune) bool {
+	if r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return unicode.In(r, unicode.Cc, unicode.Cf)
+}
+
+// isPunctuation treats all non-letter/number ASCII characters as punctuation, same as BERT.
+func isPunctuation(r rune) bool {
+	if (r >= 33 && r <= 47) || (r >= 58 && r <= 64) || (r >= 91 && r <= 96) || (r >= 123 && r <= 126) {
+		return true
+	}
+	return unicode.IsPunct(r)
+}
+
+func isChineseChar(r rune) bool {
+	return (r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x20000 && r <= 0x2A6DF) ||
+		(r >= 0x2A700 && r <= 0x2B73F) ||
+		(r >= 0x2B740 && r <= 0x2B81F) ||
+		(r >= 0x2B820 && r <= 0x2CEAF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x2F800 && r <= 0x2FA1F)
+}
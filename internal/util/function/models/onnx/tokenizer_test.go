@@ -0,0 +1,94 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package onnx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testVocab = []string{"[PAD]", "[UNK]", "[CLS]", "[SEP]", "hello", "world", "un", "##aff", "##able", ",", "!", "cafe", "中", "国"}
+
+func TestWordPieceTokenizer(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, VocabFile), []byte(strings.Join(testVocab, "\n")), 0o644))
+
+	tokenizer, err := LoadWordPieceTokenizer(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{2, 4, 9, 5, 10, 3}, tokenizer.Encode("Hello, World!", 0))
+	assert.Equal(t, []int64{2, 6, 7, 8, 3}, tokenizer.Encode("unaffable", 0))
+	// accents are stripped and unknown words are [UNK]
+	assert.Equal(t, []int64{2, 11, 1, 3}, tokenizer.Encode("Café unknown", 0))
+	// chinese characters are split
+	assert.Equal(t, []int64{2, 12, 13, 3}, tokenizer.Encode("中国", 0))
+	// truncation keeps [SEP]
+	assert.Equal(t, []int64{2, 4, 3}, tokenizer.Encode("hello world", 3))
+	assert.Equal(t, int64(0), tokenizer.PadID())
+
+	// case sensitive with tokenizer_config.json
+	require.NoError(t, os.WriteFile(filepath.Join(dir, TokenizerConfigFile), []byte(`{"do_lower_case": false}`), 0o644))
+	tokenizer, err = LoadWordPieceTokenizer(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1, 5, 3}, tokenizer.Encode("Hello world", 0))
+}
+
+func TestWordPieceTokenizerJSON(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, TokenizerFile), []byte(`{
+		"normalizer": {"type": "BertNormalizer", "lowercase": true},
+		"model": {"type": "WordPiece", "vocab": {"[PAD]": 0, "[UNK]": 1, "[CLS]": 2, "[SEP]": 3, "hello": 4}}
+	}`), 0o644))
+	tokenizer, err := LoadWordPieceTokenizer(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 4, 1, 3}, tokenizer.Encode("HELLO world", 0))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, TokenizerFile), []byte(`{"model": {"type": "BPE"}}`), 0o644))
+	_, err = LoadWordPieceTokenizer(dir)
+	assert.Error(t, err)
+
+	// missing special tokens
+	require.NoError(t, os.WriteFile(filepath.Join(dir, TokenizerFile), []byte(`{"model": {"type": "WordPiece", "vocab": {"hello": 0}}}`), 0o644))
+	_, err = LoadWordPieceTokenizer(dir)
+	assert.Error(t, err)
+}
+
+func TestPooling(t *testing.T) {
+	// batch 2, seqLen 2, dim 2
+	hidden := []float32{1, 2, 3, 4, 5, 6, 7, 8}
+	mask := [][]int64{{1, 1}, {1, 0}}
+
+	assert.Equal(t, [][]float32{{2, 3}, {5, 6}}, pool(hidden, mask, 2, 2, MeanPooling))
+	assert.Equal(t, [][]float32{{1, 2}, {5, 6}}, pool(hidden, mask, 2, 2, CLSPooling))
+
+	embs := [][]float32{{3, 4}, {0, 0}}
+	normalize(embs)
+	assert.InDeltaSlice(t, []float32{0.6, 0.8}, embs[0], 1e-6)
+	assert.Equal(t, []float32{0, 0}, embs[1])
+
+	_, err := ParsePoolingType("MEAN")
+	assert.NoError(t, err)
+	_, err = ParsePoolingType("max")
+	assert.Error(t, err)
+}
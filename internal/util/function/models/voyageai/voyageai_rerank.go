@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voyageai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+type RerankRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+
+	Query string `json:"query"`
+
+	Documents []string `json:"documents"`
+
+	// Whether to truncate the inputs to fit the context length, the service defaults to true.
+	Truncation *bool `json:"truncation,omitempty"`
+}
+
+type RerankData struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+type RerankResponse struct {
+	Object string       `json:"object"`
+	Model  string       `json:"model"`
+	Usage  Usage        `json:"usage"`
+	Data   []RerankData `json:"data"`
+}
+
+// Rerank calls the rerank api of voyageai with the client, the url of the client should be the rerank url.
+// The data is ordered by relevance score as returned by the service, truncation is left to the service if nil.
+func (c *VoyageAIEmbedding) Rerank(ctx context.Context, modelName string, query string, documents []string, truncation *bool, timeoutSec int64) (*RerankResponse, error) {
+	var r RerankRequest
+	r.Model = modelName
+	r.Query = query
+	r.Documents = documents
+	r.Truncation = truncation
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": fmt.Sprintf("Bearer %s", c.apiKey),
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
+	if err != nil {
+		return nil, err
+	}
+	var res RerankResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, err
+}
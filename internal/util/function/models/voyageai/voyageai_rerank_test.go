@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voyageai
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankOK(t *testing.T) {
+	var req map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"object": "list", "data": [{"index": 1, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}], "model": "rerank-2"}`))
+	}))
+	defer ts.Close()
+
+	c := NewVoyageAIEmbeddingClient("mock_key", ts.URL)
+	ret, err := c.Rerank(context.Background(), "rerank-2", "query", []string{"t1", "t2"}, nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []RerankData{{Index: 1, RelevanceScore: 0.9}, {Index: 0, RelevanceScore: 0.1}}, ret.Data)
+	assert.Equal(t, "rerank-2", req["model"])
+	// left to the service if not specified
+	assert.NotContains(t, req, "truncation")
+
+	truncation := false
+	_, err = c.Rerank(context.Background(), "rerank-2", "query", []string{"t1", "t2"}, &truncation, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, false, req["truncation"])
+}
+
+func TestRerankFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c := NewVoyageAIEmbeddingClient("mock_key", ts.URL)
+	_, err := c.Rerank(context.Background(), "rerank-2", "query", []string{"t1"}, nil, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,259 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/function/models/onnx"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type onnxModel interface {
+	Dim() int64
+	Embed(texts []string, maxLength int, pooling onnx.PoolingType, normalize bool) ([][]float32, error)
+}
+
+// loadOnnxModel is a variable for mocking in unit tests
+var loadOnnxModel = func(dir string, conf map[string]string) (onnxModel, error) {
+	if err := onnx.Init(conf[libraryPathConfKey]); err != nil {
+		return nil, err
+	}
+	options := onnx.SessionOptions{}
+	if v, ok := conf[intraOpThreadsConfKey]; ok && v != "" {
+		var err error
+		if options.IntraOpThreads, err = strconv.Atoi(v); err != nil || options.IntraOpThreads < 0 {
+			return nil, fmt.Errorf("[%s: %s] in milvus.yaml is not a valid non-negative number", intraOpThreadsConfKey, v)
+		}
+	}
+	return onnx.LoadModel(dir, options)
+}
+
+// OnnxEmbeddingProvider runs a local text embedding model in ONNX format inside the process,
+// so that embedding functions work without any external service.
+type OnnxEmbeddingProvider struct {
+	fieldDim int64
+
+	model onnxModel
+
+	ingestionPrompt string
+	searchPrompt    string
+	maxLength       int
+	pooling         onnx.PoolingType
+	normalize       bool
+
+	maxBatch int
+}
+
+func NewOnnxEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string) (*OnnxEmbeddingProvider, error) {
+	if strings.ToLower(params[enableConfKey]) == "false" {
+		return nil, errors.New("Onnx model inference is not enabled")
+	}
+	if fieldSchema.GetDataType() != schemapb.DataType_FloatVector {
+		return nil, fmt.Errorf("Onnx embedding provider only supports FloatVector output field, but got %s", fieldSchema.GetDataType())
+	}
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelPath, ingestionPrompt, searchPrompt string
+	maxBatch := 32
+	maxLength := 512
+	pooling := onnx.MeanPooling
+	normalize := true
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelPathParamKey:
+			modelPath = param.Value
+		case ingestionPromptParamKey:
+			ingestionPrompt = param.Value
+		case searchPromptParamKey:
+			searchPrompt = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid positive number", maxClientBatchSizeParamKey, param.Value)
+			}
+		case maxLengthParamKey:
+			if maxLength, err = strconv.Atoi(param.Value); err != nil || maxLength <= 2 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid number greater than 2", maxLengthParamKey, param.Value)
+			}
+		case poolingParamKey:
+			if pooling, err = onnx.ParsePoolingType(param.Value); err != nil {
+				return nil, err
+			}
+		case normalizeParamKey:
+			if normalize, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", normalizeParamKey, param.Value)
+			}
+		default:
+		}
+	}
+	if modelPath == "" {
+		return nil, fmt.Errorf("[%s] is required for onnx embedding provider", modelPathParamKey)
+	}
+
+	dir, err := resolveOnnxModelDir(modelPath, params)
+	if err != nil {
+		return nil, err
+	}
+	model, err := loadOnnxModel(dir, params)
+	if err != nil {
+		return nil, err
+	}
+	if model.Dim() > 0 && model.Dim() != fieldDim {
+		return nil, fmt.Errorf("The dim set in the schema is inconsistent with the dim of the model, dim in schema is %d, dim of model is %d", fieldDim, model.Dim())
+	}
+
+	return &OnnxEmbeddingProvider{
+		fieldDim:        fieldDim,
+		model:           model,
+		ingestionPrompt: ingestionPrompt,
+		searchPrompt:    searchPrompt,
+		maxLength:       maxLength,
+		pooling:         pooling,
+		normalize:       normalize,
+		maxBatch:        maxBatch,
+	}, nil
+}
+
+func (provider *OnnxEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *OnnxEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *OnnxEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	numRows := len(texts)
+	data := make([][]float32, 0, numRows)
+	prompt := provider.ingestionPrompt
+	if mode == SearchMode {
+		prompt = provider.searchPrompt
+	}
+
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := min(i+provider.maxBatch, numRows)
+		batch := texts[i:end]
+		if prompt != "" {
+			batch = make([]string, 0, end-i)
+			for _, text := range texts[i:end] {
+				batch = append(batch, prompt+text)
+			}
+		}
+		embs, err := provider.model.Embed(batch, provider.maxLength, provider.pooling, provider.normalize)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(embs) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(embs))
+		}
+		for _, item := range embs {
+			if len(item) != int(provider.fieldDim) {
+				return nil, fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+					provider.fieldDim, len(item))
+			}
+			data = append(data, item)
+		}
+	}
+	return data, nil
+}
+
+var onnxDownloadMu sync.Mutex
+
+// resolveOnnxModelDir returns the local directory of the model.
+// An absolute path is a directory on local disk, otherwise it's the object key prefix in the bucket of milvus,
+// which is downloaded into the cache directory once.
+func resolveOnnxModelDir(modelPath string, conf map[string]string) (string, error) {
+	if filepath.IsAbs(modelPath) {
+		return modelPath, nil
+	}
+
+	cacheDir := conf[cacheDirConfKey]
+	if cacheDir == "" {
+		cacheDir = filepath.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "onnx_models")
+	}
+	prefix := strings.Trim(modelPath, "/")
+	dir := filepath.Join(cacheDir, filepath.FromSlash(prefix))
+
+	onnxDownloadMu.Lock()
+	defer onnxDownloadMu.Unlock()
+	if _, err := os.Stat(filepath.Join(dir, onnx.ModelFile)); err == nil {
+		return dir, nil
+	}
+
+	ctx := context.Background()
+	cm, err := storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Create chunk manager to download onnx model failed, err: %s", err)
+	}
+	files := make([]string, 0)
+	err = cm.WalkWithPrefix(ctx, prefix+"/", true, func(info *storage.ChunkObjectInfo) bool {
+		files = append(files, info.FilePath)
+		return true
+	})
+	if err != nil {
+		return "", fmt.Errorf("List onnx model files of [%s] failed, err: %s", modelPath, err)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("Onnx model [%s] not found in object storage", modelPath)
+	}
+
+	// download into a temp dir then rename, so that a broken download never looks like a model
+	tmpDir := dir + ".downloading"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		bs, err := cm.Read(ctx, file)
+		if err != nil {
+			return "", fmt.Errorf("Download onnx model file [%s] failed, err: %s", file, err)
+		}
+		localPath := filepath.Join(tmpDir, filepath.FromSlash(strings.TrimPrefix(file, prefix+"/")))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(localPath, bs, 0o644); err != nil {
+			return "", err
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, onnx.ModelFile)); err != nil {
+		return "", fmt.Errorf("%s not found in onnx model [%s]", onnx.ModelFile, path.Join(modelPath, onnx.ModelFile))
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
@@ -0,0 +1,164 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models/onnx"
+)
+
+type mockOnnxModel struct {
+	dim   int64
+	texts []string
+}
+
+func (m *mockOnnxModel) Dim() int64 {
+	return m.dim
+}
+
+func (m *mockOnnxModel) Embed(texts []string, maxLength int, pooling onnx.PoolingType, normalize bool) ([][]float32, error) {
+	m.texts = append(m.texts, texts...)
+	embs := make([][]float32, 0, len(texts))
+	for range texts {
+		embs = append(embs, make([]float32, m.dim))
+	}
+	return embs, nil
+}
+
+func TestOnnxEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OnnxEmbeddingProviderSuite))
+}
+
+type OnnxEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	model  *mockOnnxModel
+	loadFn func(dir string, conf map[string]string) (onnxModel, error)
+}
+
+func (s *OnnxEmbeddingProviderSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{
+				FieldID: 103, Name: "int8_vector", DataType: schemapb.DataType_Int8Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+	s.model = &mockOnnxModel{dim: 4}
+	s.loadFn = loadOnnxModel
+	loadOnnxModel = func(dir string, conf map[string]string) (onnxModel, error) {
+		return s.model, nil
+	}
+}
+
+func (s *OnnxEmbeddingProviderSuite) TearDownTest() {
+	loadOnnxModel = s.loadFn
+}
+
+func createOnnxFunctionSchema(params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: append([]*commonpb.KeyValuePair{
+			{Key: modelPathParamKey, Value: "/models/all-MiniLM-L6-v2"},
+		}, params...),
+	}
+}
+
+func (s *OnnxEmbeddingProviderSuite) TestEmbedding() {
+	provider, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], createOnnxFunctionSchema(
+		&commonpb.KeyValuePair{Key: ingestionPromptParamKey, Value: "doc:"},
+		&commonpb.KeyValuePair{Key: searchPromptParamKey, Value: "query:"},
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+	), map[string]string{})
+	s.NoError(err)
+	s.Equal(10, provider.MaxBatch())
+	s.Equal(int64(4), provider.FieldDim())
+
+	r, err := provider.CallEmbedding([]string{"a", "b", "c"}, InsertMode)
+	s.NoError(err)
+	s.Equal(3, len(r.([][]float32)))
+	s.Equal([]string{"doc:a", "doc:b", "doc:c"}, s.model.texts)
+
+	s.model.texts = nil
+	_, err = provider.CallEmbedding([]string{"a"}, SearchMode)
+	s.NoError(err)
+	s.Equal([]string{"query:a"}, s.model.texts)
+}
+
+func (s *OnnxEmbeddingProviderSuite) TestDimNotMatch() {
+	s.model.dim = 8
+	_, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], createOnnxFunctionSchema(), map[string]string{})
+	s.Error(err)
+
+	// dynamic dim in model is checked when embedding
+	s.model.dim = 0
+	provider, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], createOnnxFunctionSchema(), map[string]string{})
+	s.NoError(err)
+	_, err = provider.CallEmbedding([]string{"a"}, InsertMode)
+	s.Error(err)
+}
+
+func (s *OnnxEmbeddingProviderSuite) TestInvalidParams() {
+	{
+		_, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], createOnnxFunctionSchema(), map[string]string{enableConfKey: "false"})
+		s.Error(err)
+	}
+	{
+		_, err := NewOnnxEmbeddingProvider(s.schema.Fields[3], createOnnxFunctionSchema(), map[string]string{})
+		s.Error(err)
+	}
+	{
+		functionSchema := createOnnxFunctionSchema()
+		functionSchema.Params = nil
+		_, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{})
+		s.Error(err)
+	}
+	for _, kv := range []*commonpb.KeyValuePair{
+		{Key: maxClientBatchSizeParamKey, Value: "a"},
+		{Key: maxClientBatchSizeParamKey, Value: "0"},
+		{Key: maxLengthParamKey, Value: "1"},
+		{Key: poolingParamKey, Value: "max"},
+		{Key: normalizeParamKey, Value: "yes"},
+	} {
+		_, err := NewOnnxEmbeddingProvider(s.schema.Fields[2], createOnnxFunctionSchema(kv), map[string]string{})
+		s.Error(err, kv.String())
+	}
+}
@@ -0,0 +1,286 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/function"
+	"github.com/milvus-io/milvus/internal/util/function/models/cohere"
+	"github.com/milvus-io/milvus/internal/util/function/models/voyageai"
+)
+
+const (
+	cohereProviderName           string = "cohere"
+	voyageaiProviderName         string = "voyageai"
+	jinaProviderName             string = "jina"
+	openaiCompatibleProviderName string = "openai_compatible"
+
+	modelNameParamName string = "model_name"
+
+	cohereRerankURL   string = "https://api.cohere.com/v2/rerank"
+	voyageaiRerankURL string = "https://api.voyageai.com/v1/rerank"
+	jinaRerankURL     string = "https://api.jina.ai/v1/rerank"
+
+	openaiCompatibleRerankPath string = "/v1/rerank"
+)
+
+// apiProviderSpec describes a rerank service which follows the de facto `/rerank` API:
+// the request is {"model", "query", "documents"} and the response is a list of {"index", "relevance_score"}.
+type apiProviderSpec struct {
+	defaultURL string
+	apiKeyEnv  string
+	enableEnv  string
+	// the hosted services require api key and model name
+	hosted bool
+}
+
+var apiProviderSpecs = map[string]apiProviderSpec{
+	cohereProviderName: {
+		defaultURL: cohereRerankURL,
+		apiKeyEnv:  function.CohereRerankAKEnvStr,
+		enableEnv:  function.EnableCohereRerankEnvStr,
+		hosted:     true,
+	},
+	voyageaiProviderName: {
+		defaultURL: voyageaiRerankURL,
+		apiKeyEnv:  function.VoyageAIRerankAKEnvStr,
+		enableEnv:  function.EnableVoyageAIRerankEnvStr,
+		hosted:     true,
+	},
+	jinaProviderName: {
+		defaultURL: jinaRerankURL,
+		apiKeyEnv:  function.JinaAIAKEnvStr,
+		enableEnv:  function.EnableJinaAIRerankEnvStr,
+		hosted:     true,
+	},
+	openaiCompatibleProviderName: {
+		apiKeyEnv: function.OpenAICompatibleAKEnvStr,
+		enableEnv: function.EnableOpenAICompatibleEnvStr,
+	},
+}
+
+// apiProviderConfig is the resolved config of a rerank service
+type apiProviderConfig struct {
+	url            string
+	apiKey         string
+	modelName      string
+	maxBatch       int
+	truncateParams map[string]any
+}
+
+func parseAPIProviderConfig(provider string, params []*commonpb.KeyValuePair, conf map[string]string) (*apiProviderConfig, error) {
+	spec := apiProviderSpecs[provider]
+	if !isEnable(conf, spec.enableEnv) {
+		return nil, fmt.Errorf("%s rerank is disabled", provider)
+	}
+	// function param > milvus.yaml > env
+	apiKey, confURL, err := function.ParseRerankAKAndURL(params, conf, spec.apiKeyEnv)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, maxBatch, truncateParams, err := parseCommonParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	modelName := ""
+	for _, param := range params {
+		if strings.ToLower(param.Key) == modelNameParamName {
+			modelName = param.Value
+		}
+	}
+
+	// the endpoint of hosted services is the full rerank url, the official url is used by default;
+	// the endpoint of openai compatible services is used as is, `/v1/rerank` is appended if it has no path.
+	if endpoint == "" {
+		endpoint = confURL
+	}
+	if spec.hosted {
+		if endpoint == "" {
+			endpoint = spec.defaultURL
+		}
+		if apiKey == "" {
+			return nil, fmt.Errorf("Missing credentials for %s rerank, please set the credential param or the env %s", provider, spec.apiKeyEnv)
+		}
+		if modelName == "" {
+			return nil, fmt.Errorf("Rerank function lost params %s", modelNameParamName)
+		}
+	} else {
+		if endpoint == "" {
+			return nil, fmt.Errorf("Rerank function lost params endpoint")
+		}
+		base, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if base.Path == "" || base.Path == "/" {
+			base.Path = openaiCompatibleRerankPath
+		}
+		endpoint = base.String()
+	}
+	return &apiProviderConfig{
+		url:            endpoint,
+		apiKey:         apiKey,
+		modelName:      modelName,
+		maxBatch:       maxBatch,
+		truncateParams: truncateParams,
+	}, nil
+}
+
+// batchRerank splits the documents into batches, and concatenates the scores of all batches.
+func batchRerank(docs []string, maxBatch int, rerankBatch func(batch []string) ([]float32, error)) ([]float32, error) {
+	scores := make([]float32, 0, len(docs))
+	for i := 0; i < len(docs); i += maxBatch {
+		batchScores, err := rerankBatch(docs[i:min(i+maxBatch, len(docs))])
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, batchScores...)
+	}
+	return scores, nil
+}
+
+type cohereProvider struct {
+	client          *cohere.CohereEmbedding
+	url             string
+	modelName       string
+	maxBatch        int
+	maxTokensPerDoc int64
+}
+
+func newCohereProvider(params []*commonpb.KeyValuePair, conf map[string]string) (modelProvider, error) {
+	cfg, err := parseAPIProviderConfig(cohereProviderName, params, conf)
+	if err != nil {
+		return nil, err
+	}
+	maxTokensPerDoc, _ := cfg.truncateParams[cohereMaxTokensPerDocParamName].(int64)
+	return &cohereProvider{
+		client:          cohere.NewCohereEmbeddingClient(cfg.apiKey, cfg.url),
+		url:             cfg.url,
+		modelName:       cfg.modelName,
+		maxBatch:        cfg.maxBatch,
+		maxTokensPerDoc: maxTokensPerDoc,
+	}, nil
+}
+
+func (p *cohereProvider) getURL() string {
+	return p.url
+}
+
+func (p *cohereProvider) rerank(ctx context.Context, query string, docs []string) ([]float32, error) {
+	return batchRerank(docs, p.maxBatch, func(batch []string) ([]float32, error) {
+		resp, err := p.client.Rerank(ctx, p.modelName, query, batch, p.maxTokensPerDoc, 30)
+		if err != nil {
+			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
+		}
+		return sortScoresByIndex(len(batch), lo.Map(resp.Results, func(r cohere.RerankResult, _ int) rerankResult {
+			return rerankResult{Index: r.Index, RelevanceScore: r.RelevanceScore}
+		}))
+	})
+}
+
+type voyageaiProvider struct {
+	client     *voyageai.VoyageAIEmbedding
+	url        string
+	modelName  string
+	maxBatch   int
+	truncation *bool
+}
+
+func newVoyageaiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (modelProvider, error) {
+	cfg, err := parseAPIProviderConfig(voyageaiProviderName, params, conf)
+	if err != nil {
+		return nil, err
+	}
+	var truncation *bool
+	if v, ok := cfg.truncateParams[voyageTruncationParamName].(bool); ok {
+		truncation = &v
+	}
+	return &voyageaiProvider{
+		client:     voyageai.NewVoyageAIEmbeddingClient(cfg.apiKey, cfg.url),
+		url:        cfg.url,
+		modelName:  cfg.modelName,
+		maxBatch:   cfg.maxBatch,
+		truncation: truncation,
+	}, nil
+}
+
+func (p *voyageaiProvider) getURL() string {
+	return p.url
+}
+
+func (p *voyageaiProvider) rerank(ctx context.Context, query string, docs []string) ([]float32, error) {
+	return batchRerank(docs, p.maxBatch, func(batch []string) ([]float32, error) {
+		resp, err := p.client.Rerank(ctx, p.modelName, query, batch, p.truncation, 30)
+		if err != nil {
+			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
+		}
+		return sortScoresByIndex(len(batch), lo.Map(resp.Data, func(r voyageai.RerankData, _ int) rerankResult {
+			return rerankResult{Index: r.Index, RelevanceScore: r.RelevanceScore}
+		}))
+	})
+}
+
+type apiRerankResponse struct {
+	Results []rerankResult `json:"results"`
+}
+
+// apiProvider calls the services without a dedicated client, i.e. jina and openai compatible ones.
+type apiProvider struct {
+	baseModel
+}
+
+func newAPIProvider(provider string, params []*commonpb.KeyValuePair, conf map[string]string) (modelProvider, error) {
+	cfg, err := parseAPIProviderConfig(provider, params, conf)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.modelName != "" {
+		cfg.truncateParams["model"] = cfg.modelName
+	}
+	headers := map[string]string{}
+	if cfg.apiKey != "" {
+		headers["Authorization"] = "Bearer " + cfg.apiKey
+	}
+
+	model := baseModel{
+		url:            cfg.url,
+		maxBatch:       cfg.maxBatch,
+		queryKey:       "query",
+		docKey:         "documents",
+		truncateParams: cfg.truncateParams,
+		headers:        headers,
+		parseScores: func(body []byte, numDocs int) ([]float32, error) {
+			var resp apiRerankResponse
+			if err := json.Unmarshal(body, &resp); err != nil {
+				return nil, fmt.Errorf("Rerank error, parsing %s response failed: %v", provider, err)
+			}
+			return sortScoresByIndex(numDocs, resp.Results)
+		},
+	}
+	return &apiProvider{baseModel: model}, nil
+}
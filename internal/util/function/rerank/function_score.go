@@ -40,6 +40,9 @@ const (
 	modelFunctionName string = "model"
 	rrfName           string = "rrf"
 	weightedName      string = "weighted"
+	linearName        string = "linear"
+	// alias of linear
+	normalizedName string = "normalized"
 )
 
 const (
@@ -140,8 +143,10 @@ func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.
 		rerankFunc, newRerankErr = newRRFFunction(collSchema, funcSchema)
 	case weightedName:
 		rerankFunc, newRerankErr = newWeightedFunction(collSchema, funcSchema)
+	case linearName, normalizedName:
+		rerankFunc, newRerankErr = newLinearFunction(collSchema, funcSchema)
 	default:
-		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName)
+		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName, linearName)
 	}
 
 	if newRerankErr != nil {
@@ -0,0 +1,277 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metric"
+)
+
+const (
+	NormMethodKey      string = "norm_method"
+	ScoreThresholdsKey string = "score_thresholds"
+)
+
+const (
+	minMaxNorm string = "min_max"
+	zScoreNorm string = "z_score"
+	l2Norm     string = "l2"
+	noneNorm   string = "none"
+)
+
+// LinearFunction normalizes the scores of each ann search request separately by the selected method,
+// then combines them with the weights. Unlike the weighted function, the normalization depends on
+// the score distribution of the results instead of a fixed mapping, which makes the scores of
+// different metrics, e.g. COSINE and BM25, comparable.
+type LinearFunction[T PKType] struct {
+	RerankBase
+
+	weights     []float32
+	normMethods []string
+	// nil means no threshold for the search request
+	thresholds []*float32
+}
+
+func newLinearFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, linearName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(base.GetInputFieldNames()) != 0 {
+		return nil, fmt.Errorf("The linear function does not support input parameters, but got %s", base.GetInputFieldNames())
+	}
+
+	var weights []float32
+	var normMethods []string
+	var thresholds []*float32
+	for _, param := range funcSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case WeightsParamsKey:
+			if err := json.Unmarshal([]byte(param.Value), &weights); err != nil {
+				return nil, fmt.Errorf("Parse %s param failed, weight should be []float, bug got: %s", WeightsParamsKey, param.Value)
+			}
+			for _, weight := range weights {
+				if weight < 0 {
+					return nil, fmt.Errorf("rank param weight should not be negative, but got %v", weight)
+				}
+			}
+		case NormMethodKey:
+			// a single method for all the search requests, or a list of methods for each one
+			if err := json.Unmarshal([]byte(param.Value), &normMethods); err != nil {
+				normMethods = []string{param.Value}
+			}
+			for i, method := range normMethods {
+				normMethods[i] = strings.ToLower(method)
+				if _, err := getDistributionNormalizeFunc(normMethods[i]); err != nil {
+					return nil, err
+				}
+			}
+		case ScoreThresholdsKey:
+			if err := json.Unmarshal([]byte(param.Value), &thresholds); err != nil {
+				return nil, fmt.Errorf("Parse %s param failed, it should be a list of float or null, bug got: %s", ScoreThresholdsKey, param.Value)
+			}
+		}
+	}
+	if len(weights) == 0 {
+		return nil, fmt.Errorf(WeightsParamsKey + " not found")
+	}
+
+	switch len(normMethods) {
+	case 0:
+		normMethods = []string{minMaxNorm}
+		fallthrough
+	case 1:
+		for len(normMethods) < len(weights) {
+			normMethods = append(normMethods, normMethods[0])
+		}
+	}
+	if len(normMethods) != len(weights) {
+		return nil, fmt.Errorf("The length of %s param should be 1 or equal to the length of %s, but got %d and %d", NormMethodKey, WeightsParamsKey, len(normMethods), len(weights))
+	}
+	if thresholds == nil {
+		thresholds = make([]*float32, len(weights))
+	}
+	if len(thresholds) != len(weights) {
+		return nil, fmt.Errorf("The length of %s param should be equal to the length of %s, but got %d and %d", ScoreThresholdsKey, WeightsParamsKey, len(thresholds), len(weights))
+	}
+
+	if base.pkType == schemapb.DataType_Int64 {
+		return &LinearFunction[int64]{RerankBase: *base, weights: weights, normMethods: normMethods, thresholds: thresholds}, nil
+	} else {
+		return &LinearFunction[string]{RerankBase: *base, weights: weights, normMethods: normMethods, thresholds: thresholds}, nil
+	}
+}
+
+func (linear *LinearFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns, idGroup map[any]any) (*IDScores[T], error) {
+	if len(cols) != len(linear.weights) {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprint(len(cols)), fmt.Sprint(len(linear.weights)), "the length of weights param mismatch with ann search requests")
+	}
+	linearScores := map[T]float32{}
+	for i, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		metricType := searchParams.searchMetrics[i]
+		ids := make([]T, 0, col.size)
+		scores := make([]float32, 0, col.size)
+		for j, id := range col.ids.([]T) {
+			if !passThreshold(col.scores[j], linear.thresholds[i], metricType) {
+				continue
+			}
+			ids = append(ids, id)
+			scores = append(scores, col.scores[j])
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		normFunc, _ := getDistributionNormalizeFunc(linear.normMethods[i])
+		scores = normFunc(scores, metricType)
+		for j, id := range ids {
+			linearScores[id] += linear.weights[i] * scores[j]
+		}
+	}
+	if searchParams.isGrouping() {
+		return newGroupingIDScores(linearScores, searchParams, idGroup)
+	}
+	return newIDScores(linearScores, searchParams), nil
+}
+
+func (linear *LinearFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		idScore, err := linear.processOneSearchData(ctx, searchParams, cols, inputs.idGroupValue)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
+
+// passThreshold checks the raw score of the metric: the similarity should be no less than the threshold,
+// and the distance should be no greater than the threshold.
+func passThreshold(score float32, threshold *float32, metricType string) bool {
+	if threshold == nil {
+		return true
+	}
+	if isSimilarityMetric(metricType) {
+		return score >= *threshold
+	}
+	return score <= *threshold
+}
+
+func isSimilarityMetric(metricType string) bool {
+	switch strings.ToUpper(metricType) {
+	case metric.COSINE, metric.IP, metric.BM25:
+		return true
+	default:
+		return false
+	}
+}
+
+type distributionNormalizeFunc func(scores []float32, metricType string) []float32
+
+// getDistributionNormalizeFunc returns the normalization over all the scores of a search request.
+// Distances are negated first so that greater is better, except the none method which keeps the
+// same mapping as the weighted function.
+func getDistributionNormalizeFunc(method string) (distributionNormalizeFunc, error) {
+	switch method {
+	case minMaxNorm:
+		return func(scores []float32, metricType string) []float32 {
+			scores = toLinearGreaterScores(scores, metricType)
+			minScore, maxScore := scores[0], scores[0]
+			for _, score := range scores {
+				minScore = min(minScore, score)
+				maxScore = max(maxScore, score)
+			}
+			for i, score := range scores {
+				if maxScore == minScore {
+					scores[i] = 1
+				} else {
+					scores[i] = (score - minScore) / (maxScore - minScore)
+				}
+			}
+			return scores
+		}, nil
+	case zScoreNorm:
+		return func(scores []float32, metricType string) []float32 {
+			scores = toLinearGreaterScores(scores, metricType)
+			var sum, sqSum float64
+			for _, score := range scores {
+				sum += float64(score)
+			}
+			mean := sum / float64(len(scores))
+			for _, score := range scores {
+				sqSum += (float64(score) - mean) * (float64(score) - mean)
+			}
+			std := math.Sqrt(sqSum / float64(len(scores)))
+			for i, score := range scores {
+				if std == 0 {
+					scores[i] = 0
+				} else {
+					scores[i] = float32((float64(score) - mean) / std)
+				}
+			}
+			return scores
+		}, nil
+	case l2Norm:
+		return func(scores []float32, metricType string) []float32 {
+			scores = toLinearGreaterScores(scores, metricType)
+			var sqSum float64
+			for _, score := range scores {
+				sqSum += float64(score) * float64(score)
+			}
+			norm := math.Sqrt(sqSum)
+			if norm == 0 {
+				return scores
+			}
+			for i, score := range scores {
+				scores[i] = float32(float64(score) / norm)
+			}
+			return scores
+		}, nil
+	case noneNorm:
+		return func(scores []float32, metricType string) []float32 {
+			for i, score := range scores {
+				scores[i] = toGreaterScore(score, metricType)
+			}
+			return scores
+		}, nil
+	default:
+		return nil, fmt.Errorf("Unsupported %s: [%s], list of supported [%s,%s,%s,%s]", NormMethodKey, method, minMaxNorm, zScoreNorm, l2Norm, noneNorm)
+	}
+}
+
+func toLinearGreaterScores(scores []float32, metricType string) []float32 {
+	if isSimilarityMetric(metricType) {
+		return scores
+	}
+	for i, score := range scores {
+		scores[i] = -score
+	}
+	return scores
+}
@@ -0,0 +1,205 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+func TestLinearFunction(t *testing.T) {
+	suite.Run(t, new(LinearFunctionSuite))
+}
+
+type LinearFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *LinearFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{FieldID: 102, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func (s *LinearFunctionSuite) newFunctionSchema(params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: []string{},
+		Params:          append([]*commonpb.KeyValuePair{{Key: reranker, Value: linearName}}, params...),
+	}
+}
+
+func (s *LinearFunctionSuite) TestNewLinearFunction() {
+	{
+		f, err := createFunction(s.schema, s.newFunctionSchema(&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`}))
+		s.NoError(err)
+		s.Equal([]string{minMaxNorm, minMaxNorm}, f.(*LinearFunction[int64]).normMethods)
+		s.Equal([]*float32{nil, nil}, f.(*LinearFunction[int64]).thresholds)
+	}
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: `Z_SCORE`},
+		)
+		functionSchema.Params[0].Value = normalizedName
+		f, err := createFunction(s.schema, functionSchema)
+		s.NoError(err)
+		s.Equal([]string{zScoreNorm, zScoreNorm}, f.(*LinearFunction[int64]).normMethods)
+	}
+	{
+		s.schema.Fields[0] = &schemapb.FieldSchema{FieldID: 100, Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: `["l2", "none"]`},
+			&commonpb.KeyValuePair{Key: ScoreThresholdsKey, Value: `[null, 1.5]`},
+		))
+		s.NoError(err)
+		s.Equal([]string{l2Norm, noneNorm}, f.(*LinearFunction[string]).normMethods)
+		s.Nil(f.(*LinearFunction[string]).thresholds[0])
+		s.Equal(float32(1.5), *f.(*LinearFunction[string]).thresholds[1])
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema())
+		s.ErrorContains(err, "weights not found")
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema(&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[-1]`}))
+		s.ErrorContains(err, "rank param weight should not be negative")
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: `sigmoid`},
+		))
+		s.ErrorContains(err, "Unsupported norm_method: [sigmoid]")
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: `["l2", "none", "z_score"]`},
+		))
+		s.ErrorContains(err, "The length of norm_method param should be 1 or equal to the length of weights")
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: ScoreThresholdsKey, Value: `[0.5]`},
+		))
+		s.ErrorContains(err, "The length of score_thresholds param should be equal to the length of weights")
+	}
+	{
+		_, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: ScoreThresholdsKey, Value: `NotNum`},
+		))
+		s.ErrorContains(err, "Parse score_thresholds param failed")
+	}
+	{
+		functionSchema := s.newFunctionSchema(&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`})
+		functionSchema.InputFieldNames = []string{"ts"}
+		_, err := newLinearFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "The linear function does not support input parameters")
+	}
+}
+
+func (s *LinearFunctionSuite) TestLinearFunctionProcess() {
+	nq := int64(1)
+	// id data: 0 - 9, score: 0 - 9
+	data1 := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "", 0)
+	// id data: 0 - 3, score: 0 - 3
+	data2 := function.GenSearchResultData(nq, 4, schemapb.DataType_Int64, "", 0)
+
+	// min_max
+	{
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`}))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE", "BM25"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Topks)
+		s.Equal([]int64{3, 2, 9}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{0.7333, 0.4889, 0.4}, ret.searchResultData.Scores, 0.001))
+	}
+	// thresholds
+	{
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`},
+			&commonpb.KeyValuePair{Key: ScoreThresholdsKey, Value: `[null, 2]`},
+		))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE", "BM25"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3, 9, 8}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{0.7333, 0.4, 0.3556}, ret.searchResultData.Scores, 0.001))
+	}
+	// distance metric, smaller is better
+	{
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[1]`},
+			&commonpb.KeyValuePair{Key: ScoreThresholdsKey, Value: `[8]`},
+		))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"L2"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0, 1, 2}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{1, 0.875, 0.75}, ret.searchResultData.Scores, 0.001))
+	}
+	// z_score and l2
+	{
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[1, 0]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: `["z_score", "l2"]`},
+		))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 2, 0, -1, -1, 1, false, "", []string{"IP", "BM25"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{9, 8}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{1.5667, 1.2185}, ret.searchResultData.Scores, 0.001))
+	}
+	// number of weights not equal to search data
+	{
+		f, err := newLinearFunction(s.schema, s.newFunctionSchema(&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.4, 0.6]`}))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1}, f.GetInputFieldIDs(), false)
+		_, err = f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.ErrorContains(err, "the length of weights param mismatch with ann search requests")
+	}
+}
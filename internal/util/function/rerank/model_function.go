@@ -25,11 +25,12 @@ import (
 	"net/http"
 	"net/url"
 	"os"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/samber/lo"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/function"
@@ -48,6 +49,8 @@ const (
 	vllmTruncateParamName           string = "truncate_prompt_tokens"
 	tieTruncateParamName            string = "truncate"
 	teiTruncationDirectionParamName string = "truncation_direction"
+	cohereMaxTokensPerDocParamName  string = "max_tokens_per_doc"
+	voyageTruncationParamName       string = "truncation"
 )
 
 type modelProvider interface {
@@ -62,9 +65,12 @@ type baseModel struct {
 	queryKey string
 	docKey   string
 
+	// extra fields of the request body, e.g. truncation params and model name
 	truncateParams map[string]any
+	headers        map[string]string
 
-	parseScores func([]byte) ([]float32, error)
+	// parseScores returns the scores in the order of the documents in the request
+	parseScores func(body []byte, numDocs int) ([]float32, error)
 }
 
 func (base *baseModel) getURL() string {
@@ -77,8 +83,9 @@ func (base *baseModel) rerank(ctx context.Context, query string, docs []string)
 		return nil, err
 	}
 	scores := []float32{}
-	for _, requestBody := range requestBodies {
-		rerankResp, err := base.callService(ctx, requestBody, 30)
+	for i, requestBody := range requestBodies {
+		numDocs := min(base.maxBatch, len(docs)-i*base.maxBatch)
+		rerankResp, err := base.callService(ctx, requestBody, numDocs, 30)
 		if err != nil {
 			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
 		}
@@ -91,17 +98,43 @@ func (base *baseModel) rerank(ctx context.Context, query string, docs []string)
 	return scores, nil
 }
 
-func (base *baseModel) callService(ctx context.Context, requestBody []byte, timeoutSec int64) ([]float32, error) {
+func (base *baseModel) callService(ctx context.Context, requestBody []byte, numDocs int, timeoutSec int64) ([]float32, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type": "application/json",
 	}
+	for k, v := range base.headers {
+		headers[k] = v
+	}
 	body, err := utils.RetrySend(ctx, requestBody, http.MethodPost, base.url, headers, 3)
 	if err != nil {
 		return nil, err
 	}
-	return base.parseScores(body)
+	return base.parseScores(body, numDocs)
+}
+
+type rerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+// sortScoresByIndex returns the scores in the order of the documents,
+// a score is required for each of the numDocs documents, so a short or malformed response is an error.
+func sortScoresByIndex(numDocs int, results []rerankResult) ([]float32, error) {
+	if len(results) != numDocs {
+		return nil, fmt.Errorf("Call Rerank service failed, %d docs but got %d scores", numDocs, len(results))
+	}
+	scores := make([]float32, numDocs)
+	seen := make([]bool, numDocs)
+	for _, result := range results {
+		if result.Index < 0 || result.Index >= numDocs || seen[result.Index] {
+			return nil, fmt.Errorf("Call Rerank service failed, invalid or duplicated index %d of %d docs", result.Index, numDocs)
+		}
+		seen[result.Index] = true
+		scores[result.Index] = result.RelevanceScore
+	}
+	return scores, nil
 }
 
 type vllmRerankRequest struct {
@@ -151,22 +184,14 @@ func newVllmProvider(params []*commonpb.KeyValuePair, conf map[string]string) (m
 		queryKey:       "query",
 		docKey:         "documents",
 		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		parseScores: func(body []byte, numDocs int) ([]float32, error) {
 			var rerankResp vllmRerankResponse
 			if err := json.Unmarshal(body, &rerankResp); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing vllm response failed: %v", err)
 			}
-
-			sort.Slice(rerankResp.Results, func(i, j int) bool {
-				return rerankResp.Results[i].Index < rerankResp.Results[j].Index
-			})
-
-			scores := make([]float32, 0, len(rerankResp.Results))
-			for _, result := range rerankResp.Results {
-				scores = append(scores, result.RelevanceScore)
-			}
-
-			return scores, nil
+			return sortScoresByIndex(numDocs, lo.Map(rerankResp.Results, func(r vllmResult, _ int) rerankResult {
+				return rerankResult{Index: r.Index, RelevanceScore: r.RelevanceScore}
+			}))
 		},
 	}
 	return &vllmProvider{baseModel: model}, nil
@@ -197,19 +222,14 @@ func newTeiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (mo
 		queryKey:       "query",
 		docKey:         "texts",
 		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		parseScores: func(body []byte, numDocs int) ([]float32, error) {
 			var results []TEIResponse
 			if err := json.Unmarshal(body, &results); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing TEI response failed: %v", err)
 			}
-			sort.Slice(results, func(i, j int) bool {
-				return results[i].Index < results[j].Index
-			})
-			scores := make([]float32, len(results))
-			for i, result := range results {
-				scores[i] = result.Score
-			}
-			return scores, nil
+			return sortScoresByIndex(numDocs, lo.Map(results, func(r TEIResponse, _ int) rerankResult {
+				return rerankResult{Index: r.Index, RelevanceScore: r.Score}
+			}))
 		},
 	}
 	return &teiProvider{baseModel: model}, nil
@@ -226,6 +246,19 @@ func isEnable(conf map[string]string, envKey string) bool {
 }
 
 func parseParams(params []*commonpb.KeyValuePair) (string, int, map[string]any, error) {
+	endpoint, maxBatch, truncateParams, err := parseCommonParams(params)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if endpoint == "" {
+		return "", 0, nil, fmt.Errorf("Rerank function lost params endpoint")
+	}
+	return endpoint, maxBatch, truncateParams, nil
+}
+
+// parseCommonParams parses the params shared by all the rerank providers, the endpoint is optional here
+// since the hosted services have default urls.
+func parseCommonParams(params []*commonpb.KeyValuePair) (string, int, map[string]any, error) {
 	endpoint := ""
 	maxBatch := 32
 	truncateParams := map[string]any{}
@@ -263,11 +296,20 @@ func parseParams(params []*commonpb.KeyValuePair) (string, int, map[string]any,
 			} else {
 				truncateParams[tieTruncateParamName] = teiTrun
 			}
+		case cohereMaxTokensPerDocParamName:
+			if maxTokens, err := strconv.ParseInt(param.Value, 10, 64); err != nil {
+				return "", 0, nil, fmt.Errorf("Rerank params error, %s: %s is not a number", cohereMaxTokensPerDocParamName, param.Value)
+			} else {
+				truncateParams[cohereMaxTokensPerDocParamName] = maxTokens
+			}
+		case voyageTruncationParamName:
+			if voyageTrun, err := strconv.ParseBool(param.Value); err != nil {
+				return "", 0, nil, fmt.Errorf("Rerank params error, %s: %s is not bool type", voyageTruncationParamName, param.Value)
+			} else {
+				truncateParams[voyageTruncationParamName] = voyageTrun
+			}
 		}
 	}
-	if endpoint == "" {
-		return "", 0, nil, fmt.Errorf("Rerank function lost params endpoint")
-	}
 	if maxBatch <= 0 {
 		return "", 0, nil, fmt.Errorf("Rerank function params max_batch must > 0, but got %d", maxBatch)
 	}
@@ -307,6 +349,12 @@ func newProvider(params []*commonpb.KeyValuePair) (modelProvider, error) {
 				return newVllmProvider(params, conf)
 			case teiProviderName:
 				return newTeiProvider(params, conf)
+			case cohereProviderName:
+				return newCohereProvider(params, conf)
+			case voyageaiProviderName:
+				return newVoyageaiProvider(params, conf)
+			case jinaProviderName, openaiCompatibleProviderName:
+				return newAPIProvider(provider, params, conf)
 			default:
 				return nil, fmt.Errorf("Unknow rerank provider:%s", param.Value)
 			}
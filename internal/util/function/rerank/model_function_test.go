@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -271,6 +272,169 @@ func (s *RerankModelSuite) TestCallTEI() {
 	}
 }
 
+func (s *RerankModelSuite) TestNewAPIProvider() {
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "cohere"},
+			{Key: modelNameParamName, Value: "rerank-v3.5"},
+		}
+		os.Unsetenv(function.CohereRerankAKEnvStr)
+		_, err := newProvider(params)
+		s.ErrorContains(err, "Missing credentials for cohere rerank")
+	}
+	{
+		os.Setenv(function.VoyageAIRerankAKEnvStr, "mock")
+		defer os.Unsetenv(function.VoyageAIRerankAKEnvStr)
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "voyageai"},
+		}
+		_, err := newProvider(params)
+		s.ErrorContains(err, "Rerank function lost params model_name")
+
+		params = append(params, &commonpb.KeyValuePair{Key: modelNameParamName, Value: "rerank-2"})
+		provider, err := newProvider(params)
+		s.NoError(err)
+		s.Equal(voyageaiRerankURL, provider.getURL())
+
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{"voyageai.url": "http://mock.com/v1/rerank"}
+		}
+		provider, err = newProvider(params)
+		s.NoError(err)
+		s.Equal("http://mock.com/v1/rerank", provider.getURL())
+	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "openai_compatible"},
+		}
+		_, err := newProvider(params)
+		s.ErrorContains(err, "Rerank function lost params endpoint")
+
+		params = append(params, &commonpb.KeyValuePair{Key: function.EndpointParamKey, Value: "http://localhost:8000"})
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{"openai_compatible.enable": "false"}
+		}
+		_, err = newProvider(params)
+		s.ErrorContains(err, "openai_compatible rerank is disabled")
+		paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+			return map[string]string{}
+		}
+		os.Setenv(function.EnableOpenAICompatibleEnvStr, "false")
+		_, err = newProvider(params)
+		s.ErrorContains(err, "openai_compatible rerank is disabled")
+		os.Unsetenv(function.EnableOpenAICompatibleEnvStr)
+
+		provider, err := newProvider(params)
+		s.NoError(err)
+		s.Equal("http://localhost:8000/v1/rerank", provider.getURL())
+
+		params[1].Value = "http://localhost:8000/api/rerank"
+		provider, err = newProvider(params)
+		s.NoError(err)
+		s.Equal("http://localhost:8000/api/rerank", provider.getURL())
+	}
+}
+
+func (s *RerankModelSuite) TestCallAPIProvider() {
+	for _, tc := range []struct {
+		provider string
+		response string
+	}{
+		{cohereProviderName, `{"results": [{"index": 1, "relevance_score": 0.2}, {"index": 0, "relevance_score": 0.1}]}`},
+		{voyageaiProviderName, `{"data": [{"index": 1, "relevance_score": 0.2}, {"index": 0, "relevance_score": 0.1}]}`},
+		{jinaProviderName, `{"results": [{"index": 1, "relevance_score": 0.2, "document": {"text": "t2"}}, {"index": 0, "relevance_score": 0.1}]}`},
+		{openaiCompatibleProviderName, `{"results": [{"index": 1, "relevance_score": 0.2}, {"index": 0, "relevance_score": 0.1}]}`},
+	} {
+		var req map[string]any
+		var auth string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &req)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(tc.response))
+		}))
+
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: tc.provider},
+			{Key: function.EndpointParamKey, Value: ts.URL + "/rerank"},
+			{Key: modelNameParamName, Value: "mock-model"},
+			{Key: "credential", Value: "mock"},
+		}
+		paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+			return map[string]string{"mock.apikey": "mock-key"}
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		scores, err := provider.rerank(context.Background(), "mytest", []string{"t1", "t2"})
+		s.NoError(err)
+		s.Equal([]float32{0.1, 0.2}, scores)
+		s.True(strings.EqualFold("Bearer mock-key", auth))
+		s.Equal("mock-model", req["model"])
+		s.Equal("mytest", req["query"])
+		s.Equal([]any{"t1", "t2"}, req["documents"])
+		ts.Close()
+	}
+	{
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results": [{"index": 0, "relevance_score": 0.1}]}`))
+		}))
+		defer ts.Close()
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: voyageaiProviderName},
+			{Key: function.EndpointParamKey, Value: ts.URL},
+			{Key: modelNameParamName, Value: "mock-model"},
+			{Key: "credential", Value: "mock"},
+		}
+		paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+			return map[string]string{"mock.apikey": "mock-key"}
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		_, err = provider.rerank(context.Background(), "mytest", []string{"t1"})
+		s.ErrorContains(err, "Call Rerank service failed, 1 docs but got 0 scores")
+	}
+	// short responses or invalid indexes are errors for all the providers
+	for _, provider := range []string{cohereProviderName, jinaProviderName, openaiCompatibleProviderName} {
+		for _, response := range []string{
+			`{"results": [{"index": 0, "relevance_score": 0.1}]}`,
+			`{"results": [{"index": 0, "relevance_score": 0.1}, {"index": 0, "relevance_score": 0.2}]}`,
+			`{"results": [{"index": 0, "relevance_score": 0.1}, {"index": 2, "relevance_score": 0.2}]}`,
+		} {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(response))
+			}))
+			params := []*commonpb.KeyValuePair{
+				{Key: providerParamName, Value: provider},
+				{Key: function.EndpointParamKey, Value: ts.URL},
+				{Key: modelNameParamName, Value: "mock-model"},
+				{Key: "credential", Value: "mock"},
+				{Key: maxBatchKeyName, Value: "2"},
+			}
+			p, err := newProvider(params)
+			s.NoError(err)
+			_, err = p.rerank(context.Background(), "mytest", []string{"t1", "t2", "t3"})
+			s.ErrorContains(err, "Call Rerank service failed", provider, response)
+			ts.Close()
+		}
+	}
+}
+
+func (s *RerankModelSuite) TestSortScoresByIndex() {
+	scores, err := sortScoresByIndex(3, []rerankResult{{Index: 2, RelevanceScore: 0.3}, {Index: 0, RelevanceScore: 0.1}, {Index: 1, RelevanceScore: 0.2}})
+	s.NoError(err)
+	s.Equal([]float32{0.1, 0.2, 0.3}, scores)
+
+	_, err = sortScoresByIndex(3, []rerankResult{{Index: 0}, {Index: 1}})
+	s.ErrorContains(err, "3 docs but got 2 scores")
+	_, err = sortScoresByIndex(2, []rerankResult{{Index: 0}, {Index: -1}})
+	s.ErrorContains(err, "invalid or duplicated index -1")
+	_, err = sortScoresByIndex(2, []rerankResult{{Index: 1}, {Index: 1}})
+	s.ErrorContains(err, "invalid or duplicated index 1")
+}
+
 func (s *RerankModelSuite) TestNewModelFunction() {
 	schema := &schemapb.CollectionSchema{
 		Name: "test",
@@ -399,6 +563,27 @@ func (s *RerankModelSuite) TestParseParams() {
 		s.Equal(truncateParams[teiTruncationDirectionParamName], "Left")
 		s.Equal(truncateParams[tieTruncateParamName], true)
 	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "cohere"},
+			{Key: cohereMaxTokensPerDocParamName, Value: "error"},
+		}
+		_, _, _, err := parseCommonParams(params)
+		s.ErrorContains(err, "Rerank params error")
+	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "voyageai"},
+			{Key: cohereMaxTokensPerDocParamName, Value: "512"},
+			{Key: voyageTruncationParamName, Value: "false"},
+		}
+		endpoint, maxBatch, truncateParams, err := parseCommonParams(params)
+		s.NoError(err)
+		s.Equal("", endpoint)
+		s.Equal(32, maxBatch)
+		s.Equal(truncateParams[cohereMaxTokensPerDocParamName], int64(512))
+		s.Equal(truncateParams[voyageTruncationParamName], false)
+	}
 }
 
 func (s *RerankModelSuite) TestRerankProcess() {
@@ -44,6 +44,10 @@ const (
 
 	// CSVNullKey specifies the null key used when importing CSV files.
 	CSVNullKey = "nullkey"
+
+	// FileGroups specifies the file groups in json, e.g. [["1.json"], ["2/id.npy", "2/vec.npy"]],
+	// since the v1 import request has only a flat file list. All the files are one group if not set.
+	FileGroups = "file_groups"
 )
 
 // Options for backup-restore mode.
@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+// the resolved window of the collection is cached, so the altered properties take effect after the ttl.
+const windowCacheTTL = time.Minute
+
+// CollectionPropertiesFetcher returns the database name and the properties of the collection.
+type CollectionPropertiesFetcher func(ctx context.Context, collectionID int64) (string, map[string]string, error)
+
+// DatabasePropertiesFetcher returns the properties of the database.
+type DatabasePropertiesFetcher func(ctx context.Context, dbName string) (map[string]string, error)
+
+type windowEntry struct {
+	dbName   string
+	window   *Window
+	expireAt time.Time
+}
+
+type dbPropsEntry struct {
+	props    map[string]string
+	expireAt time.Time
+}
+
+// Checker decides whether a background operation of the collection is allowed to run now.
+// The window configured on the collection overrides the one configured on the database.
+// The operation is always allowed if the window is not configured or can't be resolved,
+// so a broken configuration never blocks compaction, index build or balance forever.
+type Checker struct {
+	getCollectionProps CollectionPropertiesFetcher
+	getDatabaseProps   DatabasePropertiesFetcher
+	activeGauge        *prometheus.GaugeVec
+	now                func() time.Time
+
+	mu      sync.Mutex
+	windows map[int64]*windowEntry
+	dbProps map[string]*dbPropsEntry
+}
+
+// NewChecker creates a Checker, the active state of the windows is recorded by the gauge with db name and collection id labels.
+func NewChecker(getCollectionProps CollectionPropertiesFetcher, getDatabaseProps DatabasePropertiesFetcher, activeGauge *prometheus.GaugeVec) *Checker {
+	return &Checker{
+		getCollectionProps: getCollectionProps,
+		getDatabaseProps:   getDatabaseProps,
+		activeGauge:        activeGauge,
+		now:                time.Now,
+		windows:            make(map[int64]*windowEntry),
+		dbProps:            make(map[string]*dbPropsEntry),
+	}
+}
+
+// Allow returns whether the operation of the collection is allowed now, a nil Checker allows everything.
+func (c *Checker) Allow(ctx context.Context, collectionID int64, op Operation) bool {
+	if c == nil {
+		return true
+	}
+	entry := c.getWindow(ctx, collectionID)
+	if entry.window == nil {
+		return true
+	}
+
+	active := entry.window.Contains(c.now())
+	if c.activeGauge != nil {
+		value := 0.0
+		if active {
+			value = 1
+		}
+		c.activeGauge.WithLabelValues(entry.dbName, strconv.FormatInt(collectionID, 10)).Set(value)
+	}
+	return active || !entry.window.Gates(op)
+}
+
+func (c *Checker) getWindow(ctx context.Context, collectionID int64) *windowEntry {
+	now := c.now()
+	c.mu.Lock()
+	entry, ok := c.windows[collectionID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry
+	}
+
+	entry = c.resolve(ctx, collectionID)
+	entry.expireAt = now.Add(windowCacheTTL)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.windows[collectionID]; ok && old.window != nil && entry.window == nil && c.activeGauge != nil {
+		// the window is removed, stop reporting the state.
+		c.activeGauge.DeleteLabelValues(old.dbName, strconv.FormatInt(collectionID, 10))
+	}
+	c.windows[collectionID] = entry
+	return entry
+}
+
+func (c *Checker) resolve(ctx context.Context, collectionID int64) *windowEntry {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	dbName, props, err := c.getCollectionProps(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to get collection properties for maintenance window, allow all operations", zap.Error(err))
+		return &windowEntry{}
+	}
+	if _, ok := props[common.MaintenanceWindowCronKey]; !ok {
+		props, err = c.getDatabasePropsWithCache(ctx, dbName)
+		if err != nil {
+			log.Warn("failed to get database properties for maintenance window, allow all operations",
+				zap.String("dbName", dbName), zap.Error(err))
+			return &windowEntry{dbName: dbName}
+		}
+	}
+	window, err := ParseWindow(props)
+	if err != nil {
+		log.Warn("invalid maintenance window, allow all operations", zap.String("dbName", dbName), zap.Error(err))
+		return &windowEntry{dbName: dbName}
+	}
+	if window != nil {
+		log.Debug("maintenance window resolved", zap.String("dbName", dbName), zap.Stringer("window", window))
+	}
+	return &windowEntry{dbName: dbName, window: window}
+}
+
+// getDatabasePropsWithCache caches the database properties, since the collections of a database share them.
+func (c *Checker) getDatabasePropsWithCache(ctx context.Context, dbName string) (map[string]string, error) {
+	now := c.now()
+	c.mu.Lock()
+	entry, ok := c.dbProps[dbName]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.props, nil
+	}
+
+	props, err := c.getDatabaseProps(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dbProps[dbName] = &dbPropsEntry{props: props, expireAt: now.Add(windowCacheTTL)}
+	return props, nil
+}
+
+// Remove drops the cached window of the collection, it should be called when the collection is dropped.
+func (c *Checker) Remove(collectionID int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.windows, collectionID)
+}
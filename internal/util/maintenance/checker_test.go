@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	// 01:00 to 05:00 every day in UTC
+	window := map[string]string{
+		common.MaintenanceWindowCronKey:       "0 1 * * *",
+		common.MaintenanceWindowDurationKey:   "4h",
+		common.MaintenanceWindowTimezoneKey:   "UTC",
+		common.MaintenanceWindowOperationsKey: "mix,balance",
+	}
+	collections := map[int64]map[string]string{
+		100: window,
+		101: {},
+		102: {common.MaintenanceWindowCronKey: "invalid"},
+	}
+	databases := map[string]map[string]string{
+		"db1": window,
+	}
+	getCollectionProps := func(ctx context.Context, collectionID int64) (string, map[string]string, error) {
+		props, ok := collections[collectionID]
+		if !ok {
+			return "", nil, errors.New("collection not found")
+		}
+		dbName := "default"
+		if collectionID == 101 {
+			dbName = "db1"
+		}
+		return dbName, props, nil
+	}
+	getDatabaseProps := func(ctx context.Context, dbName string) (map[string]string, error) {
+		return databases[dbName], nil
+	}
+
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{"db_name", "collection_id"})
+	checker := NewChecker(getCollectionProps, getDatabaseProps, gauge)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	checker.now = func() time.Time { return now }
+
+	// outside the window
+	assert.False(t, checker.Allow(ctx, 100, OperationMix))
+	assert.True(t, checker.Allow(ctx, 100, OperationIndex))
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge.WithLabelValues("default", "100")))
+	// the window of the database
+	assert.False(t, checker.Allow(ctx, 101, OperationBalance))
+	// invalid window or unknown collection allows everything
+	assert.True(t, checker.Allow(ctx, 102, OperationMix))
+	assert.True(t, checker.Allow(ctx, 103, OperationMix))
+
+	// inside the window
+	now = time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+	assert.True(t, checker.Allow(ctx, 100, OperationMix))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge.WithLabelValues("default", "100")))
+
+	// the window is removed, it takes effect after the cache expires.
+	now = time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	assert.False(t, checker.Allow(ctx, 100, OperationMix))
+	collections[100] = map[string]string{}
+	assert.False(t, checker.Allow(ctx, 100, OperationMix))
+	now = now.Add(windowCacheTTL)
+	assert.True(t, checker.Allow(ctx, 100, OperationMix))
+	assert.Equal(t, 1, testutil.CollectAndCount(gauge))
+
+	checker.Remove(101)
+	checker.mu.Lock()
+	assert.NotContains(t, checker.windows, int64(101))
+	checker.mu.Unlock()
+
+	var nilChecker *Checker
+	assert.True(t, nilChecker.Allow(ctx, 100, OperationMix))
+	nilChecker.Remove(100)
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type cronBound struct {
+	name     string
+	min, max int
+}
+
+var cronBounds = []cronBound{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is accepted as sunday as well.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// schedule is a parsed standard 5-field cron expression: minute, hour, day of month, month and day of week.
+// Each field supports `*`, single values, ranges `a-b`, steps `*/n` or `a-b/n`, and comma separated lists.
+type schedule struct {
+	minute, hour, dom, month, dow uint64
+	// the day of month and day of week fields are OR-ed when both are restricted, same as the standard cron.
+	domStar, dowStar bool
+}
+
+func parseSchedule(spec string) (*schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronBounds) {
+		return nil, errors.Newf("cron expression %q must have %d fields, got %d", spec, len(cronBounds), len(fields))
+	}
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronBounds[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cron expression %q", spec)
+		}
+		bits[i] = b
+	}
+	// fold sunday 7 to 0
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = (bits[4] | 1) &^ (1 << 7)
+	}
+	return &schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, bound cronBound) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return 0, errors.Newf("invalid step %q of %s", part, bound.name)
+			}
+			rangePart, step = part[:idx], s
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = bound.min, bound.max
+		case strings.Contains(rangePart, "-"):
+			idx := strings.Index(rangePart, "-")
+			var err1, err2 error
+			start, err1 = strconv.Atoi(rangePart[:idx])
+			end, err2 = strconv.Atoi(rangePart[idx+1:])
+			if err1 != nil || err2 != nil {
+				return 0, errors.Newf("invalid range %q of %s", part, bound.name)
+			}
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, errors.Newf("invalid value %q of %s", part, bound.name)
+			}
+			start, end = v, v
+			// `a/n` means from a to the max value with step n.
+			if step > 1 {
+				end = bound.max
+			}
+		}
+		if start < bound.min || end > bound.max || start > end {
+			return 0, errors.Newf("value %q of %s out of range [%d, %d]", part, bound.name, bound.min, bound.max)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// match returns whether the schedule fires at the minute of t, t must be in the expected location.
+func (s *schedule) match(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 ||
+		s.hour&(1<<uint(t.Hour())) == 0 ||
+		s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
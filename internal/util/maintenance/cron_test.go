@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedule(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"1-a * * * *",
+	} {
+		_, err := parseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+
+	s, err := parseSchedule("*/15 1-3,22 * * 7")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1|1<<15|1<<30|1<<45), s.minute)
+	assert.Equal(t, uint64(1<<1|1<<2|1<<3|1<<22), s.hour)
+	// sunday 7 is folded to 0
+	assert.Equal(t, uint64(1), s.dow)
+	assert.True(t, s.domStar)
+	assert.False(t, s.dowStar)
+
+	s, err = parseSchedule("50/5 * * * *")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1<<50|1<<55), s.minute)
+}
+
+func TestScheduleMatch(t *testing.T) {
+	at := func(value string) time.Time {
+		v, err := time.Parse("2006-01-02 15:04", value)
+		assert.NoError(t, err)
+		return v
+	}
+
+	// 2024-01-01 is monday
+	s, err := parseSchedule("30 2 * * 1-5")
+	assert.NoError(t, err)
+	assert.True(t, s.match(at("2024-01-01 02:30")))
+	assert.False(t, s.match(at("2024-01-01 02:31")))
+	assert.False(t, s.match(at("2024-01-06 02:30")))
+
+	// both day of month and day of week are restricted, any of them matches.
+	s, err = parseSchedule("0 0 15 * 0")
+	assert.NoError(t, err)
+	assert.True(t, s.match(at("2024-01-15 00:00")))
+	assert.True(t, s.match(at("2024-01-07 00:00")))
+	assert.False(t, s.match(at("2024-01-08 00:00")))
+
+	s, err = parseSchedule("0 0 1 1,7 *")
+	assert.NoError(t, err)
+	assert.True(t, s.match(at("2024-07-01 00:00")))
+	assert.False(t, s.match(at("2024-06-01 00:00")))
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// Operation is a background operation which could be gated by the maintenance window.
+type Operation string
+
+const (
+	OperationMix        Operation = "mix"
+	OperationL0         Operation = "l0"
+	OperationClustering Operation = "clustering"
+	OperationSort       Operation = "sort"
+	OperationIndex      Operation = "index"
+	OperationBalance    Operation = "balance"
+)
+
+var allOperations = []Operation{
+	OperationMix,
+	OperationL0,
+	OperationClustering,
+	OperationSort,
+	OperationIndex,
+	OperationBalance,
+}
+
+// maxWindowDuration limits the window duration, the window is checked by scanning the start minutes backward.
+const maxWindowDuration = 7 * 24 * time.Hour
+
+// Window is a recurring maintenance window, it starts at each fire time of the cron expression and lasts for the duration.
+// The gated operations only run inside the window, the others are not affected.
+type Window struct {
+	spec       string
+	schedule   *schedule
+	duration   time.Duration
+	location   *time.Location
+	operations typeutil.Set[Operation]
+
+	// the result is memorized by minute, since the window is checked for each segment.
+	mu         sync.Mutex
+	lastMinute time.Time
+	lastActive bool
+}
+
+// ParseWindow parses the maintenance window from the collection or database properties,
+// nil is returned if the window is not configured.
+func ParseWindow(props map[string]string) (*Window, error) {
+	spec, ok := props[common.MaintenanceWindowCronKey]
+	if !ok || strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	sched, err := parseSchedule(spec)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", common.MaintenanceWindowCronKey, err.Error())
+	}
+
+	durationStr, ok := props[common.MaintenanceWindowDurationKey]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("%s is required when %s is set",
+			common.MaintenanceWindowDurationKey, common.MaintenanceWindowCronKey)
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(durationStr))
+	if err != nil || duration < time.Minute || duration > maxWindowDuration {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s %q, should be a duration between %s and %s",
+			common.MaintenanceWindowDurationKey, durationStr, time.Minute, maxWindowDuration)
+	}
+
+	location := time.Local
+	if tz, ok := props[common.MaintenanceWindowTimezoneKey]; ok && strings.TrimSpace(tz) != "" {
+		location, err = time.LoadLocation(strings.TrimSpace(tz))
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid %s %q: %s", common.MaintenanceWindowTimezoneKey, tz, err.Error())
+		}
+	}
+
+	operations := typeutil.NewSet(allOperations...)
+	if opsStr, ok := props[common.MaintenanceWindowOperationsKey]; ok && strings.TrimSpace(opsStr) != "" {
+		operations = typeutil.NewSet[Operation]()
+		for _, op := range strings.Split(opsStr, ",") {
+			op := Operation(strings.ToLower(strings.TrimSpace(op)))
+			if !typeutil.NewSet(allOperations...).Contain(op) {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid %s %q, supported operations: %v",
+					common.MaintenanceWindowOperationsKey, opsStr, allOperations)
+			}
+			operations.Insert(op)
+		}
+	}
+
+	return &Window{
+		spec:       spec,
+		schedule:   sched,
+		duration:   duration,
+		location:   location,
+		operations: operations,
+	}, nil
+}
+
+// ValidateProperties checks the maintenance window properties of a collection or database.
+func ValidateProperties(props ...*commonpb.KeyValuePair) error {
+	_, err := ParseWindow(funcutil.KeyValuePair2Map(props))
+	return err
+}
+
+// HasProperties returns whether any maintenance window property is in the properties.
+func HasProperties(props ...*commonpb.KeyValuePair) bool {
+	for _, p := range props {
+		switch p.GetKey() {
+		case common.MaintenanceWindowCronKey, common.MaintenanceWindowDurationKey,
+			common.MaintenanceWindowOperationsKey, common.MaintenanceWindowTimezoneKey:
+			return true
+		}
+	}
+	return false
+}
+
+// Gates returns whether the operation is restricted to the window.
+func (w *Window) Gates(op Operation) bool {
+	return w.operations.Contain(op)
+}
+
+// Contains returns whether t is inside the window.
+func (w *Window) Contains(t time.Time) bool {
+	t = t.In(w.location)
+	minute := t.Truncate(time.Minute)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if minute.Equal(w.lastMinute) {
+		return w.lastActive
+	}
+
+	active := false
+	for start := minute; t.Sub(start) < w.duration; start = start.Add(-time.Minute) {
+		if w.schedule.match(start.In(w.location)) {
+			active = true
+			break
+		}
+	}
+	w.lastMinute, w.lastActive = minute, active
+	return active
+}
+
+func (w *Window) String() string {
+	return w.spec + " for " + w.duration.String() + " in " + w.location.String()
+}
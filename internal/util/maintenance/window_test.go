@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestParseWindow(t *testing.T) {
+	w, err := ParseWindow(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, w)
+
+	for _, props := range []map[string]string{
+		{common.MaintenanceWindowCronKey: "0 1 * *", common.MaintenanceWindowDurationKey: "1h"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *", common.MaintenanceWindowDurationKey: "abc"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *", common.MaintenanceWindowDurationKey: "30s"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *", common.MaintenanceWindowDurationKey: "200h"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *", common.MaintenanceWindowDurationKey: "1h", common.MaintenanceWindowTimezoneKey: "Mars/Base"},
+		{common.MaintenanceWindowCronKey: "0 1 * * *", common.MaintenanceWindowDurationKey: "1h", common.MaintenanceWindowOperationsKey: "mix,backup"},
+	} {
+		_, err := ParseWindow(props)
+		assert.Error(t, err, props)
+	}
+
+	w, err = ParseWindow(map[string]string{
+		common.MaintenanceWindowCronKey:       "0 1 * * *",
+		common.MaintenanceWindowDurationKey:   "4h",
+		common.MaintenanceWindowOperationsKey: "Clustering, index",
+		common.MaintenanceWindowTimezoneKey:   "UTC",
+	})
+	assert.NoError(t, err)
+	assert.True(t, w.Gates(OperationClustering))
+	assert.True(t, w.Gates(OperationIndex))
+	assert.False(t, w.Gates(OperationMix))
+	assert.False(t, w.Gates(OperationBalance))
+	assert.NotEmpty(t, w.String())
+
+	w, err = ParseWindow(map[string]string{
+		common.MaintenanceWindowCronKey:     "0 1 * * *",
+		common.MaintenanceWindowDurationKey: "4h",
+	})
+	assert.NoError(t, err)
+	for _, op := range allOperations {
+		assert.True(t, w.Gates(op))
+	}
+}
+
+func TestValidateProperties(t *testing.T) {
+	assert.False(t, HasProperties(&commonpb.KeyValuePair{Key: common.MmapEnabledKey, Value: "true"}))
+	assert.True(t, HasProperties(&commonpb.KeyValuePair{Key: common.MaintenanceWindowDurationKey, Value: "1h"}))
+
+	assert.NoError(t, ValidateProperties())
+	assert.Error(t, ValidateProperties(&commonpb.KeyValuePair{Key: common.MaintenanceWindowCronKey, Value: "0 1 * * *"}))
+	// the later value overrides the former one.
+	assert.NoError(t, ValidateProperties(
+		&commonpb.KeyValuePair{Key: common.MaintenanceWindowCronKey, Value: "0 1 * * *"},
+		&commonpb.KeyValuePair{Key: common.MaintenanceWindowDurationKey, Value: "abc"},
+		&commonpb.KeyValuePair{Key: common.MaintenanceWindowDurationKey, Value: "1h"},
+	))
+}
+
+func TestWindowContains(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	assert.NoError(t, err)
+
+	// 22:00 to 06:00 on weekdays in Shanghai, crossing the midnight.
+	w, err := ParseWindow(map[string]string{
+		common.MaintenanceWindowCronKey:     "0 22 * * 1-5",
+		common.MaintenanceWindowDurationKey: "8h",
+		common.MaintenanceWindowTimezoneKey: "Asia/Shanghai",
+	})
+	assert.NoError(t, err)
+
+	// 2024-01-01 is monday
+	assert.False(t, w.Contains(time.Date(2024, 1, 1, 21, 59, 59, 0, shanghai)))
+	assert.True(t, w.Contains(time.Date(2024, 1, 1, 22, 0, 0, 0, shanghai)))
+	assert.True(t, w.Contains(time.Date(2024, 1, 2, 5, 59, 59, 0, shanghai)))
+	assert.False(t, w.Contains(time.Date(2024, 1, 2, 6, 0, 0, 0, shanghai)))
+	// the same instant in another location.
+	assert.True(t, w.Contains(time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)))
+	// the window starts on friday lasts to saturday morning, but not the one on saturday night.
+	assert.True(t, w.Contains(time.Date(2024, 1, 6, 1, 0, 0, 0, shanghai)))
+	assert.False(t, w.Contains(time.Date(2024, 1, 6, 23, 0, 0, 0, shanghai)))
+}
@@ -57,6 +57,18 @@ func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb
 	return merr.Success(), nil
 }
 
+func (m *GrpcRootCoordClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
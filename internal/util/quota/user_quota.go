@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"encoding/json"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// UserLimits is the user level limits, math.MaxFloat64 means no limit.
+type UserLimits struct {
+	DMLRate       float64 // bytes per second
+	DQLRate       float64 // vectors or queries per second
+	DailyRequests float64
+	DailyBytes    float64
+}
+
+// userLimitsOverride is the json format of the user level limits of a user or role,
+// the unset fields fall back to the defaults.
+type userLimitsOverride struct {
+	DMLRate       *float64 `json:"dmlRate"`       // MB/s
+	DQLRate       *float64 `json:"dqlRate"`       // vps
+	DailyRequests *float64 `json:"dailyRequests"` // requests
+	DailyBytes    *float64 `json:"dailyBytes"`    // MB
+}
+
+// GetUserLimits returns the user level limits of the user granted the roles.
+// The limits of the user take precedence over the limits of its roles,
+// and the most permissive one is used if multiple roles are configured.
+func GetUserLimits(user string, roles []string) UserLimits {
+	quotaConfig := &paramtable.Get().QuotaConfig
+	if !quotaConfig.UserLimitEnabled.GetAsBool() {
+		return UserLimits{
+			DMLRate:       math.MaxFloat64,
+			DQLRate:       math.MaxFloat64,
+			DailyRequests: math.MaxFloat64,
+			DailyBytes:    math.MaxFloat64,
+		}
+	}
+	limits := UserLimits{
+		DMLRate:       quotaConfig.UserDMLMaxRate.GetAsFloat(),
+		DQLRate:       quotaConfig.UserDQLMaxRate.GetAsFloat(),
+		DailyRequests: quotaConfig.UserMaxDailyRequests.GetAsFloat(),
+		DailyBytes:    quotaConfig.UserMaxDailyBytes.GetAsFloat(),
+	}
+
+	roleOverrides := parseUserLimitsOverrides(quotaConfig.UserLimitsPerRole.GetValue())
+	var roleLimits userLimitsOverride
+	for _, role := range roles {
+		override, ok := roleOverrides[role]
+		if !ok {
+			continue
+		}
+		roleLimits.DMLRate = morePermissive(roleLimits.DMLRate, override.DMLRate)
+		roleLimits.DQLRate = morePermissive(roleLimits.DQLRate, override.DQLRate)
+		roleLimits.DailyRequests = morePermissive(roleLimits.DailyRequests, override.DailyRequests)
+		roleLimits.DailyBytes = morePermissive(roleLimits.DailyBytes, override.DailyBytes)
+	}
+	limits.apply(roleLimits)
+
+	if override, ok := parseUserLimitsOverrides(quotaConfig.UserLimitsPerUser.GetValue())[user]; ok {
+		limits.apply(override)
+	}
+	return limits
+}
+
+func (l *UserLimits) apply(override userLimitsOverride) {
+	if override.DMLRate != nil {
+		l.DMLRate = normalizeUserLimit(*override.DMLRate, paramtable.MBSize)
+	}
+	if override.DQLRate != nil {
+		l.DQLRate = normalizeUserLimit(*override.DQLRate, 1)
+	}
+	if override.DailyRequests != nil {
+		l.DailyRequests = normalizeUserLimit(*override.DailyRequests, 1)
+	}
+	if override.DailyBytes != nil {
+		l.DailyBytes = normalizeUserLimit(*override.DailyBytes, paramtable.MBSize)
+	}
+}
+
+func parseUserLimitsOverrides(value string) map[string]userLimitsOverride {
+	overrides := make(map[string]userLimitsOverride)
+	if value == "" {
+		return overrides
+	}
+	if err := json.Unmarshal([]byte(value), &overrides); err != nil {
+		log.Warn("invalid user limits config, ignore it", zap.String("value", value), zap.Error(err))
+		return make(map[string]userLimitsOverride)
+	}
+	return overrides
+}
+
+// morePermissive returns the more permissive one of the two configured limits, nil means not configured.
+func morePermissive(a, b *float64) *float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if *a < 0 || (*b >= 0 && *a > *b) {
+		return a
+	}
+	return b
+}
+
+// normalizeUserLimit converts the configured limit by the unit, negative value means no limit.
+func normalizeUserLimit(v float64, unit float64) float64 {
+	if v < 0 {
+		return math.MaxFloat64
+	}
+	return v * unit
+}
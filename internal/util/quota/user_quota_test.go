@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestGetUserLimits(t *testing.T) {
+	paramtable.Init()
+	param := paramtable.Get()
+	unlimited := UserLimits{
+		DMLRate:       math.MaxFloat64,
+		DQLRate:       math.MaxFloat64,
+		DailyRequests: math.MaxFloat64,
+		DailyBytes:    math.MaxFloat64,
+	}
+
+	// the overrides are ignored if the user level limit is disabled.
+	param.Save(param.QuotaConfig.UserLimitsPerUser.Key, `{"alice": {"dqlRate": 1}}`)
+	defer param.Reset(param.QuotaConfig.UserLimitsPerUser.Key)
+	assert.Equal(t, unlimited, GetUserLimits("alice", nil))
+
+	param.Save(param.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer param.Reset(param.QuotaConfig.UserLimitEnabled.Key)
+	param.Save(param.QuotaConfig.UserDMLMaxRate.Key, "1")
+	defer param.Reset(param.QuotaConfig.UserDMLMaxRate.Key)
+	param.Save(param.QuotaConfig.UserDQLMaxRate.Key, "10")
+	defer param.Reset(param.QuotaConfig.UserDQLMaxRate.Key)
+	param.Save(param.QuotaConfig.UserMaxDailyRequests.Key, "1000")
+	defer param.Reset(param.QuotaConfig.UserMaxDailyRequests.Key)
+	param.Save(param.QuotaConfig.UserMaxDailyBytes.Key, "2")
+	defer param.Reset(param.QuotaConfig.UserMaxDailyBytes.Key)
+	param.Save(param.QuotaConfig.UserLimitsPerRole.Key,
+		`{"reader": {"dqlRate": 100, "dailyRequests": 2000}, "admin": {"dqlRate": -1}, "writer": {"dmlRate": 5, "dailyBytes": 10}}`)
+	defer param.Reset(param.QuotaConfig.UserLimitsPerRole.Key)
+
+	// defaults
+	assert.Equal(t, UserLimits{
+		DMLRate:       1 * paramtable.MBSize,
+		DQLRate:       10,
+		DailyRequests: 1000,
+		DailyBytes:    2 * paramtable.MBSize,
+	}, GetUserLimits("bob", []string{"unknown"}))
+
+	// role overrides
+	assert.Equal(t, UserLimits{
+		DMLRate:       5 * paramtable.MBSize,
+		DQLRate:       100,
+		DailyRequests: 2000,
+		DailyBytes:    10 * paramtable.MBSize,
+	}, GetUserLimits("bob", []string{"reader", "writer"}))
+
+	// the most permissive role wins, negative value means no limit.
+	limits := GetUserLimits("bob", []string{"reader", "admin"})
+	assert.Equal(t, math.MaxFloat64, limits.DQLRate)
+	assert.Equal(t, float64(2000), limits.DailyRequests)
+
+	// user overrides take precedence over roles.
+	limits = GetUserLimits("alice", []string{"admin"})
+	assert.Equal(t, float64(1), limits.DQLRate)
+	assert.Equal(t, float64(1000), limits.DailyRequests)
+
+	// invalid overrides are ignored.
+	param.Save(param.QuotaConfig.UserLimitsPerUser.Key, `invalid`)
+	assert.Equal(t, float64(10), GetUserLimits("alice", nil).DQLRate)
+}
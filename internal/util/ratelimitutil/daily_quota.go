@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"sync"
+	"time"
+)
+
+// DailyQuotaType is the type of daily quota.
+type DailyQuotaType int32
+
+const (
+	// DailyRequests is the quota of the number of requests per day.
+	DailyRequests DailyQuotaType = iota
+	// DailyBytes is the quota of the size of written data per day.
+	DailyBytes
+)
+
+func (t DailyQuotaType) String() string {
+	switch t {
+	case DailyRequests:
+		return "DailyRequests"
+	case DailyBytes:
+		return "DailyBytes"
+	default:
+		return "Unknown"
+	}
+}
+
+const day = 24 * time.Hour
+
+// DailyQuota is a quota which is reset at the beginning of each UTC day.
+type DailyQuota struct {
+	mu    sync.Mutex
+	limit float64
+	used  float64
+	// start is the beginning of the day that used is accumulated in.
+	start time.Time
+}
+
+// NewDailyQuota returns a new DailyQuota with the limit.
+func NewDailyQuota(limit float64) *DailyQuota {
+	return &DailyQuota{limit: limit}
+}
+
+// AllowN reports whether n can be consumed at time now, and consumes it if allowed.
+func (q *DailyQuota) AllowN(now time.Time, n float64) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.advance(now)
+	if q.used+n > q.limit {
+		return false
+	}
+	q.used += n
+	return true
+}
+
+// Cancel gives back the n consumed by AllowN.
+func (q *DailyQuota) Cancel(n float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.used -= n
+	if q.used < 0 {
+		q.used = 0
+	}
+}
+
+// Used returns the consumed quota of the day at time now.
+func (q *DailyQuota) Used(now time.Time) float64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.advance(now)
+	return q.used
+}
+
+// Limit returns the limit of the quota.
+func (q *DailyQuota) Limit() float64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit
+}
+
+// SetLimit sets the limit of the quota, the consumed quota is kept.
+func (q *DailyQuota) SetLimit(limit float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = limit
+}
+
+// advance resets the consumed quota if now is in a new day.
+func (q *DailyQuota) advance(now time.Time) {
+	start := now.UTC().Truncate(day)
+	if start.After(q.start) {
+		q.start = start
+		q.used = 0
+	}
+}
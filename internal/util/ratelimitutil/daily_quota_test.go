@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailyQuota(t *testing.T) {
+	now := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	q := NewDailyQuota(10)
+	assert.Equal(t, float64(10), q.Limit())
+
+	assert.True(t, q.AllowN(now, 6))
+	assert.False(t, q.AllowN(now, 5))
+	assert.True(t, q.AllowN(now, 4))
+	assert.Equal(t, float64(10), q.Used(now))
+
+	q.Cancel(4)
+	assert.Equal(t, float64(6), q.Used(now))
+	q.Cancel(100)
+	assert.Equal(t, float64(0), q.Used(now))
+
+	q.SetLimit(1)
+	assert.False(t, q.AllowN(now, 2))
+	assert.True(t, q.AllowN(now, 1))
+
+	// the quota is reset in the next day.
+	assert.False(t, q.AllowN(now.Add(30*time.Minute), 1))
+	next := now.Add(time.Hour)
+	assert.Equal(t, float64(0), q.Used(next))
+	assert.True(t, q.AllowN(next, 1))
+	// the time going back should not reset the quota.
+	assert.False(t, q.AllowN(now, 1))
+
+	assert.Equal(t, "DailyRequests", DailyRequests.String())
+	assert.Equal(t, "DailyBytes", DailyBytes.String())
+	assert.Equal(t, "Unknown", DailyQuotaType(100).String())
+}
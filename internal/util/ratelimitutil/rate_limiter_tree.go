@@ -42,6 +42,9 @@ type RateLimiterNode struct {
 	// children will be collections if current level is database
 	// children will be partitions if current level is collection
 	children *typeutil.ConcurrentMap[int64, *RateLimiterNode]
+
+	// daily quotas, only used by the user level for now
+	dailyQuotas *typeutil.ConcurrentMap[DailyQuotaType, *DailyQuota]
 }
 
 func NewRateLimiterNode(level internalpb.RateScope) *RateLimiterNode {
@@ -49,6 +52,7 @@ func NewRateLimiterNode(level internalpb.RateScope) *RateLimiterNode {
 		limiters:    typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter](),
 		quotaStates: typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode](),
 		children:    typeutil.NewConcurrentMap[int64, *RateLimiterNode](),
+		dailyQuotas: typeutil.NewConcurrentMap[DailyQuotaType, *DailyQuota](),
 		level:       level,
 	}
 	return rln
@@ -110,6 +114,27 @@ func (rln *RateLimiterNode) GetRateLimitError(rate float64) error {
 	return merr.WrapErrServiceRateLimit(rate, "request is rejected by grpc RateLimiter middleware, please retry later")
 }
 
+// CheckDailyQuota consumes n of the daily quota, returns an error if the quota is exhausted.
+func (rln *RateLimiterNode) CheckDailyQuota(qt DailyQuotaType, n float64) error {
+	quota, ok := rln.dailyQuotas.Get(qt)
+	if !ok {
+		return nil
+	}
+	if !quota.AllowN(time.Now(), n) {
+		return merr.WrapErrServiceQuotaExceeded(fmt.Sprintf("%s quota is exhausted, limit: %v", qt.String(), quota.Limit()))
+	}
+	return nil
+}
+
+// CancelDailyQuota gives back the n consumed by CheckDailyQuota.
+func (rln *RateLimiterNode) CancelDailyQuota(qt DailyQuotaType, n float64) {
+	quota, ok := rln.dailyQuotas.Get(qt)
+	if !ok {
+		return
+	}
+	quota.Cancel(n)
+}
+
 func TraverseRateLimiterTree(root *RateLimiterNode, fn1 func(internalpb.RateType, *ratelimitutil.Limiter) bool,
 	fn2 func(node *RateLimiterNode, state milvuspb.QuotaState, errCode commonpb.ErrorCode) bool,
 ) {
@@ -157,6 +182,10 @@ func (rln *RateLimiterNode) SetQuotaStates(new *typeutil.ConcurrentMap[milvuspb.
 	rln.quotaStates = new
 }
 
+func (rln *RateLimiterNode) GetDailyQuotas() *typeutil.ConcurrentMap[DailyQuotaType, *DailyQuota] {
+	return rln.dailyQuotas
+}
+
 func (rln *RateLimiterNode) GetID() int64 {
 	return rln.id
 }
@@ -171,16 +200,27 @@ const clearInvalidNodeInterval = 1 * time.Minute
 //		-> database level
 //			-> collection level
 //				-> partition levelearl
+//
+// and the user level limiters beside the global level, which are keyed by user name.
 type RateLimiterTree struct {
-	root *RateLimiterNode
-	mu   sync.RWMutex
+	root  *RateLimiterNode
+	users *typeutil.ConcurrentMap[string, *RateLimiterNode]
+	mu    sync.RWMutex
+
+	// the users which are absent from the last request of ClearInvalidUserLimiters.
+	absentUsers typeutil.Set[string]
 
 	lastClearTime time.Time
 }
 
 // NewRateLimiterTree returns a new RateLimiterTree.
 func NewRateLimiterTree(root *RateLimiterNode) *RateLimiterTree {
-	return &RateLimiterTree{root: root, lastClearTime: time.Now()}
+	return &RateLimiterTree{
+		root:          root,
+		users:         typeutil.NewConcurrentMap[string, *RateLimiterNode](),
+		absentUsers:   typeutil.NewSet[string](),
+		lastClearTime: time.Now(),
+	}
 }
 
 // GetRootLimiters get root limiters
@@ -350,3 +390,43 @@ func (m *RateLimiterTree) GetOrCreatePartitionLimiters(dbID int64, collectionID
 	collectionRateLimiters.AddChild(partitionID, partRateLimiters)
 	return partRateLimiters
 }
+
+// GetUsers returns the user level limiters keyed by user name.
+func (m *RateLimiterTree) GetUsers() *typeutil.ConcurrentMap[string, *RateLimiterNode] {
+	return m.users
+}
+
+func (m *RateLimiterTree) GetUserLimiters(user string) *RateLimiterNode {
+	n, _ := m.users.Get(user)
+	return n
+}
+
+// GetOrCreateUserLimiters get limiter of user level, or create a user limiter if it doesn't exist.
+func (m *RateLimiterTree) GetOrCreateUserLimiters(user string, newUserRateLimiter func() *RateLimiterNode) *RateLimiterNode {
+	if userRateLimiters := m.GetUserLimiters(user); userRateLimiters != nil {
+		return userRateLimiters
+	}
+	userRateLimiters, _ := m.users.GetOrInsert(user, newUserRateLimiter())
+	return userRateLimiters
+}
+
+// ClearInvalidUserLimiters removes the user level limiters which are absent from the requests twice in a row,
+// the limiter of the user served after the last metrics collection of QuotaCenter is absent only once.
+func (m *RateLimiterTree) ClearInvalidUserLimiters(reqUserLimiters map[string]*proxypb.Limiter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	absentUsers := typeutil.NewSet[string]()
+	m.users.Range(func(user string, _ *RateLimiterNode) bool {
+		if _, ok := reqUserLimiters[user]; ok {
+			return true
+		}
+		if m.absentUsers.Contain(user) {
+			m.users.Remove(user)
+		} else {
+			absentUsers.Insert(user)
+		}
+		return true
+	})
+	m.absentUsers = absentUsers
+}
@@ -214,3 +214,61 @@ func TestRateLimiterTreeClearInvalidLimiterNode(t *testing.T) {
 	assert.Equal(t, 1, root.GetChild(1).GetChildren().Len())
 	assert.Equal(t, 1, root.GetChild(1).GetChild(10).GetChildren().Len())
 }
+
+func TestRateLimiterNodeCheckDailyQuota(t *testing.T) {
+	rln := NewRateLimiterNode(internalpb.RateScope_User)
+	// no daily quota
+	assert.NoError(t, rln.CheckDailyQuota(DailyRequests, 100))
+	rln.CancelDailyQuota(DailyRequests, 100)
+
+	rln.GetDailyQuotas().Insert(DailyRequests, NewDailyQuota(1))
+	assert.NoError(t, rln.CheckDailyQuota(DailyRequests, 1))
+	err := rln.CheckDailyQuota(DailyRequests, 1)
+	assert.True(t, errors.Is(err, merr.ErrServiceQuotaExceeded))
+	rln.CancelDailyQuota(DailyRequests, 1)
+	assert.NoError(t, rln.CheckDailyQuota(DailyRequests, 1))
+}
+
+func TestRateLimiterTreeUserLimiters(t *testing.T) {
+	tree := NewRateLimiterTree(NewRateLimiterNode(internalpb.RateScope_Cluster))
+	assert.Nil(t, tree.GetUserLimiters("alice"))
+
+	created := 0
+	newUserLimiter := func() *RateLimiterNode {
+		created++
+		return NewRateLimiterNode(internalpb.RateScope_User)
+	}
+	alice := tree.GetOrCreateUserLimiters("alice", newUserLimiter)
+	assert.Equal(t, internalpb.RateScope_User, alice.Level())
+	assert.Same(t, alice, tree.GetOrCreateUserLimiters("alice", newUserLimiter))
+	assert.Same(t, alice, tree.GetUserLimiters("alice"))
+	assert.Equal(t, 1, created)
+
+	tree.GetOrCreateUserLimiters("bob", newUserLimiter)
+	assert.ElementsMatch(t, []string{"alice", "bob"}, tree.GetUsers().Keys())
+
+	// the user level is not part of the cluster tree.
+	assert.Equal(t, 0, tree.GetRootLimiters().GetChildren().Len())
+}
+
+func TestRateLimiterTreeClearInvalidUserLimiters(t *testing.T) {
+	tree := NewRateLimiterTree(NewRateLimiterNode(internalpb.RateScope_Cluster))
+	newUserLimiter := func() *RateLimiterNode {
+		return NewRateLimiterNode(internalpb.RateScope_User)
+	}
+	tree.GetOrCreateUserLimiters("alice", newUserLimiter)
+	tree.GetOrCreateUserLimiters("bob", newUserLimiter)
+
+	tree.ClearInvalidUserLimiters(map[string]*proxypb.Limiter{"alice": {}})
+	assert.ElementsMatch(t, []string{"alice", "bob"}, tree.GetUsers().Keys())
+
+	// bob is served again before the next request.
+	tree.ClearInvalidUserLimiters(map[string]*proxypb.Limiter{"alice": {}, "bob": {}})
+	tree.ClearInvalidUserLimiters(map[string]*proxypb.Limiter{"alice": {}})
+	assert.ElementsMatch(t, []string{"alice", "bob"}, tree.GetUsers().Keys())
+
+	tree.ClearInvalidUserLimiters(map[string]*proxypb.Limiter{})
+	assert.ElementsMatch(t, []string{"alice"}, tree.GetUsers().Keys())
+	tree.ClearInvalidUserLimiters(nil)
+	assert.Equal(t, 0, tree.GetUsers().Len())
+}
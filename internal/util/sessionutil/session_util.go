@@ -352,7 +352,7 @@ func (s *Session) getServerID() (int64, error) {
 func GetServerLabelsFromEnv(role string) map[string]string {
 	ret := make(map[string]string)
 	switch role {
-	case "querynode":
+	case "querynode", "streamingnode":
 		for _, value := range os.Environ() {
 			rs := []rune(value)
 			in := strings.Index(value, "=")
@@ -1078,6 +1078,13 @@ func (s *SessionSuite) TestGetSessions() {
 	assert.Equal(s.T(), 2, len(ret))
 	assert.Equal(s.T(), "value1", ret["key1"])
 	assert.Equal(s.T(), "value2", ret["key2"])
+
+	ret = GetServerLabelsFromEnv("streamingnode")
+	assert.Equal(s.T(), 2, len(ret))
+	assert.Equal(s.T(), "value1", ret["key1"])
+
+	ret = GetServerLabelsFromEnv("datanode")
+	assert.Equal(s.T(), 0, len(ret))
 }
 
 func TestSessionSuite(t *testing.T) {
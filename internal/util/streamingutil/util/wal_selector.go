@@ -13,6 +13,8 @@ const (
 	WALTypeKafka      = "kafka"
 	WALTypePulsar     = "pulsar"
 	WALTypeWoodpecker = "woodpecker"
+	WALTypeFileWAL    = "filewal"
+	WALTypeNats       = "nats"
 )
 
 type walEnable struct {
@@ -64,7 +66,8 @@ func validateWALName(standalone bool, mqType string) error {
 	// we may register more mq type by plugin.
 	// so we should not check all mq type here.
 	// only check standalone type.
-	if !standalone && mqType == WALTypeRocksmq {
+	// the local wal can not be shared by multiple nodes.
+	if !standalone && (mqType == WALTypeRocksmq || mqType == WALTypeFileWAL) {
 		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
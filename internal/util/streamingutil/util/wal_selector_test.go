@@ -8,6 +8,8 @@ import (
 
 func TestValidateWALType(t *testing.T) {
 	assert.Error(t, validateWALName(false, WALTypeRocksmq))
+	assert.Error(t, validateWALName(false, WALTypeFileWAL))
+	assert.NoError(t, validateWALName(true, WALTypeFileWAL))
 }
 
 func TestSelectWALType(t *testing.T) {
@@ -27,6 +29,9 @@ func TestSelectWALType(t *testing.T) {
 	assert.Equal(t, mustSelectWALName(true, WALTypeKafka, walEnable{true, true, true, true}), WALTypeKafka)
 	assert.Equal(t, mustSelectWALName(true, WALTypeWoodpecker, walEnable{true, true, true, true}), WALTypeWoodpecker)
 	assert.Panics(t, func() { mustSelectWALName(false, WALTypeRocksmq, walEnable{true, true, true, true}) })
+	assert.Equal(t, mustSelectWALName(true, WALTypeFileWAL, walEnable{true, true, true, true}), WALTypeFileWAL)
+	assert.Panics(t, func() { mustSelectWALName(false, WALTypeFileWAL, walEnable{true, true, true, true}) })
+	assert.Equal(t, mustSelectWALName(false, WALTypeNats, walEnable{true, true, true, true}), WALTypeNats)
 	assert.Equal(t, mustSelectWALName(false, WALTypePulsar, walEnable{true, true, true, true}), WALTypePulsar)
 	assert.Equal(t, mustSelectWALName(false, WALTypeKafka, walEnable{true, true, true, true}), WALTypeKafka)
 	assert.Equal(t, mustSelectWALName(false, WALTypeWoodpecker, walEnable{true, true, true, true}), WALTypeWoodpecker)
@@ -121,6 +121,9 @@ const (
 
 	// JSONIndexPath storage path const for json index
 	JSONIndexPath = "json_key_index_log"
+
+	// TrashPath storage path const for the objects quarantined by garbage collection
+	TrashPath = "trash"
 )
 
 // Search, Index parameter keys
@@ -169,6 +172,9 @@ const (
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
 	CollectionDescription       = "collection.description"
 
+	// CollectionSearchResultCacheKey opts the collection in the proxy side search result cache.
+	CollectionSearchResultCacheKey = "collection.searchResultCache.enabled"
+
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
 	CollectionInsertRateMinKey   = "collection.insertRate.min.mb"
@@ -184,6 +190,10 @@ const (
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
 
+	// binlog compression, codec name and level used to write insert & delta binlogs
+	CollectionBinlogCompressionKey      = "collection.binlog.compression"
+	CollectionBinlogCompressionLevelKey = "collection.binlog.compression.level"
+
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
 	// database level properties
@@ -217,6 +227,12 @@ const (
 	ReplicateIDKey             = "replicate.id"
 	ReplicateEndTSKey          = "replicate.endTS"
 	IndexNonEncoding           = "index.nonEncoding"
+
+	// maintenance window of the collection or database, the gated background operations only run inside the window.
+	MaintenanceWindowCronKey       = "maintenance.window.cron"
+	MaintenanceWindowDurationKey   = "maintenance.window.duration"
+	MaintenanceWindowOperationsKey = "maintenance.window.operations"
+	MaintenanceWindowTimezoneKey   = "maintenance.window.timezone"
 )
 
 const (
@@ -290,6 +306,15 @@ func IsCollectionLazyLoadEnabled(kvs ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+func IsSearchResultCacheEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == CollectionSearchResultCacheKey && strings.ToLower(kv.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
 func IsPartitionKeyIsolationKvEnabled(kvs ...*commonpb.KeyValuePair) (bool, error) {
 	for _, kv := range kvs {
 		if kv.Key == PartitionKeyIsolationKey {
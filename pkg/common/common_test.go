@@ -258,3 +258,10 @@ func TestReplicateProperty(t *testing.T) {
 		}
 	})
 }
+
+func TestIsSearchResultCacheEnabled(t *testing.T) {
+	assert.False(t, IsSearchResultCacheEnabled())
+	assert.False(t, IsSearchResultCacheEnabled(&commonpb.KeyValuePair{Key: CollectionSearchResultCacheKey, Value: "false"}))
+	assert.False(t, IsSearchResultCacheEnabled(&commonpb.KeyValuePair{Key: "foo", Value: "true"}))
+	assert.True(t, IsSearchResultCacheEnabled(&commonpb.KeyValuePair{Key: CollectionSearchResultCacheKey, Value: "True"}))
+}
@@ -96,3 +96,17 @@ type SnapShotKV interface {
 	MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, ts typeutil.Timestamp) error
 	MultiSaveAndRemoveWithPrefix(ctx context.Context, saves map[string]string, removals []string, ts typeutil.Timestamp) error
 }
+
+// MVCCKV is MetaKv backed by a store with native multi-version support, e.g. etcd or TiKV.
+// Revision is the etcd revision or the TiKV timestamp, which grows with each committed transaction.
+// The history is discarded by the store itself, i.e. the auto compaction of etcd and the GC of TiKV.
+type MVCCKV interface {
+	MetaKv
+	// MultiSaveAndRemoveWithRevision saves the kvs, removes the keys and the keys with the removal prefixes
+	// in one transaction, and returns a revision at which the transaction is visible.
+	MultiSaveAndRemoveWithRevision(ctx context.Context, saves map[string]string, removals []string, removalPrefixes []string) (int64, error)
+	// LoadAtRevision returns the value of the key at the revision.
+	LoadAtRevision(ctx context.Context, key string, revision int64) (string, error)
+	// LoadWithPrefixAtRevision returns the keys and values with the prefix at the revision.
+	LoadWithPrefixAtRevision(ctx context.Context, prefix string, revision int64) ([]string, []string, error)
+}
@@ -383,6 +383,16 @@ var (
 			Name:      "task_num_in_scheduler",
 			Help:      "number of tasks in global scheduler",
 		}, []string{TaskTypeLabel, TaskStateLabel})
+
+	// DataCoordMaintenanceWindowActive records whether the maintenance window of the collection is active,
+	// only the collections with maintenance window configured are recorded.
+	DataCoordMaintenanceWindowActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "maintenance_window_active",
+			Help:      "whether the maintenance window of the collection is active, 1 for active and 0 for inactive",
+		}, []string{databaseLabelName, collectionIDLabelName})
 )
 
 // RegisterDataCoord registers DataCoord metrics
@@ -417,6 +427,7 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(IndexStatsTaskNum)
 	registry.MustRegister(TaskVersion)
 	registry.MustRegister(TaskNumInGlobalScheduler)
+	registry.MustRegister(DataCoordMaintenanceWindowActive)
 	registerStreamingCoord(registry)
 }
 
@@ -445,4 +456,7 @@ func CleanupDataCoordWithCollectionID(collectionID int64) {
 	DataCoordL0DeleteEntriesNum.DeletePartialMatch(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
+	DataCoordMaintenanceWindowActive.DeletePartialMatch(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
 }
@@ -157,6 +157,16 @@ var (
 			Name:      "replica_ro_node_total",
 			Help:      "total read only node number of replica",
 		})
+
+	// QueryCoordMaintenanceWindowActive records whether the maintenance window of the collection is active,
+	// only the collections with maintenance window configured are recorded.
+	QueryCoordMaintenanceWindowActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "maintenance_window_active",
+			Help:      "whether the maintenance window of the collection is active, 1 for active and 0 for inactive",
+		}, []string{databaseLabelName, collectionIDLabelName})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -174,10 +184,14 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordResourceGroupInfo)
 	registry.MustRegister(QueryCoordResourceGroupReplicaTotal)
 	registry.MustRegister(QueryCoordReplicaRONodeTotal)
+	registry.MustRegister(QueryCoordMaintenanceWindowActive)
 }
 
 func CleanQueryCoordMetricsWithCollectionID(collectionID int64) {
 	QueryCoordTaskLatency.DeletePartialMatch(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
+	QueryCoordMaintenanceWindowActive.DeletePartialMatch(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
 }
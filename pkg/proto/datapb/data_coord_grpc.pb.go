@@ -66,9 +66,16 @@ const (
 	DataCoord_GcConfirm_FullMethodName                   = "/milvus.proto.data.DataCoord/GcConfirm"
 	DataCoord_ReportDataNodeTtMsgs_FullMethodName        = "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs"
 	DataCoord_GcControl_FullMethodName                   = "/milvus.proto.data.DataCoord/GcControl"
+	DataCoord_GetGcReport_FullMethodName                 = "/milvus.proto.data.DataCoord/GetGcReport"
 	DataCoord_ImportV2_FullMethodName                    = "/milvus.proto.data.DataCoord/ImportV2"
 	DataCoord_GetImportProgress_FullMethodName           = "/milvus.proto.data.DataCoord/GetImportProgress"
 	DataCoord_ListImports_FullMethodName                 = "/milvus.proto.data.DataCoord/ListImports"
+	DataCoord_CreateSnapshot_FullMethodName              = "/milvus.proto.data.DataCoord/CreateSnapshot"
+	DataCoord_ListSnapshots_FullMethodName               = "/milvus.proto.data.DataCoord/ListSnapshots"
+	DataCoord_DropSnapshot_FullMethodName                = "/milvus.proto.data.DataCoord/DropSnapshot"
+	DataCoord_RestoreSnapshotSegments_FullMethodName     = "/milvus.proto.data.DataCoord/RestoreSnapshotSegments"
+	DataCoord_CloneSegments_FullMethodName               = "/milvus.proto.data.DataCoord/CloneSegments"
+	DataCoord_TruncateSegments_FullMethodName            = "/milvus.proto.data.DataCoord/TruncateSegments"
 )
 
 // DataCoordClient is the client API for DataCoord service.
@@ -124,10 +131,18 @@ type DataCoordClient interface {
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GcControl(ctx context.Context, in *GcControlRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetGcReport(ctx context.Context, in *GetGcReportRequest, opts ...grpc.CallOption) (*GetGcReportResponse, error)
 	// importV2
 	ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
 	ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error)
+	// snapshot
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RestoreSnapshotSegments(ctx context.Context, in *RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -526,6 +541,15 @@ func (c *dataCoordClient) GcControl(ctx context.Context, in *GcControlRequest, o
 	return out, nil
 }
 
+func (c *dataCoordClient) GetGcReport(ctx context.Context, in *GetGcReportRequest, opts ...grpc.CallOption) (*GetGcReportResponse, error) {
+	out := new(GetGcReportResponse)
+	err := c.cc.Invoke(ctx, DataCoord_GetGcReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	out := new(internalpb.ImportResponse)
 	err := c.cc.Invoke(ctx, DataCoord_ImportV2_FullMethodName, in, out, opts...)
@@ -553,6 +577,60 @@ func (c *dataCoordClient) ListImports(ctx context.Context, in *internalpb.ListIm
 	return out, nil
 }
 
+func (c *dataCoordClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_DropSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RestoreSnapshotSegments(ctx context.Context, in *RestoreSnapshotSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_RestoreSnapshotSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_CloneSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_TruncateSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 // All implementations should embed UnimplementedDataCoordServer
 // for forward compatibility
@@ -606,10 +684,18 @@ type DataCoordServer interface {
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
 	GcControl(context.Context, *GcControlRequest) (*commonpb.Status, error)
+	GetGcReport(context.Context, *GetGcReportRequest) (*GetGcReportResponse, error)
 	// importV2
 	ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)
+	// snapshot
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*commonpb.Status, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DropSnapshot(context.Context, *DropSnapshotRequest) (*commonpb.Status, error)
+	RestoreSnapshotSegments(context.Context, *RestoreSnapshotSegmentsRequest) (*commonpb.Status, error)
+	CloneSegments(context.Context, *CloneSegmentsRequest) (*commonpb.Status, error)
+	TruncateSegments(context.Context, *TruncateSegmentsRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer should be embedded to have forward compatible implementations.
@@ -745,6 +831,9 @@ func (UnimplementedDataCoordServer) ReportDataNodeTtMsgs(context.Context, *Repor
 func (UnimplementedDataCoordServer) GcControl(context.Context, *GcControlRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GcControl not implemented")
 }
+func (UnimplementedDataCoordServer) GetGcReport(context.Context, *GetGcReportRequest) (*GetGcReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGcReport not implemented")
+}
 func (UnimplementedDataCoordServer) ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportV2 not implemented")
 }
@@ -754,6 +843,24 @@ func (UnimplementedDataCoordServer) GetImportProgress(context.Context, *internal
 func (UnimplementedDataCoordServer) ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
 }
+func (UnimplementedDataCoordServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedDataCoordServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedDataCoordServer) DropSnapshot(context.Context, *DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSnapshot not implemented")
+}
+func (UnimplementedDataCoordServer) RestoreSnapshotSegments(context.Context, *RestoreSnapshotSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshotSegments not implemented")
+}
+func (UnimplementedDataCoordServer) CloneSegments(context.Context, *CloneSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSegments not implemented")
+}
+func (UnimplementedDataCoordServer) TruncateSegments(context.Context, *TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateSegments not implemented")
+}
 
 // UnsafeDataCoordServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DataCoordServer will
@@ -1540,6 +1647,24 @@ func _DataCoord_GcControl_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_GetGcReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGcReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetGcReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_GetGcReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetGcReport(ctx, req.(*GetGcReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_ImportV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.ImportRequestInternal)
 	if err := dec(in); err != nil {
@@ -1594,6 +1719,114 @@ func _DataCoord_ListImports_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_DropSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).DropSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_DropSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).DropSnapshot(ctx, req.(*DropSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_RestoreSnapshotSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RestoreSnapshotSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_RestoreSnapshotSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RestoreSnapshotSegments(ctx, req.(*RestoreSnapshotSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CloneSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CloneSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_CloneSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CloneSegments(ctx, req.(*CloneSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_TruncateSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).TruncateSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_TruncateSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).TruncateSegments(ctx, req.(*TruncateSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataCoord_ServiceDesc is the grpc.ServiceDesc for DataCoord service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1773,6 +2006,10 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GcControl",
 			Handler:    _DataCoord_GcControl_Handler,
 		},
+		{
+			MethodName: "GetGcReport",
+			Handler:    _DataCoord_GetGcReport_Handler,
+		},
 		{
 			MethodName: "ImportV2",
 			Handler:    _DataCoord_ImportV2_Handler,
@@ -1785,6 +2022,30 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImports",
 			Handler:    _DataCoord_ListImports_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _DataCoord_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _DataCoord_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DropSnapshot",
+			Handler:    _DataCoord_DropSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshotSegments",
+			Handler:    _DataCoord_RestoreSnapshotSegments_Handler,
+		},
+		{
+			MethodName: "CloneSegments",
+			Handler:    _DataCoord_CloneSegments_Handler,
+		},
+		{
+			MethodName: "TruncateSegments",
+			Handler:    _DataCoord_TruncateSegments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -40,6 +40,7 @@ const (
 	OpType_TextMatch    OpType = 13 // text match
 	OpType_PhraseMatch  OpType = 14 // phrase match
 	OpType_InnerMatch   OpType = 15 // substring (e.g., "%value%")
+	OpType_RegexMatch   OpType = 16 // regular expression matching the whole string
 )
 
 // Enum value maps for OpType.
@@ -61,6 +62,7 @@ var (
 		13: "TextMatch",
 		14: "PhraseMatch",
 		15: "InnerMatch",
+		16: "RegexMatch",
 	}
 	OpType_value = map[string]int32{
 		"Invalid":      0,
@@ -79,6 +81,7 @@ var (
 		"TextMatch":    13,
 		"PhraseMatch":  14,
 		"InnerMatch":   15,
+		"RegexMatch":   16,
 	}
 )
 
@@ -2965,7 +2968,7 @@ var file_plan_proto_rawDesc = []byte{
 	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a,
 	0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
 	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x2a, 0xea, 0x01, 0x0a,
+	0x65, 0x6c, 0x64, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x2a, 0xfa, 0x01, 0x0a,
 	0x06, 0x4f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54,
 	0x68, 0x61, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72,
@@ -2980,7 +2983,8 @@ var file_plan_proto_rawDesc = []byte{
 	0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x65, 0x78,
 	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x68, 0x72, 0x61,
 	0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e, 0x6e,
-	0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x0f, 0x2a, 0x58, 0x0a, 0x0b, 0x41, 0x72, 0x69,
+	0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x0f, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x10, 0x2a, 0x58, 0x0a, 0x0b, 0x41, 0x72, 0x69,
 	0x74, 0x68, 0x4f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e,
 	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x10, 0x01, 0x12, 0x07,
 	0x0a, 0x03, 0x53, 0x75, 0x62, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x75, 0x6c, 0x10, 0x03,
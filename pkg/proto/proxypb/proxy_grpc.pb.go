@@ -35,6 +35,12 @@ const (
 	Proxy_ImportV2_FullMethodName                      = "/milvus.proto.proxy.Proxy/ImportV2"
 	Proxy_GetImportProgress_FullMethodName             = "/milvus.proto.proxy.Proxy/GetImportProgress"
 	Proxy_ListImports_FullMethodName                   = "/milvus.proto.proxy.Proxy/ListImports"
+	Proxy_CreateSnapshot_FullMethodName                = "/milvus.proto.proxy.Proxy/CreateSnapshot"
+	Proxy_ListSnapshots_FullMethodName                 = "/milvus.proto.proxy.Proxy/ListSnapshots"
+	Proxy_DropSnapshot_FullMethodName                  = "/milvus.proto.proxy.Proxy/DropSnapshot"
+	Proxy_RestoreSnapshot_FullMethodName               = "/milvus.proto.proxy.Proxy/RestoreSnapshot"
+	Proxy_CloneCollection_FullMethodName               = "/milvus.proto.proxy.Proxy/CloneCollection"
+	Proxy_TruncateCollection_FullMethodName            = "/milvus.proto.proxy.Proxy/TruncateCollection"
 	Proxy_InvalidateShardLeaderCache_FullMethodName    = "/milvus.proto.proxy.Proxy/InvalidateShardLeaderCache"
 	Proxy_GetSegmentsInfo_FullMethodName               = "/milvus.proto.proxy.Proxy/GetSegmentsInfo"
 	Proxy_GetQuotaMetrics_FullMethodName               = "/milvus.proto.proxy.Proxy/GetQuotaMetrics"
@@ -58,6 +64,13 @@ type ProxyClient interface {
 	ImportV2(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
 	ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error)
+	// snapshot
+	CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error)
+	DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	InvalidateShardLeaderCache(ctx context.Context, in *InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentsInfo(ctx context.Context, in *internalpb.GetSegmentsInfoRequest, opts ...grpc.CallOption) (*internalpb.GetSegmentsInfoResponse, error)
 	GetQuotaMetrics(ctx context.Context, in *internalpb.GetQuotaMetricsRequest, opts ...grpc.CallOption) (*internalpb.GetQuotaMetricsResponse, error)
@@ -188,6 +201,60 @@ func (c *proxyClient) ListImports(ctx context.Context, in *internalpb.ListImport
 	return out, nil
 }
 
+func (c *proxyClient) CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	out := new(internalpb.ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Proxy_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_DropSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_CloneCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_TruncateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *proxyClient) InvalidateShardLeaderCache(ctx context.Context, in *InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, Proxy_InvalidateShardLeaderCache_FullMethodName, in, out, opts...)
@@ -233,6 +300,13 @@ type ProxyServer interface {
 	ImportV2(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+	// snapshot
+	CreateSnapshot(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)
+	ListSnapshots(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)
+	DropSnapshot(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)
+	RestoreSnapshot(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)
+	CloneCollection(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)
+	TruncateCollection(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)
 	InvalidateShardLeaderCache(context.Context, *InvalidateShardLeaderCacheRequest) (*commonpb.Status, error)
 	GetSegmentsInfo(context.Context, *internalpb.GetSegmentsInfoRequest) (*internalpb.GetSegmentsInfoResponse, error)
 	GetQuotaMetrics(context.Context, *internalpb.GetQuotaMetricsRequest) (*internalpb.GetQuotaMetricsResponse, error)
@@ -281,6 +355,24 @@ func (UnimplementedProxyServer) GetImportProgress(context.Context, *internalpb.G
 func (UnimplementedProxyServer) ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
 }
+func (UnimplementedProxyServer) CreateSnapshot(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedProxyServer) ListSnapshots(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedProxyServer) DropSnapshot(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSnapshot not implemented")
+}
+func (UnimplementedProxyServer) RestoreSnapshot(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedProxyServer) CloneCollection(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (UnimplementedProxyServer) TruncateCollection(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateCollection not implemented")
+}
 func (UnimplementedProxyServer) InvalidateShardLeaderCache(context.Context, *InvalidateShardLeaderCacheRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateShardLeaderCache not implemented")
 }
@@ -536,6 +628,114 @@ func _Proxy_ListImports_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).CreateSnapshot(ctx, req.(*internalpb.CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).ListSnapshots(ctx, req.(*internalpb.ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_DropSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.DropSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).DropSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_DropSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).DropSnapshot(ctx, req.(*internalpb.DropSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RestoreSnapshot(ctx, req.(*internalpb.RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CloneCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).CloneCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_CloneCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).CloneCollection(ctx, req.(*internalpb.CloneCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_TruncateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.TruncateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).TruncateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_TruncateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).TruncateCollection(ctx, req.(*internalpb.TruncateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Proxy_InvalidateShardLeaderCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvalidateShardLeaderCacheRequest)
 	if err := dec(in); err != nil {
@@ -649,6 +849,30 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImports",
 			Handler:    _Proxy_ListImports_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Proxy_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Proxy_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DropSnapshot",
+			Handler:    _Proxy_DropSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _Proxy_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _Proxy_CloneCollection_Handler,
+		},
+		{
+			MethodName: "TruncateCollection",
+			Handler:    _Proxy_TruncateCollection_Handler,
+		},
 		{
 			MethodName: "InvalidateShardLeaderCache",
 			Handler:    _Proxy_InvalidateShardLeaderCache_Handler,
@@ -75,6 +75,9 @@ const (
 	RootCoord_OperatePrivilegeGroup_FullMethodName         = "/milvus.proto.rootcoord.RootCoord/OperatePrivilegeGroup"
 	RootCoord_CheckHealth_FullMethodName                   = "/milvus.proto.rootcoord.RootCoord/CheckHealth"
 	RootCoord_RenameCollection_FullMethodName              = "/milvus.proto.rootcoord.RootCoord/RenameCollection"
+	RootCoord_CloneCollection_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/CloneCollection"
+	RootCoord_RestoreSnapshot_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/RestoreSnapshot"
+	RootCoord_TruncateCollection_FullMethodName            = "/milvus.proto.rootcoord.RootCoord/TruncateCollection"
 	RootCoord_CreateDatabase_FullMethodName                = "/milvus.proto.rootcoord.RootCoord/CreateDatabase"
 	RootCoord_DropDatabase_FullMethodName                  = "/milvus.proto.rootcoord.RootCoord/DropDatabase"
 	RootCoord_ListDatabases_FullMethodName                 = "/milvus.proto.rootcoord.RootCoord/ListDatabases"
@@ -195,6 +198,9 @@ type RootCoordClient interface {
 	OperatePrivilegeGroup(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
 	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
@@ -679,6 +685,33 @@ func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.Ren
 	return out, nil
 }
 
+func (c *rootCoordClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_CloneCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) TruncateCollection(ctx context.Context, in *internalpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_TruncateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, RootCoord_CreateDatabase_FullMethodName, in, out, opts...)
@@ -845,6 +878,9 @@ type RootCoordServer interface {
 	OperatePrivilegeGroup(context.Context, *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	CloneCollection(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)
+	RestoreSnapshot(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)
+	TruncateCollection(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error)
 	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
 	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
 	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
@@ -1013,6 +1049,15 @@ func (UnimplementedRootCoordServer) CheckHealth(context.Context, *milvuspb.Check
 func (UnimplementedRootCoordServer) RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
 }
+func (UnimplementedRootCoordServer) CloneCollection(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (UnimplementedRootCoordServer) RestoreSnapshot(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedRootCoordServer) TruncateCollection(context.Context, *internalpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateCollection not implemented")
+}
 func (UnimplementedRootCoordServer) CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
 }
@@ -1979,6 +2024,60 @@ func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CloneCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CloneCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_CloneCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CloneCollection(ctx, req.(*internalpb.CloneCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RestoreSnapshot(ctx, req.(*internalpb.RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_TruncateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.TruncateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).TruncateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_TruncateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).TruncateCollection(ctx, req.(*internalpb.TruncateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreateDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -2302,6 +2401,18 @@ var RootCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameCollection",
 			Handler:    _RootCoord_RenameCollection_Handler,
 		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _RootCoord_CloneCollection_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _RootCoord_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "TruncateCollection",
+			Handler:    _RootCoord_TruncateCollection_Handler,
+		},
 		{
 			MethodName: "CreateDatabase",
 			Handler:    _RootCoord_CreateDatabase_Handler,
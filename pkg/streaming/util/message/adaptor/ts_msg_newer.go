@@ -149,3 +149,31 @@ func NewSchemaChangeMessageBody(msg message.ImmutableMessage) (msgstream.TsMsg,
 		SchemaChangeMessage: schChgMsg,
 	}, nil
 }
+
+type TruncateCollectionMessageBody struct {
+	*tsMsgImpl
+	TruncateCollectionMessage message.ImmutableTruncateCollectionMessageV2
+}
+
+func (t *TruncateCollectionMessageBody) ID() msgstream.UniqueID {
+	return 0
+}
+
+func NewTruncateCollectionMessageBody(msg message.ImmutableMessage) (msgstream.TsMsg, error) {
+	truncateMsg, err := message.AsImmutableTruncateCollectionMessageV2(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &TruncateCollectionMessageBody{
+		tsMsgImpl: &tsMsgImpl{
+			BaseMsg: msgstream.BaseMsg{
+				BeginTimestamp: msg.TimeTick(),
+				EndTimestamp:   msg.TimeTick(),
+			},
+			ts:      msg.TimeTick(),
+			sz:      msg.EstimateSize(),
+			msgType: MustGetCommonpbMsgTypeFromMessageType(msg.MessageType()),
+		},
+		TruncateCollectionMessage: truncateMsg,
+	}, nil
+}
@@ -54,22 +54,23 @@ func NewImmutableMesasge(
 
 // List all type-safe mutable message builders here.
 var (
-	NewTimeTickMessageBuilderV1         = createNewMessageBuilderV1[*TimeTickMessageHeader, *msgpb.TimeTickMsg]()
-	NewInsertMessageBuilderV1           = createNewMessageBuilderV1[*InsertMessageHeader, *msgpb.InsertRequest]()
-	NewDeleteMessageBuilderV1           = createNewMessageBuilderV1[*DeleteMessageHeader, *msgpb.DeleteRequest]()
-	NewCreateCollectionMessageBuilderV1 = createNewMessageBuilderV1[*CreateCollectionMessageHeader, *msgpb.CreateCollectionRequest]()
-	NewDropCollectionMessageBuilderV1   = createNewMessageBuilderV1[*DropCollectionMessageHeader, *msgpb.DropCollectionRequest]()
-	NewCreatePartitionMessageBuilderV1  = createNewMessageBuilderV1[*CreatePartitionMessageHeader, *msgpb.CreatePartitionRequest]()
-	NewDropPartitionMessageBuilderV1    = createNewMessageBuilderV1[*DropPartitionMessageHeader, *msgpb.DropPartitionRequest]()
-	NewImportMessageBuilderV1           = createNewMessageBuilderV1[*ImportMessageHeader, *msgpb.ImportMsg]()
-	NewCreateSegmentMessageBuilderV2    = createNewMessageBuilderV2[*CreateSegmentMessageHeader, *CreateSegmentMessageBody]()
-	NewFlushMessageBuilderV2            = createNewMessageBuilderV2[*FlushMessageHeader, *FlushMessageBody]()
-	NewManualFlushMessageBuilderV2      = createNewMessageBuilderV2[*ManualFlushMessageHeader, *ManualFlushMessageBody]()
-	NewBeginTxnMessageBuilderV2         = createNewMessageBuilderV2[*BeginTxnMessageHeader, *BeginTxnMessageBody]()
-	NewCommitTxnMessageBuilderV2        = createNewMessageBuilderV2[*CommitTxnMessageHeader, *CommitTxnMessageBody]()
-	NewRollbackTxnMessageBuilderV2      = createNewMessageBuilderV2[*RollbackTxnMessageHeader, *RollbackTxnMessageBody]()
-	NewSchemaChangeMessageBuilderV2     = createNewMessageBuilderV2[*SchemaChangeMessageHeader, *SchemaChangeMessageBody]()
-	newTxnMessageBuilderV2              = createNewMessageBuilderV2[*TxnMessageHeader, *TxnMessageBody]()
+	NewTimeTickMessageBuilderV1           = createNewMessageBuilderV1[*TimeTickMessageHeader, *msgpb.TimeTickMsg]()
+	NewInsertMessageBuilderV1             = createNewMessageBuilderV1[*InsertMessageHeader, *msgpb.InsertRequest]()
+	NewDeleteMessageBuilderV1             = createNewMessageBuilderV1[*DeleteMessageHeader, *msgpb.DeleteRequest]()
+	NewCreateCollectionMessageBuilderV1   = createNewMessageBuilderV1[*CreateCollectionMessageHeader, *msgpb.CreateCollectionRequest]()
+	NewDropCollectionMessageBuilderV1     = createNewMessageBuilderV1[*DropCollectionMessageHeader, *msgpb.DropCollectionRequest]()
+	NewCreatePartitionMessageBuilderV1    = createNewMessageBuilderV1[*CreatePartitionMessageHeader, *msgpb.CreatePartitionRequest]()
+	NewDropPartitionMessageBuilderV1      = createNewMessageBuilderV1[*DropPartitionMessageHeader, *msgpb.DropPartitionRequest]()
+	NewImportMessageBuilderV1             = createNewMessageBuilderV1[*ImportMessageHeader, *msgpb.ImportMsg]()
+	NewCreateSegmentMessageBuilderV2      = createNewMessageBuilderV2[*CreateSegmentMessageHeader, *CreateSegmentMessageBody]()
+	NewFlushMessageBuilderV2              = createNewMessageBuilderV2[*FlushMessageHeader, *FlushMessageBody]()
+	NewManualFlushMessageBuilderV2        = createNewMessageBuilderV2[*ManualFlushMessageHeader, *ManualFlushMessageBody]()
+	NewBeginTxnMessageBuilderV2           = createNewMessageBuilderV2[*BeginTxnMessageHeader, *BeginTxnMessageBody]()
+	NewCommitTxnMessageBuilderV2          = createNewMessageBuilderV2[*CommitTxnMessageHeader, *CommitTxnMessageBody]()
+	NewRollbackTxnMessageBuilderV2        = createNewMessageBuilderV2[*RollbackTxnMessageHeader, *RollbackTxnMessageBody]()
+	NewSchemaChangeMessageBuilderV2       = createNewMessageBuilderV2[*SchemaChangeMessageHeader, *SchemaChangeMessageBody]()
+	NewTruncateCollectionMessageBuilderV2 = createNewMessageBuilderV2[*TruncateCollectionMessageHeader, *TruncateCollectionMessageBody]()
+	newTxnMessageBuilderV2                = createNewMessageBuilderV2[*TxnMessageHeader, *TxnMessageBody]()
 )
 
 // createNewMessageBuilderV1 creates a new message builder with v1 marker.
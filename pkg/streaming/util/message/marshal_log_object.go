@@ -111,6 +111,9 @@ func marshalSpecializedHeader(t MessageType, h string, enc zapcore.ObjectEncoder
 	case *SchemaChangeMessageHeader:
 		enc.AddInt64("collectionID", header.GetCollectionId())
 		encodeSegmentIDs(header.GetFlushedSegmentIds(), enc)
+	case *TruncateCollectionMessageHeader:
+		enc.AddInt64("collectionID", header.GetCollectionId())
+		encodeSegmentIDs(header.GetSegmentIds(), enc)
 	case *ImportMessageHeader:
 	}
 }
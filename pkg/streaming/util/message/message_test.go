@@ -40,6 +40,8 @@ func TestMessageType(t *testing.T) {
 	assert.False(t, MessageTypeDropCollection.IsSystem())
 	assert.False(t, MessageTypeCreatePartition.IsSystem())
 	assert.False(t, MessageTypeDropPartition.IsSystem())
+	assert.False(t, MessageTypeTruncateCollection.IsSystem())
+	assert.True(t, MessageTypeTruncateCollection.IsExclusiveRequired())
 }
 
 func TestVersion(t *testing.T) {
@@ -75,6 +75,7 @@ func (n StreamingNodeInfo) String() string {
 type StreamingNodeStatus struct {
 	StreamingNodeInfo
 	Metrics StreamingNodeMetrics
+	Labels  map[string]string // the server labels of the streaming node, set by MILVUS_SERVER_LABEL_* env.
 	Err     error
 }
 
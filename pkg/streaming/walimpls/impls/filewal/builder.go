@@ -0,0 +1,82 @@
+package filewal
+
+import (
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	WALName = "filewal"
+
+	fsyncPolicyAlways   = "always"
+	fsyncPolicyInterval = "interval"
+	fsyncPolicyNever    = "never"
+)
+
+func init() {
+	// register the builder to the wal registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(WALName, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for filewal opener.
+type builderImpl struct{}
+
+// Name of the wal builder, should be a lowercase string.
+func (b *builderImpl) Name() string {
+	return WALName
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	cfg, err := newConfig(&paramtable.Get().FileWALCfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(cfg.rootPath, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create filewal root path %s", cfg.rootPath)
+	}
+	return newOpener(cfg), nil
+}
+
+// config is the configuration of filewal.
+type config struct {
+	rootPath       string
+	segmentMaxSize int64
+	fsyncPolicy    string
+	fsyncInterval  time.Duration
+}
+
+// newConfig creates a new config from the paramtable.
+func newConfig(params *paramtable.FileWALConfig) (*config, error) {
+	cfg := &config{
+		rootPath:       params.Path.GetValue(),
+		segmentMaxSize: params.SegmentMaxSize.GetAsSize(),
+		fsyncPolicy:    params.FsyncPolicy.GetValue(),
+		fsyncInterval:  params.FsyncInterval.GetAsDurationByParse(),
+	}
+	if cfg.rootPath == "" {
+		return nil, errors.New("filewal path is empty")
+	}
+	if cfg.segmentMaxSize <= 0 {
+		return nil, errors.Newf("filewal segment max size should be positive, got %d", cfg.segmentMaxSize)
+	}
+	switch cfg.fsyncPolicy {
+	case fsyncPolicyAlways, fsyncPolicyNever:
+	case fsyncPolicyInterval:
+		if cfg.fsyncInterval <= 0 {
+			return nil, errors.Newf("filewal fsync interval should be positive, got %s", cfg.fsyncInterval)
+		}
+	default:
+		return nil, errors.Newf("unknown filewal fsync policy %s", cfg.fsyncPolicy)
+	}
+	return cfg, nil
+}
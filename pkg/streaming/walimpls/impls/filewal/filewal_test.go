@@ -0,0 +1,160 @@
+package filewal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(WALName)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, WALName, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(WALName, fileWALID(1).Marshal())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(fileWALID(1)))
+}
+
+func TestWAL(t *testing.T) {
+	params := paramtable.Get()
+	for _, policy := range []string{fsyncPolicyAlways, fsyncPolicyInterval, fsyncPolicyNever} {
+		t.Run(policy, func(t *testing.T) {
+			params.Save(params.FileWALCfg.Path.Key, t.TempDir())
+			params.Save(params.FileWALCfg.SegmentMaxSize.Key, "4k")
+			params.Save(params.FileWALCfg.FsyncPolicy.Key, policy)
+			defer func() {
+				params.Reset(params.FileWALCfg.Path.Key)
+				params.Reset(params.FileWALCfg.SegmentMaxSize.Key)
+				params.Reset(params.FileWALCfg.FsyncPolicy.Key)
+			}()
+			walimpls.NewWALImplsTestFramework(t, 100, &builderImpl{}).Run()
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	params := paramtable.Get()
+	cfg, err := newConfig(&params.FileWALCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(params.LocalStorageCfg.Path.GetValue(), "filewal"), cfg.rootPath)
+	assert.Equal(t, int64(64<<20), cfg.segmentMaxSize)
+	assert.Equal(t, fsyncPolicyInterval, cfg.fsyncPolicy)
+	assert.Equal(t, 200*time.Millisecond, cfg.fsyncInterval)
+
+	params.Save(params.FileWALCfg.FsyncPolicy.Key, "unknown")
+	defer params.Reset(params.FileWALCfg.FsyncPolicy.Key)
+	_, err = newConfig(&params.FileWALCfg)
+	assert.Error(t, err)
+}
+
+func TestSegmentLogRecovery(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config{rootPath: dir, segmentMaxSize: 256, fsyncPolicy: fsyncPolicyAlways}
+
+	l, err := openSegmentLog(dir, cfg)
+	assert.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		id, err := l.Append([]byte("payload"), map[string]string{"id": string(rune('a' + i))})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), id)
+	}
+	assert.Greater(t, len(l.segments), 1)
+	l.Close()
+
+	// simulate a torn write on the tail of the last segment.
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentFileSuffix))
+	assert.NoError(t, err)
+	last := files[len(files)-1]
+	f, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0o644)
+	assert.NoError(t, err)
+	_, err = f.Write(encodeRecord(20, []byte("torn"))[:recordHeaderSize+2])
+	assert.NoError(t, err)
+	f.Close()
+
+	l, err = openSegmentLog(dir, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), l.FirstID())
+	assert.Equal(t, int64(20), l.NextID())
+	id, err := l.Append([]byte("payload"), map[string]string{"id": "new"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), id)
+	for i := int64(0); i <= 20; i++ {
+		e, err := l.ReadAt(context.Background(), i)
+		assert.NoError(t, err)
+		assert.Equal(t, i, e.id)
+		assert.Equal(t, []byte("payload"), e.payload)
+	}
+
+	// truncate should only remove the sealed segments.
+	assert.NoError(t, l.Truncate(15))
+	assert.Greater(t, l.FirstID(), int64(0))
+	assert.LessOrEqual(t, l.FirstID(), int64(16))
+	e, err := l.ReadAt(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, l.FirstID(), e.id)
+	assert.NoError(t, l.Truncate(20))
+	e, err = l.ReadAt(context.Background(), 20)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), e.id)
+	assert.NoError(t, l.Truncate(21))
+	assert.Len(t, l.segments, 1)
+	l.Close()
+
+	l, err = openSegmentLog(dir, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(21), l.NextID())
+
+	// read on a closed log should be woken up.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		l.Close()
+	}()
+	_, err = l.ReadAt(ctx, l.NextID())
+	assert.ErrorIs(t, err, errLogClosed)
+	_, err = l.Append([]byte("payload"), nil)
+	assert.ErrorIs(t, err, errLogClosed)
+}
+
+func TestSegmentLogCorruption(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config{rootPath: dir, segmentMaxSize: 64, fsyncPolicy: fsyncPolicyNever}
+
+	l, err := openSegmentLog(dir, cfg)
+	assert.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err := l.Append([]byte("payload"), nil)
+		assert.NoError(t, err)
+	}
+	l.Close()
+
+	// a corrupted sealed segment can not be repaired.
+	first := segmentPath(dir, 0)
+	data, err := os.ReadFile(first)
+	assert.NoError(t, err)
+	data[recordHeaderSize] ^= 0xff
+	assert.NoError(t, os.WriteFile(first, data, 0o644))
+	_, err = openSegmentLog(dir, cfg)
+	assert.ErrorIs(t, err, errCorruptRecord)
+
+	_, _, err = decodeRecord(data[:recordHeaderSize-1])
+	assert.ErrorIs(t, err, errCorruptRecord)
+	_, _, err = decodeRecord(data[:recordHeaderSize+1])
+	assert.ErrorIs(t, err, errCorruptRecord)
+}
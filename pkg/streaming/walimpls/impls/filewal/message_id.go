@@ -0,0 +1,62 @@
+package filewal
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+var _ message.MessageID = fileWALID(0)
+
+// UnmarshalMessageID unmarshal the message id.
+func UnmarshalMessageID(data string) (message.MessageID, error) {
+	id, err := unmarshalMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// unmarshalMessageID unmarshal the message id.
+func unmarshalMessageID(data string) (fileWALID, error) {
+	v, err := message.DecodeUint64(data)
+	if err != nil {
+		return 0, errors.Wrapf(message.ErrInvalidMessageID, "decode fileWALID fail with err: %s, id: %s", err.Error(), data)
+	}
+	return fileWALID(v), nil
+}
+
+// fileWALID is the message id for filewal.
+// It's the monotonic entry id of the message in the pchannel log.
+type fileWALID int64
+
+// WALName returns the name of message id related wal.
+func (id fileWALID) WALName() string {
+	return WALName
+}
+
+// LT less than.
+func (id fileWALID) LT(other message.MessageID) bool {
+	return id < other.(fileWALID)
+}
+
+// LTE less than or equal to.
+func (id fileWALID) LTE(other message.MessageID) bool {
+	return id <= other.(fileWALID)
+}
+
+// EQ Equal to.
+func (id fileWALID) EQ(other message.MessageID) bool {
+	return id == other.(fileWALID)
+}
+
+// Marshal marshal the message id.
+func (id fileWALID) Marshal() string {
+	return message.EncodeInt64(int64(id))
+}
+
+func (id fileWALID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
@@ -0,0 +1,27 @@
+package filewal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageID(t *testing.T) {
+	assert.Equal(t, WALName, fileWALID(1).WALName())
+
+	assert.True(t, fileWALID(1).LT(fileWALID(2)))
+	assert.True(t, fileWALID(1).EQ(fileWALID(1)))
+	assert.True(t, fileWALID(1).LTE(fileWALID(1)))
+	assert.True(t, fileWALID(1).LTE(fileWALID(2)))
+	assert.False(t, fileWALID(2).LT(fileWALID(1)))
+	assert.False(t, fileWALID(2).EQ(fileWALID(1)))
+	assert.False(t, fileWALID(2).LTE(fileWALID(1)))
+	assert.True(t, fileWALID(2).LTE(fileWALID(2)))
+
+	msgID, err := UnmarshalMessageID(fileWALID(1).Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, fileWALID(1), msgID)
+
+	_, err = UnmarshalMessageID(string([]byte{0x01, 0x02, 0x03, 0x04}))
+	assert.Error(t, err)
+}
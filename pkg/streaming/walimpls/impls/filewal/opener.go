@@ -0,0 +1,100 @@
+package filewal
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+// newOpener creates a new opener for filewal.
+func newOpener(cfg *config) *openerImpl {
+	return &openerImpl{
+		cfg:  cfg,
+		logs: make(map[string]*sharedLog),
+	}
+}
+
+// sharedLog is a segment log shared by all the wal instances of the same pchannel.
+type sharedLog struct {
+	*segmentLog
+	refCnt int
+}
+
+// openerImpl is the opener implementation for filewal.
+// The wal instances of the same pchannel in one process share the same segment log,
+// so a reader on a ro wal can see the messages appended by the rw wal.
+type openerImpl struct {
+	cfg  *config
+	mu   sync.Mutex
+	logs map[string]*sharedLog
+}
+
+// Open opens a wal instance.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	l, err := o.acquire(opt.Channel.Name)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to open filewal log", zap.String("channel", opt.Channel.Name), zap.Error(err))
+		return nil, err
+	}
+	return &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		l:         l,
+		release:   func() { o.release(opt.Channel.Name) },
+	}, nil
+}
+
+// acquire gets or recovers the segment log of the pchannel.
+func (o *openerImpl) acquire(channel string) (*segmentLog, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if l, ok := o.logs[channel]; ok {
+		l.refCnt++
+		return l.segmentLog, nil
+	}
+	l, err := openSegmentLog(filepath.Join(o.cfg.rootPath, channel), o.cfg)
+	if err != nil {
+		return nil, err
+	}
+	o.logs[channel] = &sharedLog{segmentLog: l, refCnt: 1}
+	return l, nil
+}
+
+// release releases the segment log of the pchannel, the log will be closed if no wal uses it.
+func (o *openerImpl) release(channel string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	l, ok := o.logs[channel]
+	if !ok {
+		return
+	}
+	l.refCnt--
+	if l.refCnt > 0 {
+		return
+	}
+	delete(o.logs, channel)
+	l.Close()
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for channel, l := range o.logs {
+		l.Close()
+		delete(o.logs, channel)
+	}
+}
@@ -0,0 +1,66 @@
+package filewal
+
+import (
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner.
+func newScanner(scannerName string, l *segmentLog, from int64) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		l:             l,
+		next:          from,
+		msgChannel:    make(chan message.ImmutableMessage),
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for filewal.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	l          *segmentLog
+	next       int64
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	return s.ScannerHelper.Close()
+}
+
+// executeConsume consumes the message from the segment log.
+func (s *scannerImpl) executeConsume() (err error) {
+	defer func() {
+		s.Finish(err)
+		close(s.msgChannel)
+	}()
+
+	for {
+		e, err := s.l.ReadAt(s.Context(), s.next)
+		if err != nil {
+			if s.Context().Err() != nil {
+				return nil
+			}
+			return err
+		}
+		msg := message.NewImmutableMesasge(fileWALID(e.id), e.payload, e.properties)
+		select {
+		case <-s.Context().Done():
+			return nil
+		case s.msgChannel <- msg:
+		}
+		// the entry may be moved forward if the requested one is truncated.
+		s.next = e.id + 1
+	}
+}
@@ -0,0 +1,524 @@
+package filewal
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+const (
+	segmentFileSuffix = ".log"
+	// recordHeaderSize is the size of the record header,
+	// layout: body length (4 bytes) | crc32 of entry id and body (4 bytes) | entry id (8 bytes).
+	recordHeaderSize = 16
+)
+
+var (
+	errLogClosed     = errors.New("filewal log closed")
+	errCorruptRecord = errors.New("filewal record corrupted")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// entry is a decoded record of the segment log.
+type entry struct {
+	id         int64
+	payload    []byte
+	properties map[string]string
+}
+
+// openSegmentLog opens the segment log at the given directory,
+// the torn tail of the last segment file will be truncated.
+func openSegmentLog(dir string, cfg *config) (*segmentLog, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create filewal directory %s", dir)
+	}
+	segments, err := recoverSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		seg, err := createSegment(dir, 0)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, seg)
+	}
+	l := &segmentLog{
+		dir:      dir,
+		cfg:      cfg,
+		cond:     syncutil.NewContextCond(&sync.Mutex{}),
+		segments: segments,
+	}
+	if cfg.fsyncPolicy == fsyncPolicyInterval {
+		l.notifier = syncutil.NewAsyncTaskNotifier[struct{}]()
+		go l.backgroundSync()
+	}
+	log.Info("filewal log opened",
+		zap.String("dir", dir),
+		zap.Int("segmentCount", len(segments)),
+		zap.Int64("firstID", l.segments[0].firstID),
+		zap.Int64("nextID", l.active().nextID()))
+	return l, nil
+}
+
+// segmentLog is the log of one pchannel.
+// The log is made of segment files named by the first entry id of the segment,
+// every record in the segment file is length-prefixed and checksummed.
+type segmentLog struct {
+	dir      string
+	cfg      *config
+	cond     *syncutil.ContextCond
+	segments []*segment // sorted by the first entry id, the last one is the active segment for appending.
+	dirty    bool       // whether the active segment has unsynced data.
+	closed   bool
+	notifier *syncutil.AsyncTaskNotifier[struct{}]
+}
+
+// Append appends a message into the log and returns the entry id of it.
+func (l *segmentLog) Append(payload []byte, properties map[string]string) (int64, error) {
+	body, err := proto.Marshal(&messagespb.Message{
+		Payload:    payload,
+		Properties: properties,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return 0, errLogClosed
+	}
+	if active := l.active(); active.size >= l.cfg.segmentMaxSize && len(active.offsets) > 0 {
+		if err := l.rollSegment(); err != nil {
+			return 0, err
+		}
+	}
+	active := l.active()
+	id := active.nextID()
+	record := encodeRecord(id, body)
+	if _, err := active.file.WriteAt(record, active.size); err != nil {
+		l.dropPartialRecord(active)
+		return 0, errors.Wrapf(err, "failed to write record into segment %s", active.path)
+	}
+	if l.cfg.fsyncPolicy == fsyncPolicyAlways {
+		if err := active.file.Sync(); err != nil {
+			l.dropPartialRecord(active)
+			return 0, errors.Wrapf(err, "failed to sync segment %s", active.path)
+		}
+	} else {
+		l.dirty = true
+	}
+	active.offsets = append(active.offsets, active.size)
+	active.size += int64(len(record))
+	l.cond.UnsafeBroadcast()
+	return id, nil
+}
+
+// dropPartialRecord drops the data written after the last complete record,
+// so the next append can start from a clean tail.
+func (l *segmentLog) dropPartialRecord(seg *segment) {
+	if err := seg.file.Truncate(seg.size); err != nil {
+		log.Warn("failed to drop the partial record of filewal segment", zap.String("path", seg.path), zap.Error(err))
+	}
+}
+
+// rollSegment seals the active segment and creates a new one.
+// The active segment is synced before sealing whatever the fsync policy is,
+// only the last segment can be repaired on recovery, so a torn sealed segment would make the log unopenable.
+func (l *segmentLog) rollSegment() error {
+	active := l.active()
+	if err := active.file.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync segment %s", active.path)
+	}
+	l.dirty = false
+	seg, err := createSegment(l.dir, active.nextID())
+	if err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		log.Warn("failed to sync filewal directory", zap.String("dir", l.dir), zap.Error(err))
+	}
+	l.segments = append(l.segments, seg)
+	return nil
+}
+
+// FirstID returns the first entry id that can be read from the log.
+func (l *segmentLog) FirstID() int64 {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	return l.segments[0].firstID
+}
+
+// NextID returns the entry id of next appended message.
+func (l *segmentLog) NextID() int64 {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	return l.active().nextID()
+}
+
+// ReadAt reads the entry with the given id, block until the entry is appended.
+// If the entry is already truncated, the first available entry will be returned.
+func (l *segmentLog) ReadAt(ctx context.Context, id int64) (*entry, error) {
+	l.cond.L.Lock()
+	for !l.closed && id >= l.active().nextID() {
+		if err := l.cond.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return nil, errLogClosed
+	}
+	if first := l.segments[0].firstID; id < first {
+		id = first
+	}
+	idx := sort.Search(len(l.segments), func(i int) bool {
+		return l.segments[i].nextID() > id
+	})
+	return l.segments[idx].readEntry(id)
+}
+
+// Truncate removes the segment files that only contain the entries before the given id,
+// the entry of the given id is kept so the checkpoint can still be read.
+// The active segment is never removed, so the entry id keeps monotonic after recovery.
+func (l *segmentLog) Truncate(id int64) error {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return errLogClosed
+	}
+	removed := 0
+	for ; removed < len(l.segments)-1 && l.segments[removed].nextID() <= id; removed++ {
+		seg := l.segments[removed]
+		// the opened file can still be read after removing, so remove it before closing.
+		if err := os.Remove(seg.path); err != nil {
+			l.segments = l.segments[removed:]
+			return errors.Wrapf(err, "failed to remove segment %s", seg.path)
+		}
+		if err := seg.file.Close(); err != nil {
+			log.Warn("failed to close truncated filewal segment", zap.String("path", seg.path), zap.Error(err))
+		}
+	}
+	if removed > 0 {
+		log.Info("filewal log truncated",
+			zap.String("dir", l.dir),
+			zap.Int64("truncateID", id),
+			zap.Int("removedSegmentCount", removed))
+	}
+	l.segments = l.segments[removed:]
+	return nil
+}
+
+// Close closes the log, all blocking readers will be woken up with errLogClosed.
+func (l *segmentLog) Close() {
+	l.cond.LockAndBroadcast()
+	if l.closed {
+		l.cond.L.Unlock()
+		return
+	}
+	l.closed = true
+	l.cond.L.Unlock()
+
+	if l.notifier != nil {
+		l.notifier.Cancel()
+		l.notifier.BlockUntilFinish()
+	}
+
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	for _, seg := range l.segments {
+		if l.cfg.fsyncPolicy != fsyncPolicyNever {
+			if err := seg.file.Sync(); err != nil {
+				log.Warn("failed to sync filewal segment when closing", zap.String("path", seg.path), zap.Error(err))
+			}
+		}
+		if err := seg.file.Close(); err != nil {
+			log.Warn("failed to close filewal segment", zap.String("path", seg.path), zap.Error(err))
+		}
+	}
+	log.Info("filewal log closed", zap.String("dir", l.dir))
+}
+
+// backgroundSync syncs the active segment periodically.
+func (l *segmentLog) backgroundSync() {
+	ticker := time.NewTicker(l.cfg.fsyncInterval)
+	defer func() {
+		ticker.Stop()
+		l.notifier.Finish(struct{}{})
+	}()
+
+	for {
+		select {
+		case <-l.notifier.Context().Done():
+			return
+		case <-ticker.C:
+			if err := l.sync(); err != nil {
+				log.Warn("failed to sync filewal segment in background", zap.String("dir", l.dir), zap.Error(err))
+			}
+		}
+	}
+}
+
+// sync syncs the active segment if there's unsynced data.
+func (l *segmentLog) sync() error {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed || !l.dirty {
+		return nil
+	}
+	if err := l.active().file.Sync(); err != nil {
+		return err
+	}
+	l.dirty = false
+	return nil
+}
+
+// active returns the active segment.
+func (l *segmentLog) active() *segment {
+	return l.segments[len(l.segments)-1]
+}
+
+// segment is a segment file of the log.
+type segment struct {
+	firstID int64
+	path    string
+	file    *os.File
+	size    int64
+	offsets []int64 // offsets[i] is the file offset of the entry firstID+i.
+}
+
+// nextID returns the entry id of next appended message of the segment.
+func (s *segment) nextID() int64 {
+	return s.firstID + int64(len(s.offsets))
+}
+
+// readEntry reads the entry with the given id from the segment.
+func (s *segment) readEntry(id int64) (*entry, error) {
+	idx := id - s.firstID
+	start := s.offsets[idx]
+	end := s.size
+	if idx+1 < int64(len(s.offsets)) {
+		end = s.offsets[idx+1]
+	}
+	buf := make([]byte, end-start)
+	if _, err := s.file.ReadAt(buf, start); err != nil {
+		return nil, errors.Wrapf(err, "failed to read record from segment %s at offset %d", s.path, start)
+	}
+	recordID, body, err := decodeRecord(buf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "segment %s at offset %d", s.path, start)
+	}
+	if recordID != id {
+		return nil, errors.Wrapf(errCorruptRecord, "segment %s at offset %d, expected entry id %d, got %d", s.path, start, id, recordID)
+	}
+	msg := &messagespb.Message{}
+	if err := proto.Unmarshal(body, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal record from segment %s at offset %d", s.path, start)
+	}
+	return &entry{
+		id:         id,
+		payload:    msg.GetPayload(),
+		properties: msg.GetProperties(),
+	}, nil
+}
+
+// load rebuilds the record offsets of the segment by scanning the segment file.
+// If repairTail is true, the records after the first corrupted one are treated as a torn tail and truncated,
+// otherwise an error is returned.
+func (s *segment) load(repairTail bool) error {
+	stat, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	fileSize := stat.Size()
+	r := io.NewSectionReader(s.file, 0, fileSize)
+	header := make([]byte, recordHeaderSize)
+
+	var offset int64
+	for offset < fileSize {
+		n, err := s.loadRecord(r, header, fileSize-offset)
+		if err != nil {
+			if !repairTail {
+				return errors.Wrapf(err, "segment %s corrupted at offset %d", s.path, offset)
+			}
+			log.Warn("truncate the torn tail of filewal segment",
+				zap.String("path", s.path),
+				zap.Int64("offset", offset),
+				zap.Int64("fileSize", fileSize),
+				zap.Error(err))
+			if err := s.file.Truncate(offset); err != nil {
+				return errors.Wrapf(err, "failed to truncate the torn tail of segment %s", s.path)
+			}
+			if err := s.file.Sync(); err != nil {
+				return errors.Wrapf(err, "failed to sync segment %s", s.path)
+			}
+			break
+		}
+		s.offsets = append(s.offsets, offset)
+		offset += n
+	}
+	s.size = offset
+	return nil
+}
+
+// loadRecord reads and verifies the next record, returns the size of the record.
+func (s *segment) loadRecord(r io.Reader, header []byte, remain int64) (int64, error) {
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, errors.Wrapf(errCorruptRecord, "incomplete record header: %s", err.Error())
+	}
+	bodySize := int64(binary.LittleEndian.Uint32(header[0:4]))
+	if recordHeaderSize+bodySize > remain {
+		return 0, errors.Wrapf(errCorruptRecord, "incomplete record body, expected %d bytes, remain %d bytes", bodySize, remain-recordHeaderSize)
+	}
+	record := make([]byte, recordHeaderSize+bodySize)
+	copy(record, header)
+	if _, err := io.ReadFull(r, record[recordHeaderSize:]); err != nil {
+		return 0, errors.Wrapf(errCorruptRecord, "incomplete record body: %s", err.Error())
+	}
+	id, _, err := decodeRecord(record)
+	if err != nil {
+		return 0, err
+	}
+	if id != s.nextID() {
+		return 0, errors.Wrapf(errCorruptRecord, "expected entry id %d, got %d", s.nextID(), id)
+	}
+	return int64(len(record)), nil
+}
+
+// encodeRecord encodes the entry into a record.
+func encodeRecord(id int64, body []byte) []byte {
+	record := make([]byte, recordHeaderSize+len(body))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(body)))
+	binary.LittleEndian.PutUint64(record[8:16], uint64(id))
+	copy(record[recordHeaderSize:], body)
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(record[8:], crcTable))
+	return record
+}
+
+// decodeRecord decodes the record and verifies the checksum.
+func decodeRecord(record []byte) (int64, []byte, error) {
+	if len(record) < recordHeaderSize {
+		return 0, nil, errors.Wrapf(errCorruptRecord, "record too short, size %d", len(record))
+	}
+	bodySize := int(binary.LittleEndian.Uint32(record[0:4]))
+	if len(record) != recordHeaderSize+bodySize {
+		return 0, nil, errors.Wrapf(errCorruptRecord, "record size mismatch, expected %d, got %d", recordHeaderSize+bodySize, len(record))
+	}
+	if crc32.Checksum(record[8:], crcTable) != binary.LittleEndian.Uint32(record[4:8]) {
+		return 0, nil, errors.Wrap(errCorruptRecord, "checksum mismatch")
+	}
+	return int64(binary.LittleEndian.Uint64(record[8:16])), record[recordHeaderSize:], nil
+}
+
+// recoverSegments opens all the segment files in the directory.
+func recoverSegments(dir string) ([]*segment, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list filewal directory %s", dir)
+	}
+	firstIDs := make([]int64, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), segmentFileSuffix) {
+			continue
+		}
+		firstID, err := strconv.ParseInt(strings.TrimSuffix(f.Name(), segmentFileSuffix), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid filewal segment file name %s", f.Name())
+		}
+		firstIDs = append(firstIDs, firstID)
+	}
+	sort.Slice(firstIDs, func(i, j int) bool { return firstIDs[i] < firstIDs[j] })
+
+	segments := make([]*segment, 0, len(firstIDs))
+	closeAll := func() {
+		for _, seg := range segments {
+			seg.file.Close()
+		}
+	}
+	for i, firstID := range firstIDs {
+		// only the last segment may be torn by a crash, the sealed ones are always synced before rolling.
+		seg, err := openSegment(dir, firstID, i == len(firstIDs)-1)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		if len(segments) > 0 && segments[len(segments)-1].nextID() != firstID {
+			seg.file.Close()
+			closeAll()
+			return nil, errors.Newf("filewal segments are not continuous, segment %s expects first id %d", seg.path, segments[len(segments)-1].nextID())
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// openSegment opens an existing segment file.
+func openSegment(dir string, firstID int64, repairTail bool) (*segment, error) {
+	path := segmentPath(dir, firstID)
+	f, err := os.OpenFile(path, os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open segment %s", path)
+	}
+	seg := &segment{
+		firstID: firstID,
+		path:    path,
+		file:    f,
+	}
+	if err := seg.load(repairTail); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return seg, nil
+}
+
+// createSegment creates a new empty segment file.
+func createSegment(dir string, firstID int64) (*segment, error) {
+	path := segmentPath(dir, firstID)
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create segment %s", path)
+	}
+	return &segment{
+		firstID: firstID,
+		path:    path,
+		file:    f,
+	}, nil
+}
+
+// segmentPath returns the path of the segment file.
+func segmentPath(dir string, firstID int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", firstID, segmentFileSuffix))
+}
+
+// syncDir syncs the directory to persist the creation of segment files.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
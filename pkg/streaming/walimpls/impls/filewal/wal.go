@@ -0,0 +1,74 @@
+package filewal
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+// walImpl is the implementation of walimpls.WALImpls for filewal.
+type walImpl struct {
+	*helper.WALHelper
+	l       *segmentLog
+	release func()
+}
+
+// WALName returns the name of the wal.
+func (w *walImpl) WALName() string {
+	return WALName
+}
+
+// Append appends a message to the wal.
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	id, err := w.l.Append(msg.Payload(), msg.Properties().ToRawMap())
+	if err != nil {
+		return nil, err
+	}
+	return fileWALID(id), nil
+}
+
+// Read create a scanner to read the wal.
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (walimpls.ScannerImpls, error) {
+	var from int64
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		from = w.l.FirstID()
+	case *streamingpb.DeliverPolicy_Latest:
+		from = w.l.NextID()
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		from = int64(id)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		from = int64(id) + 1
+	}
+	return newScanner(opt.Name, w.l, from), nil
+}
+
+// Truncate removes the segment files that only contain the messages before the given message id.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("truncate on a wal that is not in read-write mode")
+	}
+	return w.l.Truncate(int64(id.(fileWALID)))
+}
+
+// Close closes the wal instance.
+func (w *walImpl) Close() {
+	w.release()
+}
@@ -0,0 +1,76 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	WALName = "nats"
+)
+
+func init() {
+	// register the builder to the wal registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(WALName, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for nats opener.
+type builderImpl struct{}
+
+// Name of the wal builder, should be a lowercase string.
+func (b *builderImpl) Name() string {
+	return WALName
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	cfg := &paramtable.Get().NatsCfg
+	storage, err := getStorageType(cfg.StreamStorage.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	conn, err := nats.Connect(cfg.Server.GetValue(),
+		nats.Name("milvus-streaming-node"),
+		nats.Timeout(cfg.ConnectTimeout.GetAsDurationByParse()),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to nats server %s", cfg.Server.GetValue())
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to create jetstream context")
+	}
+	log.Ctx(context.Background()).Info("build nats opener finish", zap.String("server", cfg.Server.GetValue()))
+	return &openerImpl{
+		conn:     conn,
+		js:       js,
+		replicas: cfg.StreamReplicas.GetAsInt(),
+		storage:  storage,
+	}, nil
+}
+
+// getStorageType converts the configured storage into jetstream storage type.
+func getStorageType(storage string) (jetstream.StorageType, error) {
+	switch storage {
+	case "file":
+		return jetstream.FileStorage, nil
+	case "memory":
+		return jetstream.MemoryStorage, nil
+	default:
+		return 0, errors.Newf("unknown nats stream storage type %s", storage)
+	}
+}
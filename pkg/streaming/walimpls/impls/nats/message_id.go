@@ -0,0 +1,66 @@
+package nats
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+var _ message.MessageID = natsID(0)
+
+// UnmarshalMessageID unmarshal the message id.
+func UnmarshalMessageID(data string) (message.MessageID, error) {
+	id, err := unmarshalMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// unmarshalMessageID unmarshal the message id.
+func unmarshalMessageID(data string) (natsID, error) {
+	v, err := message.DecodeUint64(data)
+	if err != nil {
+		return 0, errors.Wrapf(message.ErrInvalidMessageID, "decode natsID fail with err: %s, id: %s", err.Error(), data)
+	}
+	return natsID(v), nil
+}
+
+// natsID is the message id for nats, it's the sequence of the message in the jetstream stream.
+type natsID uint64
+
+// NatsSeq returns the stream sequence of the message.
+func (id natsID) NatsSeq() uint64 {
+	return uint64(id)
+}
+
+// WALName returns the name of message id related wal.
+func (id natsID) WALName() string {
+	return WALName
+}
+
+// LT less than.
+func (id natsID) LT(other message.MessageID) bool {
+	return id < other.(natsID)
+}
+
+// LTE less than or equal to.
+func (id natsID) LTE(other message.MessageID) bool {
+	return id <= other.(natsID)
+}
+
+// EQ Equal to.
+func (id natsID) EQ(other message.MessageID) bool {
+	return id == other.(natsID)
+}
+
+// Marshal marshal the message id.
+func (id natsID) Marshal() string {
+	return message.EncodeUint64(uint64(id))
+}
+
+func (id natsID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
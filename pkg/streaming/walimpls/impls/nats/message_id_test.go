@@ -0,0 +1,30 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+func TestMessageID(t *testing.T) {
+	assert.Equal(t, uint64(1), message.MessageID(natsID(1)).(interface{ NatsSeq() uint64 }).NatsSeq())
+	assert.Equal(t, WALName, natsID(1).WALName())
+
+	assert.True(t, natsID(1).LT(natsID(2)))
+	assert.True(t, natsID(1).EQ(natsID(1)))
+	assert.True(t, natsID(1).LTE(natsID(1)))
+	assert.True(t, natsID(1).LTE(natsID(2)))
+	assert.False(t, natsID(2).LT(natsID(1)))
+	assert.False(t, natsID(2).EQ(natsID(1)))
+	assert.False(t, natsID(2).LTE(natsID(1)))
+	assert.True(t, natsID(2).LTE(natsID(2)))
+
+	msgID, err := UnmarshalMessageID(natsID(1).Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, natsID(1), msgID)
+
+	_, err = UnmarshalMessageID(string([]byte{0x01, 0x02, 0x03, 0x04}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package nats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(WALName)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, WALName, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(WALName, natsID(1).Marshal())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(natsID(1)))
+}
+
+func TestWAL(t *testing.T) {
+	s := runNatsServer(t)
+	defer s.Shutdown()
+
+	params := paramtable.Get()
+	for _, storage := range []string{"file", "memory"} {
+		t.Run(storage, func(t *testing.T) {
+			params.Save(params.NatsCfg.Server.Key, s.ClientURL())
+			params.Save(params.NatsCfg.StreamStorage.Key, storage)
+			defer func() {
+				params.Reset(params.NatsCfg.Server.Key)
+				params.Reset(params.NatsCfg.StreamStorage.Key)
+			}()
+			walimpls.NewWALImplsTestFramework(t, 100, &builderImpl{}).Run()
+		})
+	}
+}
+
+func TestBuildFailure(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.NatsCfg.StreamStorage.Key, "unknown")
+	_, err := (&builderImpl{}).Build()
+	assert.Error(t, err)
+	params.Reset(params.NatsCfg.StreamStorage.Key)
+
+	params.Save(params.NatsCfg.Server.Key, "nats://127.0.0.1:1")
+	params.Save(params.NatsCfg.ConnectTimeout.Key, "100ms")
+	defer func() {
+		params.Reset(params.NatsCfg.Server.Key)
+		params.Reset(params.NatsCfg.ConnectTimeout.Key)
+	}()
+	_, err = (&builderImpl{}).Build()
+	assert.Error(t, err)
+}
+
+// runNatsServer runs an in-process nats server with jetstream enabled.
+func runNatsServer(t *testing.T) *server.Server {
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	assert.NoError(t, err)
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	return s
+}
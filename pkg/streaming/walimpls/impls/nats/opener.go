@@ -0,0 +1,60 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+// openerImpl is the opener implementation for nats.
+// Every pchannel is mapped into a jetstream stream with the same name,
+// and the stream only has one subject that is also the pchannel name.
+type openerImpl struct {
+	conn     *nats.Conn
+	js       jetstream.JetStream
+	replicas int
+	storage  jetstream.StorageType
+}
+
+// Open opens a wal instance.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	var stream jetstream.Stream
+	var err error
+	if opt.Channel.AccessMode == types.AccessModeRW {
+		stream, err = o.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+			Name:      opt.Channel.Name,
+			Subjects:  []string{opt.Channel.Name},
+			Retention: jetstream.LimitsPolicy,
+			Storage:   o.storage,
+			Replicas:  o.replicas,
+		})
+	} else {
+		stream, err = o.js.Stream(ctx, opt.Channel.Name)
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to open nats stream", zap.String("channel", opt.Channel.Name), zap.Error(err))
+		return nil, err
+	}
+	return &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		js:        o.js,
+		stream:    stream,
+	}, nil
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {
+	o.conn.Close()
+}
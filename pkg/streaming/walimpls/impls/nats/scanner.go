@@ -0,0 +1,83 @@
+package nats
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner.
+func newScanner(scannerName string, iter jetstream.MessagesContext) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		iter:          iter,
+		msgChannel:    make(chan message.ImmutableMessage),
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for nats.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	iter       jetstream.MessagesContext
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	return s.ScannerHelper.Close()
+}
+
+// executeConsume consumes the message from the jetstream consumer.
+func (s *scannerImpl) executeConsume() (err error) {
+	defer func() {
+		s.Finish(err)
+		close(s.msgChannel)
+	}()
+	go func() {
+		// stop the iterator to wake up the blocking Next when the scanner is closed.
+		select {
+		case <-s.Context().Done():
+		case <-s.Done():
+		}
+		s.iter.Stop()
+	}()
+
+	for {
+		msg, err := s.iter.Next()
+		if err != nil {
+			if s.Context().Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "nats message iterator closed unexpectedly")
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return err
+		}
+		data := &messagespb.Message{}
+		if err := proto.Unmarshal(msg.Data(), data); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal nats message at sequence %d", meta.Sequence.Stream)
+		}
+		newMsg := message.NewImmutableMesasge(natsID(meta.Sequence.Stream), data.GetPayload(), data.GetProperties())
+		select {
+		case <-s.Context().Done():
+			return nil
+		case s.msgChannel <- newMsg:
+		}
+	}
+}
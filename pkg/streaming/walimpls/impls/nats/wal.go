@@ -0,0 +1,98 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+// walImpl is the implementation of walimpls.WALImpls for nats.
+type walImpl struct {
+	*helper.WALHelper
+	js     jetstream.JetStream
+	stream jetstream.Stream
+}
+
+// WALName returns the name of the wal.
+func (w *walImpl) WALName() string {
+	return WALName
+}
+
+// Append appends a message to the wal.
+// The payload and properties are encoded together into the data of nats message,
+// so the properties are not limited by the nats header format.
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	data, err := proto.Marshal(&messagespb.Message{
+		Payload:    msg.Payload(),
+		Properties: msg.Properties().ToRawMap(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ack, err := w.js.Publish(ctx, w.Channel().Name, data, jetstream.WithExpectStream(w.Channel().Name))
+	if err != nil {
+		return nil, err
+	}
+	return natsID(ack.Sequence), nil
+}
+
+// Read create a scanner to read the wal.
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (walimpls.ScannerImpls, error) {
+	cfg := jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{w.Channel().Name},
+	}
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		cfg.DeliverPolicy = jetstream.DeliverAllPolicy
+	case *streamingpb.DeliverPolicy_Latest:
+		cfg.DeliverPolicy = jetstream.DeliverNewPolicy
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		cfg.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = uint64(id)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		cfg.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = uint64(id) + 1
+	}
+	consumer, err := w.stream.OrderedConsumer(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := consumer.Messages(jetstream.PullMaxMessages(max(opt.ReadAheadBufferSize, 1)))
+	if err != nil {
+		return nil, err
+	}
+	return newScanner(opt.Name, iter), nil
+}
+
+// Truncate purges the messages before the given message id of the stream.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("truncate on a wal that is not in read-write mode")
+	}
+	return w.stream.Purge(ctx, jetstream.WithPurgeSequence(uint64(id.(natsID))))
+}
+
+// Close closes the wal instance.
+func (w *walImpl) Close() {
+}
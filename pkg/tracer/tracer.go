@@ -79,8 +79,8 @@ func SetTracerProvider(exp sdk.SpanExporter, traceIDRatio float64) {
 	otel.SetTracerProvider(tp)
 }
 
-// parseHeaders parses base64-encoded JSON headers string into map[string]string
-func parseHeaders(headers string) map[string]string {
+// ParseHeaders parses base64-encoded JSON headers string into map[string]string
+func ParseHeaders(headers string) map[string]string {
 	if headers == "" {
 		return nil
 	}
@@ -126,7 +126,7 @@ func CreateTracerExporter(params *paramtable.ComponentParam) (sdk.SpanExporter,
 			if !secure {
 				opts = append(opts, otlptracegrpc.WithInsecure())
 			}
-			if headersMap := parseHeaders(headers); headersMap != nil {
+			if headersMap := ParseHeaders(headers); headersMap != nil {
 				opts = append(opts, otlptracegrpc.WithHeaders(headersMap))
 			}
 			exp, err = otlptracegrpc.New(context.Background(), opts...)
@@ -137,7 +137,7 @@ func CreateTracerExporter(params *paramtable.ComponentParam) (sdk.SpanExporter,
 			if !secure {
 				opts = append(opts, otlptracehttp.WithInsecure())
 			}
-			if headersMap := parseHeaders(headers); headersMap != nil {
+			if headersMap := ParseHeaders(headers); headersMap != nil {
 				opts = append(opts, otlptracehttp.WithHeaders(headersMap))
 			}
 			exp, err = otlptracehttp.New(context.Background(), opts...)
@@ -32,6 +32,9 @@ const (
 	MetaStoreTypeEtcd = "etcd"
 	MetaStoreTypeTiKV = "tikv"
 
+	MetaSnapshotModeSuffix = "suffix"
+	MetaSnapshotModeMVCC   = "mvcc"
+
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 
@@ -105,6 +105,16 @@ type ProxyQuotaMetrics struct {
 	Hms          HardwareMetrics
 	Rms          []RateMetric
 	QueueMetrics []TaskQueueMetrics
+	UserMetrics  []UserQuotaMetrics
+}
+
+// UserQuotaMetrics contains the daily quota usage of a user.
+type UserQuotaMetrics struct {
+	User             string
+	DailyRequests    float64
+	MaxDailyRequests float64
+	DailyBytes       float64
+	MaxDailyBytes    float64
 }
 
 type QuotaCenterMetrics struct {
@@ -112,4 +122,6 @@ type QuotaCenterMetrics struct {
 	DataNodeMetrics  map[int64]*DataNodeQuotaMetrics
 	ProxyMetrics     map[int64]*ProxyQuotaMetrics
 	DataCoordMetrics *DataCoordQuotaMetrics
+	// UserMetrics is the daily quota usage of users summed up from all proxies.
+	UserMetrics map[string]*UserQuotaMetrics
 }
@@ -1532,6 +1532,8 @@ type AccessLogConfig struct {
 	RemotePath    ParamItem  `refreshable:"false"`
 	RemoteMaxTime ParamItem  `refreshable:"false"`
 	Formatter     ParamGroup `refreshable:"false"`
+	Format        ParamItem  `refreshable:"false"`
+	OtlpEnable    ParamItem  `refreshable:"false"`
 
 	CacheSize          ParamItem `refreshable:"false"`
 	CacheFlushInterval ParamItem `refreshable:"false"`
@@ -1588,6 +1590,19 @@ type proxyConfig struct {
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
 	SlowLogSpanInSeconds   ParamItem `refreshable:"true"`
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
+
+	SearchResultCacheEnabled       ParamItem `refreshable:"true"`
+	SearchResultCacheCapacity      ParamItem `refreshable:"false"`
+	SearchResultCacheTTL           ParamItem `refreshable:"false"`
+	SearchResultCacheMaxResultSize ParamItem `refreshable:"true"`
+
+	AdaptiveConcurrencyLimitEnabled     ParamItem `refreshable:"true"`
+	AdaptiveConcurrencyInitialLimit     ParamItem `refreshable:"false"`
+	AdaptiveConcurrencyMinLimit         ParamItem `refreshable:"true"`
+	AdaptiveConcurrencyMaxLimit         ParamItem `refreshable:"true"`
+	AdaptiveConcurrencyLatencyTolerance ParamItem `refreshable:"true"`
+	AdaptiveConcurrencyBackoffRatio     ParamItem `refreshable:"true"`
+	AdaptiveConcurrencyQueueNQThreshold ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1882,6 +1897,26 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.AccessLog.Format = ParamItem{
+		Key:          "proxy.accessLog.format",
+		Version:      "2.6.0",
+		DefaultValue: "text",
+		Doc: `The format of access logs, text or json.
+text: build logs with the formatters.
+json: write all the fields of each request as one JSON object per line, the formatters are ignored.`,
+		Export: true,
+	}
+	p.AccessLog.Format.Init(base.mgr)
+
+	p.AccessLog.OtlpEnable = ParamItem{
+		Key:          "proxy.accessLog.otlp.enable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to export access logs as OTLP log records, to the endpoint configured by trace.otlp.",
+		Export:       true,
+	}
+	p.AccessLog.OtlpEnable.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -2078,6 +2113,113 @@ Disabled if the value is less or equal to 0.`,
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.SearchResultCacheEnabled = ParamItem{
+		Key:          "proxy.searchResultCache.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: `Switch of the proxy side search result cache,
+only the collections with property collection.searchResultCache.enabled=true will be cached.
+The cached result is only returned if it's fresh enough for the consistency level of the request,
+so the search with strong consistency will never hit the cache.`,
+		Export: true,
+	}
+	p.SearchResultCacheEnabled.Init(base.mgr)
+
+	p.SearchResultCacheCapacity = ParamItem{
+		Key:          "proxy.searchResultCache.capacity",
+		Version:      "2.6.0",
+		DefaultValue: "1024",
+		Doc:          "The max number of search results cached in one proxy.",
+		Export:       true,
+	}
+	p.SearchResultCacheCapacity.Init(base.mgr)
+
+	p.SearchResultCacheTTL = ParamItem{
+		Key:          "proxy.searchResultCache.ttl",
+		Version:      "2.6.0",
+		DefaultValue: "60s",
+		Doc: `The max time a search result can be cached.
+The write from other proxies can only be seen after the ttl for the searches with eventually consistency.`,
+		Export: true,
+	}
+	p.SearchResultCacheTTL.Init(base.mgr)
+
+	p.SearchResultCacheMaxResultSize = ParamItem{
+		Key:          "proxy.searchResultCache.maxResultSize",
+		Version:      "2.6.0",
+		DefaultValue: "1048576",
+		Doc:          "The search result larger than this size in bytes will not be cached.",
+		Export:       true,
+	}
+	p.SearchResultCacheMaxResultSize.Init(base.mgr)
+
+	p.AdaptiveConcurrencyLimitEnabled = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: `Switch of the adaptive concurrency limit of search and query requests on each shard.
+The limit is adjusted by the latency of the shard requests and the queued nq of the query nodes,
+the requests exceeding the limit are rejected by the proxy with a retryable rate limit error.`,
+		Export: true,
+	}
+	p.AdaptiveConcurrencyLimitEnabled.Init(base.mgr)
+
+	p.AdaptiveConcurrencyInitialLimit = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.initialLimit",
+		Version:      "2.6.0",
+		DefaultValue: "64",
+		Doc:          "The initial concurrency limit of each shard.",
+		Export:       true,
+	}
+	p.AdaptiveConcurrencyInitialLimit.Init(base.mgr)
+
+	p.AdaptiveConcurrencyMinLimit = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.minLimit",
+		Version:      "2.6.0",
+		DefaultValue: "4",
+		Doc:          "The concurrency limit of each shard will not be decreased below this value.",
+		Export:       true,
+	}
+	p.AdaptiveConcurrencyMinLimit.Init(base.mgr)
+
+	p.AdaptiveConcurrencyMaxLimit = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.maxLimit",
+		Version:      "2.6.0",
+		DefaultValue: "1024",
+		Doc:          "The concurrency limit of each shard will not be increased above this value.",
+		Export:       true,
+	}
+	p.AdaptiveConcurrencyMaxLimit.Init(base.mgr)
+
+	p.AdaptiveConcurrencyLatencyTolerance = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.latencyTolerance",
+		Version:      "2.6.0",
+		DefaultValue: "2",
+		Doc: `The shard is considered overloaded if the latency of a request is higher than
+the long term average latency multiplied by this factor.`,
+		Export: true,
+	}
+	p.AdaptiveConcurrencyLatencyTolerance.Init(base.mgr)
+
+	p.AdaptiveConcurrencyBackoffRatio = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.backoffRatio",
+		Version:      "2.6.0",
+		DefaultValue: "0.9",
+		Doc:          "The concurrency limit is multiplied by this ratio when the shard is overloaded, should be in (0, 1).",
+		Export:       true,
+	}
+	p.AdaptiveConcurrencyBackoffRatio.Init(base.mgr)
+
+	p.AdaptiveConcurrencyQueueNQThreshold = ParamItem{
+		Key:          "proxy.adaptiveConcurrencyLimit.queueNQThreshold",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc: `The shard is considered overloaded if the total nq queued in the serving query node is higher than this value,
+0 means the queued nq is not taken into account.`,
+		Export: true,
+	}
+	p.AdaptiveConcurrencyQueueNQThreshold.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -4021,6 +4163,9 @@ type dataCoordConfig struct {
 	GCRemoveConcurrent          ParamItem `refreshable:"false"`
 	GCScanIntervalInHour        ParamItem `refreshable:"false"`
 	GCSlowDownCPUUsageThreshold ParamItem `refreshable:"false"`
+	GCMode                      ParamItem `refreshable:"false"`
+	GCTrashRetention            ParamItem `refreshable:"true"`
+	GCReportSampleNum           ParamItem `refreshable:"true"`
 	EnableActiveStandby         ParamItem `refreshable:"false"`
 
 	BindIndexNodeMode    ParamItem `refreshable:"false"`
@@ -4744,6 +4889,37 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCRemoveConcurrent.Init(base.mgr)
 
+	p.GCMode = ParamItem{
+		Key:          "dataCoord.gc.mode",
+		Version:      "2.6.0",
+		DefaultValue: "delete",
+		Doc: `The mode of garbage collection when removing the unused objects, options: [delete, dryRun, quarantine].
+delete: remove the objects immediately.
+dryRun: keep the objects and the meta, only report the objects that would be removed.
+quarantine: move the objects to the trash prefix, they are removed after the trash retention.
+The mode can be changed at runtime through the management api.`,
+		Export: true,
+	}
+	p.GCMode.Init(base.mgr)
+
+	p.GCTrashRetention = ParamItem{
+		Key:          "dataCoord.gc.trashRetention",
+		Version:      "2.6.0",
+		DefaultValue: "604800",
+		Doc:          "The retention duration of the objects moved to the trash prefix in quarantine mode before they are removed, unit: second.",
+		Export:       true,
+	}
+	p.GCTrashRetention.Init(base.mgr)
+
+	p.GCReportSampleNum = ParamItem{
+		Key:          "dataCoord.gc.reportSampleNum",
+		Version:      "2.6.0",
+		DefaultValue: "100",
+		Doc:          "The max number of sample object paths kept for each category in the garbage collection report.",
+		Export:       false,
+	}
+	p.GCReportSampleNum.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -5717,6 +5893,9 @@ type streamingConfig struct {
 	WALBalancerPolicyVChannelFairAntiAffinityWeight     ParamItem `refreshable:"true"`
 	WALBalancerPolicyVChannelFairRebalanceTolerance     ParamItem `refreshable:"true"`
 	WALBalancerPolicyVChannelFairRebalanceMaxStep       ParamItem `refreshable:"true"`
+	WALBalancerPolicyResourceGroupNodeLabel             ParamItem `refreshable:"true"`
+	WALBalancerPolicyResourceGroupPChannels             ParamItem `refreshable:"true"`
+	WALBalancerPolicyMinMovementMaxReassignPerRound     ParamItem `refreshable:"true"`
 
 	// broadcaster
 	WALBroadcasterConcurrencyRatio ParamItem `refreshable:"false"`
@@ -5797,7 +5976,7 @@ If the operation exceeds this timeout, it will be canceled.`,
 	p.WALBalancerPolicyName = ParamItem{
 		Key:          "streaming.walBalancer.balancePolicy.name",
 		Version:      "2.6.0",
-		Doc:          "The multiplier of balance task trigger backoff, 2 by default",
+		Doc:          "The name of balance policy, options: vchannelFair, resourceGroupAware, minMovement, vchannelFair by default",
 		DefaultValue: "vchannelFair",
 		Export:       true,
 	}
@@ -5884,6 +6063,38 @@ it also determine the depth of depth first search method that is used to find th
 	}
 	p.WALBalancerPolicyVChannelFairRebalanceMaxStep.Init(base.mgr)
 
+	p.WALBalancerPolicyResourceGroupNodeLabel = ParamItem{
+		Key:     "streaming.walBalancer.balancePolicy.resourceGroup.nodeLabel",
+		Version: "2.6.0",
+		Doc: `The server label of streaming node that indicates the resource group of it in resourceGroupAware balance policy,
+the label can be set by the environment variable MILVUS_SERVER_LABEL_<nodeLabel> of streaming node, RESOURCE_GROUP by default`,
+		DefaultValue: "RESOURCE_GROUP",
+		Export:       true,
+	}
+	p.WALBalancerPolicyResourceGroupNodeLabel.Init(base.mgr)
+
+	p.WALBalancerPolicyResourceGroupPChannels = ParamItem{
+		Key:     "streaming.walBalancer.balancePolicy.resourceGroup.pchannels",
+		Version: "2.6.0",
+		Doc: `The json map from pchannel name to resource group in resourceGroupAware balance policy, should be quoted as a string in yaml, e.g. '{"by-dev-rootcoord-dml_0": "rg1"}'.
+The pchannel will be pinned to the streaming nodes of its resource group,
+the pchannel that is not in the map will be assigned to the streaming nodes without resource group label.
+If there's no available streaming node in the resource group, the pchannel will fallback to the nodes without resource group label.`,
+		DefaultValue: "{}",
+		Export:       true,
+	}
+	p.WALBalancerPolicyResourceGroupPChannels.Init(base.mgr)
+
+	p.WALBalancerPolicyMinMovementMaxReassignPerRound = ParamItem{
+		Key:     "streaming.walBalancer.balancePolicy.minMovement.maxReassignPerRound",
+		Version: "2.6.0",
+		Doc: `The max count of pchannels that can be moved from one streaming node to another in one balance round of minMovement balance policy,
+a balance round lasts for streaming.walBalancer.triggerInterval, the assignment of lost pchannels is not limited, 1 by default`,
+		DefaultValue: "1",
+		Export:       true,
+	}
+	p.WALBalancerPolicyMinMovementMaxReassignPerRound.Init(base.mgr)
+
 	p.WALBroadcasterConcurrencyRatio = ParamItem{
 		Key:          "streaming.walBroadcaster.concurrencyRatio",
 		Version:      "2.5.4",
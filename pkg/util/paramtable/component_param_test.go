@@ -535,6 +535,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.6, Params.GCSlowDownCPUUsageThreshold.GetAsFloat())
 		params.Save("dataCoord.gc.slowDownCPUUsageThreshold", "0.5")
 		assert.Equal(t, 0.5, Params.GCSlowDownCPUUsageThreshold.GetAsFloat())
+		assert.Equal(t, "delete", Params.GCMode.GetValue())
+		assert.Equal(t, 7*24*time.Hour, Params.GCTrashRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 100, Params.GCReportSampleNum.GetAsInt())
 		params.Save("dataCoord.compaction.gcInterval", "100")
 		assert.Equal(t, float64(100), Params.CompactionGCIntervalInSeconds.GetAsDuration(time.Second).Seconds())
 		params.Save("dataCoord.compaction.dropTolerance", "100")
@@ -68,6 +68,14 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your VertexAI embedding url"
 			case "vertexai.credential":
 				return "The name in the crendential configuration item"
+			case "onnx.enable":
+				return "Whether to enable local onnx model inference"
+			case "onnx.library_path":
+				return "Path of the onnxruntime shared library, use the library of the system if empty"
+			case "onnx.cache_dir":
+				return "Local directory to cache the models downloaded from object storage, default is ${localStorage.path}/onnx_models"
+			case "onnx.intra_op_threads":
+				return "Number of threads used by each onnx model, use the default of onnxruntime if 0"
 			default:
 				return ""
 			}
@@ -85,6 +93,30 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Whether to enable TEI rerank service"
 			case "vllm.enable":
 				return "Whether to enable vllm rerank service"
+			case "cohere.enable":
+				return "Whether to enable cohere rerank service"
+			case "cohere.credential":
+				return "The name in the crendential configuration item"
+			case "cohere.url":
+				return "Your cohere rerank url, Default is the official rerank url"
+			case "voyageai.enable":
+				return "Whether to enable voyageai rerank service"
+			case "voyageai.credential":
+				return "The name in the crendential configuration item"
+			case "voyageai.url":
+				return "Your voyageai rerank url, Default is the official rerank url"
+			case "jina.enable":
+				return "Whether to enable jina rerank service"
+			case "jina.credential":
+				return "The name in the crendential configuration item"
+			case "jina.url":
+				return "Your jina rerank url, Default is the official rerank url"
+			case "openai_compatible.enable":
+				return "Whether to enable OpenAI-compatible rerank service"
+			case "openai_compatible.credential":
+				return "The name in the crendential configuration item"
+			case "openai_compatible.url":
+				return "Url of your OpenAI-compatible rerank service, e.g. http://localhost:8000/v1/rerank"
 			default:
 				return ""
 			}
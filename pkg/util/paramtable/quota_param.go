@@ -127,6 +127,15 @@ type quotaConfig struct {
 	DQLMaxQueryRatePerPartition   ParamItem `refreshable:"true"`
 	DQLMinQueryRatePerPartition   ParamItem `refreshable:"true"`
 
+	// user
+	UserLimitEnabled     ParamItem `refreshable:"true"`
+	UserDMLMaxRate       ParamItem `refreshable:"true"`
+	UserDQLMaxRate       ParamItem `refreshable:"true"`
+	UserMaxDailyRequests ParamItem `refreshable:"true"`
+	UserMaxDailyBytes    ParamItem `refreshable:"true"`
+	UserLimitsPerUser    ParamItem `refreshable:"true"`
+	UserLimitsPerRole    ParamItem `refreshable:"true"`
+
 	// limits
 	MaxCollectionNum               ParamItem `refreshable:"true"`
 	MaxCollectionNumPerDB          ParamItem `refreshable:"true"`
@@ -1585,6 +1594,132 @@ To use this setting, set quotaAndLimits.dql.enabled to true at the same time.`,
 	}
 	p.DQLMinQueryRatePerPartition.Init(base.mgr)
 
+	// user
+	p.UserLimitEnabled = ParamItem{
+		Key:          "quotaAndLimits.user.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: `Whether the per-user request throttling is enabled.
+The user level limits are checked by each proxy for the authenticated user of the request,
+so authorization must be enabled at the same time.
+The limits are the total of the cluster, the rates are split across proxies by rootcoord,
+and the daily usage is accumulated and persisted by rootcoord.`,
+		Export: true,
+	}
+	p.UserLimitEnabled.Init(base.mgr)
+
+	p.UserDMLMaxRate = ParamItem{
+		Key:          "quotaAndLimits.user.dmlRate.max",
+		Version:      "2.6.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.UserLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc: `Highest data insertion, upsert and deletion rate per second of each user, in MB/s, default no limit.
+To use this setting, set quotaAndLimits.user.enabled to true at the same time.`,
+		Export: true,
+	}
+	p.UserDMLMaxRate.Init(base.mgr)
+
+	p.UserDQLMaxRate = ParamItem{
+		Key:          "quotaAndLimits.user.dqlRate.max",
+		Version:      "2.6.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.UserLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc: `Maximum number of vectors to search and queries per second of each user, default no limit.
+To use this setting, set quotaAndLimits.user.enabled to true at the same time.`,
+		Export: true,
+	}
+	p.UserDQLMaxRate.Init(base.mgr)
+
+	p.UserMaxDailyRequests = ParamItem{
+		Key:          "quotaAndLimits.user.dailyRequests.max",
+		Version:      "2.6.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.UserLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc: `Maximum number of dml and dql requests of each user per day (UTC), default no limit.
+To use this setting, set quotaAndLimits.user.enabled to true at the same time.`,
+		Export: true,
+	}
+	p.UserMaxDailyRequests.Init(base.mgr)
+
+	p.UserMaxDailyBytes = ParamItem{
+		Key:          "quotaAndLimits.user.dailyBytes.max",
+		Version:      "2.6.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.UserLimitEnabled.GetAsBool() {
+				return max
+			}
+			size := getAsFloat(v)
+			if math.Abs(size-defaultMax) > 0.001 { // maxSize != defaultMax
+				size = megaBytes2Bytes(size)
+			}
+			// [0, inf)
+			if size < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", size)
+		},
+		Doc: `Maximum size of the data written by dml requests of each user per day (UTC), in MB, default no limit.
+To use this setting, set quotaAndLimits.user.enabled to true at the same time.`,
+		Export: true,
+	}
+	p.UserMaxDailyBytes.Init(base.mgr)
+
+	p.UserLimitsPerUser = ParamItem{
+		Key:          "quotaAndLimits.user.limits.users",
+		Version:      "2.6.0",
+		DefaultValue: "{}",
+		Doc: `The user level limits overriding the defaults for specific users, in json format.
+The keys of the json object are user names, and the values are objects with optional fields
+"dmlRate" (MB/s), "dqlRate" (vps), "dailyRequests" and "dailyBytes" (MB), negative value means no limit.
+For example: '{"alice": {"dqlRate": 100, "dailyRequests": 100000}}', the value should be quoted in yaml.`,
+		Export: true,
+	}
+	p.UserLimitsPerUser.Init(base.mgr)
+
+	p.UserLimitsPerRole = ParamItem{
+		Key:          "quotaAndLimits.user.limits.roles",
+		Version:      "2.6.0",
+		DefaultValue: "{}",
+		Doc: `The user level limits overriding the defaults for the users granted specific roles, in json format, keyed by role name.
+The format of the values is the same as quotaAndLimits.user.limits.users.
+The limits of a specific user take precedence over the limits of its roles,
+and the most permissive limit is used if the user is granted multiple roles.`,
+		Export: true,
+	}
+	p.UserLimitsPerRole.Init(base.mgr)
+
 	// limits
 	p.MaxCollectionNum = ParamItem{
 		Key:          "quotaAndLimits.limits.maxCollectionNum",
@@ -50,6 +50,8 @@ type ServiceParam struct {
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
+	FileWALCfg      FileWALConfig
+	NatsCfg         NatsConfig
 	MinioCfg        MinioConfig
 	ProfileCfg      ProfileConfig
 }
@@ -64,6 +66,8 @@ func (p *ServiceParam) init(bt *BaseTable) {
 	p.PulsarCfg.Init(bt)
 	p.KafkaCfg.Init(bt)
 	p.RocksmqCfg.Init(bt)
+	p.FileWALCfg.Init(bt)
+	p.NatsCfg.Init(bt)
 	p.MinioCfg.Init(bt)
 	p.ProfileCfg.Init(bt)
 }
@@ -475,6 +479,7 @@ type MetaStoreConfig struct {
 	MetaStoreType              ParamItem `refreshable:"false"`
 	SnapshotTTLSeconds         ParamItem `refreshable:"true"`
 	SnapshotReserveTimeSeconds ParamItem `refreshable:"true"`
+	SnapshotMode               ParamItem `refreshable:"false"`
 	PaginationSize             ParamItem `refreshable:"true"`
 	ReadConcurrency            ParamItem `refreshable:"true"`
 }
@@ -507,6 +512,19 @@ func (p *MetaStoreConfig) Init(base *BaseTable) {
 	}
 	p.SnapshotReserveTimeSeconds.Init(base.mgr)
 
+	p.SnapshotMode = ParamItem{
+		Key:          "metastore.snapshot.mode",
+		Version:      "2.6.0",
+		DefaultValue: util.MetaSnapshotModeSuffix,
+		Doc: `Default value: suffix, Valid values: [suffix, mvcc]
+suffix: keeps a copy of the meta for each timestamp under the snapshots prefix.
+mvcc: reads the history by the revisions of etcd or the timestamps of TiKV, milvus never compacts the meta store itself.
+Notice that the auto compaction of etcd or the GC life time of TiKV should retain the history for at least the snapshot ttl in mvcc mode,
+run the migration tool with the snapshot-mvcc command to switch the existing meta on etcd from suffix to mvcc.`,
+		Export: true,
+	}
+	p.SnapshotMode.Init(base.mgr)
+
 	p.PaginationSize = ParamItem{
 		Key:          "metastore.paginationSize",
 		Version:      "2.5.1",
@@ -557,7 +575,8 @@ func (p *MQConfig) Init(base *BaseTable) {
 		Version:      "2.3.0",
 		DefaultValue: "default",
 		Doc: `Default value: "default"
-Valid values: [default, pulsar, kafka, rocksmq, woodpecker]`,
+Valid values: [default, pulsar, kafka, rocksmq, woodpecker, filewal, nats]
+filewal is only valid in standalone mode, filewal and nats should be selected explicitly.`,
 		Export: true,
 	}
 	p.Type.Init(base.mgr)
@@ -1244,6 +1263,109 @@ Set an easy-to-identify root key prefix for Milvus if etcd service already exist
 	r.CompressionTypes.Init(base.mgr)
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- filewal ---
+type FileWALConfig struct {
+	Path           ParamItem `refreshable:"false"`
+	SegmentMaxSize ParamItem `refreshable:"false"`
+	FsyncPolicy    ParamItem `refreshable:"false"`
+	FsyncInterval  ParamItem `refreshable:"false"`
+}
+
+func (p *FileWALConfig) Init(base *BaseTable) {
+	p.Path = ParamItem{
+		Key:          "filewal.path",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc:          "The folder that storing the segment files of the embedded file-based wal, by default will use localStoragePath/filewal",
+		Formatter: func(v string) string {
+			if len(v) == 0 {
+				return path.Join(base.Get("localStorage.path"), "filewal")
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.Path.Init(base.mgr)
+
+	p.SegmentMaxSize = ParamItem{
+		Key:          "filewal.segmentMaxSize",
+		Version:      "2.6.0",
+		DefaultValue: "64M",
+		Doc:          "The maximum size of a segment file of the file-based wal, a new segment file will be rolled once the size is exceeded.",
+		Export:       true,
+	}
+	p.SegmentMaxSize.Init(base.mgr)
+
+	p.FsyncPolicy = ParamItem{
+		Key:          "filewal.fsync.policy",
+		Version:      "2.6.0",
+		DefaultValue: "interval",
+		Doc: `The fsync policy of the file-based wal, options: always, interval, never.
+always: fsync the segment file before every append returns.
+interval: fsync the segment file in background with filewal.fsync.interval.
+never: never fsync on append, rely on the operating system to flush the page cache, a segment file is still synced when it is sealed.`,
+		Export: true,
+	}
+	p.FsyncPolicy.Init(base.mgr)
+
+	p.FsyncInterval = ParamItem{
+		Key:          "filewal.fsync.interval",
+		Version:      "2.6.0",
+		DefaultValue: "200ms",
+		Doc:          "The interval of background fsync of the file-based wal, only take effect when filewal.fsync.policy is interval.",
+		Export:       true,
+	}
+	p.FsyncInterval.Init(base.mgr)
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- nats ---
+type NatsConfig struct {
+	Server         ParamItem `refreshable:"false"`
+	ConnectTimeout ParamItem `refreshable:"false"`
+	StreamReplicas ParamItem `refreshable:"false"`
+	StreamStorage  ParamItem `refreshable:"false"`
+}
+
+func (p *NatsConfig) Init(base *BaseTable) {
+	p.Server = ParamItem{
+		Key:          "nats.server",
+		Version:      "2.6.0",
+		DefaultValue: "nats://localhost:4222",
+		Doc:          "The url of nats server with jetstream enabled, multiple urls can be separated by comma.",
+		Export:       true,
+	}
+	p.Server.Init(base.mgr)
+
+	p.ConnectTimeout = ParamItem{
+		Key:          "nats.connectTimeout",
+		Version:      "2.6.0",
+		DefaultValue: "10s",
+		Doc:          "The timeout of connecting to the nats server.",
+		Export:       true,
+	}
+	p.ConnectTimeout.Init(base.mgr)
+
+	p.StreamReplicas = ParamItem{
+		Key:          "nats.stream.replicas",
+		Version:      "2.6.0",
+		DefaultValue: "1",
+		Doc:          "The replica number of the jetstream stream created for each pchannel.",
+		Export:       true,
+	}
+	p.StreamReplicas.Init(base.mgr)
+
+	p.StreamStorage = ParamItem{
+		Key:          "nats.stream.storage",
+		Version:      "2.6.0",
+		DefaultValue: "file",
+		Doc:          "The storage type of the jetstream stream created for each pchannel, options: file, memory.",
+		Export:       true,
+	}
+	p.StreamStorage.Init(base.mgr)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- minio ---
 type MinioConfig struct {
@@ -32,6 +32,11 @@ func TestGetQuotaErrorString(t *testing.T) {
 			args: commonpb.ErrorCode_TimeTickLongDelay,
 			want: "time tick long delay",
 		},
+		{
+			name: "Test ErrorCode_RateLimit",
+			args: commonpb.ErrorCode_RateLimit,
+			want: "daily quota of the user exceeded, please retry in the next day",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {